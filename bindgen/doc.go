@@ -0,0 +1,36 @@
+// Package bindgen generates typed Go bindings from WIT.
+//
+// Given a WIT world, Generate emits one Go source file containing:
+//
+//   - Go types for the world's records, variants, enums, flags and
+//     resources, laid out so transcoder.Compiler accepts them directly
+//   - an interface per imported WIT interface together with a
+//     constructor returning a runtime.Host for Runtime.RegisterHost
+//   - an Exports client with a typed method per exported function,
+//     calling through runtime.Instance.Invoke
+//
+// # Usage
+//
+// The cmd/bindgen command wraps the package and is intended for
+// go:generate directives:
+//
+//	//go:generate go run github.com/wippyai/wasm-runtime/cmd/bindgen -wit app.wasm -out bindings.go
+//
+// Input may be a .wit file, a directory of WIT packages, a component
+// binary with embedded WIT, or a wasm-tools JSON resolve.
+//
+// # Type Mapping
+//
+//	record        struct with `wit:"name"` field tags
+//	variant       struct with one pointer field per case (*struct{} for unit cases)
+//	enum          uint8/uint16/uint32 with one constant per case
+//	flags         uint8..uint64 with one bit constant per flag
+//	resource      named uint32 handle
+//	option<T>     *T
+//	result<T, E>  Result[T, E] with Ok and Err pointers
+//	tuple<...>    TupleN[...]
+//	list<T>       []T
+//
+// Imported resources get a Drop<Resource> method on the host interface that
+// is registered as the resource's [resource-drop] import.
+package bindgen
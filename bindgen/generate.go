@@ -0,0 +1,844 @@
+package bindgen
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"go.bytecodealliance.org/wit"
+
+	"github.com/wippyai/wasm-runtime/errors"
+)
+
+// RootNamespace is the host namespace used for functions imported directly
+// by a world rather than through an interface.
+const RootNamespace = "$root"
+
+// Options configures Generate.
+type Options struct {
+	// Package is the Go package name of the generated file. Defaults to "bindings".
+	Package string
+	// World selects the world by name ("app") or qualified name
+	// ("ns:pkg/app", optionally with "@version"). Empty selects the last
+	// world in the Resolve: the root world of a component, or the world of
+	// the main package of a WIT directory.
+	World string
+}
+
+// Generate emits Go bindings for one world of res as a formatted Go source file.
+func Generate(res *wit.Resolve, opts Options) ([]byte, error) {
+	w, err := selectWorld(res, opts.World)
+	if err != nil {
+		return nil, err
+	}
+	pkg := opts.Package
+	if pkg == "" {
+		pkg = "bindings"
+	}
+
+	g := &generator{
+		world: w,
+		names: make(map[*wit.TypeDef]string),
+		seen:  make(map[*wit.TypeDef]bool),
+	}
+	g.collect()
+	g.assignNames()
+
+	if err := g.emit(); err != nil {
+		return nil, err
+	}
+
+	var out bytes.Buffer
+	fmt.Fprintf(&out, "// Code generated by bindgen from %s. DO NOT EDIT.\n\n", worldID(w))
+	fmt.Fprintf(&out, "package %s\n\n", pkg)
+	switch {
+	case g.usesContext && g.usesRuntime:
+		out.WriteString("import (\n\t\"context\"\n\n\t\"github.com/wippyai/wasm-runtime/runtime\"\n)\n\n")
+	case g.usesRuntime:
+		out.WriteString("import \"github.com/wippyai/wasm-runtime/runtime\"\n\n")
+	}
+	out.Write(g.buf.Bytes())
+
+	src, err := format.Source(out.Bytes())
+	if err != nil {
+		return nil, errors.Wrap(errors.PhaseCompile, errors.KindInvalidData, err, "format generated bindings")
+	}
+	return src, nil
+}
+
+// worldInterface is a group of functions bound as one Go interface (imports)
+// or one client type (exports).
+type worldInterface struct {
+	iface     *wit.Interface // nil for functions declared directly in the world
+	namespace string         // host namespace for imports, export name prefix for exports
+	key       string         // world item name
+	funcs     []*wit.Function
+	resources []*wit.TypeDef // resources declared by iface, for drop handlers
+	decl      *nameDecl
+}
+
+type generator struct {
+	world       *wit.World
+	names       map[*wit.TypeDef]string
+	seen        map[*wit.TypeDef]bool
+	types       []*wit.TypeDef // named TypeDefs declared in the output, in order
+	uses        []*wit.TypeDef // aliases that reuse the Go name of their target
+	typeDecls   map[*wit.TypeDef]*nameDecl
+	imports     []*worldInterface
+	exports     []*worldInterface
+	rootImports *worldInterface
+	rootExports *worldInterface
+	tuples      map[int]bool // arities of Tuple types in use
+	buf         bytes.Buffer
+	usesContext bool
+	usesRuntime bool
+	usesResult  bool
+}
+
+// maxTuple bounds the arity of the generated TupleN helper types.
+const maxTuple = 16
+
+func selectWorld(res *wit.Resolve, name string) (*wit.World, error) {
+	if len(res.Worlds) == 0 {
+		return nil, errors.NotFound(errors.PhaseCompile, "world", name)
+	}
+	if name == "" {
+		return res.Worlds[len(res.Worlds)-1], nil
+	}
+	for _, w := range res.Worlds {
+		id := worldID(w)
+		if w.Name == name || id == name || strings.SplitN(id, "@", 2)[0] == name {
+			return w, nil
+		}
+	}
+	return nil, errors.NotFound(errors.PhaseCompile, "world", name)
+}
+
+// worldID returns the qualified name of w: ns:pkg/world@version.
+func worldID(w *wit.World) string {
+	if w.Package == nil {
+		return w.Name
+	}
+	return qualify(w.Package.Name, w.Name)
+}
+
+// interfaceID returns the name the component model uses for an interface
+// import or export: ns:pkg/iface@version, or the world item name for
+// interfaces declared inline in a world.
+func interfaceID(key string, iface *wit.Interface) string {
+	if iface.Name == nil || iface.Package == nil {
+		return key
+	}
+	return qualify(iface.Package.Name, *iface.Name)
+}
+
+func qualify(id wit.Ident, name string) string {
+	s := id.Namespace + ":" + id.Package + "/" + name
+	if id.Version != nil {
+		s += "@" + id.Version.String()
+	}
+	return s
+}
+
+// collect walks the world in declaration order, grouping functions and
+// gathering every named type they reach.
+func (g *generator) collect() {
+	g.rootImports = &worldInterface{namespace: RootNamespace}
+	g.rootExports = &worldInterface{}
+
+	walk := func(items func(func(string, wit.WorldItem) bool), root *worldInterface, out *[]*worldInterface) {
+		items(func(key string, item wit.WorldItem) bool {
+			switch it := item.(type) {
+			case *wit.InterfaceRef:
+				wi := &worldInterface{
+					iface:     it.Interface,
+					namespace: interfaceID(key, it.Interface),
+					key:       key,
+				}
+				it.Interface.TypeDefs.All()(func(_ string, td *wit.TypeDef) bool {
+					g.visit(td)
+					if _, ok := td.Kind.(*wit.Resource); ok {
+						wi.resources = append(wi.resources, td)
+					}
+					return true
+				})
+				it.Interface.Functions.All()(func(_ string, f *wit.Function) bool {
+					g.visitFunc(f)
+					wi.funcs = append(wi.funcs, f)
+					return true
+				})
+				if len(wi.funcs) > 0 || len(wi.resources) > 0 {
+					*out = append(*out, wi)
+				}
+			case *wit.Function:
+				g.visitFunc(it)
+				root.funcs = append(root.funcs, it)
+			case *wit.TypeDef:
+				g.visit(it)
+			}
+			return true
+		})
+	}
+	walk(g.world.Imports.All(), g.rootImports, &g.imports)
+	walk(g.world.Exports.All(), g.rootExports, &g.exports)
+}
+
+func (g *generator) visitFunc(f *wit.Function) {
+	for _, p := range f.Params {
+		g.visit(p.Type)
+	}
+	for _, r := range f.Results {
+		g.visit(r.Type)
+	}
+}
+
+func (g *generator) visit(t wit.Type) {
+	td, ok := t.(*wit.TypeDef)
+	if !ok || g.seen[td] {
+		return
+	}
+	g.seen[td] = true
+
+	if target, ok := td.Kind.(*wit.TypeDef); ok {
+		g.visit(target)
+		if td.Name != nil && *td.Name == target.TypeName() {
+			g.uses = append(g.uses, td)
+		} else if td.Name != nil {
+			g.types = append(g.types, td)
+		}
+		return
+	}
+	if td.Name != nil {
+		g.types = append(g.types, td)
+	}
+
+	switch k := td.Kind.(type) {
+	case *wit.Record:
+		for _, f := range k.Fields {
+			g.visit(f.Type)
+		}
+	case *wit.Variant:
+		for _, c := range k.Cases {
+			if c.Type != nil {
+				g.visit(c.Type)
+			}
+		}
+	case *wit.List:
+		g.visit(k.Type)
+	case *wit.Option:
+		g.visit(k.Type)
+	case *wit.Result:
+		if k.OK != nil {
+			g.visit(k.OK)
+		}
+		if k.Err != nil {
+			g.visit(k.Err)
+		}
+	case *wit.Tuple:
+		for _, et := range k.Types {
+			g.visit(et)
+		}
+	case *wit.Own:
+		g.visit(k.Type)
+	case *wit.Borrow:
+		g.visit(k.Type)
+	}
+}
+
+// nameDecl is a top-level Go identifier with progressively more qualified
+// spellings, used when shorter ones collide.
+type nameDecl struct {
+	candidates []string
+	level      int
+	final      string
+}
+
+func (d *nameDecl) name() string { return d.candidates[d.level] }
+
+// reservedNames are fixed identifiers emitted for world-level functions
+// and the generic Result and TupleN helpers.
+var reservedNames = func() map[string]bool {
+	m := map[string]bool{
+		"Exports":        true,
+		"NewExports":     true,
+		"Imports":        true,
+		"NewImportsHost": true,
+		"Result":         true,
+	}
+	for i := 1; i <= maxTuple; i++ {
+		m["Tuple"+strconv.Itoa(i)] = true
+	}
+	return m
+}()
+
+func (g *generator) assignNames() {
+	var decls []*nameDecl
+	g.typeDecls = make(map[*wit.TypeDef]*nameDecl, len(g.types))
+	for _, td := range g.types {
+		d := &nameDecl{candidates: typeCandidates(td)}
+		g.typeDecls[td] = d
+		decls = append(decls, d)
+	}
+	for _, wi := range append(append([]*worldInterface{}, g.imports...), g.exports...) {
+		wi.decl = &nameDecl{candidates: interfaceCandidates(wi)}
+		decls = append(decls, wi.decl)
+	}
+
+	for changed := true; changed; {
+		changed = false
+		byName := make(map[string][]*nameDecl)
+		for _, d := range decls {
+			byName[d.name()] = append(byName[d.name()], d)
+		}
+		for name, group := range byName {
+			if len(group) < 2 && !reservedNames[name] {
+				continue
+			}
+			for _, d := range group {
+				if d.level < len(d.candidates)-1 {
+					d.level++
+					changed = true
+				}
+			}
+		}
+	}
+
+	used := make(map[string]bool, len(decls)+len(reservedNames))
+	for name := range reservedNames {
+		used[name] = true
+	}
+	for _, d := range decls {
+		name := d.name()
+		for i := 2; used[name]; i++ {
+			name = d.name() + strconv.Itoa(i)
+		}
+		used[name] = true
+		d.final = name
+	}
+
+	for td, d := range g.typeDecls {
+		g.names[td] = d.final
+	}
+	for _, td := range g.uses {
+		target := td
+		for {
+			if name, ok := g.names[target]; ok {
+				g.names[td] = name
+				break
+			}
+			next, ok := target.Kind.(*wit.TypeDef)
+			if !ok {
+				break
+			}
+			target = next
+		}
+	}
+}
+
+func typeCandidates(td *wit.TypeDef) []string {
+	base := pascal(td.TypeName())
+	switch owner := td.Owner.(type) {
+	case *wit.Interface:
+		if owner.Name == nil {
+			return []string{base}
+		}
+		iface := pascal(*owner.Name)
+		if owner.Package == nil {
+			return []string{base, iface + base}
+		}
+		id := owner.Package.Name
+		return []string{base, iface + base, pascal(id.Package) + iface + base, pascal(id.Namespace) + pascal(id.Package) + iface + base}
+	case *wit.World:
+		return []string{base, pascal(owner.Name) + base}
+	}
+	return []string{base}
+}
+
+func interfaceCandidates(wi *worldInterface) []string {
+	iface := wi.iface
+	if iface.Name == nil || iface.Package == nil {
+		return []string{pascal(wi.key)}
+	}
+	name := pascal(*iface.Name)
+	id := iface.Package.Name
+	return []string{name, pascal(id.Package) + name, pascal(id.Namespace) + pascal(id.Package) + name}
+}
+
+func (g *generator) emit() error {
+	for _, td := range g.types {
+		if err := g.emitTypeDef(td); err != nil {
+			return err
+		}
+	}
+	if len(g.rootImports.funcs) > 0 {
+		if err := g.emitImport(g.rootImports, "Imports"); err != nil {
+			return err
+		}
+	}
+	for _, wi := range g.imports {
+		if err := g.emitImport(wi, wi.decl.final); err != nil {
+			return err
+		}
+	}
+	if len(g.rootExports.funcs) > 0 || len(g.exports) > 0 {
+		if err := g.emitExports(); err != nil {
+			return err
+		}
+	}
+	// Helpers go last: signatures above decide which ones are needed.
+	g.emitHelpers()
+	return nil
+}
+
+// emitHelpers declares the generic types used for anonymous results and tuples.
+func (g *generator) emitHelpers() {
+	if g.usesResult {
+		g.printf("// Result is a WIT result. Exactly one of Ok and Err is non-nil; a result\n")
+		g.printf("// without a payload uses struct{}.\n")
+		g.printf("type Result[T, E any] struct {\n\tOk  *T\n\tErr *E\n}\n\n")
+	}
+	for n := 1; n <= maxTuple; n++ {
+		if !g.tuples[n] {
+			continue
+		}
+		params := make([]string, n)
+		for i := range params {
+			params[i] = "T" + strconv.Itoa(i)
+		}
+		g.printf("// Tuple%d is a WIT tuple of %d elements.\n", n, n)
+		g.printf("type Tuple%d[%s any] struct {\n", n, strings.Join(params, ", "))
+		for i := range params {
+			g.printf("\tF%d T%d\n", i, i)
+		}
+		g.printf("}\n\n")
+	}
+}
+
+func (g *generator) printf(format string, args ...any) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+// comment writes summary followed by the WIT docs as a Go comment.
+// Either may be empty.
+func (g *generator) comment(indent string, docs wit.Docs, summary string) {
+	text := strings.TrimSpace(docs.Contents)
+	switch {
+	case text == "":
+		text = summary
+	case summary != "":
+		text = summary + "\n\n" + text
+	}
+	if text == "" {
+		return
+	}
+	for _, line := range strings.Split(text, "\n") {
+		line = strings.TrimRightFunc(line, unicode.IsSpace)
+		if line == "" {
+			g.printf("%s//\n", indent)
+		} else {
+			g.printf("%s// %s\n", indent, line)
+		}
+	}
+}
+
+func witPath(td *wit.TypeDef) string {
+	switch owner := td.Owner.(type) {
+	case *wit.Interface:
+		if owner.Name != nil {
+			return *owner.Name + "." + td.TypeName()
+		}
+	case *wit.World:
+		return owner.Name + "." + td.TypeName()
+	}
+	return td.TypeName()
+}
+
+func (g *generator) emitTypeDef(td *wit.TypeDef) error {
+	name := g.names[td]
+	path := witPath(td)
+
+	switch k := td.Kind.(type) {
+	case *wit.Record:
+		g.comment("", td.Docs, fmt.Sprintf("%s is the WIT record %s.", name, path))
+		g.printf("type %s struct {\n", name)
+		for _, f := range k.Fields {
+			typ, err := g.typeExpr(f.Type)
+			if err != nil {
+				return err
+			}
+			g.comment("\t", f.Docs, "")
+			g.printf("\t%s %s `wit:%q`\n", pascal(f.Name), typ, f.Name)
+		}
+		g.printf("}\n\n")
+
+	case *wit.Variant:
+		g.comment("", td.Docs, fmt.Sprintf("%s is the WIT variant %s. Exactly one case field is non-nil.", name, path))
+		g.printf("type %s struct {\n", name)
+		for _, c := range k.Cases {
+			typ := "struct{}"
+			if c.Type != nil {
+				var err error
+				if typ, err = g.typeExpr(c.Type); err != nil {
+					return err
+				}
+			}
+			g.comment("\t", c.Docs, "")
+			g.printf("\t%s *%s `wit:%q`\n", pascal(c.Name), typ, c.Name)
+		}
+		g.printf("}\n\n")
+
+	case *wit.Enum:
+		g.comment("", td.Docs, fmt.Sprintf("%s is the WIT enum %s.", name, path))
+		g.printf("type %s %s\n\n", name, discriminantType(len(k.Cases)))
+		g.printf("const (\n")
+		for i, c := range k.Cases {
+			g.comment("\t", c.Docs, "")
+			if i == 0 {
+				g.printf("\t%s%s %s = iota\n", name, pascal(c.Name), name)
+			} else {
+				g.printf("\t%s%s\n", name, pascal(c.Name))
+			}
+		}
+		g.printf(")\n\n")
+
+	case *wit.Flags:
+		typ, err := flagsType(len(k.Flags))
+		if err != nil {
+			return err
+		}
+		g.comment("", td.Docs, fmt.Sprintf("%s is the WIT flags %s.", name, path))
+		g.printf("type %s %s\n\n", name, typ)
+		if len(k.Flags) > 0 {
+			g.printf("const (\n")
+			for i, f := range k.Flags {
+				g.comment("\t", f.Docs, "")
+				if i == 0 {
+					g.printf("\t%s%s %s = 1 << iota\n", name, pascal(f.Name), name)
+				} else {
+					g.printf("\t%s%s\n", name, pascal(f.Name))
+				}
+			}
+			g.printf(")\n\n")
+		}
+
+	case *wit.Resource:
+		g.comment("", td.Docs, fmt.Sprintf("%s is a handle to the WIT resource %s.", name, path))
+		g.printf("type %s uint32\n\n", name)
+
+	default:
+		typ, err := g.kindExpr(td.Kind)
+		if err != nil {
+			return err
+		}
+		g.comment("", td.Docs, fmt.Sprintf("%s is the WIT type %s.", name, path))
+		g.printf("type %s = %s\n\n", name, typ)
+	}
+	return nil
+}
+
+// discriminantType returns the smallest Go integer holding n enum cases.
+func discriminantType(n int) string {
+	switch {
+	case n <= 1<<8:
+		return "uint8"
+	case n <= 1<<16:
+		return "uint16"
+	default:
+		return "uint32"
+	}
+}
+
+// flagsType returns the Go integer the transcoder reads for n flags.
+func flagsType(n int) (string, error) {
+	switch {
+	case n <= 8:
+		return "uint8", nil
+	case n <= 16:
+		return "uint16", nil
+	case n <= 32:
+		return "uint32", nil
+	case n <= 64:
+		return "uint64", nil
+	default:
+		return "", errors.Unsupported(errors.PhaseCompile, fmt.Sprintf("flags with %d members (maximum 64)", n))
+	}
+}
+
+// typeExpr returns the Go type expression for t.
+func (g *generator) typeExpr(t wit.Type) (string, error) {
+	switch t := t.(type) {
+	case wit.Bool:
+		return "bool", nil
+	case wit.S8:
+		return "int8", nil
+	case wit.U8:
+		return "uint8", nil
+	case wit.S16:
+		return "int16", nil
+	case wit.U16:
+		return "uint16", nil
+	case wit.S32:
+		return "int32", nil
+	case wit.U32:
+		return "uint32", nil
+	case wit.S64:
+		return "int64", nil
+	case wit.U64:
+		return "uint64", nil
+	case wit.F32:
+		return "float32", nil
+	case wit.F64:
+		return "float64", nil
+	case wit.Char:
+		return "rune", nil
+	case wit.String:
+		return "string", nil
+	case *wit.TypeDef:
+		if name, ok := g.names[t]; ok {
+			return name, nil
+		}
+		return g.kindExpr(t.Kind)
+	}
+	return "", errors.Unsupported(errors.PhaseCompile, fmt.Sprintf("WIT type %T", t))
+}
+
+// kindExpr returns the Go type expression for an anonymous TypeDef kind.
+func (g *generator) kindExpr(kind wit.TypeDefKind) (string, error) {
+	switch k := kind.(type) {
+	case *wit.TypeDef:
+		return g.typeExpr(k)
+	case *wit.List:
+		elem, err := g.typeExpr(k.Type)
+		return "[]" + elem, err
+	case *wit.Option:
+		elem, err := g.typeExpr(k.Type)
+		return "*" + elem, err
+	case *wit.Result:
+		ok, err := g.payloadExpr(k.OK)
+		if err != nil {
+			return "", err
+		}
+		e, err := g.payloadExpr(k.Err)
+		if err != nil {
+			return "", err
+		}
+		g.usesResult = true
+		return "Result[" + ok + ", " + e + "]", nil
+	case *wit.Tuple:
+		if len(k.Types) > maxTuple {
+			return "", errors.Unsupported(errors.PhaseCompile, fmt.Sprintf("tuple with %d elements (maximum %d)", len(k.Types), maxTuple))
+		}
+		elems := make([]string, len(k.Types))
+		for i, et := range k.Types {
+			typ, err := g.typeExpr(et)
+			if err != nil {
+				return "", err
+			}
+			elems[i] = typ
+		}
+		if g.tuples == nil {
+			g.tuples = make(map[int]bool)
+		}
+		g.tuples[len(elems)] = true
+		return fmt.Sprintf("Tuple%d[%s]", len(elems), strings.Join(elems, ", ")), nil
+	case *wit.Own:
+		return g.typeExpr(k.Type)
+	case *wit.Borrow:
+		return g.typeExpr(k.Type)
+	case wit.Type:
+		return g.typeExpr(k)
+	}
+	return "", errors.Unsupported(errors.PhaseCompile, fmt.Sprintf("WIT type %T", kind))
+}
+
+func (g *generator) payloadExpr(t wit.Type) (string, error) {
+	if t == nil {
+		return "struct{}", nil
+	}
+	return g.typeExpr(t)
+}
+
+// methodName returns the Go method name for f within its interface.
+func methodName(f *wit.Function) string {
+	switch k := f.Kind.(type) {
+	case *wit.Constructor:
+		return "New" + pascal(resourceName(k.Type))
+	case *wit.Method:
+		return pascal(resourceName(k.Type)) + pascal(f.BaseName())
+	case *wit.Static:
+		return pascal(resourceName(k.Type)) + pascal(f.BaseName())
+	}
+	return pascal(f.Name)
+}
+
+func resourceName(t wit.Type) string {
+	if td, ok := t.(*wit.TypeDef); ok {
+		return td.TypeName()
+	}
+	return ""
+}
+
+// paramName returns a Go parameter name for a WIT parameter that cannot
+// shadow the identifiers used by generated method bodies.
+func paramName(name string) string {
+	s := camel(name)
+	switch s {
+	case "ctx", "ret", "err", "e":
+		s += "_"
+	}
+	return s
+}
+
+// signature returns the parameter list (after ctx) and result type of f.
+func (g *generator) signature(f *wit.Function) (params []string, args []string, result string, err error) {
+	for _, p := range f.Params {
+		typ, err := g.typeExpr(p.Type)
+		if err != nil {
+			return nil, nil, "", err
+		}
+		name := paramName(p.Name)
+		params = append(params, name+" "+typ)
+		args = append(args, name)
+	}
+	switch len(f.Results) {
+	case 0:
+	case 1:
+		if result, err = g.typeExpr(f.Results[0].Type); err != nil {
+			return nil, nil, "", err
+		}
+	default:
+		return nil, nil, "", errors.Unsupported(errors.PhaseCompile, fmt.Sprintf("function %s with %d results", f.Name, len(f.Results)))
+	}
+	return params, args, result, nil
+}
+
+func (g *generator) emitImport(wi *worldInterface, name string) error {
+	g.usesContext = true
+	g.usesRuntime = true
+
+	if wi.iface != nil {
+		g.comment("", wi.iface.Docs, fmt.Sprintf("%s is implemented by hosts providing the %s import.", name, wi.namespace))
+	} else {
+		g.printf("// %s is implemented by hosts providing the functions world %s imports directly.\n", name, g.world.Name)
+	}
+	g.printf("type %s interface {\n", name)
+	for _, f := range wi.funcs {
+		params, _, result, err := g.signature(f)
+		if err != nil {
+			return err
+		}
+		g.comment("\t", f.Docs, "")
+		g.printf("\t%s(%s)", methodName(f), strings.Join(append([]string{"ctx context.Context"}, params...), ", "))
+		if result != "" {
+			g.printf(" %s", result)
+		}
+		g.printf("\n")
+	}
+	for _, res := range wi.resources {
+		g.printf("\t// Drop%s is called when the guest drops its last handle to a %s.\n", pascal(res.TypeName()), res.TypeName())
+		g.printf("\tDrop%s(ctx context.Context, self %s)\n", pascal(res.TypeName()), g.names[res])
+	}
+	g.printf("}\n\n")
+
+	adapter := unexport(name) + "Adapter"
+	ctor := "New" + name
+	if !strings.HasSuffix(name, "Host") {
+		ctor += "Host"
+	}
+	g.printf("// %s adapts impl for runtime.HostRegistry.RegisterHost or Runtime.RegisterHost.\n", ctor)
+	g.printf("func %s(impl %s) runtime.Host {\n\treturn %s{impl: impl}\n}\n\n", ctor, name, adapter)
+	g.printf("type %s struct {\n\timpl %s\n}\n\n", adapter, name)
+	g.printf("func (h %s) Namespace() string { return %q }\n\n", adapter, wi.namespace)
+	g.printf("func (h %s) Register() map[string]any {\n\treturn map[string]any{\n", adapter)
+	for _, f := range wi.funcs {
+		g.printf("\t\t%q: h.impl.%s,\n", f.Name, methodName(f))
+	}
+	for _, res := range wi.resources {
+		g.printf("\t\t%q: h.impl.Drop%s,\n", "[resource-drop]"+res.TypeName(), pascal(res.TypeName()))
+	}
+	g.printf("\t}\n}\n\n")
+	return nil
+}
+
+func (g *generator) emitExports() error {
+	g.usesContext = true
+	g.usesRuntime = true
+
+	g.printf("// Exports calls the exports of world %s on a runtime.Instance.\n", g.world.Name)
+	g.printf("type Exports struct {\n")
+	for _, wi := range g.exports {
+		g.printf("\t// %s calls the functions of the %s export.\n", wi.decl.final, wi.namespace)
+		g.printf("\t%s *%sExports\n", wi.decl.final, wi.decl.final)
+	}
+	g.printf("\tinst *runtime.Instance\n}\n\n")
+
+	g.printf("// NewExports wraps inst with typed calls to its exports.\n")
+	g.printf("func NewExports(inst *runtime.Instance) *Exports {\n\treturn &Exports{\n")
+	for _, wi := range g.exports {
+		g.printf("\t\t%s: &%sExports{inst: inst},\n", wi.decl.final, wi.decl.final)
+	}
+	g.printf("\t\tinst: inst,\n\t}\n}\n\n")
+
+	if err := g.emitExportFuncs("Exports", g.rootExports); err != nil {
+		return err
+	}
+	for _, wi := range g.exports {
+		typ := wi.decl.final + "Exports"
+		g.comment("", wi.iface.Docs, fmt.Sprintf("%s calls the functions of the %s export.", typ, wi.namespace))
+		g.printf("type %s struct {\n\tinst *runtime.Instance\n}\n\n", typ)
+		if err := g.emitExportFuncs(typ, wi); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (g *generator) emitExportFuncs(recv string, wi *worldInterface) error {
+	for _, f := range wi.funcs {
+		params, args, result, err := g.signature(f)
+		if err != nil {
+			return err
+		}
+		exportName := f.Name
+		if wi.namespace != "" {
+			exportName = wi.namespace + "#" + f.Name
+		}
+		callArgs := ""
+		if len(args) > 0 {
+			callArgs = ", " + strings.Join(args, ", ")
+		}
+
+		g.comment("", f.Docs, fmt.Sprintf("%s calls the %s export.", methodName(f), exportName))
+		sig := strings.Join(append([]string{"ctx context.Context"}, params...), ", ")
+		if result == "" {
+			g.printf("func (e *%s) %s(%s) error {\n", recv, methodName(f), sig)
+			g.printf("\treturn e.inst.Invoke(ctx, %q, nil%s)\n}\n\n", exportName, callArgs)
+			continue
+		}
+		g.printf("func (e *%s) %s(%s) (%s, error) {\n", recv, methodName(f), sig, result)
+		g.printf("\tvar ret %s\n", result)
+		g.printf("\terr := e.inst.Invoke(ctx, %q, &ret%s)\n", exportName, callArgs)
+		g.printf("\treturn ret, err\n}\n\n")
+	}
+	return nil
+}
+
+// unexport lowercases the leading word of an exported identifier,
+// keeping initialisms intact: HTTPTypes -> httpTypes.
+func unexport(s string) string {
+	runes := []rune(s)
+	n := 0
+	for n < len(runes) && unicode.IsUpper(runes[n]) {
+		n++
+	}
+	if n > 1 && n < len(runes) {
+		n--
+	}
+	for i := 0; i < n; i++ {
+		runes[i] = unicode.ToLower(runes[i])
+	}
+	return string(runes)
+}
@@ -0,0 +1,174 @@
+package bindgen
+
+import (
+	"bytes"
+	stderrors "errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/wippyai/wasm-runtime/errors"
+)
+
+func generateFile(t *testing.T, path string, opts Options) []byte {
+	t.Helper()
+	res, err := Load(path)
+	if err != nil {
+		t.Fatalf("Load(%s): %v", path, err)
+	}
+	src, err := Generate(res, opts)
+	if err != nil {
+		t.Fatalf("Generate: %v", err)
+	}
+	return src
+}
+
+// declarations returns the top-level type and func names of src,
+// with methods keyed as Recv.Name.
+func declarations(t *testing.T, src []byte) map[string]bool {
+	t.Helper()
+	f, err := parser.ParseFile(token.NewFileSet(), "bindings.go", src, parser.ParseComments)
+	if err != nil {
+		t.Fatalf("generated code does not parse: %v\n%s", err, src)
+	}
+	decls := make(map[string]bool)
+	for _, d := range f.Decls {
+		switch d := d.(type) {
+		case *ast.GenDecl:
+			for _, spec := range d.Specs {
+				switch s := spec.(type) {
+				case *ast.TypeSpec:
+					decls[s.Name.Name] = true
+					if it, ok := s.Type.(*ast.InterfaceType); ok {
+						for _, m := range it.Methods.List {
+							for _, n := range m.Names {
+								decls[s.Name.Name+"."+n.Name] = true
+							}
+						}
+					}
+				case *ast.ValueSpec:
+					for _, n := range s.Names {
+						decls[n.Name] = true
+					}
+				}
+			}
+		case *ast.FuncDecl:
+			name := d.Name.Name
+			if d.Recv != nil {
+				recv := d.Recv.List[0].Type
+				if star, ok := recv.(*ast.StarExpr); ok {
+					recv = star.X
+				}
+				name = recv.(*ast.Ident).Name + "." + name
+			}
+			decls[name] = true
+		}
+	}
+	return decls
+}
+
+func TestGenerate_Demo(t *testing.T) {
+	src := generateFile(t, "testdata/demo.wit", Options{Package: "demo"})
+	decls := declarations(t, src)
+
+	for _, name := range []string{
+		// types
+		"Point", "Color", "ColorBlue", "Perms", "PermsExec", "LookupError",
+		"Counter", "Status", "Location", "StoreEntry", "Entry", "Result", "Tuple2",
+		// imports
+		"Imports", "Imports.Log", "NewImportsHost",
+		"Types", "Types.NewCounter", "Types.CounterInc", "Types.CounterMake", "Types.DropCounter", "NewTypesHost",
+		"Store", "Store.Get", "Store.Put", "NewStoreHost",
+		// exports
+		"Exports", "NewExports", "Exports.Add", "APIExports", "APIExports.Centroid", "APIExports.Lookup",
+	} {
+		if !decls[name] {
+			t.Errorf("missing declaration %s", name)
+		}
+	}
+
+	for _, want := range []string{
+		"package demo\n",
+		"DO NOT EDIT.",
+		"// Point is the WIT record types.point.\n//\n// A point on the plane.\n",
+		"NotFound *string   `wit:\"not-found\"`",
+		"ColorRed Color = iota",
+		"PermsRead Perms = 1 << iota",
+		"type Status = Result[struct{}, LookupError]",
+		"type Location = Point",
+		`func (h typesAdapter) Namespace() string { return "demo:app/types@0.1.0" }`,
+		`"[resource-drop]counter": h.impl.DropCounter,`,
+		`func (h importsAdapter) Namespace() string { return "$root" }`,
+		"Log(ctx context.Context, msg string, type_ uint8)",
+		`e.inst.Invoke(ctx, "api#centroid", &ret, points)`,
+	} {
+		if !bytes.Contains(src, []byte(want)) {
+			t.Errorf("generated code missing %q", want)
+		}
+	}
+	if t.Failed() {
+		t.Logf("generated:\n%s", src)
+	}
+}
+
+func TestGenerate_SelectWorld(t *testing.T) {
+	res, err := Load("testdata/demo.wit")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, name := range []string{"app", "demo:app/app", "demo:app/app@0.1.0"} {
+		if _, err := Generate(res, Options{World: name}); err != nil {
+			t.Errorf("World %q: %v", name, err)
+		}
+	}
+
+	_, err = Generate(res, Options{World: "missing"})
+	if !stderrors.Is(err, &errors.Error{Phase: errors.PhaseCompile, Kind: errors.KindNotFound}) {
+		t.Errorf("unknown world: got %v, want not_found", err)
+	}
+}
+
+func TestLoad_Missing(t *testing.T) {
+	if _, err := Load("testdata/does-not-exist.wit"); err == nil {
+		t.Error("expected error for missing file")
+	}
+}
+
+// TestGenerate_Golden keeps the checked-in bindings under internal/ in sync
+// with the generator. Regenerate with go generate ./bindgen/...
+func TestGenerate_Golden(t *testing.T) {
+	for _, tc := range []struct{ wasm, pkg string }{
+		{"../testbed/complex.wasm", "complexbind"},
+		{"../testbed/counter.wasm", "counterbind"},
+	} {
+		t.Run(tc.pkg, func(t *testing.T) {
+			if _, err := os.Stat(tc.wasm); err != nil {
+				t.Skipf("%s not found", tc.wasm)
+			}
+			want, err := os.ReadFile("internal/" + tc.pkg + "/bindings.go")
+			if err != nil {
+				t.Fatal(err)
+			}
+			got := generateFile(t, tc.wasm, Options{Package: tc.pkg})
+			if !bytes.Equal(got, want) {
+				t.Errorf("internal/%s/bindings.go is stale; run go generate ./bindgen/...\n%s",
+					tc.pkg, firstDiff(string(want), string(got)))
+			}
+		})
+	}
+}
+
+func firstDiff(a, b string) string {
+	al, bl := strings.Split(a, "\n"), strings.Split(b, "\n")
+	for i := 0; i < len(al) && i < len(bl); i++ {
+		if al[i] != bl[i] {
+			return "line " + strconv.Itoa(i+1) + ":\n-" + al[i] + "\n+" + bl[i]
+		}
+	}
+	return "length differs"
+}
@@ -0,0 +1,217 @@
+// Code generated by bindgen from root:component/root. DO NOT EDIT.
+
+package complexbind
+
+import (
+	"context"
+
+	"github.com/wippyai/wasm-runtime/runtime"
+)
+
+// Point is the WIT record types.point.
+type Point struct {
+	X int32 `wit:"x"`
+	Y int32 `wit:"y"`
+}
+
+// Person is the WIT record types.person.
+type Person struct {
+	Name string `wit:"name"`
+	Age  uint32 `wit:"age"`
+}
+
+// Rectangle is the WIT record types.rectangle.
+type Rectangle struct {
+	TopLeft     Point `wit:"top-left"`
+	BottomRight Point `wit:"bottom-right"`
+}
+
+// Color is the WIT enum types.color.
+type Color uint8
+
+const (
+	ColorRed Color = iota
+	ColorGreen
+	ColorBlue
+)
+
+// Shape is the WIT variant types.shape. Exactly one case field is non-nil.
+type Shape struct {
+	Circle *uint32    `wit:"circle"`
+	Square *uint32    `wit:"square"`
+	Rect   *Rectangle `wit:"rect"`
+	None   *struct{}  `wit:"none"`
+}
+
+// Permissions is the WIT flags types.permissions.
+type Permissions uint8
+
+const (
+	PermissionsRead Permissions = 1 << iota
+	PermissionsWrite
+	PermissionsExecute
+)
+
+// ErrorInfo is the WIT record types.error-info.
+type ErrorInfo struct {
+	Code    uint32 `wit:"code"`
+	Message string `wit:"message"`
+}
+
+// Exports calls the exports of world root on a runtime.Instance.
+type Exports struct {
+	inst *runtime.Instance
+}
+
+// NewExports wraps inst with typed calls to its exports.
+func NewExports(inst *runtime.Instance) *Exports {
+	return &Exports{
+		inst: inst,
+	}
+}
+
+// EchoPoint calls the echo-point export.
+func (e *Exports) EchoPoint(ctx context.Context, p Point) (Point, error) {
+	var ret Point
+	err := e.inst.Invoke(ctx, "echo-point", &ret, p)
+	return ret, err
+}
+
+// EchoPerson calls the echo-person export.
+func (e *Exports) EchoPerson(ctx context.Context, p Person) (Person, error) {
+	var ret Person
+	err := e.inst.Invoke(ctx, "echo-person", &ret, p)
+	return ret, err
+}
+
+// EchoRectangle calls the echo-rectangle export.
+func (e *Exports) EchoRectangle(ctx context.Context, r Rectangle) (Rectangle, error) {
+	var ret Rectangle
+	err := e.inst.Invoke(ctx, "echo-rectangle", &ret, r)
+	return ret, err
+}
+
+// EchoColor calls the echo-color export.
+func (e *Exports) EchoColor(ctx context.Context, c Color) (Color, error) {
+	var ret Color
+	err := e.inst.Invoke(ctx, "echo-color", &ret, c)
+	return ret, err
+}
+
+// EchoShape calls the echo-shape export.
+func (e *Exports) EchoShape(ctx context.Context, s Shape) (Shape, error) {
+	var ret Shape
+	err := e.inst.Invoke(ctx, "echo-shape", &ret, s)
+	return ret, err
+}
+
+// EchoPermissions calls the echo-permissions export.
+func (e *Exports) EchoPermissions(ctx context.Context, p Permissions) (Permissions, error) {
+	var ret Permissions
+	err := e.inst.Invoke(ctx, "echo-permissions", &ret, p)
+	return ret, err
+}
+
+// EchoListS32 calls the echo-list-s32 export.
+func (e *Exports) EchoListS32(ctx context.Context, items []int32) ([]int32, error) {
+	var ret []int32
+	err := e.inst.Invoke(ctx, "echo-list-s32", &ret, items)
+	return ret, err
+}
+
+// EchoListString calls the echo-list-string export.
+func (e *Exports) EchoListString(ctx context.Context, items []string) ([]string, error) {
+	var ret []string
+	err := e.inst.Invoke(ctx, "echo-list-string", &ret, items)
+	return ret, err
+}
+
+// EchoListPoint calls the echo-list-point export.
+func (e *Exports) EchoListPoint(ctx context.Context, items []Point) ([]Point, error) {
+	var ret []Point
+	err := e.inst.Invoke(ctx, "echo-list-point", &ret, items)
+	return ret, err
+}
+
+// MaybePoint calls the maybe-point export.
+func (e *Exports) MaybePoint(ctx context.Context, makeSome bool) (*Point, error) {
+	var ret *Point
+	err := e.inst.Invoke(ctx, "maybe-point", &ret, makeSome)
+	return ret, err
+}
+
+// MaybeString calls the maybe-string export.
+func (e *Exports) MaybeString(ctx context.Context, makeSome bool) (*string, error) {
+	var ret *string
+	err := e.inst.Invoke(ctx, "maybe-string", &ret, makeSome)
+	return ret, err
+}
+
+// TryDivide calls the try-divide export.
+func (e *Exports) TryDivide(ctx context.Context, a int32, b int32) (Result[int32, ErrorInfo], error) {
+	var ret Result[int32, ErrorInfo]
+	err := e.inst.Invoke(ctx, "try-divide", &ret, a, b)
+	return ret, err
+}
+
+// TryParse calls the try-parse export.
+func (e *Exports) TryParse(ctx context.Context, s string) (Result[int32, string], error) {
+	var ret Result[int32, string]
+	err := e.inst.Invoke(ctx, "try-parse", &ret, s)
+	return ret, err
+}
+
+// SwapPair calls the swap-pair export.
+func (e *Exports) SwapPair(ctx context.Context, a int32, b int32) (Tuple2[int32, int32], error) {
+	var ret Tuple2[int32, int32]
+	err := e.inst.Invoke(ctx, "swap-pair", &ret, a, b)
+	return ret, err
+}
+
+// Triple calls the triple export.
+func (e *Exports) Triple(ctx context.Context, x int32) (Tuple3[int32, int32, int32], error) {
+	var ret Tuple3[int32, int32, int32]
+	err := e.inst.Invoke(ctx, "triple", &ret, x)
+	return ret, err
+}
+
+// FilterAdults calls the filter-adults export.
+func (e *Exports) FilterAdults(ctx context.Context, people []Person) ([]Person, error) {
+	var ret []Person
+	err := e.inst.Invoke(ctx, "filter-adults", &ret, people)
+	return ret, err
+}
+
+// SumList calls the sum-list export.
+func (e *Exports) SumList(ctx context.Context, numbers []int32) (int64, error) {
+	var ret int64
+	err := e.inst.Invoke(ctx, "sum-list", &ret, numbers)
+	return ret, err
+}
+
+// DoubleList calls the double-list export.
+func (e *Exports) DoubleList(ctx context.Context, numbers []int32) ([]int32, error) {
+	var ret []int32
+	err := e.inst.Invoke(ctx, "double-list", &ret, numbers)
+	return ret, err
+}
+
+// Result is a WIT result. Exactly one of Ok and Err is non-nil; a result
+// without a payload uses struct{}.
+type Result[T, E any] struct {
+	Ok  *T
+	Err *E
+}
+
+// Tuple2 is a WIT tuple of 2 elements.
+type Tuple2[T0, T1 any] struct {
+	F0 T0
+	F1 T1
+}
+
+// Tuple3 is a WIT tuple of 3 elements.
+type Tuple3[T0, T1, T2 any] struct {
+	F0 T0
+	F1 T1
+	F2 T2
+}
@@ -0,0 +1,157 @@
+package complexbind
+
+import (
+	"context"
+	"os"
+	"reflect"
+	"testing"
+
+	"github.com/wippyai/wasm-runtime/runtime"
+)
+
+func setup(t *testing.T) *Exports {
+	t.Helper()
+	wasm, err := os.ReadFile("../../../testbed/complex.wasm")
+	if err != nil {
+		t.Skip("complex.wasm not found")
+	}
+
+	ctx := context.Background()
+	rt, err := runtime.New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { rt.Close(ctx) })
+
+	mod, err := rt.LoadComponent(ctx, wasm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inst, err := mod.Instantiate(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { inst.Close(ctx) })
+
+	return NewExports(inst)
+}
+
+func TestRecords(t *testing.T) {
+	ex := setup(t)
+	ctx := context.Background()
+
+	p, err := ex.EchoPoint(ctx, Point{X: 3, Y: -4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p != (Point{X: 3, Y: -4}) {
+		t.Errorf("EchoPoint = %+v", p)
+	}
+
+	rect := Rectangle{TopLeft: Point{X: 1, Y: 2}, BottomRight: Point{X: 3, Y: 4}}
+	r, err := ex.EchoRectangle(ctx, rect)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r != rect {
+		t.Errorf("EchoRectangle = %+v, want %+v", r, rect)
+	}
+
+	people, err := ex.FilterAdults(ctx, []Person{{Name: "Ann", Age: 30}, {Name: "Bo", Age: 12}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(people, []Person{{Name: "Ann", Age: 30}}) {
+		t.Errorf("FilterAdults = %+v", people)
+	}
+}
+
+func TestEnumFlagsVariant(t *testing.T) {
+	ex := setup(t)
+	ctx := context.Background()
+
+	c, err := ex.EchoColor(ctx, ColorBlue)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c != ColorBlue {
+		t.Errorf("EchoColor = %d, want %d", c, ColorBlue)
+	}
+
+	perms := PermissionsRead | PermissionsExecute
+	p, err := ex.EchoPermissions(ctx, perms)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p != perms {
+		t.Errorf("EchoPermissions = %b, want %b", p, perms)
+	}
+
+	side := uint32(7)
+	s, err := ex.EchoShape(ctx, Shape{Square: &side})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Square == nil || *s.Square != 7 || s.Circle != nil || s.Rect != nil || s.None != nil {
+		t.Errorf("EchoShape(square) = %+v", s)
+	}
+
+	s, err = ex.EchoShape(ctx, Shape{None: &struct{}{}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.None == nil || s.Square != nil {
+		t.Errorf("EchoShape(none) = %+v", s)
+	}
+}
+
+func TestOptionResultTuple(t *testing.T) {
+	ex := setup(t)
+	ctx := context.Background()
+
+	mp, err := ex.MaybePoint(ctx, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mp == nil {
+		t.Error("MaybePoint(true) = nil")
+	}
+	ms, err := ex.MaybeString(ctx, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ms != nil {
+		t.Errorf("MaybeString(false) = %q, want nil", *ms)
+	}
+
+	res, err := ex.TryDivide(ctx, 10, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Ok == nil || *res.Ok != 5 || res.Err != nil {
+		t.Errorf("TryDivide(10, 2) = %+v", res)
+	}
+	res, err = ex.TryDivide(ctx, 1, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res.Err == nil || res.Err.Message != "division by zero" {
+		t.Errorf("TryDivide(1, 0) = %+v", res)
+	}
+
+	pair, err := ex.SwapPair(ctx, 1, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pair.F0 != 2 || pair.F1 != 1 {
+		t.Errorf("SwapPair = %+v", pair)
+	}
+
+	sum, err := ex.SumList(ctx, []int32{1, 2, 3, 4})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sum != 10 {
+		t.Errorf("SumList = %d, want 10", sum)
+	}
+}
@@ -0,0 +1,5 @@
+// Package complexbind holds bindings generated from testbed/complex.wasm.
+// Its tests check that generated types round-trip through the transcoder.
+package complexbind
+
+//go:generate go run ../../../cmd/bindgen -wit ../../../testbed/complex.wasm -out bindings.go
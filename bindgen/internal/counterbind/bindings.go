@@ -0,0 +1,67 @@
+// Code generated by bindgen from root:component/root. DO NOT EDIT.
+
+package counterbind
+
+import (
+	"context"
+
+	"github.com/wippyai/wasm-runtime/runtime"
+)
+
+// Counter is a handle to the WIT resource host.counter.
+type Counter uint32
+
+// Host is implemented by hosts providing the test:counter/host@0.1.0 import.
+type Host interface {
+	NewCounter(ctx context.Context) Counter
+	CounterIncrement(ctx context.Context, self Counter)
+	CounterGet(ctx context.Context, self Counter) uint32
+	// DropCounter is called when the guest drops its last handle to a counter.
+	DropCounter(ctx context.Context, self Counter)
+}
+
+// NewHost adapts impl for runtime.HostRegistry.RegisterHost or Runtime.RegisterHost.
+func NewHost(impl Host) runtime.Host {
+	return hostAdapter{impl: impl}
+}
+
+type hostAdapter struct {
+	impl Host
+}
+
+func (h hostAdapter) Namespace() string { return "test:counter/host@0.1.0" }
+
+func (h hostAdapter) Register() map[string]any {
+	return map[string]any{
+		"[constructor]counter":      h.impl.NewCounter,
+		"[method]counter.increment": h.impl.CounterIncrement,
+		"[method]counter.get":       h.impl.CounterGet,
+		"[resource-drop]counter":    h.impl.DropCounter,
+	}
+}
+
+// Exports calls the exports of world root on a runtime.Instance.
+type Exports struct {
+	inst *runtime.Instance
+}
+
+// NewExports wraps inst with typed calls to its exports.
+func NewExports(inst *runtime.Instance) *Exports {
+	return &Exports{
+		inst: inst,
+	}
+}
+
+// RunTest calls the run-test export.
+func (e *Exports) RunTest(ctx context.Context, n uint32) (uint32, error) {
+	var ret uint32
+	err := e.inst.Invoke(ctx, "run-test", &ret, n)
+	return ret, err
+}
+
+// MultiTest calls the multi-test export.
+func (e *Exports) MultiTest(ctx context.Context) (uint32, error) {
+	var ret uint32
+	err := e.inst.Invoke(ctx, "multi-test", &ret)
+	return ret, err
+}
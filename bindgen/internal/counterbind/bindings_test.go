@@ -0,0 +1,92 @@
+package counterbind
+
+import (
+	"context"
+	"os"
+	"sync"
+	"testing"
+
+	"github.com/wippyai/wasm-runtime/runtime"
+)
+
+// counters serves the counter resource from a handle table.
+type counters struct {
+	mu      sync.Mutex
+	values  map[Counter]uint32
+	next    Counter
+	dropped int
+}
+
+func (c *counters) NewCounter(context.Context) Counter {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.next++
+	c.values[c.next] = 0
+	return c.next
+}
+
+func (c *counters) CounterIncrement(_ context.Context, self Counter) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.values[self]++
+}
+
+func (c *counters) CounterGet(_ context.Context, self Counter) uint32 {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.values[self]
+}
+
+func (c *counters) DropCounter(_ context.Context, self Counter) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.values, self)
+	c.dropped++
+}
+
+func TestHostResource(t *testing.T) {
+	wasm, err := os.ReadFile("../../../testbed/counter.wasm")
+	if err != nil {
+		t.Skip("counter.wasm not found")
+	}
+
+	ctx := context.Background()
+	rt, err := runtime.New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.Close(ctx)
+
+	impl := &counters{values: make(map[Counter]uint32)}
+	if err := rt.RegisterHost(NewHost(impl)); err != nil {
+		t.Fatal(err)
+	}
+
+	mod, err := rt.LoadComponent(ctx, wasm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inst, err := mod.Instantiate(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer inst.Close(ctx)
+
+	ex := NewExports(inst)
+	got, err := ex.RunTest(ctx, 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != 5 {
+		t.Errorf("RunTest(5) = %d, want 5", got)
+	}
+
+	impl.mu.Lock()
+	defer impl.mu.Unlock()
+	if impl.next == 0 {
+		t.Error("constructor was not called")
+	}
+	if impl.dropped == 0 {
+		t.Error("resource-drop was not called")
+	}
+}
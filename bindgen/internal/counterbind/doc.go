@@ -0,0 +1,5 @@
+// Package counterbind holds bindings generated from testbed/counter.wasm.
+// Its tests serve the counter resource through the generated host interface.
+package counterbind
+
+//go:generate go run ../../../cmd/bindgen -wit ../../../testbed/counter.wasm -out bindings.go
@@ -0,0 +1,29 @@
+package bindgen
+
+import (
+	"path/filepath"
+	"strings"
+
+	"go.bytecodealliance.org/wit"
+
+	"github.com/wippyai/wasm-runtime/errors"
+)
+
+// Load reads WIT definitions from path. Accepted inputs are a .wit file,
+// a directory of WIT packages, a component binary (its embedded WIT is
+// extracted) or a JSON-encoded Resolve produced by wasm-tools.
+func Load(path string) (*wit.Resolve, error) {
+	var (
+		res *wit.Resolve
+		err error
+	)
+	if strings.EqualFold(filepath.Ext(path), ".json") {
+		res, err = wit.LoadJSON(path)
+	} else {
+		res, err = wit.LoadWIT(path)
+	}
+	if err != nil {
+		return nil, errors.Load("read WIT from "+path, err)
+	}
+	return res, nil
+}
@@ -0,0 +1,75 @@
+package bindgen
+
+import (
+	"go/token"
+	"strings"
+	"unicode"
+)
+
+// initialisms are WIT words rendered in upper case in Go identifiers.
+var initialisms = map[string]string{
+	"api":  "API",
+	"dns":  "DNS",
+	"http": "HTTP",
+	"id":   "ID",
+	"io":   "IO",
+	"ip":   "IP",
+	"json": "JSON",
+	"tcp":  "TCP",
+	"tls":  "TLS",
+	"udp":  "UDP",
+	"uri":  "URI",
+	"url":  "URL",
+	"utf8": "UTF8",
+}
+
+// pascal converts a WIT kebab-case name to an exported Go identifier:
+// get-http-url -> GetHTTPURL.
+func pascal(name string) string {
+	var b strings.Builder
+	for _, word := range splitWords(name) {
+		if up, ok := initialisms[strings.ToLower(word)]; ok {
+			b.WriteString(up)
+			continue
+		}
+		runes := []rune(word)
+		runes[0] = unicode.ToUpper(runes[0])
+		b.WriteString(string(runes))
+	}
+	s := b.String()
+	if s == "" || !unicode.IsLetter([]rune(s)[0]) {
+		s = "X" + s
+	}
+	return s
+}
+
+// camel converts a WIT kebab-case name to an unexported Go identifier,
+// avoiding Go keywords: max-len -> maxLen, type -> type_.
+func camel(name string) string {
+	words := splitWords(name)
+	if len(words) == 0 {
+		return "x"
+	}
+	var b strings.Builder
+	b.WriteString(strings.ToLower(words[0]))
+	if len(words) > 1 {
+		b.WriteString(pascal(strings.Join(words[1:], "-")))
+	}
+	s := b.String()
+	if !unicode.IsLetter([]rune(s)[0]) {
+		s = "x" + s
+	}
+	if token.IsKeyword(s) {
+		s += "_"
+	}
+	return s
+}
+
+// splitWords splits a WIT identifier on dashes, dots and other separators,
+// dropping the %-escape used for keywords.
+func splitWords(name string) []string {
+	name = strings.TrimPrefix(name, "%")
+	return strings.FieldsFunc(name, func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+}
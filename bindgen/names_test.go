@@ -0,0 +1,50 @@
+package bindgen
+
+import "testing"
+
+func TestPascal(t *testing.T) {
+	tests := map[string]string{
+		"point":          "Point",
+		"top-left":       "TopLeft",
+		"get-http-url":   "GetHTTPURL",
+		"id":             "ID",
+		"%type":          "Type",
+		"list-s32":       "ListS32",
+		"[method]fields": "MethodFields",
+		"2d":             "X2d",
+	}
+	for in, want := range tests {
+		if got := pascal(in); got != want {
+			t.Errorf("pascal(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestCamel(t *testing.T) {
+	tests := map[string]string{
+		"make-some": "makeSome",
+		"user-id":   "userID",
+		"type":      "type_",
+		"%func":     "func_",
+		"x":         "x",
+	}
+	for in, want := range tests {
+		if got := camel(in); got != want {
+			t.Errorf("camel(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+func TestUnexport(t *testing.T) {
+	tests := map[string]string{
+		"Types":     "types",
+		"HTTPTypes": "httpTypes",
+		"API":       "api",
+		"X":         "x",
+	}
+	for in, want := range tests {
+		if got := unexport(in); got != want {
+			t.Errorf("unexport(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// Command bindgen generates typed Go bindings from WIT.
+//
+// Usage:
+//
+//	bindgen -wit <file.wit|dir|component.wasm> [-world name] [-pkg name] [-out file.go]
+//
+// The output declares Go types for the world's records, variants, enums,
+// flags and resources, a Go interface per imported interface with an
+// adapter for runtime.HostRegistry, and an Exports client wrapping
+// runtime.Instance.
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/wippyai/wasm-runtime/bindgen"
+)
+
+func main() {
+	var (
+		witPath = flag.String("wit", "", "WIT file, WIT directory, component .wasm or wasm-tools JSON")
+		world   = flag.String("world", "", "World to generate (default: the root world)")
+		pkg     = flag.String("pkg", "", "Go package name (default: output directory name, or \"bindings\")")
+		out     = flag.String("out", "", "Output file (default: stdout)")
+	)
+	flag.Parse()
+
+	if *witPath == "" {
+		fmt.Fprintln(os.Stderr, "Usage: bindgen -wit <file.wit|dir|component.wasm> [-world name] [-pkg name] [-out file.go]")
+		os.Exit(1)
+	}
+
+	if err := run(*witPath, *world, *pkg, *out); err != nil {
+		fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(witPath, world, pkg, out string) error {
+	if pkg == "" && out != "" {
+		if abs, err := filepath.Abs(out); err == nil {
+			pkg = filepath.Base(filepath.Dir(abs))
+		}
+	}
+
+	res, err := bindgen.Load(witPath)
+	if err != nil {
+		return err
+	}
+
+	src, err := bindgen.Generate(res, bindgen.Options{Package: pkg, World: world})
+	if err != nil {
+		return err
+	}
+
+	if out == "" {
+		_, err = os.Stdout.Write(src)
+		return err
+	}
+	return os.WriteFile(out, src, 0o644)
+}
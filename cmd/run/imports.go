@@ -0,0 +1,104 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/wippyai/wasm-runtime/linker"
+	"github.com/wippyai/wasm-runtime/runtime"
+	"github.com/wippyai/wasm-runtime/wasi/preview2"
+)
+
+// runImports prints how each import of a component resolves against the
+// WASI hosts: the namespace version it binds to, and the nearest
+// candidates of imports that do not resolve.
+func runImports(args []string) error {
+	fs := flag.NewFlagSet("imports", flag.ExitOnError)
+	wasmFile := fs.String("wasm", "", "Path to component wasm file")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: run imports -wasm <file.wasm>")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *wasmFile == "" && fs.NArg() == 1 {
+		*wasmFile = fs.Arg(0)
+	}
+	if *wasmFile == "" {
+		fs.Usage()
+		os.Exit(1)
+	}
+
+	data, err := os.ReadFile(*wasmFile)
+	if err != nil {
+		return fmt.Errorf("read file: %w", err)
+	}
+
+	ctx := context.Background()
+	rt, err := runtime.New(ctx)
+	if err != nil {
+		return fmt.Errorf("create runtime: %w", err)
+	}
+	defer rt.Close(ctx)
+
+	wasi := preview2.New()
+	defer wasi.Close()
+	if err := rt.RegisterWASI(wasi); err != nil {
+		return fmt.Errorf("register WASI: %w", err)
+	}
+
+	module, err := rt.LoadComponent(ctx, data)
+	if err != nil {
+		return fmt.Errorf("load component: %w", err)
+	}
+	reports, err := module.Imports(ctx)
+	if err != nil {
+		return fmt.Errorf("resolve imports: %w", err)
+	}
+
+	unresolved := printImports(os.Stdout, reports)
+	if unresolved > 0 {
+		return fmt.Errorf("%d of %d imports unresolved", unresolved, len(reports))
+	}
+	return nil
+}
+
+// printImports writes reports and returns the number of imports with
+// unresolved functions.
+func printImports(w io.Writer, reports []linker.ImportReport) int {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	unresolved := 0
+	for i := range reports {
+		r := &reports[i]
+		status := r.Provider.String()
+		switch {
+		case r.Provider == linker.ProviderStub || r.Provider == linker.ProviderNone:
+			unresolved++
+		case r.SemverMatched():
+			status += ", semver match"
+		}
+		fmt.Fprintf(tw, "%s\t%s\t%s\n", r.Name, status, r.Resolved)
+		if len(r.Candidates) > 0 {
+			fmt.Fprintf(tw, "  candidates: %s\n", strings.Join(r.Candidates, ", "))
+		}
+		for _, f := range r.Funcs {
+			// Functions bound outside the import's namespace show their path
+			resolved := ""
+			if !strings.HasPrefix(f.Resolved, r.Resolved+"#") {
+				resolved = f.Resolved
+			}
+			fmt.Fprintf(tw, "  %s\t%s\t%s\n", f.Name, f.Provider, resolved)
+			if len(f.Candidates) > 0 {
+				fmt.Fprintf(tw, "    candidates: %s\n", strings.Join(f.Candidates, ", "))
+			}
+		}
+	}
+	tw.Flush()
+	return unresolved
+}
@@ -13,6 +13,21 @@ import (
 )
 
 func main() {
+	if len(os.Args) > 1 {
+		subcommands := map[string]func([]string) error{
+			"imports": runImports,
+			"opt":     runOpt,
+			"wat":     runWat,
+		}
+		if sub, ok := subcommands[os.Args[1]]; ok {
+			if err := sub(os.Args[2:]); err != nil {
+				fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+				os.Exit(1)
+			}
+			return
+		}
+	}
+
 	var (
 		wasmFile    = flag.String("wasm", "", "Path to component wasm file")
 		funcName    = flag.String("func", "", "Function to call (optional)")
@@ -30,6 +45,9 @@ func main() {
 		fmt.Fprintln(os.Stderr, "Usage: run -wasm <file.wasm> [-func name] [-arg string] [-env K=V,...]")
 		fmt.Fprintln(os.Stderr, "       run -wasm <file.wasm> -list")
 		fmt.Fprintln(os.Stderr, "       run -wasm <file.wasm> -i  (interactive mode)")
+		fmt.Fprintln(os.Stderr, "       run imports -wasm <file.wasm>")
+		fmt.Fprintln(os.Stderr, "       run wat [-folded] [-o file.wat] -wasm <file.wasm>")
+		fmt.Fprintln(os.Stderr, "       run opt [-asyncify imports] [-strip patterns] [-strip-debug] [-o out.wasm] -wasm <file.wasm>")
 		os.Exit(1)
 	}
 
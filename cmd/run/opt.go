@@ -0,0 +1,89 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/wippyai/wasm-runtime/asyncify"
+	"github.com/wippyai/wasm-runtime/wasm"
+	"github.com/wippyai/wasm-runtime/wasm/opt"
+)
+
+// runOpt shrinks a core module, optionally asyncifying it first, and
+// reports the size after each step.
+func runOpt(args []string) error {
+	fs := flag.NewFlagSet("opt", flag.ExitOnError)
+	wasmFile := fs.String("wasm", "", "Path to core module wasm file")
+	outFile := fs.String("o", "", "Write the optimized module to this file")
+	asyncImports := fs.String("asyncify", "", "Asyncify first, with these async imports (module.name,...)")
+	strip := fs.String("strip", "", "Custom sections to remove, as path.Match patterns (comma-separated)")
+	stripDebug := fs.Bool("strip-debug", false, "Remove DWARF and other debug custom sections")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: run opt [-asyncify imports] [-strip patterns] [-strip-debug] [-o out.wasm] -wasm <file.wasm>")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *wasmFile == "" && fs.NArg() == 1 {
+		*wasmFile = fs.Arg(0)
+	}
+	if *wasmFile == "" {
+		fs.Usage()
+		os.Exit(1)
+	}
+
+	data, err := os.ReadFile(*wasmFile)
+	if err != nil {
+		return fmt.Errorf("read file: %w", err)
+	}
+	report("input", data, len(data))
+
+	if *asyncImports != "" {
+		data, err = asyncify.Transform(data, asyncify.Config{AsyncImports: strings.Split(*asyncImports, ",")})
+		if err != nil {
+			return fmt.Errorf("asyncify: %w", err)
+		}
+		report("asyncify", data, len(data))
+	}
+
+	m, err := wasm.ParseModule(data)
+	if err != nil {
+		return fmt.Errorf("parse: %w", err)
+	}
+	var opts opt.Options
+	if *strip != "" {
+		opts.Strip = strings.Split(*strip, ",")
+	}
+	if *stripDebug {
+		opts.Strip = append(opts.Strip, opt.DebugSections...)
+	}
+	if err := opt.Optimize(m, opts); err != nil {
+		return fmt.Errorf("optimize: %w", err)
+	}
+	out := m.Encode()
+	report("optimized", out, len(data))
+
+	if *outFile == "" {
+		return nil
+	}
+	if err := os.WriteFile(*outFile, out, 0o644); err != nil {
+		return fmt.Errorf("write file: %w", err)
+	}
+	return nil
+}
+
+// report prints the size of a module and its change from before bytes.
+func report(step string, data []byte, before int) {
+	line := fmt.Sprintf("%-10s %9d bytes", step, len(data))
+	if before > 0 && before != len(data) {
+		line += fmt.Sprintf("  %+.1f%%", float64(len(data)-before)*100/float64(before))
+	}
+	if m, err := wasm.ParseModule(data); err == nil {
+		line += fmt.Sprintf("  (%d functions, %d globals, %d types)",
+			m.NumImportedFuncs()+len(m.Code), m.NumImportedGlobals()+len(m.Globals), m.NumTypes())
+	}
+	fmt.Println(line)
+}
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/wippyai/wasm-runtime/wat"
+)
+
+// runWat disassembles a core module to WebAssembly text.
+func runWat(args []string) error {
+	fs := flag.NewFlagSet("wat", flag.ExitOnError)
+	wasmFile := fs.String("wasm", "", "Path to core module wasm file")
+	outFile := fs.String("o", "", "Write the text to this file instead of stdout")
+	folded := fs.Bool("folded", false, "Print instructions as nested S-expressions")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: run wat [-folded] [-o file.wat] -wasm <file.wasm>")
+		fs.PrintDefaults()
+	}
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if *wasmFile == "" && fs.NArg() == 1 {
+		*wasmFile = fs.Arg(0)
+	}
+	if *wasmFile == "" {
+		fs.Usage()
+		os.Exit(1)
+	}
+
+	data, err := os.ReadFile(*wasmFile)
+	if err != nil {
+		return fmt.Errorf("read file: %w", err)
+	}
+
+	text, err := wat.Disassemble(data, wat.PrintOptions{Folded: *folded})
+	if err != nil {
+		return fmt.Errorf("disassemble: %w", err)
+	}
+
+	if *outFile == "" {
+		_, err = os.Stdout.WriteString(text)
+		return err
+	}
+	if err := os.WriteFile(*outFile, []byte(text), 0o644); err != nil {
+		return fmt.Errorf("write file: %w", err)
+	}
+	return nil
+}
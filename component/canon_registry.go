@@ -12,6 +12,7 @@ type CanonRegistry struct {
 	Lifts    map[string]*LiftDef
 	Lowers   map[string]*LowerDef
 	resolver *TypeResolver
+	handles  *TypeResolver // resolver keeping own and borrow handles
 
 	// liftByFuncIdx maps component function index to lift definition
 	liftByFuncIdx map[uint32]*LiftDef
@@ -27,6 +28,11 @@ type LiftDef struct {
 	TypeIdx     uint32
 	MemoryIdx   uint32
 	ReallocIdx  int32
+
+	// HandleParams and HandleResults are Params and Results with resource
+	// handles kept as own or borrow of the named resource instead of u32.
+	HandleParams  []wit.Type
+	HandleResults []wit.Type
 }
 
 // LowerDef describes a canon lower (component import -> core wasm func)
@@ -39,6 +45,11 @@ type LowerDef struct {
 	MemoryIdx  uint32
 	ReallocIdx int32
 	IsAsync    bool
+
+	// HandleParams and HandleResults are Params and Results with resource
+	// handles kept as own or borrow of the named resource instead of u32.
+	HandleParams  []wit.Type
+	HandleResults []wit.Type
 }
 
 // NewCanonRegistry builds a registry by processing canons in section order.
@@ -50,6 +61,7 @@ func NewCanonRegistry(comp *Component, resolver *TypeResolver) (*CanonRegistry,
 		Lifts:         make(map[string]*LiftDef),
 		Lowers:        make(map[string]*LowerDef),
 		resolver:      resolver,
+		handles:       resolver.WithHandles(resourceNames(comp)),
 		liftByFuncIdx: make(map[uint32]*LiftDef),
 	}
 
@@ -81,6 +93,13 @@ func NewCanonRegistry(comp *Component, resolver *TypeResolver) (*CanonRegistry,
 					}
 				}
 			}
+		case SectionImport:
+			// Func imports add to component func space
+			for i := section.StartIndex; i < section.StartIndex+section.Count; i++ {
+				if i < len(comp.Imports) && comp.Imports[i].ExternKind == ExternFunc {
+					compFuncIdx++
+				}
+			}
 		case SectionExport:
 			// Process exports in this section
 			// Func exports (Sort=0x01) add to component func space
@@ -141,6 +160,10 @@ func (r *CanonRegistry) processLiftWithFuncIdx(comp *Component, canon *CanonDef,
 		}
 		results = []wit.Type{result}
 	}
+	handleParams, handleResult, err := r.handles.ResolveFunc(ft)
+	if err != nil {
+		return fmt.Errorf("lift: %w", err)
+	}
 
 	// Find export name - first try from core func export name (for instance exports)
 	// then fall back to component function export
@@ -165,6 +188,10 @@ func (r *CanonRegistry) processLiftWithFuncIdx(comp *Component, canon *CanonDef,
 		MemoryIdx:   canon.GetMemoryIndex(),
 		ReallocIdx:  canon.GetReallocIndex(),
 	}
+	lift.HandleParams = handleParams
+	if handleResult != nil {
+		lift.HandleResults = []wit.Type{handleResult}
+	}
 
 	r.Lifts[name] = lift
 	r.liftByFuncIdx[compFuncIdx] = lift
@@ -220,6 +247,13 @@ func (r *CanonRegistry) processLower(comp *Component, canon *CanonDef) error {
 								lower.Results = []wit.Type{result}
 							}
 						}
+						params, result, err = r.handles.ResolveFuncWithInternalTypes(funcType, internalTypes)
+						if err == nil {
+							lower.HandleParams = params
+							if result != nil {
+								lower.HandleResults = []wit.Type{result}
+							}
+						}
 					}
 					// For resource methods without full type info, apply defaults
 					if (strings.Contains(name, "[method]") || strings.Contains(name, "[static]")) && len(lower.Results) == 0 {
@@ -272,7 +306,10 @@ func (r *CanonRegistry) findFuncInInstanceType(instType *InstanceType, exportNam
 			// Type exports (Kind=0x03) add to type index space
 			if d.Export.externDesc.Kind == 0x03 {
 				boundIdx := d.Export.externDesc.TypeIndex
-				if boundType, found := internalTypes[boundIdx]; found {
+				if d.Export.externDesc.BoundKind == 0x01 {
+					// A sub resource bound declares a fresh resource
+					internalTypes[typeIdx] = resourceDecl{Name: decl.Name}
+				} else if boundType, found := internalTypes[boundIdx]; found {
 					internalTypes[typeIdx] = boundType
 				} else {
 					internalTypes[typeIdx] = PrimValType{Type: PrimU32}
@@ -300,6 +337,46 @@ func (r *CanonRegistry) findFuncInInstanceType(instType *InstanceType, exportNam
 	return nil, nil
 }
 
+// resourceNames names the resources comp defines by the names they are
+// exported under, directly or as part of an exported instance.
+func resourceNames(comp *Component) map[uint32]string {
+	names := make(map[uint32]string)
+	add := func(name string, typeIdx uint32) {
+		for range 16 {
+			if int(typeIdx) >= len(comp.TypeIndexSpace) {
+				return
+			}
+			switch t := comp.TypeIndexSpace[typeIdx].(type) {
+			case ResourceType:
+				if _, ok := names[typeIdx]; !ok {
+					names[typeIdx] = name
+				}
+				return
+			case TypeIndexRef:
+				typeIdx = t.Index
+			default:
+				return
+			}
+		}
+	}
+	for _, exp := range comp.Exports {
+		if exp.Sort == SortType {
+			add(exp.Name, exp.SortIndex)
+		}
+	}
+	for _, inst := range comp.Instances {
+		if inst.Parsed == nil || inst.Parsed.Kind != InstanceFromExports {
+			continue
+		}
+		for _, arg := range inst.Parsed.Args {
+			if arg.Sort == SortType {
+				add(arg.Name, arg.Index)
+			}
+		}
+	}
+	return names
+}
+
 func (r *CanonRegistry) findExportNameByFuncIdx(comp *Component, compFuncIdx uint32) string {
 	for _, exp := range comp.Exports {
 		if exp.Sort == 0x01 && exp.SortIndex == compFuncIdx {
@@ -312,17 +389,9 @@ func (r *CanonRegistry) findExportNameByFuncIdx(comp *Component, compFuncIdx uin
 func (r *CanonRegistry) findImportName(comp *Component, funcIdx uint32) string {
 	if int(funcIdx) < len(comp.FuncIndexSpace) {
 		funcEntry := comp.FuncIndexSpace[funcIdx]
-		if int(funcEntry.InstanceIdx) < len(comp.Imports) {
-			instanceCount := uint32(0)
-			for _, imp := range comp.Imports {
-				if imp.ExternKind == ExternInstance {
-					if instanceCount == funcEntry.InstanceIdx {
-						// Build qualified name: namespace#function
-						return imp.Name + "#" + funcEntry.ExportName
-					}
-					instanceCount++
-				}
-			}
+		if imp := comp.InstanceImport(funcEntry.InstanceIdx); imp != nil {
+			// Build qualified name: namespace#function
+			return imp.Name + "#" + funcEntry.ExportName
 		}
 	}
 	return ""
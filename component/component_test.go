@@ -1,6 +1,7 @@
 package component
 
 import (
+	"bytes"
 	"testing"
 
 	"github.com/wippyai/wasm-runtime/component/internal/arena"
@@ -412,7 +413,7 @@ func TestTypeIndexRef(t *testing.T) {
 
 func TestResourceType(t *testing.T) {
 	dtor := uint32(5)
-	res := resourceType{
+	res := ResourceType{
 		Dtor: &dtor,
 		Methods: []resourceMethod{
 			{Name: "get", Func: FuncType{}},
@@ -429,3 +430,29 @@ func TestResourceType(t *testing.T) {
 		t.Errorf("first method name = %q, want 'get'", res.Methods[0].Name)
 	}
 }
+
+func TestParseResourceType(t *testing.T) {
+	typ, err := parseType(bytes.NewReader([]byte{0x3f, 0x7f, 0x01, 0x05}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	res, ok := typ.(ResourceType)
+	if !ok {
+		t.Fatalf("type = %T, want ResourceType", typ)
+	}
+	if res.Dtor == nil || *res.Dtor != 5 {
+		t.Errorf("dtor = %v, want 5", res.Dtor)
+	}
+
+	typ, err = parseType(bytes.NewReader([]byte{0x3f, 0x7f, 0x00}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res := typ.(ResourceType); res.Dtor != nil {
+		t.Errorf("dtor = %d, want none", *res.Dtor)
+	}
+
+	if _, err := parseType(bytes.NewReader([]byte{0x3f, 0x7e, 0x00})); err == nil {
+		t.Error("expected error for non-i32 representation")
+	}
+}
@@ -28,9 +28,17 @@ type Component struct {
 	// FuncIndexSpace is the component function index space
 	FuncIndexSpace []FuncIndexEntry
 
-	// InstanceTypes maps instance index to its type index
+	// InstanceTypes maps instance index to its type index. Instances without
+	// a declared type (instantiated, aliased or re-exported) map to NoInstanceType.
 	InstanceTypes []uint32
 
+	// InstanceIndexSpace describes every component instance index (requires ParseTypes=true)
+	InstanceIndexSpace []InstanceIndexEntry
+
+	// ComponentIndexSpace maps component index to its position in Components,
+	// or -1 for imported and aliased components (requires ParseTypes=true)
+	ComponentIndexSpace []int
+
 	// LiftedFuncIndex maps canon lift index to component function index
 	LiftedFuncIndex map[int]uint32
 
@@ -72,7 +80,7 @@ const (
 // SectionMarker identifies a section for index space construction
 type SectionMarker struct {
 	Kind       SectionKind
-	StartIndex int // Starting index in the corresponding slice (Aliases, Canons, or Exports)
+	StartIndex int // Starting index in the corresponding slice (Aliases, Canons, Exports, Types or Imports)
 	Count      int // Number of items in this section
 }
 
@@ -83,6 +91,7 @@ const (
 	SectionCanon
 	SectionExport
 	SectionType
+	SectionImport
 )
 
 // FuncIndexEntry describes a function in the component function index space.
@@ -110,6 +119,7 @@ type CoreInstance struct {
 }
 
 type Instance struct {
+	Parsed  *ParsedInstance
 	RawData []byte
 }
 
@@ -164,6 +174,9 @@ const (
 	SortInstance  byte = 0x05
 )
 
+// NoInstanceType marks InstanceTypes entries of instances without a declared type
+const NoInstanceType = ^uint32(0)
+
 // maxNameLength bounds allocations to prevent OOM from malformed binaries
 const maxNameLength = 100000
 
@@ -251,13 +264,48 @@ func DecodeAndValidate(data []byte) (*ValidatedComponent, error) {
 	}
 
 	// Attach the raw component data
-	validated.Raw = raw
+	if err := attachRaw(validated, raw); err != nil {
+		return nil, err
+	}
 
 	return validated, nil
 }
 
+// attachRaw attaches raw component data to v and its nested components
+func attachRaw(v *ValidatedComponent, raw *Component) error {
+	v.Raw = raw
+	if len(v.Components) != len(raw.Components) {
+		return fmt.Errorf("validated %d nested components, decoded %d", len(v.Components), len(raw.Components))
+	}
+	for i, child := range v.Components {
+		childRaw, err := decode(raw.Components[i], DecodeOptions{ParseTypes: true})
+		if err != nil {
+			return fmt.Errorf("decode nested component %d: %w", i, err)
+		}
+		if err := attachRaw(child, childRaw); err != nil {
+			return fmt.Errorf("nested component %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
 // DecodeWithOptions decodes a component with the given options
 func DecodeWithOptions(data []byte, opts DecodeOptions) (*Component, error) {
+	comp, err := decode(data, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(comp.CoreModules) == 0 && len(comp.Components) == 0 {
+		return nil, fmt.Errorf("no core modules found in component")
+	}
+
+	return comp, nil
+}
+
+// decode decodes all sections of a component. Nested components may consist
+// of imports and exports only, so no section is required.
+func decode(data []byte, opts DecodeOptions) (*Component, error) {
 	if !IsComponent(data) {
 		return nil, fmt.Errorf("not a component")
 	}
@@ -328,8 +376,24 @@ func DecodeWithOptions(data []byte, opts DecodeOptions) (*Component, error) {
 			comp.CoreTypes = append(comp.CoreTypes, sectionData)
 		case 4:
 			comp.Components = append(comp.Components, sectionData)
+			if opts.ParseTypes {
+				comp.ComponentIndexSpace = append(comp.ComponentIndexSpace, len(comp.Components)-1)
+			}
 		case 5:
-			comp.Instances = append(comp.Instances, Instance{RawData: sectionData})
+			parsed, err := ParseInstanceSection(sectionData)
+			if err != nil {
+				return nil, fmt.Errorf("parse instance section %d: %w", sectionCount, err)
+			}
+			for _, p := range parsed {
+				comp.Instances = append(comp.Instances, Instance{Parsed: p, RawData: sectionData})
+				if opts.ParseTypes {
+					comp.InstanceIndexSpace = append(comp.InstanceIndexSpace, InstanceIndexEntry{
+						Source: InstanceSourceDefined,
+						Index:  uint32(len(comp.Instances) - 1),
+					})
+					comp.InstanceTypes = append(comp.InstanceTypes, NoInstanceType)
+				}
+			}
 		case 6:
 			startIdx := len(comp.Aliases)
 			if opts.ParseTypes && len(sectionData) > 0 {
@@ -348,12 +412,32 @@ func DecodeWithOptions(data []byte, opts DecodeOptions) (*Component, error) {
 									InstanceIdx: parsed.Instance,
 									ExportName:  parsed.Name,
 								})
-							} else {
-								// Outer alias - still use TypeIndexRef for now
+							} else if parsed.OuterCount == 0 {
 								comp.TypeIndexSpace = append(comp.TypeIndexSpace, TypeIndexRef{Index: parsed.OuterIndex})
+							} else {
+								// Enclosing component's type, resolved through a nested TypeResolver
+								comp.TypeIndexSpace = append(comp.TypeIndexSpace, outerAlias{
+									Count: parsed.OuterCount,
+									Index: parsed.OuterIndex,
+								})
 							}
 						}
 
+						// Instance aliases (sort=0x05) add to the instance index space
+						if parsed.Sort == SortInstance && parsed.TargetKind == 0x00 {
+							comp.InstanceIndexSpace = append(comp.InstanceIndexSpace, InstanceIndexEntry{
+								Source: InstanceSourceAlias,
+								Index:  parsed.Instance,
+								Name:   parsed.Name,
+							})
+							comp.InstanceTypes = append(comp.InstanceTypes, NoInstanceType)
+						}
+
+						// Component aliases (sort=0x04) refer to components defined elsewhere
+						if parsed.Sort == SortComponent {
+							comp.ComponentIndexSpace = append(comp.ComponentIndexSpace, -1)
+						}
+
 						// Function aliases (sort=0x01) add to the function index space
 						if parsed.Sort == 0x01 && parsed.TargetKind == 0x00 {
 							// Instance export alias - record the instance and export name
@@ -460,14 +544,20 @@ func DecodeWithOptions(data []byte, opts DecodeOptions) (*Component, error) {
 			}
 			comp.Start = start
 		case 10:
+			startIdx := len(comp.Imports)
 			imports, err := decodeImports(sectionData)
 			if err != nil {
 				return nil, fmt.Errorf("decode imports: %w", err)
 			}
 			comp.Imports = append(comp.Imports, imports...)
+			comp.SectionOrder = append(comp.SectionOrder, SectionMarker{
+				Kind:       SectionImport,
+				StartIndex: startIdx,
+				Count:      len(imports),
+			})
 
 			if opts.ParseTypes {
-				for _, imp := range imports {
+				for i, imp := range imports {
 					// Type imports (extern kind 0x03) add to the type index space
 					if imp.ExternKind == ExternType {
 						// The import references an existing type
@@ -476,8 +566,16 @@ func DecodeWithOptions(data []byte, opts DecodeOptions) (*Component, error) {
 
 					// Instance imports (extern kind 0x05) create instances
 					if imp.ExternKind == ExternInstance {
+						comp.InstanceIndexSpace = append(comp.InstanceIndexSpace, InstanceIndexEntry{
+							Source: InstanceSourceImport,
+							Index:  uint32(startIdx + i),
+						})
 						comp.InstanceTypes = append(comp.InstanceTypes, imp.TypeIndex)
 					}
+
+					if imp.ExternKind == ExternComponent {
+						comp.ComponentIndexSpace = append(comp.ComponentIndexSpace, -1)
+					}
 				}
 			}
 		case 11:
@@ -492,11 +590,30 @@ func DecodeWithOptions(data []byte, opts DecodeOptions) (*Component, error) {
 				StartIndex: startIdx,
 				Count:      len(exports),
 			})
-		}
-	}
 
-	if len(comp.CoreModules) == 0 {
-		return nil, fmt.Errorf("no core modules found in component")
+			// Exports other than functions introduce new indices as well
+			if opts.ParseTypes {
+				for _, exp := range exports {
+					switch exp.Sort {
+					case SortType:
+						comp.TypeIndexSpace = append(comp.TypeIndexSpace, TypeIndexRef{Index: exp.SortIndex})
+					case SortInstance:
+						comp.InstanceIndexSpace = append(comp.InstanceIndexSpace, InstanceIndexEntry{
+							Source: InstanceSourceExport,
+							Index:  exp.SortIndex,
+							Name:   exp.Name,
+						})
+						comp.InstanceTypes = append(comp.InstanceTypes, NoInstanceType)
+					case SortComponent:
+						pos := -1
+						if int(exp.SortIndex) < len(comp.ComponentIndexSpace) {
+							pos = comp.ComponentIndexSpace[exp.SortIndex]
+						}
+						comp.ComponentIndexSpace = append(comp.ComponentIndexSpace, pos)
+					}
+				}
+			}
+		}
 	}
 
 	return comp, nil
@@ -807,6 +924,24 @@ func decodeExports(data []byte) ([]Export, error) {
 			return nil, fmt.Errorf("export %d: read sort index: %w", i, err)
 		}
 
+		// Optional type ascription: 0x00 none, 0x01 followed by an externdesc.
+		// Older encoders omit it on the last export of a section.
+		if r.Len() > 0 {
+			hasType, err := readByte(r)
+			if err != nil {
+				return nil, fmt.Errorf("export %d: read type ascription: %w", i, err)
+			}
+			switch hasType {
+			case 0x00:
+			case 0x01:
+				if _, err := parseExternDesc(r); err != nil {
+					return nil, fmt.Errorf("export %d: type ascription: %w", i, err)
+				}
+			default:
+				return nil, fmt.Errorf("export %d: invalid type ascription 0x%02x", i, hasType)
+			}
+		}
+
 		exports = append(exports, Export{
 			Name:      string(nameBytes),
 			Sort:      sort,
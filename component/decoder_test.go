@@ -266,6 +266,52 @@ func TestDecodeMinimalTypeIndexSpace(t *testing.T) {
 	}
 }
 
+func TestDecodeAndValidateNestedComponent(t *testing.T) {
+	data, err := os.ReadFile("../testbed/sleep-test.wasm")
+	if err != nil {
+		t.Skipf("Sleep test component not found: %v", err)
+	}
+
+	validated, err := DecodeAndValidate(data)
+	if err != nil {
+		t.Fatalf("DecodeAndValidate() error = %v", err)
+	}
+
+	// The exported interface is re-typed by a nested wrapper component
+	if len(validated.Components) != 1 {
+		t.Fatalf("validated %d nested components, want 1", len(validated.Components))
+	}
+	child := validated.Components[0]
+	if child.Raw == nil {
+		t.Fatal("nested component has no decoded Raw")
+	}
+	if len(child.Raw.CoreModules) != 0 || len(child.Raw.Exports) == 0 {
+		t.Errorf("nested wrapper has %d core modules and %d exports", len(child.Raw.CoreModules), len(child.Raw.Exports))
+	}
+
+	comp := validated.Raw
+	if len(comp.ComponentIndexSpace) != 1 || comp.ComponentIndexSpace[0] != 0 {
+		t.Errorf("ComponentIndexSpace = %v, want [0]", comp.ComponentIndexSpace)
+	}
+
+	instantiated := 0
+	for _, entry := range comp.InstanceIndexSpace {
+		if entry.Source != InstanceSourceDefined {
+			continue
+		}
+		parsed := comp.Instances[entry.Index].Parsed
+		if parsed != nil && parsed.Kind == InstanceInstantiate && parsed.ComponentIndex == 0 {
+			instantiated++
+		}
+	}
+	if instantiated != 1 {
+		t.Errorf("found %d instantiations of component 0, want 1", instantiated)
+	}
+	if len(comp.InstanceTypes) != len(comp.InstanceIndexSpace) {
+		t.Errorf("InstanceTypes has %d entries for %d instances", len(comp.InstanceTypes), len(comp.InstanceIndexSpace))
+	}
+}
+
 func TestDecodeCalculatorFuncIndexSpace(t *testing.T) {
 	data, err := os.ReadFile("../testbed/calculator.wasm")
 	if err != nil {
@@ -6,4 +6,8 @@
 // Type indices in the binary reference into TypeIndexSpace, which is built
 // incrementally as sections are parsed. Aliases can create forward references
 // that require deferred resolution via typeAlias.
+//
+// Nested components are validated and decoded recursively into
+// ValidatedComponent.Components. Each has its own index spaces; outer
+// aliases into enclosing components resolve through TypeResolver.Nested.
 package component
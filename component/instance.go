@@ -0,0 +1,187 @@
+package component
+
+import (
+	"bytes"
+	"fmt"
+)
+
+// ParsedInstance holds a parsed component instance from section 5
+type ParsedInstance struct {
+	// Args are the instantiate arguments, or the exports of a FromExports instance
+	Args           []InstanceArg
+	ComponentIndex uint32
+	Kind           InstanceKind
+}
+
+type InstanceKind byte
+
+const (
+	InstanceInstantiate InstanceKind = 0x00
+	InstanceFromExports InstanceKind = 0x01
+)
+
+// InstanceArg names an item of the component index spaces
+type InstanceArg struct {
+	Name     string
+	Sort     byte
+	CoreSort byte // only for Sort == SortCore
+	Index    uint32
+}
+
+// InstanceIndexEntry describes an entry of the component instance index space
+type InstanceIndexEntry struct {
+	// Name is the aliased or exported name for InstanceSourceAlias and
+	// InstanceSourceExport
+	Name string
+	// Index is the position in Component.Imports for InstanceSourceImport,
+	// in Component.Instances for InstanceSourceDefined, and the referenced
+	// instance index for InstanceSourceAlias and InstanceSourceExport
+	Index  uint32
+	Source InstanceSource
+}
+
+type InstanceSource byte
+
+const (
+	InstanceSourceImport  InstanceSource = iota // instance import
+	InstanceSourceDefined                       // instance section entry
+	InstanceSourceAlias                         // alias of an instance export
+	InstanceSourceExport                        // instance export, re-exposing another index
+)
+
+// ParseInstanceSection parses section 5 containing vec(instance)
+func ParseInstanceSection(data []byte) ([]*ParsedInstance, error) {
+	r := bytes.NewReader(data)
+
+	count, err := readLEB128(r)
+	if err != nil {
+		return nil, fmt.Errorf("read instance count: %w", err)
+	}
+	if count > 100000 {
+		return nil, fmt.Errorf("instance count %d exceeds maximum", count)
+	}
+
+	instances := make([]*ParsedInstance, count)
+	for i := uint32(0); i < count; i++ {
+		inst, err := parseSingleInstance(r)
+		if err != nil {
+			return nil, fmt.Errorf("parse instance %d: %w", i, err)
+		}
+		instances[i] = inst
+	}
+
+	return instances, nil
+}
+
+func parseSingleInstance(r *bytes.Reader) (*ParsedInstance, error) {
+	kind, err := r.ReadByte()
+	if err != nil {
+		return nil, fmt.Errorf("read kind: %w", err)
+	}
+
+	inst := &ParsedInstance{Kind: InstanceKind(kind)}
+
+	switch inst.Kind {
+	case InstanceInstantiate:
+		// instantiate: component-index:u32 args:vec<(string, sortidx)>
+		inst.ComponentIndex, err = readLEB128(r)
+		if err != nil {
+			return nil, fmt.Errorf("read component index: %w", err)
+		}
+		inst.Args, err = parseInstanceArgs(r, false)
+		if err != nil {
+			return nil, err
+		}
+
+	case InstanceFromExports:
+		// from-exports: vec<(exportname, sortidx)>
+		inst.Args, err = parseInstanceArgs(r, true)
+		if err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("unknown instance kind: %d", kind)
+	}
+
+	return inst, nil
+}
+
+// parseInstanceArgs reads vec<(name, sortidx)>. Inline export names carry
+// the same kind byte as export section names.
+func parseInstanceArgs(r *bytes.Reader, exportNames bool) ([]InstanceArg, error) {
+	count, err := readLEB128(r)
+	if err != nil {
+		return nil, fmt.Errorf("read arg count: %w", err)
+	}
+	if count > 100000 {
+		return nil, fmt.Errorf("arg count %d exceeds maximum", count)
+	}
+
+	args := make([]InstanceArg, count)
+	for i := uint32(0); i < count; i++ {
+		if exportNames {
+			if _, err := r.ReadByte(); err != nil {
+				return nil, fmt.Errorf("read arg %d name kind: %w", i, err)
+			}
+		}
+		name, err := readName(r)
+		if err != nil {
+			return nil, fmt.Errorf("read arg %d name: %w", i, err)
+		}
+
+		sort, err := r.ReadByte()
+		if err != nil {
+			return nil, fmt.Errorf("read arg %d sort: %w", i, err)
+		}
+		var coreSort byte
+		if sort == SortCore {
+			coreSort, err = r.ReadByte()
+			if err != nil {
+				return nil, fmt.Errorf("read arg %d core sort: %w", i, err)
+			}
+		}
+
+		idx, err := readLEB128(r)
+		if err != nil {
+			return nil, fmt.Errorf("read arg %d index: %w", i, err)
+		}
+
+		args[i] = InstanceArg{
+			Name:     name,
+			Sort:     sort,
+			CoreSort: coreSort,
+			Index:    idx,
+		}
+	}
+
+	return args, nil
+}
+
+// InstanceImport returns the import that defines instance idx, or nil when
+// the instance is not imported. Components assembled without decoding have
+// no instance index space; their instances are taken to be the instance
+// imports in order.
+func (c *Component) InstanceImport(idx uint32) *Import {
+	if len(c.InstanceIndexSpace) == 0 {
+		n := uint32(0)
+		for i := range c.Imports {
+			if c.Imports[i].ExternKind != ExternInstance {
+				continue
+			}
+			if n == idx {
+				return &c.Imports[i]
+			}
+			n++
+		}
+		return nil
+	}
+	if int(idx) >= len(c.InstanceIndexSpace) {
+		return nil
+	}
+	entry := c.InstanceIndexSpace[idx]
+	if entry.Source != InstanceSourceImport || int(entry.Index) >= len(c.Imports) {
+		return nil
+	}
+	return &c.Imports[entry.Index]
+}
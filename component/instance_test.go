@@ -0,0 +1,83 @@
+package component
+
+import "testing"
+
+func TestParseInstanceSection(t *testing.T) {
+	data := []byte{
+		0x02,
+		// instantiate component 1 with "host" = instance 0
+		0x00, 0x01, 0x01, 0x04, 'h', 'o', 's', 't', 0x05, 0x00,
+		// from exports: "add" = func 3, "m" = core module 2
+		0x01, 0x02, 0x00, 0x03, 'a', 'd', 'd', 0x01, 0x03, 0x00, 0x01, 'm', 0x00, 0x11, 0x02,
+	}
+
+	instances, err := ParseInstanceSection(data)
+	if err != nil {
+		t.Fatalf("ParseInstanceSection: %v", err)
+	}
+	if len(instances) != 2 {
+		t.Fatalf("got %d instances, want 2", len(instances))
+	}
+
+	inst := instances[0]
+	if inst.Kind != InstanceInstantiate || inst.ComponentIndex != 1 || len(inst.Args) != 1 {
+		t.Fatalf("instance 0 = %+v", inst)
+	}
+	if arg := inst.Args[0]; arg.Name != "host" || arg.Sort != SortInstance || arg.Index != 0 {
+		t.Errorf("instance 0 arg = %+v", arg)
+	}
+
+	inst = instances[1]
+	if inst.Kind != InstanceFromExports || len(inst.Args) != 2 {
+		t.Fatalf("instance 1 = %+v", inst)
+	}
+	if arg := inst.Args[0]; arg.Name != "add" || arg.Sort != SortFunc || arg.Index != 3 {
+		t.Errorf("instance 1 arg 0 = %+v", arg)
+	}
+	if arg := inst.Args[1]; arg.Name != "m" || arg.Sort != SortCore || arg.CoreSort != 0x11 || arg.Index != 2 {
+		t.Errorf("instance 1 arg 1 = %+v", arg)
+	}
+}
+
+func TestParseInstanceSection_Invalid(t *testing.T) {
+	tests := map[string][]byte{
+		"unknown kind":  {0x01, 0x07},
+		"truncated arg": {0x01, 0x00, 0x00, 0x01, 0x04, 'h'},
+		"empty":         {},
+	}
+	for name, data := range tests {
+		if _, err := ParseInstanceSection(data); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+}
+
+func TestComponent_InstanceImport(t *testing.T) {
+	c := &Component{
+		Imports: []Import{
+			{Name: "wasi:cli/run@0.2.0", ExternKind: ExternFunc},
+			{Name: "test:a/host", ExternKind: ExternInstance},
+			{Name: "test:b/host", ExternKind: ExternInstance},
+		},
+	}
+
+	// Without an index space instances are the instance imports in order
+	if imp := c.InstanceImport(1); imp == nil || imp.Name != "test:b/host" {
+		t.Errorf("InstanceImport(1) = %v, want test:b/host", imp)
+	}
+
+	c.InstanceIndexSpace = []InstanceIndexEntry{
+		{Source: InstanceSourceImport, Index: 1},
+		{Source: InstanceSourceDefined, Index: 0},
+		{Source: InstanceSourceImport, Index: 2},
+	}
+	if imp := c.InstanceImport(2); imp == nil || imp.Name != "test:b/host" {
+		t.Errorf("InstanceImport(2) = %v, want test:b/host", imp)
+	}
+	if imp := c.InstanceImport(1); imp != nil {
+		t.Errorf("InstanceImport(1) = %v, want nil for a defined instance", imp)
+	}
+	if imp := c.InstanceImport(5); imp != nil {
+		t.Errorf("InstanceImport(5) = %v, want nil out of range", imp)
+	}
+}
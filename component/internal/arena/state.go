@@ -191,7 +191,12 @@ func (cs *State) Instances() []TypeID {
 	return cs.instances
 }
 
-// Exports returns the exports map
+// Imports returns the import map
+func (cs *State) Imports() map[string]EntityType {
+	return cs.imports
+}
+
+// Exports returns the export map
 func (cs *State) Exports() map[string]EntityType {
 	return cs.exports
 }
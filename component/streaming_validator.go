@@ -1,6 +1,7 @@
 package component
 
 import (
+	"errors"
 	"fmt"
 	"io"
 
@@ -11,6 +12,7 @@ import (
 type StreamingValidator struct {
 	types      *arena.TypeArena
 	components []*arena.State
+	nested     [][]*ValidatedComponent // nested components per open component scope
 	state      ValidatorState
 }
 
@@ -29,6 +31,8 @@ type ValidatedComponent struct {
 	arena *arena.TypeArena // internal
 	state *arena.State     // internal
 	Raw   *Component       // Raw parsed component data
+	// Components holds the nested components in component section order
+	Components []*ValidatedComponent
 }
 
 // TypeCount returns the number of types
@@ -77,6 +81,10 @@ func (v *StreamingValidator) ProcessSection(sectionID byte, data []byte) error {
 		return v.processCanonSection(data)
 	case 11: // Export section
 		return v.processExportSection(data)
+	case 4: // Nested component section
+		return v.processComponentSection(data)
+	case 5: // Instance section
+		return v.processInstanceSection(data)
 	default:
 		return nil
 	}
@@ -89,6 +97,7 @@ func (v *StreamingValidator) Version(version uint16) error {
 	if version == 0x0d || version == 0x000d {
 		v.state = StateComponent
 		v.components = append(v.components, arena.NewState(arena.KindComponent))
+		v.nested = append(v.nested, nil)
 		return nil
 	}
 	return fmt.Errorf("unsupported version: %#x", version)
@@ -112,8 +121,9 @@ func (v *StreamingValidator) End() (*ValidatedComponent, error) {
 	v.state = StateEnd
 
 	return &ValidatedComponent{
-		arena: v.types,
-		state: v.components[0],
+		arena:      v.types,
+		state:      v.components[0],
+		Components: v.nested[0],
 	}, nil
 }
 
@@ -163,6 +173,10 @@ func (v *StreamingValidator) addType(current *arena.State, ty Type) error {
 		return nil
 	case PrimValType, RecordType, VariantType, ListType, TupleType, EnumType, FlagsType, OptionType, ResultType:
 		return v.addDefinedType(current, ty)
+	case ResourceType:
+		// Each definition is a fresh resource type
+		current.AddType(arena.AnyTypeID{Kind: arena.TypeKindResource, ID: v.types.AllocResource()})
+		return nil
 	case OwnType:
 		// Own type references a resource (or alias to resource)
 		anyType, err := current.GetType(t.TypeIndex)
@@ -578,6 +592,18 @@ func (v *StreamingValidator) processAlias(current *arena.State, alias *ParsedAli
 		return v.aliasInstanceExportFunc(current, alias.Instance, alias.Name)
 	}
 
+	// Instance and component aliases (sort=0x05, 0x04)
+	if alias.Sort == SortInstance || alias.Sort == SortComponent {
+		switch alias.TargetKind {
+		case 0x00:
+			return v.aliasInstanceExport(current, alias.Sort, alias.Instance, alias.Name)
+		case 0x02:
+			return v.aliasOuterComponent(current, alias.Sort, alias.OuterCount, alias.OuterIndex)
+		default:
+			return fmt.Errorf("unsupported alias target kind 0x%02x for sort 0x%02x", alias.TargetKind, alias.Sort)
+		}
+	}
+
 	return nil
 }
 
@@ -723,15 +749,284 @@ func (v *StreamingValidator) processExportSection(data []byte) error {
 	}
 
 	for _, exp := range exports {
-		// Function exports (Sort=0x01) add to component func index space
-		if exp.Sort == 0x01 {
-			current.AddFunc(0)
+		entity, err := v.sortEntity(current, exp.Sort, 0x11, exp.SortIndex)
+		if err != nil {
+			return fmt.Errorf("export %q: %w", exp.Name, err)
+		}
+
+		// Exports introduce a new index in their sort's index space
+		switch exp.Sort {
+		case SortFunc:
+			current.AddFunc(entity.ID)
+		case SortType:
+			anyType, err := current.GetType(exp.SortIndex)
+			if err != nil {
+				return fmt.Errorf("export %q: %w", exp.Name, err)
+			}
+			current.AddType(anyType)
+		case SortInstance:
+			current.AddInstance(entity.ID)
+		case SortComponent:
+			current.AddComponent(entity.ID)
+		}
+
+		if exp.Sort != SortCore {
+			if err := current.AddExport(exp.Name, entity); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// processComponentSection validates a nested component in its own scope
+// and adds its type to the component index space
+func (v *StreamingValidator) processComponentSection(data []byte) error {
+	parent, err := v.current()
+	if err != nil {
+		return err
+	}
+	if !IsComponent(data) {
+		return fmt.Errorf("nested component: not a component")
+	}
+
+	child := arena.NewState(arena.KindComponent)
+	v.components = append(v.components, child)
+	v.nested = append(v.nested, nil)
+
+	err = v.processNestedSections(data[8:])
+
+	children := v.nested[len(v.nested)-1]
+	v.components = v.components[:len(v.components)-1]
+	v.nested = v.nested[:len(v.nested)-1]
+
+	if err != nil {
+		return fmt.Errorf("nested component: %w", err)
+	}
+	if err := child.CheckAllValuesUsed(); err != nil {
+		return fmt.Errorf("nested component: %w", err)
+	}
+
+	compID := v.types.AllocComponent(arena.TypeData{
+		Imports: child.Imports(),
+		Exports: child.Exports(),
+	})
+	parent.AddComponent(compID)
+
+	last := len(v.nested) - 1
+	v.nested[last] = append(v.nested[last], &ValidatedComponent{
+		arena:      v.types,
+		state:      child,
+		Components: children,
+	})
+	return nil
+}
+
+// processNestedSections feeds the sections of a nested component body
+func (v *StreamingValidator) processNestedSections(body []byte) error {
+	r := getReader(body)
+	defer putReader(r)
+
+	for {
+		sectionID, err := readByte(r)
+		if errors.Is(err, io.EOF) {
+			return nil
+		}
+		if err != nil {
+			return fmt.Errorf("read section ID: %w", err)
+		}
+
+		size, err := readLEB128(r)
+		if err != nil {
+			return fmt.Errorf("read section size: %w", err)
+		}
+		if int(size) > r.Len() {
+			return fmt.Errorf("section %d size %d exceeds remaining %d", sectionID, size, r.Len())
+		}
+
+		sectionData := make([]byte, size)
+		if _, err := io.ReadFull(r, sectionData); err != nil {
+			return fmt.Errorf("read section data: %w", err)
+		}
+
+		if err := v.ProcessSection(sectionID, sectionData); err != nil {
+			return fmt.Errorf("validate section %d: %w", sectionID, err)
+		}
+	}
+}
+
+// processInstanceSection processes an instance section
+func (v *StreamingValidator) processInstanceSection(data []byte) error {
+	current, err := v.current()
+	if err != nil {
+		return err
+	}
+
+	instances, err := ParseInstanceSection(data)
+	if err != nil {
+		return err
+	}
+
+	for i, inst := range instances {
+		var exports map[string]arena.EntityType
+
+		switch inst.Kind {
+		case InstanceInstantiate:
+			compID, err := current.GetComponent(inst.ComponentIndex)
+			if err != nil {
+				return fmt.Errorf("instance %d: %w", i, err)
+			}
+			compType := v.types.GetComponent(compID)
+			if compType == nil {
+				return fmt.Errorf("instance %d: component type %d not found", i, compID)
+			}
+
+			args := make(map[string]arena.EntityType, len(inst.Args))
+			for _, arg := range inst.Args {
+				entity, err := v.sortEntity(current, arg.Sort, arg.CoreSort, arg.Index)
+				if err != nil {
+					return fmt.Errorf("instance %d: argument %q: %w", i, arg.Name, err)
+				}
+				if _, dup := args[arg.Name]; dup {
+					return fmt.Errorf("instance %d: duplicate argument %q", i, arg.Name)
+				}
+				args[arg.Name] = entity
+			}
+
+			for name, imp := range compType.Imports {
+				arg, ok := args[name]
+				if !ok {
+					return fmt.Errorf("instance %d: missing argument for import %q", i, name)
+				}
+				if arg.Kind != imp.Kind {
+					return fmt.Errorf("instance %d: argument %q has kind %d, import expects %d", i, name, arg.Kind, imp.Kind)
+				}
+			}
+
+			exports = compType.Exports
+
+		case InstanceFromExports:
+			exports = make(map[string]arena.EntityType, len(inst.Args))
+			for _, arg := range inst.Args {
+				entity, err := v.sortEntity(current, arg.Sort, arg.CoreSort, arg.Index)
+				if err != nil {
+					return fmt.Errorf("instance %d: export %q: %w", i, arg.Name, err)
+				}
+				if _, dup := exports[arg.Name]; dup {
+					return fmt.Errorf("instance %d: duplicate export %q", i, arg.Name)
+				}
+				exports[arg.Name] = entity
+			}
 		}
+
+		instID := v.types.AllocInstance(arena.InstanceTypeData{Exports: exports})
+		current.AddInstance(instID)
 	}
 
 	return nil
 }
 
+// sortEntity looks up the entity at idx in the index space of sort
+func (v *StreamingValidator) sortEntity(current *arena.State, sort, coreSort byte, idx uint32) (arena.EntityType, error) {
+	switch sort {
+	case SortFunc:
+		id, err := current.GetFunc(idx)
+		if err != nil {
+			return arena.EntityType{}, err
+		}
+		return arena.EntityType{Kind: arena.EntityKindFunc, ID: id}, nil
+
+	case SortType:
+		anyType, err := current.GetType(idx)
+		if err != nil {
+			return arena.EntityType{}, err
+		}
+		return arena.EntityType{Kind: arena.EntityKindType, ID: anyType.ID}, nil
+
+	case SortInstance:
+		id, err := current.GetInstance(idx)
+		if err != nil {
+			return arena.EntityType{}, err
+		}
+		return arena.EntityType{Kind: arena.EntityKindInstance, ID: id}, nil
+
+	case SortComponent:
+		id, err := current.GetComponent(idx)
+		if err != nil {
+			return arena.EntityType{}, err
+		}
+		return arena.EntityType{Kind: arena.EntityKindComponent, ID: id}, nil
+
+	case SortValue:
+		if _, err := current.GetValue(idx); err != nil {
+			return arena.EntityType{}, err
+		}
+		current.MarkValueUsed(idx)
+		return arena.EntityType{Kind: arena.EntityKindValue}, nil
+
+	case SortCore:
+		// Core modules are not tracked in the validator's index spaces
+		if coreSort == 0x11 {
+			return arena.EntityType{Kind: arena.EntityKindModule}, nil
+		}
+		return arena.EntityType{}, fmt.Errorf("core sort 0x%02x cannot be passed between components", coreSort)
+
+	default:
+		return arena.EntityType{}, fmt.Errorf("unknown sort 0x%02x", sort)
+	}
+}
+
+// aliasInstanceExport aliases an instance or component from an instance export
+func (v *StreamingValidator) aliasInstanceExport(current *arena.State, sort byte, instanceIdx uint32, name string) error {
+	instTypeID, err := current.GetInstance(instanceIdx)
+	if err != nil {
+		return fmt.Errorf("instance index %d out of range", instanceIdx)
+	}
+
+	instType := v.types.GetInstance(instTypeID)
+	if instType == nil {
+		return fmt.Errorf("instance type %d not found", instTypeID)
+	}
+
+	entity, ok := instType.Exports[name]
+	if !ok {
+		return fmt.Errorf("instance has no export %q", name)
+	}
+
+	switch sort {
+	case SortInstance:
+		if entity.Kind != arena.EntityKindInstance {
+			return fmt.Errorf("export %q is not an instance", name)
+		}
+		current.AddInstance(entity.ID)
+	case SortComponent:
+		if entity.Kind != arena.EntityKindComponent {
+			return fmt.Errorf("export %q is not a component", name)
+		}
+		current.AddComponent(entity.ID)
+	}
+	return nil
+}
+
+// aliasOuterComponent aliases a component from an enclosing component
+func (v *StreamingValidator) aliasOuterComponent(current *arena.State, sort byte, count, index uint32) error {
+	if sort != SortComponent {
+		return fmt.Errorf("outer alias of sort 0x%02x is not allowed", sort)
+	}
+	if int(count) >= len(v.components) {
+		return fmt.Errorf("outer count %d out of range", count)
+	}
+
+	outer := v.components[len(v.components)-1-int(count)]
+	id, err := outer.GetComponent(index)
+	if err != nil {
+		return fmt.Errorf("outer component index %d: %w", index, err)
+	}
+	current.AddComponent(id)
+	return nil
+}
+
 // parseSingleAlias parses a single alias from its kind byte and data
 func parseSingleAlias(kind byte, data []byte) (*ParsedAlias, error) {
 	r := getReader(data)
@@ -8,8 +8,11 @@ import (
 
 // TypeResolver converts component binary types to wit.Type
 type TypeResolver struct {
+	parent        *TypeResolver // enclosing component scope, nil at the root
 	types         []Type
-	instanceTypes []uint32 // Maps instance index to type index
+	instanceTypes []uint32          // Maps instance index to type index
+	resources     map[uint32]string // resource type index -> export name, set by WithHandles
+	handles       bool              // resolve own/borrow as handles instead of u32
 }
 
 // NewTypeResolverWithInstances creates a resolver with instance type mappings
@@ -17,6 +20,43 @@ func NewTypeResolverWithInstances(types []Type, instanceTypes []uint32) *TypeRes
 	return &TypeResolver{types: types, instanceTypes: instanceTypes}
 }
 
+// Nested creates a resolver for a component nested inside r's component.
+// Outer aliases in the nested type space resolve against r and its parents.
+func (r *TypeResolver) Nested(types []Type, instanceTypes []uint32) *TypeResolver {
+	return &TypeResolver{parent: r, types: types, instanceTypes: instanceTypes}
+}
+
+// WithHandles returns a copy of r resolving own and borrow to handle types
+// of the named resource rather than their u32 ABI representation.
+// resources names the resource types r's component defines; imported
+// resources are named by their type alias.
+func (r *TypeResolver) WithHandles(resources map[uint32]string) *TypeResolver {
+	c := *r
+	c.resources = resources
+	c.handles = true
+	return &c
+}
+
+// outer returns the resolver count scopes up
+func (r *TypeResolver) outer(count uint32) (*TypeResolver, error) {
+	scope := r
+	for i := uint32(0); i < count; i++ {
+		if scope.parent == nil {
+			return nil, fmt.Errorf("outer alias count %d exceeds nesting depth %d", count, i)
+		}
+		scope = scope.parent
+	}
+	return scope, nil
+}
+
+func (r *TypeResolver) resolveOuterAlias(a outerAlias) (wit.Type, error) {
+	scope, err := r.outer(a.Count)
+	if err != nil {
+		return nil, err
+	}
+	return scope.resolveTypeIndex(a.Index)
+}
+
 // Resolve converts a ValType to wit.Type
 func (r *TypeResolver) Resolve(cvt ValType) (wit.Type, error) {
 	switch t := cvt.(type) {
@@ -26,6 +66,8 @@ func (r *TypeResolver) Resolve(cvt ValType) (wit.Type, error) {
 		return r.resolveTypeIndex(t.Index)
 	case typeAlias:
 		return r.resolveTypeAlias(t)
+	case outerAlias:
+		return r.resolveOuterAlias(t)
 	case RecordType:
 		return r.resolveRecord(t)
 	case ListType:
@@ -44,9 +86,11 @@ func (r *TypeResolver) Resolve(cvt ValType) (wit.Type, error) {
 		return r.resolveVariant(t)
 	case BorrowType:
 		// Borrow handles are u32 at Canonical ABI level
-		return wit.U32{}, nil
+		return r.handle(&wit.Borrow{}, r.resourceName(t.TypeIndex)), nil
 	case OwnType:
 		// Own handles are u32 at Canonical ABI level
+		return r.handle(&wit.Own{}, r.resourceName(t.TypeIndex)), nil
+	case resourceDecl:
 		return wit.U32{}, nil
 	default:
 		return nil, fmt.Errorf("unsupported component val type: %T", cvt)
@@ -120,21 +164,67 @@ func (r *TypeResolver) resolveTypeIndex(idx uint32) (wit.Type, error) {
 		return wit.U32{}, nil
 	case OwnType:
 		// own<T> is a resource handle - at Canonical ABI level, it's a u32
-		return wit.U32{}, nil
+		return r.handle(&wit.Own{}, r.resourceName(t.TypeIndex)), nil
 	case BorrowType:
 		// borrow<T> is a resource handle - at Canonical ABI level, it's a u32
-		return wit.U32{}, nil
+		return r.handle(&wit.Borrow{}, r.resourceName(t.TypeIndex)), nil
 	case *componentTypeDecl:
 		return nil, fmt.Errorf("cannot convert component type decl to wit.Type")
 	case TypeIndexRef:
 		return r.resolveTypeIndex(t.Index)
 	case typeAlias:
 		return r.resolveTypeAlias(t)
+	case outerAlias:
+		return r.resolveOuterAlias(t)
 	default:
 		return nil, fmt.Errorf("unsupported type at index %d: %T", idx, ct)
 	}
 }
 
+// handle returns the type of a kind (own or borrow) handle to resource
+// name: its u32 representation, or the handle itself when r keeps handles.
+func (r *TypeResolver) handle(kind wit.TypeDefKind, name string) wit.Type {
+	if !r.handles {
+		return wit.U32{}
+	}
+	res := &wit.TypeDef{Kind: &wit.Resource{}}
+	if name != "" {
+		res.Name = &name
+	}
+	switch k := kind.(type) {
+	case *wit.Own:
+		k.Type = res
+	case *wit.Borrow:
+		k.Type = res
+	}
+	return &wit.TypeDef{Kind: kind}
+}
+
+// resourceName follows aliases from type index idx to the name of the
+// resource it refers to, or "" when it has none.
+func (r *TypeResolver) resourceName(idx uint32) string {
+	if !r.handles {
+		return ""
+	}
+	for range 16 {
+		if name, ok := r.resources[idx]; ok {
+			return name
+		}
+		if int(idx) >= len(r.types) {
+			return ""
+		}
+		switch t := r.types[idx].(type) {
+		case TypeIndexRef:
+			idx = t.Index
+		case typeAlias:
+			return t.ExportName
+		default:
+			return ""
+		}
+	}
+	return ""
+}
+
 func (r *TypeResolver) resolveRecord(rec RecordType) (wit.Type, error) {
 	fields := make([]wit.Field, len(rec.Fields))
 	for i, f := range rec.Fields {
@@ -367,12 +457,28 @@ func (r *TypeResolver) resolveInternalType(cvt ValType, internalTypes map[uint32
 		return &wit.TypeDef{
 			Kind: &wit.Variant{Cases: cases},
 		}, nil
+	case OwnType:
+		return r.handle(&wit.Own{}, r.internalResourceName(t.TypeIndex, internalTypes)), nil
+	case BorrowType:
+		return r.handle(&wit.Borrow{}, r.internalResourceName(t.TypeIndex, internalTypes)), nil
 	default:
 		// For other types (PrimValType, FlagsType, EnumType, typeAlias), use normal resolution
 		return r.Resolve(cvt)
 	}
 }
 
+// internalResourceName names the resource at index idx of an instance
+// type's internal type space.
+func (r *TypeResolver) internalResourceName(idx uint32, internalTypes map[uint32]Type) string {
+	switch t := internalTypes[idx].(type) {
+	case resourceDecl:
+		return t.Name
+	case typeAlias:
+		return t.ExportName
+	}
+	return ""
+}
+
 // resolveTypeAlias resolves a type alias from an instance export
 func (r *TypeResolver) resolveTypeAlias(alias typeAlias) (wit.Type, error) {
 	// Get the instance's type index
@@ -380,6 +486,9 @@ func (r *TypeResolver) resolveTypeAlias(alias typeAlias) (wit.Type, error) {
 		return nil, fmt.Errorf("instance index %d out of range", alias.InstanceIdx)
 	}
 	typeIdx := r.instanceTypes[alias.InstanceIdx]
+	if typeIdx == NoInstanceType {
+		return nil, fmt.Errorf("instance %d has no declared type", alias.InstanceIdx)
+	}
 
 	// Get the instance type
 	if int(typeIdx) >= len(r.types) {
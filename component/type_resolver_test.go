@@ -352,6 +352,54 @@ func TestTypeResolver_ResolveOwn(t *testing.T) {
 	}
 }
 
+func TestTypeResolver_WithHandles(t *testing.T) {
+	types := []Type{
+		ResourceType{},
+		TypeIndexRef{Index: 0},
+		typeAlias{ExportName: "stream", InstanceIdx: 0},
+	}
+	r := NewTypeResolverWithInstances(types, nil).WithHandles(map[uint32]string{0: "counter"})
+
+	tests := []struct {
+		in       ValType
+		kind     string
+		resource string
+	}{
+		{OwnType{TypeIndex: 0}, "own", "counter"},
+		{BorrowType{TypeIndex: 1}, "borrow", "counter"},
+		{OwnType{TypeIndex: 2}, "own", "stream"},
+	}
+	for _, tt := range tests {
+		result, err := r.Resolve(tt.in)
+		if err != nil {
+			t.Fatalf("Resolve(%v): %v", tt.in, err)
+		}
+		td, ok := result.(*wit.TypeDef)
+		if !ok {
+			t.Fatalf("Resolve(%v) = %T, want *wit.TypeDef", tt.in, result)
+		}
+		var res *wit.TypeDef
+		switch k := td.Kind.(type) {
+		case *wit.Own:
+			if tt.kind == "own" {
+				res = k.Type
+			}
+		case *wit.Borrow:
+			if tt.kind == "borrow" {
+				res = k.Type
+			}
+		}
+		if res == nil || res.Name == nil || *res.Name != tt.resource {
+			t.Errorf("Resolve(%v) = %s, want %s<%s>", tt.in, result.WIT(nil, ""), tt.kind, tt.resource)
+		}
+	}
+
+	// The ABI resolver is unchanged
+	if result, _ := NewTypeResolverWithInstances(types, nil).Resolve(OwnType{TypeIndex: 0}); result != (wit.U32{}) {
+		t.Errorf("Resolve without handles = %v, want u32", result)
+	}
+}
+
 func TestTypeResolver_ResolveTypeIndex(t *testing.T) {
 	types := []Type{
 		PrimValType{Type: PrimU32},
@@ -962,3 +1010,26 @@ func TestTypeResolver_ResolveTypeAlias_InternalTypeNotFound(t *testing.T) {
 		t.Fatal("expected error for internal type not found")
 	}
 }
+
+func TestTypeResolver_NestedOuterAlias(t *testing.T) {
+	root := NewTypeResolverWithInstances([]Type{
+		PrimValType{Type: PrimString},
+		PrimValType{Type: PrimU64},
+	}, nil)
+	child := root.Nested([]Type{
+		PrimValType{Type: PrimU8},
+		outerAlias{Count: 1, Index: 1},
+	}, nil)
+
+	got, err := child.Resolve(TypeIndexRef{Index: 1})
+	if err != nil {
+		t.Fatalf("resolve outer alias: %v", err)
+	}
+	if _, ok := got.(wit.U64); !ok {
+		t.Errorf("got %T, want wit.U64 from the enclosing scope", got)
+	}
+
+	if _, err := child.Resolve(outerAlias{Count: 2, Index: 0}); err == nil {
+		t.Error("expected error for outer alias past the root")
+	}
+}
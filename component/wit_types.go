@@ -175,6 +175,25 @@ type typeAlias struct {
 func (typeAlias) isValType() {}
 func (typeAlias) isType()    {}
 
+// resourceDecl is a resource exported by an instance type with a
+// sub resource bound, named for handle resolution.
+type resourceDecl struct {
+	Name string
+}
+
+func (resourceDecl) isValType() {}
+func (resourceDecl) isType()    {}
+
+// outerAlias refers to a type of an enclosing component. Count is the number
+// of components to walk up; resolution needs a TypeResolver created with Nested.
+type outerAlias struct {
+	Count uint32
+	Index uint32
+}
+
+func (outerAlias) isValType() {}
+func (outerAlias) isType()    {}
+
 // FuncType represents a function type
 // Per spec: functype ::= 0x40 ps:<paramlist> rs:<resultlist>
 // resultlist can be either 0x00+type (single result) or 0x01 0x00 (no result)
@@ -279,14 +298,16 @@ type InstanceDeclExport struct {
 
 func (InstanceDeclExport) isInstanceDeclType() {}
 
-// resourceType represents a resource type
-type resourceType struct {
+// ResourceType represents a resource type defined by the component:
+// (resource (rep i32) (dtor f)). The representation is always core i32.
+type ResourceType struct {
 	Rep     *ValType // representation type, nil for abstract
-	Dtor    *uint32  // destructor function index
+	Dtor    *uint32  // core function index of the destructor, nil if none
 	Methods []resourceMethod
 }
 
-func (resourceType) isDefType() {}
+func (ResourceType) isDefType() {}
+func (ResourceType) isType()    {}
 
 type resourceMethod struct {
 	Name string
@@ -340,12 +361,42 @@ func parseType(r io.Reader) (Type, error) {
 		return parseTypeDecl(r)
 	case 0x42:
 		return parseInstanceType(r)
+	case 0x3f:
+		return parseResourceType(r)
 	default:
 		r = io.MultiReader(bytes.NewReader([]byte{typeByte}), r)
 		return parseDefTypeAsType(r)
 	}
 }
 
+// parseResourceType parses a resource definition after its 0x3f byte:
+// rep:0x7f dtor:<core:funcidx>?
+func parseResourceType(r io.Reader) (Type, error) {
+	var b [1]byte
+	if _, err := io.ReadFull(r, b[:]); err != nil {
+		return nil, fmt.Errorf("read resource rep: %w", err)
+	}
+	if b[0] != 0x7f {
+		return nil, fmt.Errorf("unsupported resource rep 0x%02x, want i32", b[0])
+	}
+	if _, err := io.ReadFull(r, b[:]); err != nil {
+		return nil, fmt.Errorf("read resource dtor: %w", err)
+	}
+	var res ResourceType
+	switch b[0] {
+	case 0x00:
+	case 0x01:
+		idx, err := readLEB128(r)
+		if err != nil {
+			return nil, fmt.Errorf("read resource dtor: %w", err)
+		}
+		res.Dtor = &idx
+	default:
+		return nil, fmt.Errorf("invalid resource dtor flag 0x%02x", b[0])
+	}
+	return res, nil
+}
+
 func parseDefTypeAsType(r io.Reader) (Type, error) {
 	var typeByte byte
 	if err := binary.Read(r, binary.LittleEndian, &typeByte); err != nil {
@@ -691,86 +742,94 @@ func parseExportDecl(r io.Reader) (exportDecl, error) {
 		return exportDecl{}, fmt.Errorf("read name: %w", err)
 	}
 
+	desc, err := parseExternDesc(r)
+	if err != nil {
+		return exportDecl{}, err
+	}
+
+	return exportDecl{
+		Name:       string(nameBytes),
+		externDesc: desc,
+	}, nil
+}
+
+// parseExternDesc parses an externdesc: a kind byte followed by its type reference
+func parseExternDesc(r io.Reader) (externDesc, error) {
 	var externKind byte
 	if err := binary.Read(r, binary.LittleEndian, &externKind); err != nil {
-		return exportDecl{}, fmt.Errorf("read extern kind: %w", err)
+		return externDesc{}, fmt.Errorf("read extern kind: %w", err)
 	}
 
 	var typeIndex uint32
+	var err error
 
 	switch externKind {
 	case 0x00:
 		var extraByte byte
 		if err := binary.Read(r, binary.LittleEndian, &extraByte); err != nil {
-			return exportDecl{}, fmt.Errorf("read core module extra byte: %w", err)
+			return externDesc{}, fmt.Errorf("read core module extra byte: %w", err)
 		}
 		typeIndex, err = readLEB128(r)
 		if err != nil {
-			return exportDecl{}, fmt.Errorf("read type index: %w", err)
+			return externDesc{}, fmt.Errorf("read type index: %w", err)
 		}
 
 	case 0x01, 0x04, 0x05:
 		typeIndex, err = readLEB128(r)
 		if err != nil {
-			return exportDecl{}, fmt.Errorf("read type index: %w", err)
+			return externDesc{}, fmt.Errorf("read type index: %w", err)
 		}
 
 	case 0x02:
 		var boundKind byte
 		if err := binary.Read(r, binary.LittleEndian, &boundKind); err != nil {
-			return exportDecl{}, fmt.Errorf("read value bound kind: %w", err)
+			return externDesc{}, fmt.Errorf("read value bound kind: %w", err)
 		}
 		switch boundKind {
 		case 0x00:
 			typeIndex, err = readLEB128(r)
 			if err != nil {
-				return exportDecl{}, fmt.Errorf("read value index: %w", err)
+				return externDesc{}, fmt.Errorf("read value index: %w", err)
 			}
 		case 0x01:
 			_, err = parseValType(r)
 			if err != nil {
-				return exportDecl{}, fmt.Errorf("read value type: %w", err)
+				return externDesc{}, fmt.Errorf("read value type: %w", err)
 			}
 		default:
-			return exportDecl{}, fmt.Errorf("unknown value bound kind: 0x%02x", boundKind)
+			return externDesc{}, fmt.Errorf("unknown value bound kind: 0x%02x", boundKind)
 		}
 
 	case 0x03:
 		var boundKind byte
 		if err := binary.Read(r, binary.LittleEndian, &boundKind); err != nil {
-			return exportDecl{}, fmt.Errorf("read type bound kind: %w", err)
+			return externDesc{}, fmt.Errorf("read type bound kind: %w", err)
 		}
 		switch boundKind {
 		case 0x00: // Eq bound - type index follows
 			typeIndex, err = readLEB128(r)
 			if err != nil {
-				return exportDecl{}, fmt.Errorf("read type index: %w", err)
+				return externDesc{}, fmt.Errorf("read type index: %w", err)
 			}
 		case 0x01: // SubResource bound - no additional data
 			// SubResource bound indicates this type can be any subresource
 		default:
-			return exportDecl{}, fmt.Errorf("unknown type bound kind: 0x%02x", boundKind)
-		}
-		return exportDecl{
-			Name: string(nameBytes),
-			externDesc: externDesc{
-				Kind:      externKind,
-				TypeIndex: typeIndex,
-				BoundKind: boundKind,
-				HasBound:  true,
-			},
+			return externDesc{}, fmt.Errorf("unknown type bound kind: 0x%02x", boundKind)
+		}
+		return externDesc{
+			Kind:      externKind,
+			TypeIndex: typeIndex,
+			BoundKind: boundKind,
+			HasBound:  true,
 		}, nil
 
 	default:
-		return exportDecl{}, fmt.Errorf("unknown extern kind: 0x%02x", externKind)
+		return externDesc{}, fmt.Errorf("unknown extern kind: 0x%02x", externKind)
 	}
 
-	return exportDecl{
-		Name: string(nameBytes),
-		externDesc: externDesc{
-			Kind:      externKind,
-			TypeIndex: typeIndex,
-		},
+	return externDesc{
+		Kind:      externKind,
+		TypeIndex: typeIndex,
 	}, nil
 }
 
@@ -660,7 +660,12 @@ func (w *LowerWrapper) liftArg(witType wit.Type, flat []uint64, mem wasmruntime.
 	}
 
 	consumed := flatCount(witType)
-	return reflect.ValueOf(value[0]).Convert(goType), consumed, nil
+	rv := reflect.ValueOf(value[0])
+	if !rv.IsValid() {
+		// A none option or unit case decodes to nil.
+		return reflect.Zero(goType), consumed, nil
+	}
+	return rv.Convert(goType), consumed, nil
 }
 
 func (w *LowerWrapper) lowerResultWithAlloc(witType wit.Type, value any, mem wasmruntime.Memory, alloc wasmruntime.Allocator) ([]uint64, error) {
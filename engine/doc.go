@@ -19,6 +19,10 @@
 //  3. WazeroModule.Instantiate() creates a WazeroInstance via the linker
 //  4. WazeroInstance provides Call methods for invoking exports
 //
+// Composed components, which instantiate nested components, are linked the
+// same way. Calls of an export lifted by a nested instance use that
+// instance's memory and allocator.
+//
 // # Canonical ABI
 //
 // The canonical ABI defines how WIT types map to WASM core types:
@@ -16,15 +16,21 @@ import (
 	wasmruntime "github.com/wippyai/wasm-runtime"
 	"github.com/wippyai/wasm-runtime/asyncify"
 	"github.com/wippyai/wasm-runtime/component"
+	"github.com/wippyai/wasm-runtime/errors"
 	"github.com/wippyai/wasm-runtime/linker"
+	"github.com/wippyai/wasm-runtime/profile"
 	"github.com/wippyai/wasm-runtime/transcoder"
 )
 
 // WazeroEngine implements Engine using wazero runtime
 type WazeroEngine struct {
-	runtime      wazero.Runtime
-	wasiInitMu   sync.Mutex
-	wasiInitDone atomic.Bool
+	runtime         wazero.Runtime
+	wasiInitMu      sync.Mutex
+	wasiInitDone    atomic.Bool
+	limiterInitMu   sync.Mutex
+	limiterInitDone atomic.Bool
+	profiling       bool
+	stubImports     linker.StubMode
 }
 
 // Config holds configuration for engine creation
@@ -38,6 +44,16 @@ type Config struct {
 	// This allows atomic operations and shared memory within WASM modules.
 	// Note: Thread operations are guest-only and not exposed to host functions.
 	EnableThreads bool
+
+	// EnableProfiling compiles modules with function listeners so calls
+	// can be recorded by a profile.Profiler. Listeners slow every call,
+	// including calls that are not profiled.
+	EnableProfiling bool
+
+	// StubImports defines the imports of components no host function
+	// provides as stubs instead of failing to instantiate. The stubs are
+	// reported by linker.Instance.Diagnostics.
+	StubImports linker.StubMode
 }
 
 // NewWazeroEngine creates a new wazero-based engine
@@ -59,7 +75,26 @@ func NewWazeroEngineWithConfig(ctx context.Context, cfg *Config) (*WazeroEngine,
 	}
 
 	runtime := wazero.NewRuntimeWithConfig(ctx, runtimeCfg)
-	return &WazeroEngine{runtime: runtime}, nil
+	e := &WazeroEngine{runtime: runtime}
+	if cfg != nil {
+		e.profiling = cfg.EnableProfiling
+		e.stubImports = cfg.StubImports
+	}
+	return e, nil
+}
+
+// Profiling reports whether the engine was created with EnableProfiling.
+func (e *WazeroEngine) Profiling() bool {
+	return e.profiling
+}
+
+// listen installs the profiling function listeners in the context of
+// compiling and instantiating modules, when enabled
+func (e *WazeroEngine) listen(ctx context.Context) context.Context {
+	if !e.profiling {
+		return ctx
+	}
+	return profile.Listen(ctx)
 }
 
 // CompileConfig holds configuration for pre-compilation
@@ -70,6 +105,10 @@ type CompileConfig struct {
 
 // InstanceConfig holds configuration for module instantiation
 type InstanceConfig struct {
+	// ResourceLimiter is consulted for memory growth, table growth, instance
+	// creation and resource handle creation. Share one limiter across
+	// instances to enforce a per-tenant budget.
+	ResourceLimiter wasmruntime.ResourceLimiter
 	Name            string
 	AsyncifyImports []string
 	EnableAsyncify  bool
@@ -95,8 +134,9 @@ func (e *WazeroEngine) LoadModule(ctx context.Context, wasmBytes []byte) (*Wazer
 			return nil, fmt.Errorf("build canon registry: %w", err)
 		}
 
-		// Check if this is a multi-module component
-		if len(comp.CoreModules) > 1 || len(comp.CoreInstances) > 0 {
+		// Multi-module components, and composed ones whose nested instances
+		// run in their own memories, are linked per instantiation
+		if len(comp.CoreModules) > 1 || len(comp.CoreInstances) > 0 || nestsCoreModules(validated) {
 			// Store validated component for per-instantiation linker creation
 			// Create shared compiler for layout caching
 			compiler := transcoder.NewCompiler()
@@ -121,7 +161,8 @@ func (e *WazeroEngine) LoadModule(ctx context.Context, wasmBytes []byte) (*Wazer
 		wasmBytes = comp.CoreModules[0]
 	}
 
-	compiled, err := e.runtime.CompileModule(ctx, wasmBytes)
+	symbols := linker.NewModuleSymbols(wasmBytes, wasmBytes)
+	compiled, err := e.runtime.CompileModule(profile.ListenModule(e.listen(ctx), symbols.Source), wasmBytes)
 	if err != nil {
 		return nil, fmt.Errorf("compile failed: %w", err)
 	}
@@ -140,9 +181,22 @@ func (e *WazeroEngine) LoadModule(ctx context.Context, wasmBytes []byte) (*Wazer
 		canonRegistry: canonRegistry,
 		typeResolver:  typeResolver,
 		rawBytes:      wasmBytes,
+		symbols:       symbols,
+		sharedMemory:  linker.DefinesSharedMemory(wasmBytes),
 	}, nil
 }
 
+// nestsCoreModules reports whether c nests components that carry core
+// modules. Wrapper components that only re-type exports carry none.
+func nestsCoreModules(c *component.ValidatedComponent) bool {
+	for _, child := range c.Components {
+		if child.Raw != nil && len(child.Raw.CoreModules) > 0 || nestsCoreModules(child) {
+			return true
+		}
+	}
+	return false
+}
+
 func (e *WazeroEngine) Close(ctx context.Context) error {
 	return e.runtime.Close(ctx)
 }
@@ -166,7 +220,7 @@ func (e *WazeroEngine) InitWASI(ctx context.Context) error {
 		return nil
 	}
 
-	_, err := InstantiateWASIWithAdapter(ctx, e.runtime)
+	_, err := InstantiateWASIWithAdapter(e.listen(ctx), e.runtime)
 	if err != nil {
 		// If another path initialized WASI concurrently in the same runtime,
 		// treat it as success and mark done.
@@ -179,6 +233,36 @@ func (e *WazeroEngine) InitWASI(ctx context.Context) error {
 	return nil
 }
 
+// initLimiterHost instantiates the host module backing the table.grow hook
+// that linker.InstrumentTableGrow injects into core modules.
+func (e *WazeroEngine) initLimiterHost(ctx context.Context) error {
+	if e.limiterInitDone.Load() {
+		return nil
+	}
+
+	e.limiterInitMu.Lock()
+	defer e.limiterInitMu.Unlock()
+
+	if e.limiterInitDone.Load() || e.runtime.Module(linker.LimiterModuleName) != nil {
+		e.limiterInitDone.Store(true)
+		return nil
+	}
+
+	_, err := e.runtime.NewHostModuleBuilder(linker.LimiterModuleName).
+		NewFunctionBuilder().
+		WithGoModuleFunction(api.GoModuleFunc(linker.TableGrowHook),
+			[]api.ValueType{api.ValueTypeI32, api.ValueTypeI32},
+			[]api.ValueType{api.ValueTypeI32}).
+		Export(linker.LimiterTableGrowFunc).
+		Instantiate(e.listen(ctx))
+	if err != nil {
+		return fmt.Errorf("instantiate limiter host: %w", err)
+	}
+
+	e.limiterInitDone.Store(true)
+	return nil
+}
+
 // WazeroModule is a compiled WASM module
 type WazeroModule struct {
 	engine        *WazeroEngine
@@ -194,8 +278,17 @@ type WazeroModule struct {
 	cachedPre     *linker.InstancePre
 	linker        *linker.Linker
 	rawBytes      []byte
+	asyncImports  []string              // imports rawBytes was asyncified for
+	symbols       *linker.ModuleSymbols // names and DWARF, for trap backtraces
 	hostFuncsMu   sync.RWMutex
 	cachedPreMu   sync.RWMutex
+	sharedMemory  bool // memory must not move under a MemoryAllocator
+
+	// limited is compiled with the table.grow hook for instances with a
+	// resource limiter; it is compiled on first use
+	limited        wazero.CompiledModule
+	limitedSymbols *linker.ModuleSymbols
+	limitedMu      sync.Mutex
 }
 
 type HostFunc struct {
@@ -433,6 +526,43 @@ func (m *WazeroModule) initHostModules(ctx context.Context) error {
 	return m.engine.InitWASI(ctx)
 }
 
+// limitedModule returns the compilation used for instances with a resource
+// limiter and its symbols. Modules that use table.grow are compiled
+// again with the hook linker.InstrumentTableGrow injects; others reuse the
+// plain compilation.
+func (m *WazeroModule) limitedModule(ctx context.Context) (wazero.CompiledModule, *linker.ModuleSymbols, error) {
+	m.limitedMu.Lock()
+	defer m.limitedMu.Unlock()
+
+	if m.limited != nil {
+		return m.limited, m.limitedSymbols, nil
+	}
+	instrumented, err := linker.InstrumentTableGrow(m.rawBytes)
+	if err != nil {
+		return nil, nil, fmt.Errorf("instrument table.grow: %w", err)
+	}
+	if len(instrumented) == len(m.rawBytes) {
+		m.limited, m.limitedSymbols = m.compiled, m.symbols
+		return m.limited, m.limitedSymbols, nil
+	}
+	if err := m.engine.initLimiterHost(ctx); err != nil {
+		return nil, nil, err
+	}
+	if len(m.asyncImports) > 0 {
+		instrumented, err = asyncify.Transform(instrumented, asyncify.Config{AsyncImports: m.asyncImports})
+		if err != nil {
+			return nil, nil, fmt.Errorf("asyncify transform: %w", err)
+		}
+	}
+	symbols := linker.NewModuleSymbols(m.rawBytes, instrumented)
+	compiled, err := m.runtime.CompileModule(profile.ListenModule(ctx, symbols.Source), instrumented)
+	if err != nil {
+		return nil, nil, fmt.Errorf("compile with table.grow hook: %w", err)
+	}
+	m.limited, m.limitedSymbols = compiled, symbols
+	return m.limited, m.limitedSymbols, nil
+}
+
 // linkerConfig holds configuration for ensureLinker
 type linkerConfig struct {
 	AsyncifyImports   []string
@@ -458,6 +588,7 @@ func (m *WazeroModule) ensureLinker(cfg linkerConfig) {
 		SemverMatching:    true,
 		AsyncifyTransform: cfg.AsyncifyTransform,
 		AsyncifyImports:   cfg.AsyncifyImports,
+		StubImports:       m.engine.stubImports,
 	}
 	m.linker = linker.New(m.runtime, opts)
 
@@ -484,11 +615,12 @@ func (m *WazeroModule) ensureLinker(cfg linkerConfig) {
 		resultTypes := hf.Wrapper.FlatResultTypes()
 		fn := m.buildTypedHostFunc(hf.Wrapper)
 
+		goType := reflect.TypeOf(hf.Handler)
 		ns := m.linker.Namespace(hf.Namespace)
-		ns.DefineFunc(witName, fn, paramTypes, resultTypes)
+		ns.DefineTypedFunc(witName, fn, paramTypes, resultTypes, goType)
 		// Also register under the original name if different (for compatibility)
 		if witName != hf.Name {
-			ns.DefineFunc(hf.Name, fn, paramTypes, resultTypes)
+			ns.DefineTypedFunc(hf.Name, fn, paramTypes, resultTypes, goType)
 		}
 	}
 }
@@ -553,7 +685,7 @@ func buildResourceDropFunc(handler any) (api.GoModuleFunc, error) {
 		if withCtx {
 			args = append(args, reflect.ValueOf(ctx))
 		}
-		args = append(args, reflect.ValueOf(uint32(stack[0])))
+		args = append(args, reflect.ValueOf(uint32(stack[0])).Convert(selfType))
 		rv.Call(args)
 	}, nil
 }
@@ -581,14 +713,22 @@ func (m *WazeroModule) Compile(ctx context.Context, cfg *CompileConfig) error {
 				return fmt.Errorf("asyncify transform: %w", err)
 			}
 			oldCompiled := m.compiled
-			compiled, err := m.runtime.CompileModule(ctx, transformed)
+			symbols := linker.NewModuleSymbols(m.rawBytes, transformed)
+			compiled, err := m.runtime.CompileModule(profile.ListenModule(m.engine.listen(ctx), symbols.Source), transformed)
 			if err != nil {
 				return fmt.Errorf("recompile after asyncify: %w", err)
 			}
-			m.compiled = compiled
+			m.compiled, m.symbols = compiled, symbols
+			m.asyncImports = asyncImports
 			if oldCompiled != nil {
 				oldCompiled.Close(ctx)
 			}
+			m.limitedMu.Lock()
+			if m.limited != nil && m.limited != oldCompiled {
+				m.limited.Close(ctx)
+			}
+			m.limited, m.limitedSymbols = nil, nil
+			m.limitedMu.Unlock()
 		}
 		return nil
 	}
@@ -606,7 +746,7 @@ func (m *WazeroModule) Compile(ctx context.Context, cfg *CompileConfig) error {
 	})
 
 	// Compile the component
-	pre, err := m.linker.Instantiate(ctx, m.validated)
+	pre, err := m.linker.Instantiate(m.engine.listen(ctx), m.validated)
 	if err != nil {
 		return fmt.Errorf("compile component: %w", err)
 	}
@@ -621,6 +761,9 @@ func (m *WazeroModule) Instantiate(ctx context.Context) (*WazeroInstance, error)
 
 // InstantiateWithConfig creates an instance with custom configuration
 func (m *WazeroModule) InstantiateWithConfig(ctx context.Context, cfg *InstanceConfig) (*WazeroInstance, error) {
+	// Host modules created while instantiating are profiled like guest code
+	ctx = m.engine.listen(ctx)
+
 	// If this is a multi-module component, use per-instantiation linker
 	if m.validated != nil {
 		return m.instantiateMultiModuleWithConfig(ctx, cfg)
@@ -639,11 +782,42 @@ func (m *WazeroModule) InstantiateWithConfig(ctx context.Context, cfg *InstanceC
 		modConfig = modConfig.WithName("") // anonymous for parallel instantiation
 	}
 
+	compiled, symbols := m.compiled, m.symbols
+	var limiter wasmruntime.ResourceLimiter
+	var memAlloc *linker.MemoryAllocator
+	if cfg != nil && cfg.ResourceLimiter != nil {
+		var err error
+		if compiled, symbols, err = m.limitedModule(ctx); err != nil {
+			return nil, err
+		}
+		limiter = cfg.ResourceLimiter
+		if err := limiter.Acquire(wasmruntime.ResourceInstance); err != nil {
+			return nil, fmt.Errorf("instantiate failed: %w", err)
+		}
+		memAlloc = linker.NewMemoryAllocator(limiter)
+		if m.sharedMemory {
+			ctx = memAlloc.WithSharedContext(ctx)
+		} else {
+			ctx = memAlloc.WithContext(ctx)
+		}
+		ctx = wasmruntime.WithResourceLimiter(ctx, limiter)
+	}
+
 	// Instantiate the module
-	instance, err := m.runtime.InstantiateModule(ctx, m.compiled, modConfig)
+	instance, err := m.runtime.InstantiateModule(ctx, compiled, modConfig)
 	if err != nil {
+		if limiter != nil {
+			limiter.Release(wasmruntime.ResourceInstance)
+		}
 		return nil, fmt.Errorf("instantiate failed: %w", err)
 	}
+	if memAlloc != nil {
+		if err := memAlloc.Err(); err != nil {
+			_ = instance.Close(ctx)
+			limiter.Release(wasmruntime.ResourceInstance)
+			return nil, fmt.Errorf("instantiate failed: %w", err)
+		}
+	}
 
 	wazInst := &WazeroInstance{
 		module:    m,
@@ -654,6 +828,8 @@ func (m *WazeroModule) InstantiateWithConfig(ctx context.Context, cfg *InstanceC
 		funcCache: make(map[string]api.Function),
 		liftCache: make(map[string]*cachedLift),
 		stackBuf:  make([]uint64, 16), // pre-allocate stack buffer
+		limiter:   limiter,
+		symbols:   symbols,
 	}
 
 	// Cache memory
@@ -662,22 +838,15 @@ func (m *WazeroModule) InstantiateWithConfig(ctx context.Context, cfg *InstanceC
 	}
 
 	// Cache allocator - try standard cabi_realloc first, then fallbacks
-	allocFnDef := instance.ExportedFunctionDefinitions()[CabiRealloc]
-	if allocFnDef == nil {
-		allocFnDef = instance.ExportedFunctionDefinitions()[legacyRealloc]
-	}
-	if allocFnDef == nil {
-		allocFnDef = instance.ExportedFunctionDefinitions()[legacyAlloc]
-	}
-	if allocFnDef == nil {
-		allocFnDef = instance.ExportedFunctionDefinitions()[simpleAlloc]
-	}
-
+	// Look up by export name; FunctionDefinition.Name is the debug name.
 	var isSimpleAlloc bool
-	if allocFnDef != nil {
-		wazInst.allocFn = instance.ExportedFunction(allocFnDef.Name())
-		paramCount := len(allocFnDef.ParamTypes())
-		isSimpleAlloc = paramCount < 4
+	exportedDefs := instance.ExportedFunctionDefinitions()
+	for _, name := range []string{CabiRealloc, legacyRealloc, legacyAlloc, simpleAlloc} {
+		if def := exportedDefs[name]; def != nil {
+			wazInst.allocFn = instance.ExportedFunction(name)
+			isSimpleAlloc = len(def.ParamTypes()) < 4
+			break
+		}
 	}
 
 	// Cache free function
@@ -712,8 +881,10 @@ func (m *WazeroModule) InstantiateWithConfig(ctx context.Context, cfg *InstanceC
 func (m *WazeroModule) instantiateMultiModuleWithConfig(ctx context.Context, cfg *InstanceConfig) (*WazeroInstance, error) {
 	enableAsyncify := cfg != nil && cfg.EnableAsyncify
 	var asyncifyImports []string
+	var instOpts linker.InstanceOptions
 	if cfg != nil {
 		asyncifyImports = cfg.AsyncifyImports
+		instOpts.ResourceLimiter = cfg.ResourceLimiter
 	}
 
 	// Get or create the cached InstancePre (single lock acquisition)
@@ -736,32 +907,45 @@ func (m *WazeroModule) instantiateMultiModuleWithConfig(ctx context.Context, cfg
 	m.cachedPreMu.Unlock()
 
 	// Create new instance from pre-compiled template
-	inst, err := pre.NewInstance(ctx)
+	inst, err := pre.NewInstanceWithOptions(ctx, instOpts)
 	if err != nil {
 		return nil, fmt.Errorf("instantiate component: %w", err)
 	}
 
-	// Get the final module for the WazeroInstance
-	graph := inst.Graph()
-	if graph == nil {
-		inst.Close(ctx)
-		return nil, fmt.Errorf("no instance graph")
+	// Get the final module for the WazeroInstance. Composed components
+	// may define no core modules of their own, only nested instances.
+	var module api.Module
+	var mods []component.ModuleInstantiation
+	if graph := inst.Graph(); graph != nil {
+		mods = graph.ModuleInstantiations()
 	}
-
-	mods := graph.ModuleInstantiations()
-	if len(mods) == 0 {
+	if len(mods) > 0 {
+		lastMod := mods[len(mods)-1]
+		module = inst.GetModule(lastMod.InstanceIndex)
+		if module == nil {
+			inst.Close(ctx)
+			return nil, fmt.Errorf("final module not found at index %d", lastMod.InstanceIndex)
+		}
+	} else if len(m.validated.Components) == 0 {
 		inst.Close(ctx)
 		return nil, fmt.Errorf("no module instantiations")
 	}
 
-	lastMod := mods[len(mods)-1]
-	module := inst.GetModule(lastMod.InstanceIndex)
-	if module == nil {
-		inst.Close(ctx)
-		return nil, fmt.Errorf("final module not found at index %d", lastMod.InstanceIndex)
+	wazInst := m.linkedInstance(inst, module)
+
+	// Enable asyncify if requested and module supports it
+	if enableAsyncify {
+		if err := wazInst.EnableAsyncify(AsyncifyConfig{}); err != nil {
+			debugf("asyncify not available for component: %v", err)
+		}
 	}
 
-	// Create WazeroInstance wrapper
+	return wazInst, nil
+}
+
+// linkedInstance wraps inst, an instance of a multi-module component whose
+// final core module is module, caching its memory and allocator.
+func (m *WazeroModule) linkedInstance(inst *linker.Instance, module api.Module) *WazeroInstance {
 	wazInst := &WazeroInstance{
 		module:     m,
 		instance:   module,
@@ -797,15 +981,46 @@ func (m *WazeroModule) instantiateMultiModuleWithConfig(ctx context.Context, cfg
 		stackBuf:      wazInst.stackBuf,
 		isSimpleAlloc: isSimpleAlloc,
 	}
+	return wazInst
+}
 
-	// Enable asyncify if requested and module supports it
-	if enableAsyncify {
-		if err := wazInst.EnableAsyncify(AsyncifyConfig{}); err != nil {
-			debugf("asyncify not available for component: %v", err)
+// lifterOf returns the instance to call export name through: a view of the
+// nested component instance that lifts it, with that instance's memory and
+// allocator, or nil when the component lifts the export itself.
+func (i *WazeroInstance) lifterOf(name string) *WazeroInstance {
+	if i.linkerInst == nil {
+		return nil
+	}
+	i.cacheMu.RLock()
+	view, ok := i.lifters[name]
+	i.cacheMu.RUnlock()
+	if ok {
+		return view
+	}
+
+	if owner := i.linkerInst.Lifter(name); owner != nil {
+		exp, _ := i.linkerInst.GetExport(name)
+		view = i.module.linkedInstance(owner, nil)
+		// The owner knows the export by its own name; seed the caches with
+		// the outer one
+		view.lifters = map[string]*WazeroInstance{name: nil}
+		view.funcCache[name] = exp.CoreFunc
+		if exp.Canon != nil {
+			view.liftCache[name] = &cachedLift{
+				fn:      exp.CoreFunc,
+				params:  exp.Canon.ParamTypes,
+				results: exp.Canon.ResultTypes,
+			}
 		}
 	}
 
-	return wazInst, nil
+	i.cacheMu.Lock()
+	if i.lifters == nil {
+		i.lifters = make(map[string]*WazeroInstance)
+	}
+	i.lifters[name] = view
+	i.cacheMu.Unlock()
+	return view
 }
 
 // WazeroInstance is a running WASM instance.
@@ -819,11 +1034,14 @@ type WazeroInstance struct {
 	compiler   *transcoder.Compiler
 	funcCache  map[string]api.Function
 	liftCache  map[string]*cachedLift
+	lifters    map[string]*WazeroInstance // views of nested lifters, nil for own exports
 	module     *WazeroModule
 	decoder    *transcoder.Decoder
 	encoder    *transcoder.Encoder
 	alloc      *wazeroAllocator
 	linkerInst *linker.Instance
+	limiter    wasmruntime.ResourceLimiter
+	symbols    *linker.ModuleSymbols
 	asyncify   *Asyncify
 	scheduler  *Scheduler
 	stackBuf   []uint64
@@ -850,6 +1068,53 @@ func (i *WazeroInstance) GetExportedFunction(name string) api.Function {
 	return i.getExportedFunction(name)
 }
 
+// ExportedGlobal returns a global exported by a core module instance, or
+// nil if there is none. Components do not export globals.
+func (i *WazeroInstance) ExportedGlobal(name string) api.Global {
+	if i.instance == nil {
+		return nil
+	}
+	return i.instance.ExportedGlobal(name)
+}
+
+// LinkerInstance returns the linker instance of a multi-module component,
+// or nil for instances of a single core module.
+func (i *WazeroInstance) LinkerInstance() *linker.Instance {
+	return i.linkerInst
+}
+
+// FuncIndex returns the index of the function named name in the name
+// section. With Locate it implements errors.Symbols for trap backtraces.
+func (i *WazeroInstance) FuncIndex(module, name string) (uint32, bool) {
+	if i.linkerInst != nil {
+		return i.linkerInst.FuncIndex(module, name)
+	}
+	return i.symbols.FuncIndex(module, name)
+}
+
+// Locate resolves a trap frame through the DWARF of the module as loaded.
+func (i *WazeroInstance) Locate(f *errors.Frame) bool {
+	if i.linkerInst != nil {
+		return i.linkerInst.Locate(f)
+	}
+	return i.symbols.Locate(f)
+}
+
+// Source returns the file:line the core function behind export is
+// declared at, from DWARF, or "" without debug info.
+func (i *WazeroInstance) Source(export string) string {
+	fn := i.getExportedFunction(export)
+	if fn == nil {
+		return ""
+	}
+	def := fn.Definition()
+	f := errors.Frame{Name: def.Name(), Index: int(def.Index())}
+	if !i.Locate(&f) || len(f.Source) == 0 {
+		return ""
+	}
+	return f.Source[0]
+}
+
 // MemorySize returns the current linear memory size in bytes, or 0 if no memory.
 func (i *WazeroInstance) MemorySize() uint32 {
 	if i.memory == nil {
@@ -865,6 +1130,9 @@ func (i *WazeroInstance) prepareCallContext(ctx context.Context) context.Context
 	if i.linkerInst != nil {
 		return linker.WithInstance(ctx, i.linkerInst)
 	}
+	if i.limiter != nil {
+		return wasmruntime.WithResourceLimiter(ctx, i.limiter)
+	}
 	return ctx
 }
 
@@ -880,6 +1148,9 @@ func (i *WazeroInstance) EnableAsyncify(config AsyncifyConfig) error {
 		a.SetDataAddr(config.DataAddr)
 	}
 
+	if i.instance == nil {
+		return fmt.Errorf("asyncify: component has no core module of its own")
+	}
 	if err := a.Init(i.instance); err != nil {
 		return err
 	}
@@ -921,6 +1192,9 @@ func (i *WazeroInstance) RunAsync(ctx context.Context, name string, args ...uint
 // CallWithLift calls a function using cached lift information from canon registry.
 // It is faster than Call for repeated invocations as it caches lookup results.
 func (i *WazeroInstance) CallWithLift(ctx context.Context, funcName string, params ...any) (any, error) {
+	if v := i.lifterOf(funcName); v != nil {
+		return v.CallWithLift(ctx, funcName, params...)
+	}
 	ctx = i.prepareCallContext(ctx)
 
 	// Check cache first (read lock)
@@ -962,6 +1236,9 @@ func (i *WazeroInstance) CallWithLift(ctx context.Context, funcName string, para
 
 // CallWithTypes calls a WASM function with explicit WIT type information
 func (i *WazeroInstance) CallWithTypes(ctx context.Context, funcName string, paramTypes []wit.Type, resultTypes []wit.Type, params ...any) (any, error) {
+	if v := i.lifterOf(funcName); v != nil {
+		return v.CallWithTypes(ctx, funcName, paramTypes, resultTypes, params...)
+	}
 	ctx = i.prepareCallContext(ctx)
 
 	// Get cached or lookup function (read lock)
@@ -999,6 +1276,9 @@ func (i *WazeroInstance) CallWithTypes(ctx context.Context, funcName string, par
 // For strings, the result points directly into WASM memory and is only valid
 // while the instance is alive.
 func (i *WazeroInstance) CallInto(ctx context.Context, funcName string, paramTypes []wit.Type, resultTypes []wit.Type, result any, params ...any) error {
+	if v := i.lifterOf(funcName); v != nil {
+		return v.CallInto(ctx, funcName, paramTypes, resultTypes, result, params...)
+	}
 	ctx = i.prepareCallContext(ctx)
 
 	// Get cached or lookup function (read lock)
@@ -1119,10 +1399,16 @@ func (i *WazeroInstance) Close(ctx context.Context) error {
 			firstErr = err
 		}
 		i.instance = nil
+		// Multi-module instances release through the linker instance
+		if i.limiter != nil {
+			i.limiter.Release(wasmruntime.ResourceInstance)
+			i.limiter = nil
+		}
 	}
 	// Clear references to help GC
 	i.funcCache = nil
 	i.liftCache = nil
+	i.lifters = nil
 	i.memory = nil
 	i.allocFn = nil
 	i.freeFn = nil
@@ -1235,10 +1521,62 @@ func (m *WazeroModule) FindLift(name string) *component.LiftDef {
 	return m.canonRegistry.FindLift(name)
 }
 
+// FindLower returns the lower definition a host function registered as
+// namespace#name would satisfy, using the same semver matching as
+// RegisterHostFuncTyped.
+func (m *WazeroModule) FindLower(namespace, name string) *component.LowerDef {
+	if m.canonRegistry == nil {
+		return nil
+	}
+	return m.findLowerDef(namespace, name)
+}
+
+// Lowers returns the lower definitions of all imported functions.
+func (m *WazeroModule) Lowers() []*component.LowerDef {
+	if m.canonRegistry == nil {
+		return nil
+	}
+	return m.canonRegistry.AllLowers()
+}
+
+// Imports reports how the module's imports resolve against its host
+// functions, including imports that would fail compilation. It builds the
+// module's linker, so host functions registered afterwards do not reach
+// the module.
+func (m *WazeroModule) Imports(ctx context.Context) ([]linker.ImportReport, error) {
+	m.cachedPreMu.Lock()
+	defer m.cachedPreMu.Unlock()
+	if m.cachedPre != nil {
+		return m.cachedPre.Imports(), nil
+	}
+
+	m.ensureLinker(linkerConfig{})
+	if m.validated != nil {
+		return m.linker.ComponentImports(m.validated), nil
+	}
+	return m.linker.Imports(m.Lowers()), nil
+}
+
+// Linked reports whether the module's linker has been built. Host functions
+// registered after that do not reach the module.
+func (m *WazeroModule) Linked() bool {
+	m.cachedPreMu.RLock()
+	defer m.cachedPreMu.RUnlock()
+	return m.linker != nil
+}
+
 // ExportNames returns the names of all exported functions
 func (m *WazeroModule) ExportNames() []string {
 	if m.canonRegistry == nil {
-		return nil
+		if m.compiled == nil {
+			return nil
+		}
+		defs := m.compiled.ExportedFunctions()
+		names := make([]string, 0, len(defs))
+		for name := range defs {
+			names = append(names, name)
+		}
+		return names
 	}
 	lifts := m.canonRegistry.AllLifts()
 	names := make([]string, 0, len(lifts))
@@ -1252,6 +1590,7 @@ func (m *WazeroModule) ExportNames() []string {
 // Use StartCall to create, Step to advance, and LiftResult to extract results.
 type CallSession struct {
 	instance    *WazeroInstance
+	name        string
 	fn          api.Function
 	paramTypes  []wit.Type
 	resultTypes []wit.Type
@@ -1302,12 +1641,29 @@ func (i *WazeroInstance) StartCall(ctx context.Context, funcName string, params
 
 	return &CallSession{
 		instance:    i,
+		name:        funcName,
 		fn:          fn,
 		paramTypes:  lift.Params,
 		resultTypes: lift.Results,
 	}, nil
 }
 
+// Name returns the name of the export being called.
+func (cs *CallSession) Name() string {
+	return cs.name
+}
+
+// FuncIndex returns the index of the function named name, for
+// errors.TrapError backtraces.
+func (cs *CallSession) FuncIndex(module, name string) (uint32, bool) {
+	return cs.instance.FuncIndex(module, name)
+}
+
+// Locate resolves a trap frame, for errors.TrapError backtraces.
+func (cs *CallSession) Locate(f *errors.Frame) bool {
+	return cs.instance.Locate(f)
+}
+
 // Step advances execution. Pass nil for the first call, or a YieldResult to resume.
 func (cs *CallSession) Step(ctx context.Context, yr *YieldResult) (StepResult, error) {
 	ctx = cs.instance.prepareCallContext(ctx)
@@ -6,6 +6,9 @@ import (
 	"strings"
 	"testing"
 
+	wasmruntime "github.com/wippyai/wasm-runtime"
+	"github.com/wippyai/wasm-runtime/component"
+	"github.com/wippyai/wasm-runtime/linker"
 	"github.com/wippyai/wasm-runtime/wat"
 )
 
@@ -368,3 +371,109 @@ func TestRegisterResourceDropWithoutCanonLower(t *testing.T) {
 		t.Fatalf("RegisterHostFuncTyped(resource-drop) failed: %v", err)
 	}
 }
+
+func TestNestsCoreModules(t *testing.T) {
+	wrapper := &component.ValidatedComponent{Raw: &component.Component{}}
+	impl := &component.ValidatedComponent{Raw: &component.Component{CoreModules: [][]byte{{0x00}}}}
+
+	if nestsCoreModules(&component.ValidatedComponent{Components: []*component.ValidatedComponent{wrapper}}) {
+		t.Error("export wrapper components should not count as nested core modules")
+	}
+	if !nestsCoreModules(&component.ValidatedComponent{Components: []*component.ValidatedComponent{impl}}) {
+		t.Error("expected nested core modules")
+	}
+	deep := &component.ValidatedComponent{Raw: &component.Component{}, Components: []*component.ValidatedComponent{impl}}
+	if !nestsCoreModules(&component.ValidatedComponent{Components: []*component.ValidatedComponent{deep}}) {
+		t.Error("expected core modules nested two levels deep")
+	}
+}
+
+func TestTableGrowHookOnlyWhenLimited(t *testing.T) {
+	ctx := context.Background()
+
+	eng, err := NewWazeroEngine(ctx)
+	if err != nil {
+		t.Fatalf("NewWazeroEngine: %v", err)
+	}
+	defer eng.Close(ctx)
+
+	wasmBytes, err := wat.Compile(`(module
+		(table 1 funcref)
+		(func (export "grow") (param i32) (result i32)
+			(table.grow (ref.null func) (local.get 0))))`)
+	if err != nil {
+		t.Fatalf("wat compile: %v", err)
+	}
+	mod, err := eng.LoadModule(ctx, wasmBytes)
+	if err != nil {
+		t.Fatalf("LoadModule: %v", err)
+	}
+
+	grow := func(inst *WazeroInstance, delta uint64) (uint64, error) {
+		res, err := inst.GetExportedFunction("grow").Call(inst.prepareCallContext(ctx), delta)
+		if err != nil {
+			return 0, err
+		}
+		return res[0], nil
+	}
+
+	plain, err := mod.Instantiate(ctx)
+	if err != nil {
+		t.Fatalf("Instantiate: %v", err)
+	}
+	defer plain.Close(ctx)
+	if eng.runtime.Module(linker.LimiterModuleName) != nil {
+		t.Error("limiter host instantiated without a limiter")
+	}
+	if v, err := grow(plain, 10); err != nil || v != 1 {
+		t.Errorf("grow(10) = %d, %v; want 1", v, err)
+	}
+
+	limiter := wasmruntime.NewLimiter(wasmruntime.Limits{TableElements: 4})
+	limited, err := mod.InstantiateWithConfig(ctx, &InstanceConfig{ResourceLimiter: limiter})
+	if err != nil {
+		t.Fatalf("InstantiateWithConfig: %v", err)
+	}
+	defer limited.Close(ctx)
+	if v, err := grow(limited, 2); err != nil || v != 1 {
+		t.Errorf("limited grow(2) = %d, %v; want 1", v, err)
+	}
+	if _, err := grow(limited, 2); err == nil {
+		t.Error("limited grow past the limit succeeded")
+	}
+}
+
+func TestAllocatorFoundByExportName(t *testing.T) {
+	ctx := context.Background()
+
+	eng, err := NewWazeroEngine(ctx)
+	if err != nil {
+		t.Fatalf("NewWazeroEngine: %v", err)
+	}
+	defer eng.Close(ctx)
+
+	// The name section gives the allocator a debug name other than its export
+	wasmBytes, err := wat.Compile(`(module
+		(memory (export "memory") 1)
+		(func $bump (export "cabi_realloc") (param i32 i32 i32 i32) (result i32)
+			(i32.const 1024)))`)
+	if err != nil {
+		t.Fatalf("wat compile: %v", err)
+	}
+	mod, err := eng.LoadModule(ctx, wasmBytes)
+	if err != nil {
+		t.Fatalf("LoadModule: %v", err)
+	}
+	inst, err := mod.Instantiate(ctx)
+	if err != nil {
+		t.Fatalf("Instantiate: %v", err)
+	}
+	defer inst.Close(ctx)
+
+	if inst.allocFn == nil {
+		t.Fatal("cabi_realloc export not used as the allocator")
+	}
+	if inst.alloc.isSimpleAlloc {
+		t.Error("cabi_realloc treated as a simple allocator")
+	}
+}
@@ -17,5 +17,26 @@
 //	err := errors.TypeMismatch(errors.PhaseEncode, path, "string", "u32")
 //	err := errors.OutOfBounds(errors.PhaseDecode, path, 10, 5)
 //
+// # Traps
+//
+// Calls that trap in the guest return an error of KindTrap whose Trap field
+// holds the trap code, the export being called and a backtrace of core
+// functions, named from the name section and demangled:
+//
+//	var e *errors.Error
+//	if stderrors.As(err, &e) && e.Trap != nil {
+//		fmt.Println(e.Trap.Code, e.Trap.Export)
+//		for _, f := range e.Trap.Frames {
+//			fmt.Println(f.Index, f.Name, f.Source)
+//		}
+//	}
+//
+// Modules built with debug info also get the DWARF source lines of each
+// frame, inlined calls included; frames of modules without DWARF carry
+// only their function name and index. The runtime resolves frames
+// through Symbols against the modules as loaded, so indexes, offsets and
+// lines are those of the original module even when it was compiled with
+// table.grow instrumented or asyncify applied.
+//
 // All errors implement the standard error interface and support errors.Is/As.
 package errors
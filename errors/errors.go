@@ -24,30 +24,33 @@ const (
 type Kind string
 
 const (
-	KindTypeMismatch   Kind = "type_mismatch"
-	KindOutOfBounds    Kind = "out_of_bounds"
-	KindInvalidData    Kind = "invalid_data"
-	KindUnsupported    Kind = "unsupported"
-	KindAllocation     Kind = "allocation"
-	KindFieldMissing   Kind = "field_missing"
-	KindFieldUnknown   Kind = "field_unknown"
-	KindInvalidUTF8    Kind = "invalid_utf8"
-	KindOverflow       Kind = "overflow"
-	KindNilPointer     Kind = "nil_pointer"
-	KindInvalidEnum    Kind = "invalid_enum"
-	KindInvalidVariant Kind = "invalid_variant"
-	KindMissingImport  Kind = "missing_import"
-	KindNotFound       Kind = "not_found"
-	KindNotInitialized Kind = "not_initialized"
-	KindInvalidInput   Kind = "invalid_input"
-	KindRegistration   Kind = "registration"
-	KindInstantiation  Kind = "instantiation"
+	KindTypeMismatch      Kind = "type_mismatch"
+	KindOutOfBounds       Kind = "out_of_bounds"
+	KindInvalidData       Kind = "invalid_data"
+	KindUnsupported       Kind = "unsupported"
+	KindAllocation        Kind = "allocation"
+	KindFieldMissing      Kind = "field_missing"
+	KindFieldUnknown      Kind = "field_unknown"
+	KindInvalidUTF8       Kind = "invalid_utf8"
+	KindOverflow          Kind = "overflow"
+	KindNilPointer        Kind = "nil_pointer"
+	KindInvalidEnum       Kind = "invalid_enum"
+	KindInvalidVariant    Kind = "invalid_variant"
+	KindMissingImport     Kind = "missing_import"
+	KindNotFound          Kind = "not_found"
+	KindNotInitialized    Kind = "not_initialized"
+	KindInvalidInput      Kind = "invalid_input"
+	KindRegistration      Kind = "registration"
+	KindInstantiation     Kind = "instantiation"
+	KindResourceExhausted Kind = "resource_exhausted"
+	KindTrap              Kind = "trap"
 )
 
 // Error is the structured error type used throughout SDK
 type Error struct {
 	Value   any
 	Cause   error
+	Trap    *Trap // set for KindTrap
 	Phase   Phase
 	Kind    Kind
 	GoType  string
@@ -95,6 +98,12 @@ func (e *Error) Error() string {
 		b.WriteString(e.Detail)
 	}
 
+	// The backtrace replaces the engine's own trace in the cause
+	if e.Trap != nil {
+		e.Trap.writeBacktrace(&b)
+		return b.String()
+	}
+
 	if e.Cause != nil {
 		b.WriteString(" (caused by: ")
 		b.WriteString(e.Cause.Error())
@@ -454,6 +463,66 @@ func (e *MissingImportsError) Is(target error) bool {
 	return ok
 }
 
+// SignatureMismatch is a host function whose Go type does not match the
+// WIT type of the import it is bound to
+type SignatureMismatch struct {
+	Namespace string // e.g., "wasi:cli/stdout@0.2.0"
+	Function  string // e.g., "get-stdout"
+	Position  string // "params", "results", "param <name>" or "result"
+	WitType   string
+	GoType    string
+	Reason    string
+}
+
+// SignatureMismatchError is returned when host functions do not match the
+// signatures of the imports they are bound to
+type SignatureMismatchError struct {
+	Mismatches []SignatureMismatch
+}
+
+func (e *SignatureMismatchError) Error() string {
+	if len(e.Mismatches) == 0 {
+		return "[linking] type_mismatch: no mismatches specified"
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d host function signature mismatch(es):\n", len(e.Mismatches))
+
+	// Group by function, keeping the order of first appearance
+	byFunc := make(map[string][]SignatureMismatch)
+	var order []string
+	for _, m := range e.Mismatches {
+		key := m.Namespace + "#" + m.Function
+		if _, exists := byFunc[key]; !exists {
+			order = append(order, key)
+		}
+		byFunc[key] = append(byFunc[key], m)
+	}
+
+	for _, key := range order {
+		b.WriteString("\n  ")
+		b.WriteString(key)
+		b.WriteString(":\n")
+		for _, m := range byFunc[key] {
+			fmt.Fprintf(&b, "    - %s: wit %s, go %s", m.Position, m.WitType, m.GoType)
+			if m.Reason != "" {
+				b.WriteString(" (")
+				b.WriteString(m.Reason)
+				b.WriteByte(')')
+			}
+			b.WriteByte('\n')
+		}
+	}
+
+	return strings.TrimSuffix(b.String(), "\n")
+}
+
+// Is reports whether target matches this error type
+func (e *SignatureMismatchError) Is(target error) bool {
+	_, ok := target.(*SignatureMismatchError)
+	return ok
+}
+
 // Runtime package convenience constructors
 
 // NotInitialized creates a not-initialized error for missing module/instance
@@ -522,3 +591,12 @@ func ParseFailed(what string, cause error) *Error {
 		Cause:  cause,
 	}
 }
+
+// ResourceExhausted creates an error for a limit rejected by a resource limiter
+func ResourceExhausted(phase Phase, what string, requested, limit uint64) *Error {
+	return &Error{
+		Phase:  phase,
+		Kind:   KindResourceExhausted,
+		Detail: fmt.Sprintf("%s limit exceeded: requested %d, limit %d", what, requested, limit),
+	}
+}
@@ -2,6 +2,8 @@ package errors
 
 import (
 	"errors"
+	"fmt"
+	"reflect"
 	"testing"
 )
 
@@ -232,6 +234,16 @@ func TestConvenienceConstructors(t *testing.T) {
 			t.Errorf("Kind = %v, want %v", err.Kind, KindInvalidEnum)
 		}
 	})
+
+	t.Run("ResourceExhausted", func(t *testing.T) {
+		err := ResourceExhausted(PhaseRuntime, "memory", 2048, 1024)
+		if err.Kind != KindResourceExhausted {
+			t.Errorf("Kind = %v, want %v", err.Kind, KindResourceExhausted)
+		}
+		if !containsSubstring(err.Detail, "memory") || !containsSubstring(err.Detail, "1024") {
+			t.Errorf("Detail = %v, should contain resource and limit", err.Detail)
+		}
+	})
 }
 
 func TestMissingImportsError(t *testing.T) {
@@ -304,6 +316,30 @@ func TestMissingImportsError(t *testing.T) {
 	})
 }
 
+func TestSignatureMismatchError(t *testing.T) {
+	err := &SignatureMismatchError{Mismatches: []SignatureMismatch{
+		{Namespace: "test:host/api", Function: "add", Position: "param a", WitType: "u32", GoType: "int", Reason: "type mismatch"},
+		{Namespace: "test:host/api", Function: "log", Position: "params", WitType: "(msg: string)", GoType: "()"},
+		{Namespace: "test:host/api", Function: "add", Position: "result", WitType: "u32", GoType: "string"},
+	}}
+
+	want := "3 host function signature mismatch(es):\n" +
+		"\n  test:host/api#add:\n" +
+		"    - param a: wit u32, go int (type mismatch)\n" +
+		"    - result: wit u32, go string\n" +
+		"\n  test:host/api#log:\n" +
+		"    - params: wit (msg: string), go ()"
+	if got := err.Error(); got != want {
+		t.Errorf("Error() =\n%s\nwant\n%s", got, want)
+	}
+	if !errors.Is(err, &SignatureMismatchError{}) {
+		t.Error("errors.Is should match SignatureMismatchError")
+	}
+	if msg := (&SignatureMismatchError{}).Error(); !containsSubstring(msg, "no mismatches") {
+		t.Errorf("empty error message = %q", msg)
+	}
+}
+
 func TestDemangleRust(t *testing.T) {
 	tests := []struct {
 		input    string
@@ -350,3 +386,136 @@ func containsSubstringHelper(s, substr string) bool {
 	}
 	return false
 }
+
+func TestParseTrap(t *testing.T) {
+	tests := []struct {
+		name    string
+		err     error
+		code    TrapCode
+		message string
+		frames  []Frame
+	}{
+		{
+			name: "wasm error",
+			err: errors.New("wasm error: out of bounds memory access\nwasm stack trace:\n\t" +
+				"m.store(i32,i32)\n\t\tsrc/lib.rs:10:5\n\t.$7() (i32,i64)"),
+			code:    TrapOutOfBounds,
+			message: "out of bounds memory access",
+			frames:  []Frame{{Module: "m", Name: "store", Index: -1}, {Index: 7}},
+		},
+		{
+			name: "dwarf source lines",
+			err: errors.New("wasm error: unreachable\nwasm stack trace:\n\t" +
+				".helper()\n\t\t0x1a: src/lib.rs:3:9 (inlined)\n\t\t      src/lib.rs:20:7\n\t.main()"),
+			code:    TrapUnreachable,
+			message: "unreachable",
+			frames: []Frame{
+				{Name: "helper", Index: -1, Offset: 0x1a},
+				{Name: "main", Index: -1},
+			},
+		},
+		{
+			name:    "host panic",
+			err:     errors.New("handle 3 not found (recovered by wazero)\nwasm stack trace:\n\tenv.get(i32) i32\n\t.run()"),
+			code:    TrapHostPanic,
+			message: "handle 3 not found",
+			frames:  []Frame{{Module: "env", Name: "get", Index: -1}, {Name: "run", Index: -1}},
+		},
+		{
+			name: "go runtime error",
+			err: errors.New("runtime error: index out of range (recovered by wazero)\nwasm stack trace:\n\t" +
+				".$0()\n\nGo runtime stack trace:\ngoroutine 1"),
+			code:    TrapHostPanic,
+			message: "runtime error: index out of range",
+			frames:  []Frame{{Index: 0}},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trap, ok := ParseTrap(tt.err)
+			if !ok {
+				t.Fatal("not parsed as a trap")
+			}
+			if trap.Code != tt.code || trap.Message != tt.message {
+				t.Errorf("code = %s, message = %q", trap.Code, trap.Message)
+			}
+			if len(trap.Frames) != len(tt.frames) {
+				t.Fatalf("frames = %+v, want %+v", trap.Frames, tt.frames)
+			}
+			for i, f := range tt.frames {
+				if !reflect.DeepEqual(trap.Frames[i], f) {
+					t.Errorf("frame %d = %+v, want %+v", i, trap.Frames[i], f)
+				}
+			}
+		})
+	}
+
+	for _, err := range []error{nil, errors.New("module closed with exit_code(1)"), errors.New("context canceled")} {
+		if _, ok := ParseTrap(err); ok {
+			t.Errorf("ParseTrap(%v) reported a trap", err)
+		}
+	}
+}
+
+// testSymbols locates function 5 at offset 0x2a of a module rewritten
+// with one more import
+type testSymbols struct{}
+
+func (testSymbols) FuncIndex(module, name string) (uint32, bool) {
+	return 5, name == "_ZN4demo4boom17h0123456789abcdefE"
+}
+
+func (testSymbols) Locate(f *Frame) bool {
+	if f.Index != 5 || f.Offset != 0x2a {
+		return false
+	}
+	f.Index, f.Offset = 4, 0x25
+	f.Source = []string{"src/lib.rs:3:9 (inlined)", "src/lib.rs:20:7"}
+	return true
+}
+
+func TestTrapError(t *testing.T) {
+	// The engine's source lines refer to the compiled module and are
+	// replaced by those Symbols locates
+	cause := errors.New("wasm error: unreachable\nwasm stack trace:\n\t" +
+		"._ZN4demo4boom17h0123456789abcdefE()\n\t\t0x2a: src/wrong.rs:1:1\n\t.$3()\n\t\t0x40: src/wrong.rs:2:1")
+	index := testSymbols{}
+
+	err := TrapError(PhaseRuntime, "my:demo/api#run", cause, index)
+	if !errors.Is(err, &Error{Phase: PhaseRuntime, Kind: KindTrap}) {
+		t.Fatalf("error = %v, want a trap", err)
+	}
+	var e *Error
+	errors.As(err, &e)
+	trap := e.Trap
+	if trap.Export != "my:demo/api#run" || trap.Interface != "my:demo/api" {
+		t.Errorf("export = %q, interface = %q", trap.Export, trap.Interface)
+	}
+	wantFrame := Frame{Name: "demo::boom", Index: 4, Offset: 0x25, Source: []string{"src/lib.rs:3:9 (inlined)", "src/lib.rs:20:7"}}
+	if !reflect.DeepEqual(trap.Frames[0], wantFrame) {
+		t.Errorf("frame 0 = %+v", trap.Frames[0])
+	}
+	// Offsets of frames that cannot be located are dropped
+	if !reflect.DeepEqual(trap.Frames[1], Frame{Index: 3}) {
+		t.Errorf("frame 1 = %+v", trap.Frames[1])
+	}
+	want := "[runtime] trap at my:demo/api#run: unreachable\nwasm backtrace:\n  0: .demo::boom (func 4)" +
+		"\n       at src/lib.rs:3:9 (inlined)\n       at src/lib.rs:20:7\n  1: .$3"
+	if err.Error() != want {
+		t.Errorf("Error() =\n%s\nwant\n%s", err.Error(), want)
+	}
+	if !errors.Is(err, cause) {
+		t.Error("trap error should wrap the engine error")
+	}
+
+	// A trap from a nested call is kept rather than wrapped again
+	outer := fmt.Errorf("%w (recovered by wazero)\nwasm stack trace:\n\t.$0()", err)
+	if got := TrapError(PhaseRuntime, "outer", outer, nil); got != err {
+		t.Errorf("nested trap = %v, want the original trap", got)
+	}
+
+	other := errors.New("encode params: invalid")
+	if got := TrapError(PhaseRuntime, "run", other, index); got != other {
+		t.Errorf("non-trap error changed to %v", got)
+	}
+}
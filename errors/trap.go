@@ -0,0 +1,243 @@
+package errors
+
+import (
+	stderrors "errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// TrapCode identifies why guest execution trapped
+type TrapCode string
+
+const (
+	TrapUnreachable         TrapCode = "unreachable"
+	TrapOutOfBounds         TrapCode = "out_of_bounds"          // linear memory access
+	TrapTableOutOfBounds    TrapCode = "table_out_of_bounds"    // table access or uninitialized element
+	TrapIndirectCallType    TrapCode = "indirect_call_type"     // call_indirect signature mismatch
+	TrapIntegerOverflow     TrapCode = "integer_overflow"       // float to int truncation
+	TrapIntegerDivideByZero TrapCode = "integer_divide_by_zero" // div or rem by zero
+	TrapInvalidConversion   TrapCode = "invalid_conversion"     // NaN to int truncation
+	TrapStackOverflow       TrapCode = "stack_overflow"
+	TrapUnalignedAtomic     TrapCode = "unaligned_atomic"
+	TrapHostPanic           TrapCode = "host_panic" // a host function panicked
+	TrapUnknown             TrapCode = "unknown"
+)
+
+// wazeroTrapCodes maps wazero's runtime error messages to trap codes
+var wazeroTrapCodes = map[string]TrapCode{
+	"unreachable":                   TrapUnreachable,
+	"out of bounds memory access":   TrapOutOfBounds,
+	"invalid table access":          TrapTableOutOfBounds,
+	"indirect call type mismatch":   TrapIndirectCallType,
+	"integer overflow":              TrapIntegerOverflow,
+	"integer divide by zero":        TrapIntegerDivideByZero,
+	"invalid conversion to integer": TrapInvalidConversion,
+	"stack overflow":                TrapStackOverflow,
+	"unaligned atomic":              TrapUnalignedAtomic,
+}
+
+// Frame is one core function in a trap backtrace, innermost first
+type Frame struct {
+	Module string   // module name from the name section, usually empty
+	Name   string   // demangled function name from the name section, empty if unnamed
+	Source []string // file:line:column from DWARF, inlined calls first; nil without debug info
+	Index  int      // core function index, -1 if unknown
+	Offset uint64   // code section offset of the instruction, 0 if unknown
+}
+
+// String formats the frame as module.name (func index)
+func (f Frame) String() string {
+	var b strings.Builder
+	b.WriteString(f.Module)
+	b.WriteByte('.')
+	if f.Name != "" {
+		b.WriteString(f.Name)
+	} else if f.Index >= 0 {
+		b.WriteString("$" + strconv.Itoa(f.Index))
+	} else {
+		b.WriteByte('?')
+	}
+	if f.Name != "" && f.Index >= 0 {
+		b.WriteString(" (func ")
+		b.WriteString(strconv.Itoa(f.Index))
+		b.WriteByte(')')
+	}
+	return b.String()
+}
+
+// Trap describes a guest trap. It is attached to errors of KindTrap.
+type Trap struct {
+	Code      TrapCode
+	Message   string  // engine message, or the panic value for TrapHostPanic
+	Export    string  // component export being called, e.g. "my:pkg/api#run"
+	Interface string  // interface of Export, empty for top-level functions
+	Frames    []Frame // backtrace, innermost first
+}
+
+// Symbols resolves the frames the engine reports, which refer to the core
+// modules as compiled, against the modules as loaded. The runtime
+// compiles rewritten modules, so their function indexes and code offsets
+// differ from those of the module the DWARF describes.
+type Symbols interface {
+	// FuncIndex maps a function named in the name section of module to
+	// its core function index. It reports false for unknown or ambiguous
+	// names.
+	FuncIndex(module, name string) (uint32, bool)
+	// Locate rewrites the index and offset of f to those of the module as
+	// loaded and sets its source lines. It reports false when f is not in
+	// exactly one of the modules.
+	Locate(f *Frame) bool
+}
+
+// ParseTrap extracts a trap from an engine call error. It reports false
+// for errors that are not traps, such as exits and cancellation.
+func ParseTrap(err error) (*Trap, bool) {
+	if err == nil {
+		return nil, false
+	}
+	msg := err.Error()
+	head, stack, ok := strings.Cut(msg, "\nwasm stack trace:\n")
+	if !ok {
+		return nil, false
+	}
+
+	t := &Trap{Code: TrapUnknown}
+	if _, text, found := strings.Cut(head, "wasm error: "); found {
+		t.Message = text
+		if code, known := wazeroTrapCodes[text]; known {
+			t.Code = code
+		}
+	} else if text, found := strings.CutSuffix(head, " (recovered by wazero)"); found {
+		t.Code = TrapHostPanic
+		t.Message = text
+	} else {
+		t.Message = head
+	}
+
+	// A Go runtime error appends the Go stack after a blank line
+	stack, _, _ = strings.Cut(stack, "\n\n")
+	for _, line := range strings.Split(stack, "\n") {
+		line = strings.TrimPrefix(line, "\t")
+		switch {
+		case line == "" || strings.HasPrefix(line, "..."): // wazero caps long traces
+		case line[0] == '\t':
+			// Source lines the engine resolved from DWARF for the previous
+			// frame: "0x1a2: lib.rs:3:9 (inlined)", later lines indented
+			// instead. Only the offset is kept, as the engine reads the
+			// DWARF against the compiled module; see Symbols.
+			if len(t.Frames) > 0 {
+				addOffset(&t.Frames[len(t.Frames)-1], strings.TrimSpace(line))
+			}
+		default:
+			t.Frames = append(t.Frames, parseFrame(line))
+		}
+	}
+	return t, true
+}
+
+// addOffset sets the code section offset of f from a source line
+func addOffset(f *Frame, line string) {
+	if hex, _, ok := strings.Cut(line, ": "); ok && strings.HasPrefix(hex, "0x") {
+		if off, err := strconv.ParseUint(hex[2:], 16, 64); err == nil {
+			f.Offset = off
+		}
+	}
+}
+
+// parseFrame parses a wazero frame "module.name(i32,i32) i64", where
+// name is "$index" for functions without a name.
+func parseFrame(line string) Frame {
+	// Strip results: " i32" or " (i32,i64)"
+	if i := strings.LastIndex(line, ") "); i >= 0 {
+		line = line[:i+1]
+	}
+	// Strip params
+	if i := strings.LastIndexByte(line, '('); i >= 0 {
+		line = line[:i]
+	}
+
+	f := Frame{Index: -1}
+	f.Module, f.Name, _ = strings.Cut(line, ".")
+	if idx, ok := strings.CutPrefix(f.Name, "$"); ok {
+		if n, err := strconv.Atoi(idx); err == nil {
+			f.Index = n
+			f.Name = ""
+		}
+	}
+	return f
+}
+
+// resolve fills in the indexes of named frames, which the engine prints
+// only for functions without a name, then locates each frame in the
+// modules as loaded. Offsets of frames that cannot be located are those
+// of the compiled module and are dropped.
+func (t *Trap) resolve(symbols Symbols) {
+	for i := range t.Frames {
+		f := &t.Frames[i]
+		if symbols == nil {
+			f.Offset = 0
+			continue
+		}
+		if f.Index < 0 && f.Name != "" {
+			if idx, ok := symbols.FuncIndex(f.Module, f.Name); ok {
+				f.Index = int(idx)
+			}
+		}
+		if f.Index < 0 || !symbols.Locate(f) {
+			f.Offset = 0
+		}
+	}
+}
+
+// TrapError converts an engine call error from export into a KindTrap
+// error carrying the parsed Trap. symbols, which may be nil, resolves
+// frames to core function indexes and source lines. Errors that are not
+// traps are returned unchanged; a trap already converted by a nested
+// call, such as a linked provider, is returned as is.
+func TrapError(phase Phase, export string, cause error, symbols Symbols) error {
+	var existing *Error
+	if stderrors.As(cause, &existing) && existing.Trap != nil {
+		return existing
+	}
+	t, ok := ParseTrap(cause)
+	if !ok {
+		return cause
+	}
+	t.resolve(symbols)
+	for i := range t.Frames {
+		t.Frames[i].Name = demangleRust(t.Frames[i].Name)
+	}
+	t.Export = export
+	if iface, _, found := strings.Cut(export, "#"); found {
+		t.Interface = iface
+	}
+
+	detail := string(t.Code)
+	if t.Message != "" && (t.Code == TrapHostPanic || t.Code == TrapUnknown) {
+		detail = fmt.Sprintf("%s: %s", t.Code, t.Message)
+	}
+	return &Error{
+		Phase:  phase,
+		Kind:   KindTrap,
+		Path:   []string{export},
+		Detail: detail,
+		Cause:  cause,
+		Trap:   t,
+	}
+}
+
+// writeBacktrace appends the trap's frames to b
+func (t *Trap) writeBacktrace(b *strings.Builder) {
+	if len(t.Frames) == 0 {
+		return
+	}
+	b.WriteString("\nwasm backtrace:")
+	for i, f := range t.Frames {
+		fmt.Fprintf(b, "\n  %d: %s", i, f)
+		for _, src := range f.Source {
+			b.WriteString("\n       at ")
+			b.WriteString(src)
+		}
+	}
+}
@@ -0,0 +1,165 @@
+package wasmruntime
+
+import (
+	"context"
+	"sync/atomic"
+
+	"github.com/wippyai/wasm-runtime/errors"
+)
+
+// ResourceKind identifies a countable resource tracked by a ResourceLimiter.
+type ResourceKind uint8
+
+const (
+	ResourceInstance    ResourceKind = iota // component and core module instances
+	ResourceHandle                          // handles in linker and WASI resource tables
+	ResourceFile                            // open WASI filesystem descriptors
+	ResourceSocket                          // open TCP and UDP sockets
+	ResourceHTTPRequest                     // outgoing HTTP requests awaiting a response
+	numResourceKinds
+)
+
+func (k ResourceKind) String() string {
+	switch k {
+	case ResourceInstance:
+		return "instance"
+	case ResourceHandle:
+		return "handle"
+	case ResourceFile:
+		return "file"
+	case ResourceSocket:
+		return "socket"
+	case ResourceHTTPRequest:
+		return "http-request"
+	default:
+		return "unknown"
+	}
+}
+
+// ResourceLimiter is consulted before a guest acquires memory, table
+// elements, instances or resource handles. One limiter may be shared by
+// many instances to enforce a per-tenant budget.
+//
+// Returning an error rejects the request. Rejected memory growth, table
+// growth and handle creation trap the guest; rejected files, sockets and
+// HTTP requests are reported to the guest as WASI error codes. Errors should
+// be classified as errors.KindResourceExhausted.
+type ResourceLimiter interface {
+	// MemoryGrowing is called before a linear memory grows from current to desired bytes.
+	MemoryGrowing(current, desired uint64) error
+	// TableGrowing is called before a table grows from current to desired elements.
+	TableGrowing(current, desired uint32) error
+	// Acquire is called before one unit of kind is created.
+	Acquire(kind ResourceKind) error
+	// Release is called when a unit admitted by Acquire is freed.
+	Release(kind ResourceKind)
+}
+
+// Limits configures a Limiter. Zero values mean unlimited.
+type Limits struct {
+	MemoryBytes   uint64 // maximum size of a single linear memory
+	TableElements uint32 // maximum size of a single table
+	Instances     uint32
+	Handles       uint32
+	Files         uint32
+	Sockets       uint32
+	HTTPRequests  uint32
+}
+
+// Limiter is a ResourceLimiter enforcing fixed Limits.
+// Counters are shared by every instance using the same Limiter.
+type Limiter struct {
+	limits Limits
+	counts [numResourceKinds]atomic.Int64
+}
+
+// NewLimiter creates a limiter enforcing l.
+func NewLimiter(l Limits) *Limiter {
+	return &Limiter{limits: l}
+}
+
+// MemoryGrowing implements ResourceLimiter.
+func (l *Limiter) MemoryGrowing(_, desired uint64) error {
+	if l.limits.MemoryBytes != 0 && desired > l.limits.MemoryBytes {
+		return errors.ResourceExhausted(errors.PhaseRuntime, "memory", desired, l.limits.MemoryBytes)
+	}
+	return nil
+}
+
+// TableGrowing implements ResourceLimiter.
+func (l *Limiter) TableGrowing(_, desired uint32) error {
+	if l.limits.TableElements != 0 && desired > l.limits.TableElements {
+		return errors.ResourceExhausted(errors.PhaseRuntime, "table", uint64(desired), uint64(l.limits.TableElements))
+	}
+	return nil
+}
+
+// Acquire implements ResourceLimiter.
+func (l *Limiter) Acquire(kind ResourceKind) error {
+	if kind >= numResourceKinds {
+		return errors.InvalidInput(errors.PhaseRuntime, "unknown resource kind")
+	}
+	limit := int64(l.limit(kind))
+	counter := &l.counts[kind]
+	for {
+		n := counter.Load()
+		if limit != 0 && n >= limit {
+			return errors.ResourceExhausted(errors.PhaseRuntime, kind.String(), uint64(n+1), uint64(limit))
+		}
+		if counter.CompareAndSwap(n, n+1) {
+			return nil
+		}
+	}
+}
+
+// Release implements ResourceLimiter.
+func (l *Limiter) Release(kind ResourceKind) {
+	if kind < numResourceKinds {
+		l.counts[kind].Add(-1)
+	}
+}
+
+// Count returns the number of units of kind currently held.
+func (l *Limiter) Count(kind ResourceKind) int64 {
+	if kind >= numResourceKinds {
+		return 0
+	}
+	return l.counts[kind].Load()
+}
+
+func (l *Limiter) limit(kind ResourceKind) uint32 {
+	switch kind {
+	case ResourceInstance:
+		return l.limits.Instances
+	case ResourceHandle:
+		return l.limits.Handles
+	case ResourceFile:
+		return l.limits.Files
+	case ResourceSocket:
+		return l.limits.Sockets
+	case ResourceHTTPRequest:
+		return l.limits.HTTPRequests
+	default:
+		return 0
+	}
+}
+
+// limiterContextKey is the context key for the active resource limiter.
+type limiterContextKey struct{}
+
+// WithResourceLimiter returns a context carrying limiter.
+// Host functions that cannot reach their instance consult it.
+func WithResourceLimiter(ctx context.Context, limiter ResourceLimiter) context.Context {
+	if limiter == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, limiterContextKey{}, limiter)
+}
+
+// ResourceLimiterFromContext returns the limiter carried by ctx, or nil.
+func ResourceLimiterFromContext(ctx context.Context) ResourceLimiter {
+	if l, ok := ctx.Value(limiterContextKey{}).(ResourceLimiter); ok {
+		return l
+	}
+	return nil
+}
@@ -0,0 +1,93 @@
+package wasmruntime
+
+import (
+	"context"
+	stderrors "errors"
+	"sync"
+	"testing"
+
+	"github.com/wippyai/wasm-runtime/errors"
+)
+
+func TestLimiter(t *testing.T) {
+	exhausted := &errors.Error{Phase: errors.PhaseRuntime, Kind: errors.KindResourceExhausted}
+	l := NewLimiter(Limits{MemoryBytes: 1024, TableElements: 8, Sockets: 2})
+
+	if err := l.MemoryGrowing(0, 1024); err != nil {
+		t.Errorf("memory at limit: %v", err)
+	}
+	if err := l.MemoryGrowing(1024, 1025); !stderrors.Is(err, exhausted) {
+		t.Errorf("memory over limit: got %v", err)
+	}
+	if err := l.TableGrowing(4, 9); !stderrors.Is(err, exhausted) {
+		t.Errorf("table over limit: got %v", err)
+	}
+
+	for i := 0; i < 2; i++ {
+		if err := l.Acquire(ResourceSocket); err != nil {
+			t.Fatalf("socket %d: %v", i, err)
+		}
+	}
+	if err := l.Acquire(ResourceSocket); !stderrors.Is(err, exhausted) {
+		t.Errorf("socket over limit: got %v", err)
+	}
+	l.Release(ResourceSocket)
+	if err := l.Acquire(ResourceSocket); err != nil {
+		t.Errorf("socket after release: %v", err)
+	}
+
+	// Zero limits are unlimited
+	for i := 0; i < 100; i++ {
+		if err := l.Acquire(ResourceHandle); err != nil {
+			t.Fatalf("unlimited handle %d: %v", i, err)
+		}
+	}
+	if n := l.Count(ResourceHandle); n != 100 {
+		t.Errorf("Count = %d, want 100", n)
+	}
+}
+
+func TestLimiter_Concurrent(t *testing.T) {
+	l := NewLimiter(Limits{Instances: 10})
+	var wg sync.WaitGroup
+	var mu sync.Mutex
+	admitted := 0
+	for i := 0; i < 50; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			if l.Acquire(ResourceInstance) == nil {
+				mu.Lock()
+				admitted++
+				mu.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if admitted != 10 {
+		t.Errorf("admitted = %d, want 10", admitted)
+	}
+}
+
+func TestResourceLimiterContext(t *testing.T) {
+	ctx := context.Background()
+	if ResourceLimiterFromContext(ctx) != nil {
+		t.Error("empty context returned a limiter")
+	}
+	l := NewLimiter(Limits{})
+	if got := ResourceLimiterFromContext(WithResourceLimiter(ctx, l)); got != l {
+		t.Error("limiter not carried by context")
+	}
+	if WithResourceLimiter(ctx, nil) != ctx {
+		t.Error("nil limiter should return ctx unchanged")
+	}
+}
+
+func TestResourceKindString(t *testing.T) {
+	if ResourceHTTPRequest.String() != "http-request" {
+		t.Errorf("String = %q", ResourceHTTPRequest.String())
+	}
+	if ResourceKind(200).String() != "unknown" {
+		t.Errorf("unknown kind String = %q", ResourceKind(200).String())
+	}
+}
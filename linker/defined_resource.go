@@ -0,0 +1,193 @@
+package linker
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/tetratelabs/wazero/api"
+	"github.com/wippyai/wasm-runtime/component"
+)
+
+// definedResource follows type aliases from typeIdx to a resource type
+// defined by comp, returning the index of its definition.
+func definedResource(comp *component.Component, typeIdx uint32) (uint32, *component.ResourceType, bool) {
+	for range 16 {
+		if int(typeIdx) >= len(comp.TypeIndexSpace) {
+			return 0, nil, false
+		}
+		switch t := comp.TypeIndexSpace[typeIdx].(type) {
+		case component.ResourceType:
+			return typeIdx, &t, true
+		case component.TypeIndexRef:
+			typeIdx = t.Index
+		default:
+			return 0, nil, false
+		}
+	}
+	return 0, nil, false
+}
+
+// ExportedResource returns the resource store type ID of a resource the
+// component defines and exports as name, either directly ("counter") or
+// through an exported interface ("my:pkg/api#counter"). Handles of the
+// resource live in Resources().Table(typeID), whose destructor calls the
+// resource's guest destructor.
+func (inst *Instance) ExportedResource(name string) (uint32, bool) {
+	if inst.pre == nil || inst.pre.component == nil || inst.pre.component.Raw == nil {
+		return 0, false
+	}
+	comp := inst.pre.component.Raw
+
+	typeIdx, ok := uint32(0), false
+	if iface, res, found := strings.Cut(name, "#"); found {
+		typeIdx, ok = exportedInterfaceType(comp, iface, res)
+	} else {
+		for _, exp := range comp.Exports {
+			if exp.Sort == component.SortType && exp.Name == name {
+				typeIdx, ok = exp.SortIndex, true
+				break
+			}
+		}
+	}
+	if !ok {
+		return 0, false
+	}
+
+	typeID, res, ok := definedResource(comp, typeIdx)
+	if !ok {
+		return 0, false
+	}
+	inst.definedTable(typeID, res)
+	return typeID, true
+}
+
+// exportedInterfaceType finds type name in the exported instance iface.
+func exportedInterfaceType(comp *component.Component, iface, name string) (uint32, bool) {
+	for _, exp := range comp.Exports {
+		if exp.Sort != component.SortInstance || exp.Name != iface {
+			continue
+		}
+		idx := exp.SortIndex
+		for range 16 {
+			if int(idx) >= len(comp.InstanceIndexSpace) {
+				return 0, false
+			}
+			entry := comp.InstanceIndexSpace[idx]
+			if entry.Source != component.InstanceSourceExport {
+				break
+			}
+			idx = entry.Index
+		}
+		if int(idx) >= len(comp.InstanceIndexSpace) {
+			return 0, false
+		}
+		entry := comp.InstanceIndexSpace[idx]
+		if entry.Source != component.InstanceSourceDefined || int(entry.Index) >= len(comp.Instances) {
+			return 0, false
+		}
+		parsed := comp.Instances[entry.Index].Parsed
+		if parsed == nil || parsed.Kind != component.InstanceFromExports {
+			return 0, false
+		}
+		for _, arg := range parsed.Args {
+			if arg.Sort == component.SortType && arg.Name == name {
+				return arg.Index, true
+			}
+		}
+		return 0, false
+	}
+	return 0, false
+}
+
+// definedTable returns the handle table of the defined resource typeID,
+// creating it with a destructor that calls the guest's core function.
+func (inst *Instance) definedTable(typeID uint32, res *component.ResourceType) *ResourceTable {
+	if res.Dtor == nil {
+		return inst.resources.Table(typeID)
+	}
+	dtorIdx := *res.Dtor
+	return inst.resources.TableWithDtor(typeID, func(rep uint32) {
+		fn := inst.coreFunc(dtorIdx)
+		if fn == nil {
+			panic(fmt.Errorf("resource %d destructor: core func %d not found", typeID, dtorIdx))
+		}
+		if _, err := fn.Call(WithInstance(context.Background(), inst), uint64(rep)); err != nil {
+			panic(fmt.Errorf("resource %d destructor: %w", typeID, err))
+		}
+	})
+}
+
+// coreFunc resolves a core function aliased from a core instance export.
+func (inst *Instance) coreFunc(idx uint32) api.Function {
+	comp := inst.pre.component.Raw
+	if int(idx) >= len(comp.CoreFuncIndexSpace) {
+		return nil
+	}
+	entry := comp.CoreFuncIndexSpace[idx]
+	if entry.Kind != component.CoreFuncAliasExport || entry.InstanceIdx >= len(inst.coreInstances) {
+		return nil
+	}
+	ci := inst.coreInstances[entry.InstanceIdx]
+	if ci == nil || ci.module == nil {
+		return nil
+	}
+	return inst.safeGetExportedFunction(ci.module, entry.ExportName)
+}
+
+// resourceBuiltin implements canon resource.new, resource.rep and
+// resource.drop for resources defined by the component. It returns nil
+// for imported resources, whose drop is provided by the host. The
+// handler finds the calling instance at call time, as bridge modules are
+// shared between instances.
+func (inst *Instance) resourceBuiltin(kind component.CoreFuncKind, typeIdx uint32, name string) *FuncDef {
+	typeID, res, ok := definedResource(inst.pre.component.Raw, typeIdx)
+	if !ok {
+		return nil
+	}
+
+	def := &FuncDef{
+		Name:       name,
+		ParamTypes: []api.ValueType{api.ValueTypeI32},
+	}
+	caller := func(ctx context.Context, mod api.Module) *Instance {
+		if found := lookupInstanceFromCaller(mod); found != nil {
+			return found
+		}
+		if found := InstanceFromContext(ctx); found != nil {
+			return found
+		}
+		return inst
+	}
+
+	switch kind {
+	case component.CoreFuncResourceNew:
+		def.ResultTypes = []api.ValueType{api.ValueTypeI32}
+		def.Handler = func(ctx context.Context, mod api.Module, stack []uint64) {
+			table := caller(ctx, mod).definedTable(typeID, res)
+			stack[0] = uint64(table.New(uint32(stack[0])))
+		}
+	case component.CoreFuncResourceRep:
+		def.ResultTypes = []api.ValueType{api.ValueTypeI32}
+		def.Handler = func(ctx context.Context, mod api.Module, stack []uint64) {
+			owner := caller(ctx, mod)
+			owner.definedTable(typeID, res)
+			rep, err := ResourceRep(owner.resources, typeID, Handle(stack[0]))
+			if err != nil {
+				panic(err)
+			}
+			stack[0] = uint64(rep)
+		}
+	case component.CoreFuncResourceDrop:
+		def.Handler = func(ctx context.Context, mod api.Module, stack []uint64) {
+			owner := caller(ctx, mod)
+			owner.definedTable(typeID, res)
+			if err := ResourceDrop(owner.resources, typeID, Handle(stack[0])); err != nil {
+				panic(err)
+			}
+		}
+	default:
+		return nil
+	}
+	return def
+}
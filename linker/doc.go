@@ -15,7 +15,37 @@
 //
 //  1. Resolver (VirtualInstance or pre-instantiated Module)
 //  2. Linker namespace bindings
-//  3. Error on unresolved imports
+//  3. Error on unresolved imports, or a stub under Options.StubImports
+//
+// Stubs trap with a missing_import error when called, or with StubDefault
+// return the default value of the import's WIT result type. Each stub is
+// listed by InstancePre.Diagnostics.
+//
+// InstancePre.Imports reports how each imported interface resolved: the
+// namespace version, virtual instance or stub providing each function, and
+// for unresolved functions the versions of their namespace that define
+// them. Linker.Explain reports the same for a single function path.
+//
+// # Host Signatures
+//
+// Host functions defined with Namespace.DefineTypedFunc carry their Go
+// function type. Instantiate checks each against the WIT signature of the
+// import it is bound to, see CheckSignature, and fails with an
+// errors.SignatureMismatchError listing every mismatch.
+//
+// # Nested Components
+//
+// Components that embed and instantiate other components, as produced by
+// composition tools, are instantiated recursively. Each nested component
+// gets its own core instances and memory. Its imports are satisfied by the
+// instantiate arguments: instances imported by the enclosing component
+// resolve through its linker, and functions lifted by another component are
+// called through adapters that copy values between memories. Exports
+// aliased from nested instances call into the instance that lifts them.
+//
+// Not supported: imported or outer-aliased components, resources crossing
+// component boundaries, and lowering functions of nested instances into the
+// enclosing component's own core modules.
 //
 // # Example
 //
@@ -11,6 +11,7 @@ import (
 
 	"github.com/tetratelabs/wazero"
 	"github.com/tetratelabs/wazero/api"
+	wasmruntime "github.com/wippyai/wasm-runtime"
 	"github.com/wippyai/wasm-runtime/component"
 	"github.com/wippyai/wasm-runtime/linker/internal/bridge"
 	"github.com/wippyai/wasm-runtime/linker/internal/invoke"
@@ -91,6 +92,25 @@ func (inst *Instance) resolveMemory() (api.Memory, api.Function) {
 	return inst.cachedMemory, inst.cachedAlloc
 }
 
+// hostHandler returns the handler inst's own linker defines for the host
+// function def, falling back to handler. Host modules are shared by name
+// across the runtime, so the one built first may carry the functions of
+// another component registered under the same import. owner is the linker
+// that built the host module; its own instances use handler directly.
+func (inst *Instance) hostHandler(owner *Linker, def resolve.HostFuncDef, handler api.GoModuleFunc) api.GoModuleFunc {
+	if inst.pre == nil || inst.pre.linker == owner {
+		return handler
+	}
+	fd, ok := def.(*FuncDef)
+	if !ok || fd.path == "" {
+		return handler
+	}
+	if own := inst.pre.resolveHost(fd.path); own != nil && own != fd {
+		return own.Handler
+	}
+	return handler
+}
+
 // trapHandler closes the module with exit code 1.
 func trapHandler(ctx context.Context, mod api.Module, _ []uint64) {
 	if mod != nil {
@@ -102,6 +122,7 @@ func trapHandler(ctx context.Context, mod api.Module, _ []uint64) {
 type Export struct {
 	CoreFunc api.Function
 	Canon    *CanonExport
+	owner    *Instance // nested instance lifting the function, nil for own exports
 	Name     string
 }
 
@@ -123,10 +144,15 @@ type Instance struct {
 	bridgeBuilder   *bridge.Builder
 	bridgeCollector *bridge.Collector
 	resources       *ResourceStore
+	limiter         wasmruntime.ResourceLimiter
+	memAllocator    *MemoryAllocator
 	coreInstances   map[int]*coreInstance
 	bridgeModules   map[string]bool
 	virtualBridges  map[string]bool
 	pre             *InstancePre
+	parent          *Instance   // enclosing component instance, nil at the root
+	nested          []*Instance // nested component instances, parallel to pre.nested
+	lifted          map[uint32]Export
 	exports         map[string]Export
 	encoder         *transcoder.Encoder
 	decoder         *transcoder.Decoder
@@ -135,6 +161,7 @@ type Instance struct {
 	valueSpace      []uint64
 	instanceID      uint64
 	memResolved     bool
+	closed          bool
 }
 
 // coreInstance wraps either a real wazero module or a virtual instance.
@@ -150,9 +177,11 @@ func (inst *Instance) moduleName(base string) string {
 
 // compFuncSource describes what creates a component function.
 type compFuncSource struct {
+	importName string // for imports: the import name
 	kind       compFuncKind
 	coreFunc   uint32 // for lifts: core func index being lifted
 	reExportOf uint32 // for exports: the function index this re-exports
+	alias      uint32 // for aliases: position in FuncIndexSpace
 }
 
 type compFuncKind int
@@ -161,6 +190,7 @@ const (
 	compFuncAlias  compFuncKind = iota // from function alias
 	compFuncLift                       // from canon lift
 	compFuncExport                     // from func export (re-export)
+	compFuncImport                     // from func import
 )
 
 // maxReExportDepth limits re-export chain resolution to detect cycles.
@@ -190,7 +220,8 @@ func (w *boundModuleWrapper) ExportedFunction(name string) api.Function {
 }
 
 // createBoundHandlerFromDef creates a handler that binds memory at call time.
-func createBoundHandlerFromDef(def resolve.HostFuncDef) api.GoModuleFunc {
+// owner is the linker building the host module, see hostHandler.
+func createBoundHandlerFromDef(owner *Linker, def resolve.HostFuncDef) api.GoModuleFunc {
 	handler := def.GetHandler()
 	return func(ctx context.Context, caller api.Module, stack []uint64) {
 		// Try to find the instance: first by caller module name, then by context.
@@ -202,6 +233,7 @@ func createBoundHandlerFromDef(def resolve.HostFuncDef) api.GoModuleFunc {
 			handler(ctx, caller, stack)
 			return
 		}
+		fn := inst.hostHandler(owner, def, handler)
 
 		mem, alloc := inst.resolveMemory()
 		if mem != nil {
@@ -211,20 +243,21 @@ func createBoundHandlerFromDef(def resolve.HostFuncDef) api.GoModuleFunc {
 				boundAlloc: alloc,
 				allocName:  "cabi_realloc",
 			}
-			handler(ctx, wrapped, stack)
+			fn(ctx, wrapped, stack)
 		} else {
-			handler(ctx, caller, stack)
+			fn(ctx, caller, stack)
 		}
 	}
 }
 
 // createSharedMemoryHandler creates a handler that resolves memory at call time.
-func createSharedMemoryHandler(def *FuncDef) api.GoModuleFunc {
-	return createSharedMemoryHandlerFromDef(def)
+func createSharedMemoryHandler(owner *Linker, def *FuncDef) api.GoModuleFunc {
+	return createSharedMemoryHandlerFromDef(owner, def)
 }
 
 // createSharedMemoryHandlerFromDef creates a handler from a HostFuncDef.
-func createSharedMemoryHandlerFromDef(def resolve.HostFuncDef) api.GoModuleFunc {
+// owner is the linker building the host module, see hostHandler.
+func createSharedMemoryHandlerFromDef(owner *Linker, def resolve.HostFuncDef) api.GoModuleFunc {
 	handler := def.GetHandler()
 	return func(ctx context.Context, caller api.Module, stack []uint64) {
 		// Try caller module name first, then context (for shim modules without #instanceID)
@@ -236,6 +269,7 @@ func createSharedMemoryHandlerFromDef(def resolve.HostFuncDef) api.GoModuleFunc
 			handler(ctx, caller, stack)
 			return
 		}
+		fn := inst.hostHandler(owner, def, handler)
 
 		mem, alloc := inst.resolveMemory()
 		if mem != nil {
@@ -245,15 +279,39 @@ func createSharedMemoryHandlerFromDef(def resolve.HostFuncDef) api.GoModuleFunc
 				boundAlloc: alloc,
 				allocName:  "cabi_realloc",
 			}
-			handler(ctx, wrapped, stack)
+			fn(ctx, wrapped, stack)
 		} else {
-			handler(ctx, caller, stack)
+			fn(ctx, caller, stack)
 		}
 	}
 }
 
 // NewInstance creates a new live instance.
 func (pre *InstancePre) NewInstance(ctx context.Context) (*Instance, error) {
+	return pre.NewInstanceWithOptions(ctx, InstanceOptions{})
+}
+
+// NewInstanceWithOptions creates a new live instance configured by opts.
+func (pre *InstancePre) NewInstanceWithOptions(ctx context.Context, opts InstanceOptions) (*Instance, error) {
+	if opts.ResourceLimiter != nil && pre.growsTables && !pre.limitTables {
+		limited, err := pre.limitedVariant(ctx)
+		if err != nil {
+			return nil, err
+		}
+		pre = limited
+	}
+	return pre.newInstance(ctx, opts, nil)
+}
+
+// newInstance creates a live instance of pre nested in parent, which is nil
+// for the outermost component. Nested instances share opts.
+func (pre *InstancePre) newInstance(ctx context.Context, opts InstanceOptions, parent *Instance) (*Instance, error) {
+	if opts.ResourceLimiter != nil {
+		if err := opts.ResourceLimiter.Acquire(wasmruntime.ResourceInstance); err != nil {
+			return nil, instError("init", -1, "", "instance limit reached", err)
+		}
+	}
+
 	numInst := pre.numInstances
 	if numInst == 0 {
 		numInst = 1
@@ -267,15 +325,20 @@ func (pre *InstancePre) NewInstance(ctx context.Context) (*Instance, error) {
 
 	bridgeBuilder, err := bridge.NewBuilder(pre.linker.runtime)
 	if err != nil {
+		if opts.ResourceLimiter != nil {
+			opts.ResourceLimiter.Release(wasmruntime.ResourceInstance)
+		}
 		return nil, instError("init", -1, "", "failed to create bridge builder", err)
 	}
 
 	inst := &Instance{
 		pre:             pre,
+		parent:          parent,
 		instanceID:      atomic.AddUint64(&instanceCounter, 1),
 		modules:         make([]api.Module, 0, numInst),
 		exports:         make(map[string]Export, numExp),
-		resources:       NewResourceStore(),
+		resources:       NewResourceStoreWithLimiter(opts.ResourceLimiter),
+		limiter:         opts.ResourceLimiter,
 		coreInstances:   make(map[int]*coreInstance, numInst),
 		bridgeModules:   make(map[string]bool, numInst),
 		virtualBridges:  make(map[string]bool),
@@ -286,29 +349,60 @@ func (pre *InstancePre) NewInstance(ctx context.Context) (*Instance, error) {
 		layoutCalc:      transcoder.NewLayoutCalculator(),
 	}
 
+	if opts.ResourceLimiter != nil {
+		inst.memAllocator = NewMemoryAllocator(opts.ResourceLimiter)
+	}
+
 	instanceRegistry.Store(inst.instanceID, inst)
 
-	if pre.graph == nil {
+	if pre.graph == nil && len(pre.nested) == 0 {
 		return inst, nil
 	}
 
-	order := pre.topoOrder
-	if order == nil {
-		var err error
-		order, err = pre.graph.TopologicalSort()
+	if pre.graph != nil {
+		if err := inst.instantiateCore(ctx); err != nil {
+			inst.Close(ctx)
+			return nil, err
+		}
+	}
+
+	for i, child := range pre.nested {
+		nested, err := child.newInstance(ctx, opts, inst)
 		if err != nil {
 			inst.Close(ctx)
-			return nil, instError("compile", -1, "", "topological sort failed", err)
+			return nil, instError("nested", i, "", "nested component instantiation failed", err)
 		}
+		inst.nested = append(inst.nested, nested)
 	}
 
-	if err := inst.ensureHostModules(ctx); err != nil {
+	inst.buildExports()
+
+	if err := inst.callStart(ctx); err != nil {
 		inst.Close(ctx)
+		// Error already wrapped by callStart
 		return nil, err
 	}
 
+	return inst, nil
+}
+
+// instantiateCore instantiates the component's core instances in dependency order.
+func (inst *Instance) instantiateCore(ctx context.Context) error {
+	order := inst.pre.topoOrder
+	if order == nil {
+		var err error
+		order, err = inst.pre.graph.TopologicalSort()
+		if err != nil {
+			return instError("compile", -1, "", "topological sort failed", err)
+		}
+	}
+
+	if err := inst.ensureHostModules(ctx); err != nil {
+		return err
+	}
+
 	for _, idx := range order {
-		parsedInst := pre.graph.Instances[idx]
+		parsedInst := inst.pre.graph.Instances[idx]
 		if parsedInst == nil {
 			continue
 		}
@@ -317,16 +411,14 @@ func (pre *InstancePre) NewInstance(ctx context.Context) (*Instance, error) {
 		case component.CoreInstanceInstantiate:
 			mod, err := inst.instantiateModule(ctx, idx, parsedInst)
 			if err != nil {
-				inst.Close(ctx)
 				// Error already wrapped by instantiateModule
-				return nil, err
+				return err
 			}
 			inst.modules = append(inst.modules, mod)
 			inst.coreInstances[idx] = &coreInstance{module: mod}
 
 			if err := inst.createGlobalBridges(ctx, idx, int(parsedInst.ModuleIndex), mod); err != nil {
-				inst.Close(ctx)
-				return nil, err
+				return err
 			}
 
 		case component.CoreInstanceFromExports:
@@ -335,15 +427,7 @@ func (pre *InstancePre) NewInstance(ctx context.Context) (*Instance, error) {
 		}
 	}
 
-	inst.buildExports()
-
-	if err := inst.callStart(ctx); err != nil {
-		inst.Close(ctx)
-		// Error already wrapped by callStart
-		return nil, err
-	}
-
-	return inst, nil
+	return nil
 }
 
 // instantiateModule creates a core module instance.
@@ -474,7 +558,7 @@ func (inst *Instance) instantiateModule(
 			for _, binding := range localBindings {
 				if binding.FuncDef != nil {
 					hasExports = true
-					handler := createSharedMemoryHandler(binding.FuncDef)
+					handler := createSharedMemoryHandler(inst.pre.linker, binding.FuncDef)
 					// Use binding types (from WASM import) to match the expected signature
 					hostBuilder.NewFunctionBuilder().
 						WithGoModuleFunction(handler, binding.ParamTypes, binding.ResultTypes).
@@ -497,10 +581,24 @@ func (inst *Instance) instantiateModule(
 		}
 	}
 
+	if inst.memAllocator != nil {
+		if inst.pre.sharedMemory[parsed.ModuleIndex] {
+			ctx = inst.memAllocator.WithSharedContext(ctx)
+		} else {
+			ctx = inst.memAllocator.WithContext(ctx)
+		}
+	}
+
 	mod, err := inst.pre.linker.runtime.InstantiateModule(ctx, compiled, modConfig)
 	if err != nil {
 		return nil, instError("module_instantiate", instanceIdx, "", "wazero instantiation failed", err)
 	}
+	if inst.memAllocator != nil {
+		if err := inst.memAllocator.Err(); err != nil {
+			_ = mod.Close(ctx)
+			return nil, instError("module_instantiate", instanceIdx, "", "initial memory exceeds limit", err)
+		}
+	}
 
 	return mod, nil
 }
@@ -520,7 +618,7 @@ func (inst *Instance) ensureHostModules(ctx context.Context) error {
 			for _, binding := range localBindings {
 				if binding.FuncDef != nil {
 					hasExports = true
-					handler := createSharedMemoryHandler(binding.FuncDef)
+					handler := createSharedMemoryHandler(inst.pre.linker, binding.FuncDef)
 					// Use binding types (from WASM import) to match the expected signature
 					hostBuilder.NewFunctionBuilder().
 						WithGoModuleFunction(handler, binding.ParamTypes, binding.ResultTypes).
@@ -715,9 +813,9 @@ func (inst *Instance) addRequiredHostFunctions(exports []bridge.Export, namespac
 			continue
 		}
 
-		hostDef := inst.pre.linker.Resolve(key)
+		hostDef := inst.pre.resolveImport(key)
 		if hostDef != nil {
-			handler := createSharedMemoryHandler(hostDef)
+			handler := createSharedMemoryHandler(inst.pre.linker, hostDef)
 			exports = append(exports, bridge.Export{
 				Name:        funcName,
 				Fn:          handler,
@@ -746,7 +844,7 @@ func (inst *Instance) mergeBindingExports(exports []bridge.Export, bindings []re
 			IsTrap:      b.Trap,
 		}
 		if b.FuncDef != nil {
-			hb.Handler = createSharedMemoryHandler(b.FuncDef)
+			hb.Handler = createSharedMemoryHandler(inst.pre.linker, b.FuncDef)
 			hb.ParamTypes = b.FuncDef.ParamTypes
 			hb.ResultTypes = b.FuncDef.ResultTypes
 		}
@@ -1236,7 +1334,7 @@ func (inst *Instance) createGlobalBridges(ctx context.Context, instanceIdx, modu
 				hostBuilder := inst.pre.linker.runtime.NewHostModuleBuilder(hostModName)
 				for _, binding := range funcBindings {
 					if binding.FuncDef != nil {
-						handler := createSharedMemoryHandler(binding.FuncDef)
+						handler := createSharedMemoryHandler(inst.pre.linker, binding.FuncDef)
 						hostBuilder.NewFunctionBuilder().
 							WithGoModuleFunction(handler, binding.ParamTypes, binding.ResultTypes).
 							Export(binding.ImportName)
@@ -1449,9 +1547,9 @@ func (inst *Instance) collectVirtualExports(virt *VirtualInstance, namespace str
 			} else if inst.pre.depGraph != nil && inst.pre.depGraph.IsRequiredFromHost(namespace, entityName) {
 				// Function not accessible (likely re-exported import)
 				// Look up host function from linker
-				hostDef := inst.pre.linker.Resolve(namespace + "#" + entityName)
+				hostDef := inst.pre.resolveImport(namespace + "#" + entityName)
 				if hostDef != nil {
-					handler := createSharedMemoryHandler(hostDef)
+					handler := createSharedMemoryHandler(inst.pre.linker, hostDef)
 					exports = append(exports, bridge.Export{
 						Name:        entityName,
 						Fn:          handler,
@@ -1466,7 +1564,7 @@ func (inst *Instance) collectVirtualExports(virt *VirtualInstance, namespace str
 				continue
 			}
 			// Create wrapper that resolves memory at call time via registry lookup
-			handler := createSharedMemoryHandlerFromDef(src.Def)
+			handler := createSharedMemoryHandlerFromDef(inst.pre.linker, src.Def)
 			exports = append(exports, bridge.Export{
 				Name:        entityName,
 				Fn:          handler,
@@ -1479,7 +1577,7 @@ func (inst *Instance) collectVirtualExports(virt *VirtualInstance, namespace str
 				continue
 			}
 			// Create handler using registry lookup (same as shared handler)
-			boundHandler := createBoundHandlerFromDef(src.Def)
+			boundHandler := createBoundHandlerFromDef(inst.pre.linker, src.Def)
 			exports = append(exports, bridge.Export{
 				Name:        entityName,
 				Fn:          boundHandler,
@@ -1569,10 +1667,18 @@ func (inst *Instance) createVirtualInstance(
 								}
 							}
 						}
+					case component.CoreFuncResourceNew, component.CoreFuncResourceRep:
+						// Builtins of resources defined by this component
+						if def := inst.resourceBuiltin(coreEntry.Kind, coreEntry.Resource, exp.Name); def != nil {
+							entity.Source = HostFunc{Def: def}
+						}
 					case component.CoreFuncResourceDrop:
 						// Resource.drop - find the function in any import namespace
 						// The export name is like "[resource-drop]pollable"
-						def := inst.resolveResourceDropFunc(exp.Name)
+						def := inst.resourceBuiltin(coreEntry.Kind, coreEntry.Resource, exp.Name)
+						if def == nil {
+							def = inst.resolveResourceDropFunc(exp.Name)
+						}
 						if def != nil {
 							entity.Source = HostFunc{Def: def}
 						} else {
@@ -1635,8 +1741,7 @@ func (inst *Instance) createVirtualInstance(
 							path := exp.Name
 							if int(compFuncIdx) < len(comp.FuncIndexSpace) {
 								entry := comp.FuncIndexSpace[compFuncIdx]
-								if int(entry.InstanceIdx) < len(comp.Imports) {
-									imp := comp.Imports[entry.InstanceIdx]
+								if imp := comp.InstanceImport(entry.InstanceIdx); imp != nil {
 									path = imp.Name + "#" + entry.ExportName
 								}
 							}
@@ -1806,12 +1911,11 @@ func (inst *Instance) resolveCanonLowerFunc(compFuncIdx uint32) *FuncDef {
 	entry := comp.FuncIndexSpace[compFuncIdx]
 
 	// For import aliases: InstanceIdx is the import index, ExportName is the func name
-	if int(entry.InstanceIdx) < len(comp.Imports) {
-		imp := comp.Imports[entry.InstanceIdx]
+	if imp := comp.InstanceImport(entry.InstanceIdx); imp != nil {
 		// imp.Name is the import path like "test:strings/host@0.1.0"
 		// entry.ExportName is the function name like "log"
 		path := imp.Name + "#" + entry.ExportName
-		return inst.pre.linker.Resolve(path)
+		return inst.pre.resolveImport(path)
 	}
 
 	return nil
@@ -1852,9 +1956,17 @@ func (inst *Instance) buildExports() {
 	for _, exp := range comp.Exports {
 		export := Export{Name: exp.Name}
 
-		if exp.Sort == 0x01 {
+		switch exp.Sort {
+		case 0x01:
+			// Functions lifted by a nested component run in its instance
+			if lifted, ok := inst.nestedExport(exp.Name, exp.SortIndex); ok {
+				export = lifted
+				break
+			}
 			// Resolve the function and canon info, following re-export chains if needed
 			export.CoreFunc, export.Canon = inst.resolveCompFuncWithCanon(comp, compFuncSources, exp.SortIndex)
+		case component.SortInstance:
+			inst.addNestedInstanceExports(exp.Name, exp.SortIndex)
 		}
 
 		inst.exports[exp.Name] = export
@@ -1991,8 +2103,8 @@ func (inst *Instance) resolveCompFuncWithCanon(
 			return coreFunc, canon
 
 		case compFuncAlias:
-			if int(funcIdx) < len(comp.FuncIndexSpace) {
-				entry := comp.FuncIndexSpace[funcIdx]
+			if int(src.alias) < len(comp.FuncIndexSpace) {
+				entry := comp.FuncIndexSpace[src.alias]
 				if ci := inst.coreInstances[int(entry.InstanceIdx)]; ci != nil && ci.module != nil {
 					return ci.module.ExportedFunction(entry.ExportName), nil
 				}
@@ -2021,26 +2133,12 @@ func (inst *Instance) resolveCanon(funcIdx uint32) *CanonExport {
 	}
 
 	// Resolve function type to wit.Type
-	comp := inst.pre.component.Raw
-	if int(info.TypeIndex) >= len(comp.TypeIndexSpace) {
-		return nil
-	}
-
-	funcType, ok := comp.TypeIndexSpace[info.TypeIndex].(*component.FuncType)
-	if !ok {
-		return nil
-	}
-
-	paramTypes, resultType, err := inst.pre.typeResolver.ResolveFunc(funcType)
+	paramTypes, resultTypes, err := inst.pre.liftSignature(funcIdx)
 	if err != nil {
 		return nil
 	}
 
-	var resultTypes []wit.Type
-	if resultType != nil {
-		resultTypes = []wit.Type{resultType}
-	}
-
+	comp := inst.pre.component.Raw
 	canon := &CanonExport{
 		ParamTypes:  paramTypes,
 		ResultTypes: resultTypes,
@@ -2115,8 +2213,8 @@ func (inst *Instance) resolveCompFunc(
 			return inst.getCoreFunc(comp, int(src.coreFunc))
 
 		case compFuncAlias:
-			if int(funcIdx) < len(comp.FuncIndexSpace) {
-				entry := comp.FuncIndexSpace[funcIdx]
+			if int(src.alias) < len(comp.FuncIndexSpace) {
+				entry := comp.FuncIndexSpace[src.alias]
 				if ci := inst.coreInstances[int(entry.InstanceIdx)]; ci != nil && ci.module != nil {
 					return ci.module.ExportedFunction(entry.ExportName)
 				}
@@ -2233,9 +2331,18 @@ func (inst *Instance) Call(ctx context.Context, name string, args ...any) ([]any
 	if !ok {
 		return nil, fmt.Errorf("linker: export %q not found", name)
 	}
+	owner := inst
+	if exp.owner != nil {
+		owner = exp.owner
+	}
+	results, err := owner.call(ctx, exp, args)
+	return results, owner.trapError(name, err)
+}
 
+// call invokes exp, a function lifted by inst.
+func (inst *Instance) call(ctx context.Context, exp Export, args []any) ([]any, error) {
 	if exp.CoreFunc == nil {
-		return nil, fmt.Errorf("linker: export %q has no core function", name)
+		return nil, fmt.Errorf("linker: export %q has no core function", exp.Name)
 	}
 
 	// Attach instance to context for host handler lookup (needed for shim modules)
@@ -2280,22 +2387,9 @@ func (inst *Instance) Call(ctx context.Context, name string, args ...any) ([]any
 
 		if usesRetptr && len(flatResults) == 1 {
 			// Result was returned via pointer - load from memory
-			basePtr := uint32(flatResults[0])
-			offset := uint32(0)
-			results = make([]any, len(exp.Canon.ResultTypes))
-			for i, rt := range exp.Canon.ResultTypes {
-				// Calculate layout for proper alignment
-				layout := inst.layoutCalc.Calculate(rt)
-				// Align offset to type's alignment requirement
-				if layout.Align > 0 {
-					offset = (offset + layout.Align - 1) &^ (layout.Align - 1)
-				}
-				val, err := inst.decoder.LoadValue(rt, basePtr+offset, mem)
-				if err != nil {
-					return nil, fmt.Errorf("load result[%d]: %w", i, err)
-				}
-				results[i] = val
-				offset += layout.Size
+			results, err = inst.loadValues(exp.Canon.ResultTypes, uint32(flatResults[0]), mem, "result")
+			if err != nil {
+				return nil, err
 			}
 		} else {
 			// Results returned as flat values
@@ -2316,6 +2410,27 @@ func (inst *Instance) Call(ctx context.Context, name string, args ...any) ([]any
 	return results, nil
 }
 
+// loadValues loads values of types laid out one after another from base,
+// like a tuple. what names the values in errors.
+func (inst *Instance) loadValues(types []wit.Type, base uint32, mem transcoder.Memory, what string) ([]any, error) {
+	values := make([]any, len(types))
+	offset := uint32(0)
+	for i, t := range types {
+		// Align offset to type's alignment requirement
+		layout := inst.layoutCalc.Calculate(t)
+		if layout.Align > 0 {
+			offset = (offset + layout.Align - 1) &^ (layout.Align - 1)
+		}
+		val, err := inst.decoder.LoadValue(t, base+offset, mem)
+		if err != nil {
+			return nil, fmt.Errorf("load %s[%d]: %w", what, i, err)
+		}
+		values[i] = val
+		offset += layout.Size
+	}
+	return values, nil
+}
+
 // CallRaw invokes an exported function with raw uint64 values, no ABI encoding.
 func (inst *Instance) CallRaw(ctx context.Context, name string, args ...uint64) ([]uint64, error) {
 	exp, ok := inst.exports[name]
@@ -2327,8 +2442,12 @@ func (inst *Instance) CallRaw(ctx context.Context, name string, args ...uint64)
 		return nil, fmt.Errorf("linker: export %q has no core function", name)
 	}
 
-	// Attach instance to context for host handler lookup (needed for shim modules)
-	ctx = WithInstance(ctx, inst)
+	// Attach the owning instance to context for host handler lookup (needed for shim modules)
+	owner := inst
+	if exp.owner != nil {
+		owner = exp.owner
+	}
+	ctx = WithInstance(ctx, owner)
 
 	return exp.CoreFunc.Call(ctx, args...)
 }
@@ -2432,8 +2551,27 @@ func (inst *Instance) Close(ctx context.Context) error {
 	// Unregister from instance registry
 	instanceRegistry.Delete(inst.instanceID)
 
-	// Close core modules (instance-specific, scoped by instanceID)
+	// Close nested instances, last instantiated first
 	var firstErr error
+	for i := len(inst.nested) - 1; i >= 0; i-- {
+		if err := inst.nested[i].Close(ctx); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	inst.nested = nil
+
+	if !inst.closed {
+		// Handles still held by the guest die with it
+		if inst.resources != nil {
+			inst.resources.Close()
+		}
+		if inst.limiter != nil {
+			inst.limiter.Release(wasmruntime.ResourceInstance)
+		}
+	}
+	inst.closed = true
+
+	// Close core modules (instance-specific, scoped by instanceID)
 	for _, mod := range inst.modules {
 		if mod != nil {
 			if err := mod.Close(ctx); err != nil && firstErr == nil {
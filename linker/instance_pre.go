@@ -2,12 +2,16 @@ package linker
 
 import (
 	"context"
+	"fmt"
+	"sync"
 
 	"github.com/tetratelabs/wazero"
 	"github.com/tetratelabs/wazero/api"
 	"github.com/wippyai/wasm-runtime/asyncify"
 	"github.com/wippyai/wasm-runtime/component"
 	"github.com/wippyai/wasm-runtime/linker/internal/graph"
+	"github.com/wippyai/wasm-runtime/profile"
+	"go.bytecodealliance.org/wit"
 	"go.uber.org/zap"
 )
 
@@ -25,13 +29,27 @@ type InstancePre struct {
 	depGraph            *graph.Graph
 	expectedFuncTypes   map[string]map[string]importSig
 	compFuncSources     map[uint32]compFuncSource
+	hostFuncs           sync.Map // path -> *FuncDef, see resolveHost
 	canonLifts          map[uint32]*canonLiftInfo
 	typeResolver        *component.TypeResolver
+	parent              *InstancePre                     // enclosing component, nil at the root
+	args                map[string]component.InstanceArg // instantiate arguments by import name
+	nestedIndex         map[uint32]int                   // component instance index -> position in nested
+	stubs               map[string]*FuncDef              // stubs of unresolved component imports by path
+	diagnostics         []Diagnostic
 	bindings            []resolvedBinding
 	topoOrder           []int
 	compiled            []wazero.CompiledModule
+	symbols             []*ModuleSymbols // names and DWARF of each compiled module, for trap backtraces
+	sharedMemory        []bool           // whether each compiled module defines a shared memory
+	nested              []*InstancePre   // nested component instances, in instantiation order
+	path                []int            // positions in nested from the root to this component
+	limited             *InstancePre     // variant routing table.grow through the limiter, see limitedVariant
+	limitedMu           sync.Mutex
 	numExports          int
 	numInstances        int
+	limitTables         bool // table.grow is routed through the limiter hook
+	growsTables         bool // a core module, possibly nested, uses table.grow
 }
 
 // canonLiftInfo holds pre-parsed canonical lift information
@@ -62,16 +80,42 @@ func (l *Linker) Instantiate(ctx context.Context, c *component.ValidatedComponen
 		return nil, instError("validate", -1, "", "nil component", nil)
 	}
 
-	pre := &InstancePre{
+	return l.instantiate(ctx, &InstancePre{
 		linker:    l,
 		component: c,
-	}
+	})
+}
+
+// instantiate compiles pre.component. Nested components arrive with their
+// parent, path and instantiate arguments already set.
+func (l *Linker) instantiate(ctx context.Context, pre *InstancePre) (*InstancePre, error) {
+	c := pre.component
 
 	// Compile all core modules (CoreModules is [][]byte)
 	for i, modBytes := range c.Raw.CoreModules {
 		// Rewrite empty module names in imports (wazero doesn't allow them)
 		modBytes = rewriteEmptyModuleNames(modBytes)
 
+		// Route table.grow through the resource limiter hook, only in the
+		// variant compiled for limited instances
+		if growsTable(modBytes) {
+			pre.growsTables = true
+		}
+		if pre.limitTables {
+			instrumented, err := InstrumentTableGrow(modBytes)
+			if err != nil {
+				for j, cm := range pre.compiled {
+					if closeErr := cm.Close(ctx); closeErr != nil {
+						Logger().Warn("failed to close compiled module during cleanup",
+							zap.Int("module_index", j),
+							zap.Error(closeErr))
+					}
+				}
+				return nil, instError("compile", i, "", "table.grow instrumentation failed", err)
+			}
+			modBytes = instrumented
+		}
+
 		// Apply asyncify transform if enabled and module isn't already asyncified
 		if l.options.AsyncifyTransform && !asyncify.IsAsyncified(modBytes) {
 			transformed, err := asyncify.Transform(modBytes, asyncify.Config{
@@ -90,7 +134,8 @@ func (l *Linker) Instantiate(ctx context.Context, c *component.ValidatedComponen
 			modBytes = transformed
 		}
 
-		compiled, err := l.runtime.CompileModule(ctx, modBytes)
+		symbols := NewModuleSymbols(c.Raw.CoreModules[i], modBytes)
+		compiled, err := l.runtime.CompileModule(profile.ListenModule(ctx, symbols.Source), modBytes)
 		if err != nil {
 			// Clean up already-compiled modules before returning error
 			for j, cm := range pre.compiled {
@@ -103,6 +148,8 @@ func (l *Linker) Instantiate(ctx context.Context, c *component.ValidatedComponen
 			return nil, instError("compile", i, "", "module compilation failed", err)
 		}
 		pre.compiled = append(pre.compiled, compiled)
+		pre.symbols = append(pre.symbols, symbols)
+		pre.sharedMemory = append(pre.sharedMemory, DefinesSharedMemory(modBytes))
 	}
 
 	// Build instance graph if we have core instances
@@ -149,11 +196,24 @@ func (l *Linker) Instantiate(ctx context.Context, c *component.ValidatedComponen
 
 	// Build type resolver for function signature resolution
 	if len(c.Raw.TypeIndexSpace) > 0 {
-		pre.typeResolver = component.NewTypeResolverWithInstances(
-			c.Raw.TypeIndexSpace,
-			c.Raw.InstanceTypes,
-		)
+		if pre.parent != nil {
+			pre.typeResolver = pre.parent.typeResolver.Nested(c.Raw.TypeIndexSpace, c.Raw.InstanceTypes)
+		} else {
+			pre.typeResolver = component.NewTypeResolverWithInstances(
+				c.Raw.TypeIndexSpace,
+				c.Raw.InstanceTypes,
+			)
+		}
+	}
+
+	// Check typed host functions against the imports they are bound to,
+	// and define stubs for unresolved ones
+	lowers := pre.canonLowers()
+	if err := pre.checkHostTypes(lowers); err != nil {
+		pre.Close(ctx)
+		return nil, err
 	}
+	pre.stubs = pre.stubLowers(lowers)
 
 	// Store capacity hints
 	pre.numInstances = len(c.Raw.CoreInstances)
@@ -168,6 +228,12 @@ func (l *Linker) Instantiate(ctx context.Context, c *component.ValidatedComponen
 	// Pre-aggregate expected global types from all core modules
 	pre.expectedGlobalTypes = pre.buildExpectedGlobalTypes()
 
+	// Compile nested components instantiated by this one
+	if err := pre.instantiateNested(ctx); err != nil {
+		pre.Close(ctx)
+		return nil, err
+	}
+
 	return pre, nil
 }
 
@@ -294,9 +360,20 @@ func (pre *InstancePre) buildCompFuncSources() map[uint32]compFuncSource {
 	comp := pre.component.Raw
 	sources := make(map[uint32]compFuncSource)
 	funcIdx := uint32(0)
+	aliasIdx := uint32(0)
 
 	for _, marker := range comp.SectionOrder {
 		switch marker.Kind {
+		case component.SectionImport:
+			for i := marker.StartIndex; i < marker.StartIndex+marker.Count; i++ {
+				if i < len(comp.Imports) && comp.Imports[i].ExternKind == component.ExternFunc {
+					sources[funcIdx] = compFuncSource{
+						kind:       compFuncImport,
+						importName: comp.Imports[i].Name,
+					}
+					funcIdx++
+				}
+			}
 		case component.SectionAlias:
 			for i := marker.StartIndex; i < marker.StartIndex+marker.Count; i++ {
 				if i >= len(comp.Aliases) {
@@ -304,8 +381,9 @@ func (pre *InstancePre) buildCompFuncSources() map[uint32]compFuncSource {
 				}
 				alias := comp.Aliases[i]
 				if alias.Parsed != nil && alias.Parsed.Sort == 0x01 {
-					sources[funcIdx] = compFuncSource{kind: compFuncAlias}
+					sources[funcIdx] = compFuncSource{kind: compFuncAlias, alias: aliasIdx}
 					funcIdx++
+					aliasIdx++
 				}
 			}
 		case component.SectionCanon:
@@ -354,6 +432,12 @@ func (pre *InstancePre) buildCanonLifts() map[uint32]*canonLiftInfo {
 
 	for _, marker := range comp.SectionOrder {
 		switch marker.Kind {
+		case component.SectionImport:
+			for i := marker.StartIndex; i < marker.StartIndex+marker.Count; i++ {
+				if i < len(comp.Imports) && comp.Imports[i].ExternKind == component.ExternFunc {
+					funcIdx++
+				}
+			}
 		case component.SectionAlias:
 			for i := marker.StartIndex; i < marker.StartIndex+marker.Count; i++ {
 				if i >= len(comp.Aliases) {
@@ -406,6 +490,32 @@ func (pre *InstancePre) buildCanonLifts() map[uint32]*canonLiftInfo {
 	return lifts
 }
 
+// liftSignature resolves the WIT signature of the lifted component function funcIdx.
+func (pre *InstancePre) liftSignature(funcIdx uint32) (params, results []wit.Type, err error) {
+	info, ok := pre.canonLifts[funcIdx]
+	if !ok || pre.typeResolver == nil {
+		return nil, nil, fmt.Errorf("function %d is not a lifted function", funcIdx)
+	}
+
+	comp := pre.component.Raw
+	if int(info.TypeIndex) >= len(comp.TypeIndexSpace) {
+		return nil, nil, fmt.Errorf("function %d: type index %d out of range", funcIdx, info.TypeIndex)
+	}
+	funcType, ok := comp.TypeIndexSpace[info.TypeIndex].(*component.FuncType)
+	if !ok {
+		return nil, nil, fmt.Errorf("function %d: type %d is not a function type", funcIdx, info.TypeIndex)
+	}
+
+	params, result, err := pre.typeResolver.ResolveFunc(funcType)
+	if err != nil {
+		return nil, nil, fmt.Errorf("function %d: %w", funcIdx, err)
+	}
+	if result != nil {
+		results = []wit.Type{result}
+	}
+	return params, results, nil
+}
+
 // resolveBindings pre-resolves all import bindings for the component
 func (pre *InstancePre) resolveBindings() error {
 	if pre.graph == nil {
@@ -446,12 +556,18 @@ func (pre *InstancePre) resolveBindings() error {
 			// Try to resolve from linker namespace first
 			path := moduleName + "#" + funcName
 			def := pre.linker.Resolve(path)
+			if def == nil && moduleName == LimiterModuleName && funcName == LimiterTableGrowFunc {
+				def = tableGrowFuncDef()
+			}
 			if def != nil {
 				binding.FuncDef = def
 			} else if argsProvided[moduleName] {
 				// Import satisfied by Args (core instance reference) - will be
 				// resolved at instantiation time via bridges.
 				binding.Trap = true
+			} else if pre.linker.options.StubImports != StubNone {
+				binding.FuncDef = pre.stubCoreImport(mi.InstanceIndex, path, binding.ResultTypes)
+				binding.FuncDef.ParamTypes = binding.ParamTypes
 			} else {
 				return instError("import_resolution", mi.InstanceIndex, path, "unresolved import", nil)
 			}
@@ -463,6 +579,17 @@ func (pre *InstancePre) resolveBindings() error {
 	return nil
 }
 
+// resolveHost resolves a host function path in this component's linker,
+// caching the result.
+func (pre *InstancePre) resolveHost(path string) *FuncDef {
+	if def, ok := pre.hostFuncs.Load(path); ok {
+		return def.(*FuncDef)
+	}
+	def := pre.linker.Resolve(path)
+	pre.hostFuncs.Store(path, def)
+	return def
+}
+
 // CompiledModules returns the compiled modules for inspection
 func (pre *InstancePre) CompiledModules() []wazero.CompiledModule {
 	return pre.compiled
@@ -473,9 +600,41 @@ func (pre *InstancePre) Component() *component.ValidatedComponent {
 	return pre.component
 }
 
-// Close releases compiled module resources
+// limitedVariant returns pre compiled with table.grow routed through the
+// limiter hook, compiling it on first use. Only instances with a
+// ResourceLimiter need the rewrite, so the others keep the original code.
+func (pre *InstancePre) limitedVariant(ctx context.Context) (*InstancePre, error) {
+	pre.limitedMu.Lock()
+	defer pre.limitedMu.Unlock()
+	if pre.limited == nil {
+		limited, err := pre.linker.instantiate(ctx, &InstancePre{
+			linker:      pre.linker,
+			component:   pre.component,
+			limitTables: true,
+		})
+		if err != nil {
+			return nil, err
+		}
+		pre.limited = limited
+	}
+	return pre.limited, nil
+}
+
+// Close releases compiled module resources, including those of nested components
 func (pre *InstancePre) Close(ctx context.Context) error {
 	var firstErr error
+	pre.limitedMu.Lock()
+	if pre.limited != nil {
+		firstErr = pre.limited.Close(ctx)
+		pre.limited = nil
+	}
+	pre.limitedMu.Unlock()
+	for _, child := range pre.nested {
+		if err := child.Close(ctx); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	pre.nested = nil
 	for _, cm := range pre.compiled {
 		if err := cm.Close(ctx); err != nil && firstErr == nil {
 			firstErr = err
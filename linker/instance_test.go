@@ -4053,7 +4053,7 @@ func TestCreateSharedMemoryHandler_UsesContextFallback(t *testing.T) {
 		},
 	}
 
-	handler := createSharedMemoryHandler(def)
+	handler := createSharedMemoryHandler(nil, def)
 
 	wasmBytes, _ := wat.Compile(`(module)`)
 	compiled, _ := rt.CompileModule(ctx, wasmBytes)
@@ -4087,7 +4087,7 @@ func TestCreateSharedMemoryHandler_NoInstanceFallback(t *testing.T) {
 		},
 	}
 
-	handler := createSharedMemoryHandler(def)
+	handler := createSharedMemoryHandler(nil, def)
 
 	wasmBytes, _ := wat.Compile(`(module)`)
 	compiled, _ := rt.CompileModule(ctx, wasmBytes)
@@ -4195,7 +4195,7 @@ func BenchmarkSharedMemoryHandler_WithCallerLookup(b *testing.B) {
 	def := &FuncDef{
 		Handler: func(ctx context.Context, mod api.Module, stack []uint64) {},
 	}
-	handler := createSharedMemoryHandler(def)
+	handler := createSharedMemoryHandler(nil, def)
 
 	wasmBytes, _ := wat.Compile(`(module)`)
 	compiled, _ := rt.CompileModule(ctx, wasmBytes)
@@ -4221,7 +4221,7 @@ func BenchmarkSharedMemoryHandler_WithContextFallback(b *testing.B) {
 	def := &FuncDef{
 		Handler: func(ctx context.Context, mod api.Module, stack []uint64) {},
 	}
-	handler := createSharedMemoryHandler(def)
+	handler := createSharedMemoryHandler(nil, def)
 
 	wasmBytes, _ := wat.Compile(`(module)`)
 	compiled, _ := rt.CompileModule(ctx, wasmBytes)
@@ -4244,7 +4244,7 @@ func BenchmarkSharedMemoryHandler_NoInstance(b *testing.B) {
 	def := &FuncDef{
 		Handler: func(ctx context.Context, mod api.Module, stack []uint64) {},
 	}
-	handler := createSharedMemoryHandler(def)
+	handler := createSharedMemoryHandler(nil, def)
 
 	wasmBytes, _ := wat.Compile(`(module)`)
 	compiled, _ := rt.CompileModule(ctx, wasmBytes)
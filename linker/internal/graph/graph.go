@@ -87,8 +87,7 @@ func (g *Graph) identifyHostRequirements(comp *component.Component) {
 	// Check FuncIndexSpace for import aliases
 	for _, entry := range comp.FuncIndexSpace {
 		// entry.InstanceIdx points to the instance, entry.ExportName is the function
-		if int(entry.InstanceIdx) < len(comp.Imports) {
-			imp := comp.Imports[entry.InstanceIdx]
+		if imp := comp.InstanceImport(entry.InstanceIdx); imp != nil {
 			key := imp.Name + "#" + entry.ExportName
 			g.requiredByHost[key] = true
 		}
@@ -100,8 +99,7 @@ func (g *Graph) identifyHostRequirements(comp *component.Component) {
 			// This is a lowered function - check if it comes from an import
 			if int(entry.FuncIndex) < len(comp.FuncIndexSpace) {
 				funcEntry := comp.FuncIndexSpace[entry.FuncIndex]
-				if int(funcEntry.InstanceIdx) < len(comp.Imports) {
-					imp := comp.Imports[funcEntry.InstanceIdx]
+				if imp := comp.InstanceImport(funcEntry.InstanceIdx); imp != nil {
 					key := imp.Name + "#" + funcEntry.ExportName
 					// Only mark as required if NOT provided by adapter
 					if !g.providedByAdapter[key] {
@@ -291,3 +291,70 @@ func ParseTableExports(wasmBytes []byte) []string {
 
 	return nil
 }
+
+// ParseFuncNames extracts the function names subsection of the "name"
+// custom section, keyed by function index. It returns nil when the module
+// has no names.
+func ParseFuncNames(wasmBytes []byte) map[uint32]string {
+	if len(wasmBytes) < 8 {
+		return nil
+	}
+
+	pos := 8
+	for pos < len(wasmBytes) {
+		sectionID := wasmBytes[pos]
+		pos++
+		sectionSize, n := DecodeULEB128(wasmBytes[pos:])
+		pos += n
+		sectionEnd := pos + int(sectionSize)
+		if sectionEnd > len(wasmBytes) {
+			return nil
+		}
+
+		if sectionID != 0x00 {
+			pos = sectionEnd
+			continue
+		}
+		nameLen, n := DecodeULEB128(wasmBytes[pos:])
+		pos += n
+		if pos+int(nameLen) > sectionEnd || string(wasmBytes[pos:pos+int(nameLen)]) != "name" {
+			pos = sectionEnd
+			continue
+		}
+		pos += int(nameLen)
+
+		for pos < sectionEnd {
+			subID := wasmBytes[pos]
+			pos++
+			subSize, n := DecodeULEB128(wasmBytes[pos:])
+			pos += n
+			subEnd := pos + int(subSize)
+			if subEnd > sectionEnd {
+				return nil
+			}
+			if subID != 0x01 {
+				pos = subEnd
+				continue
+			}
+
+			count, n := DecodeULEB128(wasmBytes[pos:])
+			pos += n
+			names := make(map[uint32]string, count)
+			for i := uint32(0); i < count && pos < subEnd; i++ {
+				idx, n := DecodeULEB128(wasmBytes[pos:])
+				pos += n
+				nameLen, n := DecodeULEB128(wasmBytes[pos:])
+				pos += n
+				if pos+int(nameLen) > subEnd {
+					break
+				}
+				names[idx] = string(wasmBytes[pos : pos+int(nameLen)])
+				pos += int(nameLen)
+			}
+			return names
+		}
+		return nil
+	}
+
+	return nil
+}
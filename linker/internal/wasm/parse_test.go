@@ -266,3 +266,20 @@ func TestParseGlobalExports_ImportedGlobal(t *testing.T) {
 		t.Errorf("expected import module 'env', got '%s'", result[0].ImportModule)
 	}
 }
+
+func TestParseFuncNames(t *testing.T) {
+	// custom "name": module subsection (skipped), then function names 0 -> "a", 2 -> "run"
+	payload := []byte{0x04, 'n', 'a', 'm', 'e',
+		0x00, 0x02, 0x01, 'm',
+		0x01, 0x09, 0x02, 0x00, 0x01, 'a', 0x02, 0x03, 'r', 'u', 'n'}
+	module := append(append([]byte{}, testMagicVersion...), 0x00, byte(len(payload)))
+	module = append(module, payload...)
+
+	names := ParseFuncNames(module)
+	if len(names) != 2 || names[0] != "a" || names[2] != "run" {
+		t.Errorf("ParseFuncNames() = %v", names)
+	}
+	if names := ParseFuncNames(testMagicVersion); names != nil {
+		t.Errorf("expected nil without a name section, got %v", names)
+	}
+}
@@ -0,0 +1,461 @@
+package linker
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"slices"
+	"sync"
+
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/experimental"
+	wasmruntime "github.com/wippyai/wasm-runtime"
+	"github.com/wippyai/wasm-runtime/wasm"
+)
+
+// wazero has no table.grow hook, so modules using table.grow are rewritten
+// to call this import with (delta, current size) before each grow.
+const (
+	LimiterModuleName    = "wasm-runtime:limits/table"
+	LimiterTableGrowFunc = "grow"
+)
+
+// InstanceOptions configures a single component instance.
+type InstanceOptions struct {
+	// ResourceLimiter is consulted for memory growth, table growth, instance
+	// creation and resource handle creation. Nil disables limiting.
+	// Shared (threads) memories cannot move, so they are reserved at their
+	// maximum size up front; the limiter still decides how far they grow.
+	// Modules using table.grow are recompiled with the limiter hook for the
+	// first limited instance.
+	ResourceLimiter wasmruntime.ResourceLimiter
+}
+
+// TableGrowHook implements the limiter import injected by InstrumentTableGrow.
+// The limiter is taken from the calling instance, then from ctx.
+func TableGrowHook(ctx context.Context, caller api.Module, stack []uint64) {
+	limiter := limiterForCaller(ctx, caller)
+	if limiter == nil {
+		return
+	}
+	delta, size := uint32(stack[0]), uint32(stack[1])
+	desired := size + delta
+	if desired < size {
+		return // overflow: table.grow fails on its own
+	}
+	if err := limiter.TableGrowing(size, desired); err != nil {
+		panic(err)
+	}
+}
+
+func limiterForCaller(ctx context.Context, caller api.Module) wasmruntime.ResourceLimiter {
+	inst := lookupInstanceFromCaller(caller)
+	if inst == nil {
+		inst = InstanceFromContext(ctx)
+	}
+	if inst != nil && inst.limiter != nil {
+		return inst.limiter
+	}
+	return wasmruntime.ResourceLimiterFromContext(ctx)
+}
+
+// tableGrowFuncDef returns the definition bound to the limiter import.
+func tableGrowFuncDef() *FuncDef {
+	return &FuncDef{
+		Name:        LimiterTableGrowFunc,
+		Handler:     TableGrowHook,
+		ParamTypes:  []api.ValueType{api.ValueTypeI32, api.ValueTypeI32},
+		ResultTypes: []api.ValueType{api.ValueTypeI32},
+	}
+}
+
+// InstrumentTableGrow rewrites each table.grow to call the limiter import
+// first. The import is prepended to the function index space, so every
+// function reference is shifted by one. Modules without table.grow are
+// returned unchanged.
+func InstrumentTableGrow(wasmBytes []byte) ([]byte, error) {
+	if !bytes.Contains(wasmBytes, []byte{wasm.OpPrefixMisc, byte(wasm.MiscTableGrow)}) {
+		return wasmBytes, nil
+	}
+
+	m, err := wasm.ParseModule(wasmBytes)
+	if err != nil {
+		return nil, fmt.Errorf("parse module: %w", err)
+	}
+
+	bodies := make([][]wasm.Instruction, len(m.Code))
+	found := false
+	for i := range m.Code {
+		instrs, err := wasm.DecodeInstructions(m.Code[i].Code)
+		if err != nil {
+			return nil, fmt.Errorf("decode function %d: %w", i, err)
+		}
+		bodies[i] = instrs
+		if !found {
+			for _, in := range instrs {
+				if isTableGrow(in) {
+					found = true
+					break
+				}
+			}
+		}
+	}
+	if !found {
+		return wasmBytes, nil
+	}
+
+	typeIdx := addFuncType(m, wasm.FuncType{
+		Params:  []wasm.ValType{wasm.ValI32, wasm.ValI32},
+		Results: []wasm.ValType{wasm.ValI32},
+	})
+
+	// Insert after the last function import so its index is NumImportedFuncs.
+	hookIdx := uint32(m.NumImportedFuncs())
+	insertAt := 0
+	for i, imp := range m.Imports {
+		if imp.Desc.Kind == wasm.KindFunc {
+			insertAt = i + 1
+		}
+	}
+	hook := wasm.Import{
+		Module: LimiterModuleName,
+		Name:   LimiterTableGrowFunc,
+		Desc:   wasm.ImportDesc{Kind: wasm.KindFunc, TypeIdx: typeIdx},
+	}
+	m.Imports = append(m.Imports[:insertAt], append([]wasm.Import{hook}, m.Imports[insertAt:]...)...)
+
+	shift := func(idx uint32) uint32 {
+		if idx >= hookIdx {
+			return idx + 1
+		}
+		return idx
+	}
+
+	for i, instrs := range bodies {
+		out := make([]wasm.Instruction, 0, len(instrs)+4)
+		for _, in := range instrs {
+			switch in.Opcode {
+			case wasm.OpCall, wasm.OpReturnCall:
+				imm := in.Imm.(wasm.CallImm)
+				in.Imm = wasm.CallImm{FuncIdx: shift(imm.FuncIdx)}
+			case wasm.OpRefFunc:
+				imm := in.Imm.(wasm.RefFuncImm)
+				in.Imm = wasm.RefFuncImm{FuncIdx: shift(imm.FuncIdx)}
+			}
+			if isTableGrow(in) {
+				tableIdx := in.Imm.(wasm.MiscImm).Operands[0]
+				out = append(out,
+					wasm.Instruction{Opcode: wasm.OpPrefixMisc, Imm: wasm.MiscImm{
+						SubOpcode: wasm.MiscTableSize,
+						Operands:  []uint32{tableIdx},
+					}},
+					wasm.Instruction{Opcode: wasm.OpCall, Imm: wasm.CallImm{FuncIdx: hookIdx}},
+				)
+			}
+			out = append(out, in)
+		}
+		m.Code[i].Code = wasm.EncodeInstructions(out)
+	}
+
+	for i := range m.Globals {
+		if m.Globals[i].Init, err = shiftConstExpr(m.Globals[i].Init, shift); err != nil {
+			return nil, fmt.Errorf("global %d: %w", i, err)
+		}
+	}
+	for i := range m.Elements {
+		el := &m.Elements[i]
+		for j := range el.FuncIdxs {
+			el.FuncIdxs[j] = shift(el.FuncIdxs[j])
+		}
+		for j := range el.Exprs {
+			if el.Exprs[j], err = shiftConstExpr(el.Exprs[j], shift); err != nil {
+				return nil, fmt.Errorf("element %d: %w", i, err)
+			}
+		}
+	}
+	for i := range m.Exports {
+		if m.Exports[i].Kind == wasm.KindFunc {
+			m.Exports[i].Idx = shift(m.Exports[i].Idx)
+		}
+	}
+	if m.Start != nil {
+		start := shift(*m.Start)
+		m.Start = &start
+	}
+	custom := m.CustomSections[:0]
+	for _, cs := range m.CustomSections {
+		if cs.Name == "name" {
+			if cs.Data = shiftNameSection(cs.Data, shift); cs.Data == nil {
+				continue
+			}
+		}
+		custom = append(custom, cs)
+	}
+	m.CustomSections = custom
+
+	return m.Encode(), nil
+}
+
+// growsTable reports whether a function of the module uses table.grow.
+func growsTable(wasmBytes []byte) bool {
+	if !bytes.Contains(wasmBytes, []byte{wasm.OpPrefixMisc, byte(wasm.MiscTableGrow)}) {
+		return false
+	}
+	m, err := wasm.ParseModule(wasmBytes)
+	if err != nil {
+		return false
+	}
+	for i := range m.Code {
+		instrs, err := wasm.DecodeInstructions(m.Code[i].Code)
+		if err != nil {
+			// Let InstrumentTableGrow report it
+			return true
+		}
+		if slices.ContainsFunc(instrs, isTableGrow) {
+			return true
+		}
+	}
+	return false
+}
+
+// DefinesSharedMemory reports whether the module defines a shared memory,
+// which must be allocated through MemoryAllocator.WithSharedContext.
+func DefinesSharedMemory(wasmBytes []byte) bool {
+	m, err := wasm.ParseModule(wasmBytes)
+	if err != nil {
+		return false
+	}
+	for _, mem := range m.Memories {
+		if mem.Limits.Shared {
+			return true
+		}
+	}
+	return false
+}
+
+func isTableGrow(in wasm.Instruction) bool {
+	if in.Opcode != wasm.OpPrefixMisc {
+		return false
+	}
+	imm, ok := in.Imm.(wasm.MiscImm)
+	return ok && imm.SubOpcode == wasm.MiscTableGrow && len(imm.Operands) == 1
+}
+
+// addFuncType appends ft to whichever type representation the module uses.
+func addFuncType(m *wasm.Module, ft wasm.FuncType) uint32 {
+	if len(m.TypeDefs) == 0 {
+		return m.AddType(ft)
+	}
+	idx := uint32(m.NumTypes())
+	m.TypeDefs = append(m.TypeDefs, wasm.TypeDef{Kind: wasm.TypeDefKindFunc, Func: &ft})
+	m.Types = append(m.Types, ft)
+	return idx
+}
+
+func shiftConstExpr(expr []byte, shift func(uint32) uint32) ([]byte, error) {
+	if !bytes.Contains(expr, []byte{wasm.OpRefFunc}) {
+		return expr, nil
+	}
+	instrs, err := wasm.DecodeInstructions(expr)
+	if err != nil {
+		return nil, err
+	}
+	for i := range instrs {
+		if instrs[i].Opcode == wasm.OpRefFunc {
+			imm := instrs[i].Imm.(wasm.RefFuncImm)
+			instrs[i].Imm = wasm.RefFuncImm{FuncIdx: shift(imm.FuncIdx)}
+		}
+	}
+	return wasm.EncodeInstructions(instrs), nil
+}
+
+// shiftNameSection remaps the function, local and label name subsections.
+// A malformed section is dropped rather than left with stale indices.
+func shiftNameSection(data []byte, shift func(uint32) uint32) []byte {
+	r := bytes.NewReader(data)
+	var out bytes.Buffer
+	for r.Len() > 0 {
+		id, err := r.ReadByte()
+		if err != nil {
+			return nil
+		}
+		size, err := wasm.ReadLEB128u(r)
+		if err != nil || int(size) > r.Len() {
+			return nil
+		}
+		payload := make([]byte, size)
+		_, _ = r.Read(payload)
+
+		switch id {
+		case 1: // function names: vec(funcidx name)
+			payload = shiftNameMap(payload, shift, false)
+		case 2, 3: // local and label names: vec(funcidx vec(idx name))
+			payload = shiftNameMap(payload, shift, true)
+		}
+		if payload == nil {
+			return nil
+		}
+		out.WriteByte(id)
+		wasm.WriteLEB128u(&out, uint32(len(payload)))
+		out.Write(payload)
+	}
+	return out.Bytes()
+}
+
+func shiftNameMap(data []byte, shift func(uint32) uint32, indirect bool) []byte {
+	r := bytes.NewReader(data)
+	var out bytes.Buffer
+	count, err := wasm.ReadLEB128u(r)
+	if err != nil {
+		return nil
+	}
+	wasm.WriteLEB128u(&out, count)
+	for i := uint32(0); i < count; i++ {
+		idx, err := wasm.ReadLEB128u(r)
+		if err != nil {
+			return nil
+		}
+		wasm.WriteLEB128u(&out, shift(idx))
+		start := len(data) - r.Len()
+		if indirect {
+			n, err := wasm.ReadLEB128u(r)
+			if err != nil {
+				return nil
+			}
+			for j := uint32(0); j < n; j++ {
+				if _, err := wasm.ReadLEB128u(r); err != nil {
+					return nil
+				}
+				if !skipName(r) {
+					return nil
+				}
+			}
+		} else if !skipName(r) {
+			return nil
+		}
+		out.Write(data[start : len(data)-r.Len()])
+	}
+	return out.Bytes()
+}
+
+func skipName(r *bytes.Reader) bool {
+	n, err := wasm.ReadLEB128u(r)
+	if err != nil || int(n) > r.Len() {
+		return false
+	}
+	_, err = r.Seek(int64(n), 1)
+	return err == nil
+}
+
+// MemoryAllocator routes linear memory growth through a ResourceLimiter.
+// Install it on the instantiation context with WithContext, or with
+// WithSharedContext for modules defining a shared memory. Growth rejected
+// during a call traps the guest; rejection of the initial size is reported
+// by Err after instantiation.
+type MemoryAllocator struct {
+	limiter wasmruntime.ResourceLimiter
+	err     error
+	mu      sync.Mutex
+}
+
+// NewMemoryAllocator creates an allocator consulting limiter.
+func NewMemoryAllocator(limiter wasmruntime.ResourceLimiter) *MemoryAllocator {
+	return &MemoryAllocator{limiter: limiter}
+}
+
+// Allocate implements experimental.MemoryAllocator.
+func (a *MemoryAllocator) Allocate(capacity, maximum uint64) experimental.LinearMemory {
+	return &limitedMemory{alloc: a, buf: make([]byte, 0, capacity), max: maximum}
+}
+
+// sharedAllocator allocates memories that never move, as wazero requires
+// of shared memories. The buffer is reserved at the maximum size, which
+// the Go runtime maps lazily, and growth only extends its length. The
+// limiter is asked about the maximum before it is reserved; if it refuses,
+// only the initial size is allocated and instantiation fails through Err.
+type sharedAllocator struct {
+	*MemoryAllocator
+}
+
+// Allocate implements experimental.MemoryAllocator.
+func (a sharedAllocator) Allocate(capacity, maximum uint64) experimental.LinearMemory {
+	if err := a.limiter.MemoryGrowing(0, maximum); err != nil {
+		a.setErr(err)
+		maximum = capacity
+	}
+	return &limitedMemory{alloc: a.MemoryAllocator, buf: make([]byte, 0, maximum), max: maximum, fixed: true}
+}
+
+// Err returns the rejection recorded while sizing initial memories.
+func (a *MemoryAllocator) Err() error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.err
+}
+
+func (a *MemoryAllocator) setErr(err error) {
+	a.mu.Lock()
+	if a.err == nil {
+		a.err = err
+	}
+	a.mu.Unlock()
+}
+
+// WithContext returns ctx with the allocator installed.
+func (a *MemoryAllocator) WithContext(ctx context.Context) context.Context {
+	return experimental.WithMemoryAllocator(ctx, a)
+}
+
+// WithSharedContext returns ctx with the allocator installed for a module
+// that defines a shared memory. Its memories are reserved at their maximum
+// size, so they never move, and the limiter must admit that size at
+// instantiation.
+func (a *MemoryAllocator) WithSharedContext(ctx context.Context) context.Context {
+	return experimental.WithMemoryAllocator(ctx, sharedAllocator{a})
+}
+
+type limitedMemory struct {
+	alloc *MemoryAllocator
+	buf   []byte
+	max   uint64
+	ready bool
+	fixed bool // the buffer must not move
+}
+
+// Reallocate implements experimental.LinearMemory.
+func (m *limitedMemory) Reallocate(size uint64) []byte {
+	if cur := uint64(len(m.buf)); size > cur {
+		if err := m.alloc.limiter.MemoryGrowing(cur, size); err != nil {
+			if m.ready {
+				panic(err)
+			}
+			// wazero cannot fail the initial allocation; the caller checks Err.
+			m.alloc.setErr(err)
+		}
+	}
+	m.ready = true
+
+	if size <= uint64(cap(m.buf)) {
+		m.buf = m.buf[:size]
+		return m.buf
+	}
+	if m.fixed {
+		return nil
+	}
+	newCap := uint64(cap(m.buf)) * 2
+	if newCap < size {
+		newCap = size
+	}
+	if m.max != 0 && newCap > m.max {
+		newCap = max(m.max, size)
+	}
+	grown := make([]byte, size, newCap)
+	copy(grown, m.buf)
+	m.buf = grown
+	return m.buf
+}
+
+// Free implements experimental.LinearMemory.
+func (m *limitedMemory) Free() {
+	m.buf = nil
+}
@@ -0,0 +1,385 @@
+package linker
+
+import (
+	"bytes"
+	"context"
+	stderrors "errors"
+	"fmt"
+	"testing"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/experimental"
+	wasmruntime "github.com/wippyai/wasm-runtime"
+	"github.com/wippyai/wasm-runtime/component"
+	"github.com/wippyai/wasm-runtime/errors"
+	"github.com/wippyai/wasm-runtime/wasm"
+	"github.com/wippyai/wasm-runtime/wat"
+)
+
+var errExhausted = &errors.Error{Phase: errors.PhaseRuntime, Kind: errors.KindResourceExhausted}
+
+func TestInstrumentTableGrow_Unchanged(t *testing.T) {
+	wasmBytes, err := wat.Compile(`(module (func (export "f") (result i32) (i32.const 1)))`)
+	if err != nil {
+		t.Fatalf("wat compile: %v", err)
+	}
+	out, err := InstrumentTableGrow(wasmBytes)
+	if err != nil {
+		t.Fatalf("InstrumentTableGrow: %v", err)
+	}
+	if !bytes.Equal(out, wasmBytes) {
+		t.Error("module without table.grow was rewritten")
+	}
+}
+
+func TestInstrumentTableGrow(t *testing.T) {
+	wasmBytes, err := wat.Compile(`(module
+		(import "env" "ext" (func $ext (result i32)))
+		(table $t 2 funcref)
+		(global $g funcref (ref.func $two))
+		(elem (i32.const 0) $two $three)
+		(func $two (result i32) (i32.const 2))
+		(func $three (result i32) (i32.const 3))
+		(func (export "grow") (param i32) (result i32)
+			(table.grow $t (ref.null func) (local.get 0)))
+		(func (export "sum") (result i32)
+			(i32.add (call $two) (call_indirect (result i32) (i32.const 1))))
+		(func (export "ext") (result i32) (call $ext))
+	)`)
+	if err != nil {
+		t.Fatalf("wat compile: %v", err)
+	}
+
+	out, err := InstrumentTableGrow(wasmBytes)
+	if err != nil {
+		t.Fatalf("InstrumentTableGrow: %v", err)
+	}
+
+	m, err := wasm.ParseModule(out)
+	if err != nil {
+		t.Fatalf("parse instrumented: %v", err)
+	}
+	if n := m.NumImportedFuncs(); n != 2 {
+		t.Fatalf("imported funcs = %d, want 2", n)
+	}
+	if imp := m.Imports[1]; imp.Module != LimiterModuleName || imp.Name != LimiterTableGrowFunc {
+		t.Errorf("hook import = %s.%s", imp.Module, imp.Name)
+	}
+
+	ctx := context.Background()
+	rt := wazero.NewRuntime(ctx)
+	defer rt.Close(ctx)
+
+	if _, err := rt.NewHostModuleBuilder("env").
+		NewFunctionBuilder().
+		WithFunc(func() int32 { return 42 }).
+		Export("ext").
+		Instantiate(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rt.NewHostModuleBuilder(LimiterModuleName).
+		NewFunctionBuilder().
+		WithGoModuleFunction(api.GoModuleFunc(TableGrowHook),
+			[]api.ValueType{api.ValueTypeI32, api.ValueTypeI32},
+			[]api.ValueType{api.ValueTypeI32}).
+		Export(LimiterTableGrowFunc).
+		Instantiate(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	mod, err := rt.InstantiateWithConfig(ctx, out, wazero.NewModuleConfig())
+	if err != nil {
+		t.Fatalf("instantiate: %v", err)
+	}
+
+	call := func(ctx context.Context, name string, args ...uint64) (uint64, error) {
+		res, err := mod.ExportedFunction(name).Call(ctx, args...)
+		if err != nil {
+			return 0, err
+		}
+		return res[0], nil
+	}
+
+	if v, err := call(ctx, "sum"); err != nil || v != 5 {
+		t.Errorf("sum = %d, %v; want 5", v, err)
+	}
+	if v, err := call(ctx, "ext"); err != nil || v != 42 {
+		t.Errorf("ext = %d, %v; want 42", v, err)
+	}
+
+	limiter := wasmruntime.NewLimiter(wasmruntime.Limits{TableElements: 4})
+	limited := wasmruntime.WithResourceLimiter(ctx, limiter)
+
+	if v, err := call(limited, "grow", 2); err != nil || v != 2 {
+		t.Errorf("grow(2) = %d, %v; want 2", v, err)
+	}
+	if _, err := call(limited, "grow", 1); !stderrors.Is(err, errExhausted) {
+		t.Errorf("grow(1) over limit: got %v, want resource_exhausted", err)
+	}
+	// Without a limiter the hook is a no-op
+	if v, err := call(ctx, "grow", 1); err != nil || v != 4 {
+		t.Errorf("grow(1) unlimited = %d, %v; want 4", v, err)
+	}
+}
+
+func TestResourceTableLimiter(t *testing.T) {
+	limiter := wasmruntime.NewLimiter(wasmruntime.Limits{Handles: 2})
+	store := NewResourceStoreWithLimiter(limiter)
+	table := store.Table(1)
+
+	h1 := table.New(10)
+	if _, err := table.NewChecked(20); err != nil {
+		t.Fatalf("second handle: %v", err)
+	}
+	if _, err := table.NewChecked(30); !stderrors.Is(err, errExhausted) {
+		t.Fatalf("third handle: got %v, want resource_exhausted", err)
+	}
+
+	func() {
+		defer func() {
+			if r := recover(); r == nil {
+				t.Error("New over limit did not panic")
+			}
+		}()
+		table.New(30)
+	}()
+
+	if _, _, err := table.Drop(h1); err != nil {
+		t.Fatalf("Drop: %v", err)
+	}
+	if n := limiter.Count(wasmruntime.ResourceHandle); n != 1 {
+		t.Errorf("handles after drop = %d, want 1", n)
+	}
+	if _, err := table.NewChecked(30); err != nil {
+		t.Errorf("handle after drop: %v", err)
+	}
+}
+
+func TestMemoryAllocator(t *testing.T) {
+	limiter := wasmruntime.NewLimiter(wasmruntime.Limits{MemoryBytes: 2 * 65536})
+	alloc := NewMemoryAllocator(limiter)
+	mem := alloc.Allocate(65536, 4*65536)
+
+	if buf := mem.Reallocate(65536); len(buf) != 65536 {
+		t.Fatalf("initial len = %d", len(buf))
+	}
+	buf := mem.Reallocate(2 * 65536)
+	if len(buf) != 2*65536 {
+		t.Fatalf("grown len = %d", len(buf))
+	}
+
+	defer func() {
+		r := recover()
+		err, ok := r.(error)
+		if !ok || !stderrors.Is(err, errExhausted) {
+			t.Errorf("grow over limit: recovered %v, want resource_exhausted", r)
+		}
+	}()
+	mem.Reallocate(3 * 65536)
+}
+
+func TestTableGrowInstrumentedOnlyWhenLimited(t *testing.T) {
+	wasmBytes, err := wat.Compile(`(component
+		(core module $m
+			(table 1 funcref)
+			(func (export "grow") (param i32) (result i32)
+				(table.grow (ref.null func) (local.get 0))))
+		(core instance $i (instantiate $m))
+		(func (export "grow") (param "n" u32) (result s32)
+			(canon lift (core func $i "grow"))))`)
+	if err != nil {
+		t.Fatalf("wat compile: %v", err)
+	}
+	validated, err := component.DecodeAndValidate(wasmBytes)
+	if err != nil {
+		t.Fatalf("decode and validate: %v", err)
+	}
+
+	ctx := context.Background()
+	rt := wazero.NewRuntime(ctx)
+	defer rt.Close(ctx)
+
+	pre, err := NewWithDefaults(rt).Instantiate(ctx, validated)
+	if err != nil {
+		t.Fatalf("Instantiate: %v", err)
+	}
+	defer pre.Close(ctx)
+
+	grow := func(inst *Instance, delta uint64) (uint64, error) {
+		res, err := inst.ExportedFunction("grow").Call(ctx, delta)
+		if err != nil {
+			return 0, err
+		}
+		return res[0], nil
+	}
+
+	plain, err := pre.NewInstance(ctx)
+	if err != nil {
+		t.Fatalf("NewInstance: %v", err)
+	}
+	defer plain.Close(ctx)
+	if pre.limited != nil {
+		t.Error("instrumented variant compiled without a limiter")
+	}
+	if v, err := grow(plain, 10); err != nil || v != 1 {
+		t.Errorf("grow(10) = %d, %v; want 1", v, err)
+	}
+
+	limiter := wasmruntime.NewLimiter(wasmruntime.Limits{TableElements: 4})
+	limited, err := pre.NewInstanceWithOptions(ctx, InstanceOptions{ResourceLimiter: limiter})
+	if err != nil {
+		t.Fatalf("NewInstanceWithOptions: %v", err)
+	}
+	defer limited.Close(ctx)
+	if v, err := grow(limited, 2); err != nil || v != 1 {
+		t.Errorf("limited grow(2) = %d, %v; want 1", v, err)
+	}
+	if _, err := grow(limited, 2); !stderrors.Is(err, errExhausted) {
+		t.Errorf("limited grow past the limit: got %v, want resource_exhausted", err)
+	}
+}
+
+func TestSharedMemoryUnderLimiter(t *testing.T) {
+	ctx := context.Background()
+	rt := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().
+		WithCoreFeatures(api.CoreFeaturesV2|experimental.CoreFeaturesThreads))
+	defer rt.Close(ctx)
+
+	pre, err := NewWithDefaults(rt).Instantiate(ctx, sharedMemoryComponent(t, 4))
+	if err != nil {
+		t.Fatalf("Instantiate: %v", err)
+	}
+	defer pre.Close(ctx)
+
+	limiter := wasmruntime.NewLimiter(wasmruntime.Limits{MemoryBytes: 4 * 65536})
+	inst, err := pre.NewInstanceWithOptions(ctx, InstanceOptions{ResourceLimiter: limiter})
+	if err != nil {
+		t.Fatalf("NewInstanceWithOptions: %v", err)
+	}
+	defer inst.Close(ctx)
+
+	call := func(name string, args ...uint64) ([]uint64, error) {
+		return inst.GetModule(0).ExportedFunction(name).Call(ctx, args...)
+	}
+	if _, err := call("store", 16, 42); err != nil {
+		t.Fatalf("store: %v", err)
+	}
+	if res, err := call("grow", 3); err != nil || res[0] != 1 {
+		t.Fatalf("grow(3) = %v, %v; want 1", res, err)
+	}
+	if res, err := call("load", 16); err != nil || res[0] != 42 {
+		t.Errorf("load after grow = %v, %v; want 42", res, err)
+	}
+}
+
+func TestSharedMemoryMaximumOverLimit(t *testing.T) {
+	ctx := context.Background()
+	rt := wazero.NewRuntimeWithConfig(ctx, wazero.NewRuntimeConfig().
+		WithCoreFeatures(api.CoreFeaturesV2|experimental.CoreFeaturesThreads))
+	defer rt.Close(ctx)
+
+	pre, err := NewWithDefaults(rt).Instantiate(ctx, sharedMemoryComponent(t, 10))
+	if err != nil {
+		t.Fatalf("Instantiate: %v", err)
+	}
+	defer pre.Close(ctx)
+
+	// The maximum is reserved up front, so the limiter must admit it even
+	// though the initial size fits
+	limiter := wasmruntime.NewLimiter(wasmruntime.Limits{MemoryBytes: 4 * 65536})
+	inst, err := pre.NewInstanceWithOptions(ctx, InstanceOptions{ResourceLimiter: limiter})
+	if err == nil {
+		inst.Close(ctx)
+		t.Fatal("instantiated a shared memory whose maximum exceeds the limit")
+	}
+	if !stderrors.Is(err, errExhausted) {
+		t.Errorf("got %v, want resource_exhausted", err)
+	}
+}
+
+// sharedMemoryComponent returns a component whose core module defines a
+// shared memory of one page growing to maxPages.
+func sharedMemoryComponent(t *testing.T, maxPages int) *component.ValidatedComponent {
+	t.Helper()
+	wasmBytes, err := wat.Compile(fmt.Sprintf(`(component
+		(core module $m
+			(memory 1 %d shared)
+			(func (export "grow") (param i32) (result i32)
+				(memory.grow (local.get 0)))
+			(func (export "store") (param i32 i32)
+				(i32.store (local.get 0) (local.get 1)))
+			(func (export "load") (param i32) (result i32)
+				(i32.load (local.get 0))))
+		(core instance $i (instantiate $m))
+		(func (export "grow") (param "n" u32) (result s32)
+			(canon lift (core func $i "grow"))))`, maxPages))
+	if err != nil {
+		t.Fatalf("wat compile: %v", err)
+	}
+	validated, err := component.DecodeAndValidate(wasmBytes)
+	if err != nil {
+		t.Fatalf("decode and validate: %v", err)
+	}
+	return validated
+}
+
+func TestResourceStoreCloseReleasesHandles(t *testing.T) {
+	limiter := wasmruntime.NewLimiter(wasmruntime.Limits{Handles: 3})
+	store := NewResourceStoreWithLimiter(limiter)
+	a, b := store.Table(1), store.Table(2)
+
+	h := a.New(10)
+	if err := a.Clone(h); err != nil {
+		t.Fatalf("Clone: %v", err)
+	}
+	a.New(11)
+	b.New(20)
+	if n := limiter.Count(wasmruntime.ResourceHandle); n != 3 {
+		t.Fatalf("handles = %d, want 3", n)
+	}
+
+	store.Close()
+	if n := limiter.Count(wasmruntime.ResourceHandle); n != 0 {
+		t.Errorf("handles after close = %d, want 0", n)
+	}
+	if a.Len() != 0 || b.Len() != 0 {
+		t.Errorf("live entries after close: %d and %d", a.Len(), b.Len())
+	}
+}
+
+func TestInstanceCloseReleasesHandles(t *testing.T) {
+	validated := nestedMinimal(t)
+
+	ctx := context.Background()
+	rt := wazero.NewRuntime(ctx)
+	defer rt.Close(ctx)
+
+	l := NewWithDefaults(rt)
+	defineMinimalHost(l)
+	pre, err := l.Instantiate(ctx, validated)
+	if err != nil {
+		t.Fatalf("Instantiate: %v", err)
+	}
+	defer pre.Close(ctx)
+
+	limiter := wasmruntime.NewLimiter(wasmruntime.Limits{Handles: 4})
+	for range 2 {
+		inst, err := pre.NewInstanceWithOptions(ctx, InstanceOptions{ResourceLimiter: limiter})
+		if err != nil {
+			t.Fatalf("NewInstanceWithOptions: %v", err)
+		}
+		table := inst.Resources().Table(0)
+		for i := range 3 {
+			if _, err := table.NewChecked(uint32(i)); err != nil {
+				t.Fatalf("handle %d: %v", i, err)
+			}
+		}
+		if err := inst.Close(ctx); err != nil {
+			t.Fatalf("Close: %v", err)
+		}
+		if n := limiter.Count(wasmruntime.ResourceHandle); n != 0 {
+			t.Fatalf("handles after close = %d, want 0", n)
+		}
+	}
+}
@@ -14,6 +14,9 @@ type Options struct {
 	AsyncifyImports   []string
 	SemverMatching    bool
 	AsyncifyTransform bool
+	// StubImports defines imports no host function provides as stubs,
+	// reported by InstancePre.Diagnostics.
+	StubImports StubMode
 }
 
 // DefaultOptions returns default linker configuration.
@@ -30,6 +33,8 @@ type Linker struct {
 	root           *Namespace
 	resolver       *Resolver
 	bridgeRefCount map[string]int
+	parent         *Linker           // enclosing component's linker, nil at the root
+	imports        map[string]string // import namespace -> parent namespace
 	options        Options
 	mu             sync.RWMutex
 	hostModuleMu   sync.Mutex
@@ -45,6 +50,26 @@ func New(rt wazero.Runtime, opts Options) *Linker {
 	}
 }
 
+// nested creates the linker of a component nested in l's component. Host
+// modules and bridges stay shared with l.
+func (l *Linker) nested() *Linker {
+	return &Linker{
+		runtime: l.runtime,
+		root:    NewNamespace(),
+		options: l.options,
+		parent:  l,
+		imports: make(map[string]string),
+	}
+}
+
+// top returns the linker of the outermost component.
+func (l *Linker) top() *Linker {
+	for l.parent != nil {
+		l = l.parent
+	}
+	return l
+}
+
 // NewWithDefaults creates a new Linker with default options.
 func NewWithDefaults(rt wazero.Runtime) *Linker {
 	return New(rt, DefaultOptions())
@@ -117,9 +142,21 @@ func (l *Linker) DefineFunc(path string, fn api.GoModuleFunc, params, results []
 // Resolve uses path format: "wasi:io/streams@0.2.0#read"
 func (l *Linker) Resolve(path string) *FuncDef {
 	l.mu.RLock()
-	defer l.mu.RUnlock()
+	def := l.root.ResolveWithSemver(path, l.options.SemverMatching)
+	l.mu.RUnlock()
+	if def != nil || l.parent == nil {
+		return def
+	}
 
-	return l.root.ResolveWithSemver(path, l.options.SemverMatching)
+	// Imports of a nested component forwarded from its parent's imports
+	nsPath, funcName, err := splitFuncPath(path)
+	if err != nil {
+		return nil
+	}
+	if target, ok := l.imports[nsPath]; ok {
+		return l.parent.Resolve(target + "#" + funcName)
+	}
+	return nil
 }
 
 // splitFuncPath splits "ns/path#funcname" into namespace and function parts
@@ -172,6 +209,7 @@ func (b *HostModuleBuilder) Build(ctx context.Context) (api.Module, error) {
 
 // getOrCreateHostModule atomically gets or creates a host module.
 func (l *Linker) getOrCreateHostModule(ctx context.Context, name string, builder func() (api.Module, error)) (api.Module, bool, error) {
+	l = l.top()
 	l.hostModuleMu.Lock()
 	defer l.hostModuleMu.Unlock()
 
@@ -187,6 +225,7 @@ func (l *Linker) getOrCreateHostModule(ctx context.Context, name string, builder
 
 // getOrReplaceHostModule atomically gets, validates, or replaces a host module.
 func (l *Linker) getOrReplaceHostModule(ctx context.Context, name string, validator func(api.Module) bool, builder func() (api.Module, error)) (api.Module, bool, error) {
+	l = l.top()
 	l.hostModuleMu.Lock()
 	defer l.hostModuleMu.Unlock()
 
@@ -207,6 +246,7 @@ func (l *Linker) getOrReplaceHostModule(ctx context.Context, name string, valida
 
 // addBridgeRefs increments reference counts for bridge modules.
 func (l *Linker) addBridgeRefs(names map[string]bool) {
+	l = l.top()
 	if len(names) == 0 {
 		return
 	}
@@ -219,6 +259,7 @@ func (l *Linker) addBridgeRefs(names map[string]bool) {
 
 // releaseBridgeRefs decrements reference counts and closes bridges that reach zero.
 func (l *Linker) releaseBridgeRefs(ctx context.Context, names map[string]bool) {
+	l = l.top()
 	if len(names) == 0 {
 		return
 	}
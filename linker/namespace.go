@@ -1,6 +1,7 @@
 package linker
 
 import (
+	"reflect"
 	"strings"
 	"sync"
 
@@ -95,6 +96,8 @@ func uintToStr(n uint32) string {
 type FuncDef struct {
 	Name        string
 	Handler     api.GoModuleFunc
+	GoType      reflect.Type // Go func type of a typed handler, nil for raw handlers
+	path        string       // namespace#name it was defined at
 	ParamTypes  []api.ValueType
 	ResultTypes []api.ValueType
 }
@@ -196,12 +199,30 @@ func (ns *Namespace) DefineFunc(name string, fn api.GoModuleFunc, params, result
 
 	ns.funcs[name] = &FuncDef{
 		Name:        name,
+		path:        ns.FullPath() + "#" + name,
 		Handler:     fn,
 		ParamTypes:  params,
 		ResultTypes: results,
 	}
 }
 
+// DefineTypedFunc registers a host function lowering the Go function of
+// type goType. Instantiation checks goType against the WIT type of the
+// import the function is bound to, see CheckSignature.
+func (ns *Namespace) DefineTypedFunc(name string, fn api.GoModuleFunc, params, results []api.ValueType, goType reflect.Type) {
+	ns.mu.Lock()
+	defer ns.mu.Unlock()
+
+	ns.funcs[name] = &FuncDef{
+		Name:        name,
+		path:        ns.FullPath() + "#" + name,
+		Handler:     fn,
+		GoType:      goType,
+		ParamTypes:  params,
+		ResultTypes: results,
+	}
+}
+
 // GetFunc returns a function by name, or nil if not found
 func (ns *Namespace) GetFunc(name string) *FuncDef {
 	ns.mu.RLock()
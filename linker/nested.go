@@ -0,0 +1,503 @@
+package linker
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/tetratelabs/wazero/api"
+	"github.com/wippyai/wasm-runtime/component"
+	"github.com/wippyai/wasm-runtime/linker/internal/memory"
+	"github.com/wippyai/wasm-runtime/transcoder"
+	"go.bytecodealliance.org/wit"
+)
+
+// compRef is a component function or instance resolved to where it is
+// defined: an import of the outermost component, served by the host, or an
+// item of the index space of some component in the tree.
+type compRef struct {
+	pre   *InstancePre // defining component, nil for host imports
+	host  string       // host path: "ns" for instances, "ns#name" for functions
+	index uint32       // lifted function index or defined instance index in pre
+}
+
+// namedRef is a function exported by an instance
+type namedRef struct {
+	name string
+	ref  compRef
+}
+
+// instantiateNested compiles the nested components pre instantiates, in
+// index order, wiring their imports to the instantiate arguments.
+func (pre *InstancePre) instantiateNested(ctx context.Context) error {
+	comp := pre.component.Raw
+
+	for idx, entry := range comp.InstanceIndexSpace {
+		if entry.Source != component.InstanceSourceDefined || int(entry.Index) >= len(comp.Instances) {
+			continue
+		}
+		parsed := comp.Instances[entry.Index].Parsed
+		if parsed == nil || parsed.Kind != component.InstanceInstantiate {
+			continue
+		}
+
+		child, err := pre.instantiateChild(ctx, parsed)
+		if err != nil {
+			return instError("nested", idx, "", "nested component instantiation failed", err)
+		}
+		if pre.nestedIndex == nil {
+			pre.nestedIndex = make(map[uint32]int)
+		}
+		pre.nestedIndex[uint32(idx)] = len(pre.nested)
+		pre.nested = append(pre.nested, child)
+		pre.growsTables = pre.growsTables || child.growsTables
+	}
+
+	if len(pre.nested) == 0 && len(comp.Instances) > 0 && len(comp.InstanceIndexSpace) == 0 {
+		for _, ci := range comp.Instances {
+			if ci.Parsed != nil && ci.Parsed.Kind == component.InstanceInstantiate {
+				return instError("nested", -1, "", "component decoded without types cannot instantiate nested components", nil)
+			}
+		}
+	}
+	return nil
+}
+
+// instantiateChild compiles the component parsed instantiates.
+func (pre *InstancePre) instantiateChild(ctx context.Context, parsed *component.ParsedInstance) (*InstancePre, error) {
+	comp := pre.component.Raw
+	if int(parsed.ComponentIndex) >= len(comp.ComponentIndexSpace) {
+		return nil, fmt.Errorf("component index %d out of range", parsed.ComponentIndex)
+	}
+	pos := comp.ComponentIndexSpace[parsed.ComponentIndex]
+	if pos < 0 {
+		return nil, fmt.Errorf("component %d is imported or aliased, which is not supported", parsed.ComponentIndex)
+	}
+	if pos >= len(pre.component.Components) {
+		return nil, fmt.Errorf("component %d was not validated", parsed.ComponentIndex)
+	}
+	validated := pre.component.Components[pos]
+
+	child := &InstancePre{
+		linker:    pre.linker.nested(),
+		component: validated,
+		parent:    pre,
+		path:      append(slices.Clone(pre.path), len(pre.nested)),
+		args:      make(map[string]component.InstanceArg, len(parsed.Args)),
+		// a limited variant instruments its children too
+		limitTables: pre.limitTables,
+	}
+	for _, arg := range parsed.Args {
+		child.args[arg.Name] = arg
+	}
+
+	for _, imp := range validated.Raw.Imports {
+		if imp.ExternKind != component.ExternInstance {
+			continue
+		}
+		arg, ok := child.args[imp.Name]
+		if !ok || arg.Sort != component.SortInstance {
+			return nil, fmt.Errorf("import %q: missing instance argument", imp.Name)
+		}
+		if err := pre.linkImport(child.linker, imp.Name, arg.Index); err != nil {
+			return nil, fmt.Errorf("import %q: %w", imp.Name, err)
+		}
+	}
+
+	return child.linker.instantiate(ctx, child)
+}
+
+// linkImport defines the functions of instance instIdx of pre in l under
+// the import name. Host instances are forwarded to the parent linker;
+// functions lifted by a component become adapters that lift the arguments
+// out of the caller's memory and call the lifting instance.
+func (pre *InstancePre) linkImport(l *Linker, name string, instIdx uint32) error {
+	ref, err := pre.instanceRef(instIdx)
+	if err != nil {
+		return err
+	}
+	if ref.pre == nil {
+		l.imports[name] = ref.host
+		return nil
+	}
+
+	funcs, err := ref.funcs()
+	if err != nil {
+		return err
+	}
+	for _, f := range funcs {
+		path := name + "#" + f.name
+		if f.ref.pre == nil {
+			// Unresolved host functions surface as unresolved imports of the child
+			if def := pre.linker.top().Resolve(f.ref.host); def != nil {
+				if err := l.DefineFunc(path, def.Handler, def.ParamTypes, def.ResultTypes); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		params, results, err := f.ref.pre.liftSignature(f.ref.index)
+		if err != nil {
+			return fmt.Errorf("%s: %w", f.name, err)
+		}
+		flatParams, flatResults := lowerSignature(params, results)
+		adapter := adapterFunc(f.ref.pre, f.ref.index, params, results)
+		if err := l.DefineFunc(path, adapter, flatParams, flatResults); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// funcRef resolves component function idx of pre to its definition.
+func (pre *InstancePre) funcRef(idx uint32) (compRef, error) {
+	src, ok := pre.compFuncSources[idx]
+	if !ok {
+		return compRef{}, fmt.Errorf("function %d out of range", idx)
+	}
+
+	switch src.kind {
+	case compFuncLift:
+		return compRef{pre: pre, index: idx}, nil
+
+	case compFuncExport:
+		return pre.funcRef(src.reExportOf)
+
+	case compFuncImport:
+		if pre.parent == nil {
+			return compRef{}, fmt.Errorf("function import %q is not supported", src.importName)
+		}
+		arg, ok := pre.args[src.importName]
+		if !ok || arg.Sort != component.SortFunc {
+			return compRef{}, fmt.Errorf("import %q: missing function argument", src.importName)
+		}
+		return pre.parent.funcRef(arg.Index)
+
+	case compFuncAlias:
+		comp := pre.component.Raw
+		if int(src.alias) >= len(comp.FuncIndexSpace) {
+			return compRef{}, fmt.Errorf("function alias %d out of range", src.alias)
+		}
+		entry := comp.FuncIndexSpace[src.alias]
+		inst, err := pre.instanceRef(entry.InstanceIdx)
+		if err != nil {
+			return compRef{}, err
+		}
+		ref, sort, err := inst.export(entry.ExportName)
+		if err != nil {
+			return compRef{}, err
+		}
+		if sort != component.SortFunc {
+			return compRef{}, fmt.Errorf("export %q is not a function", entry.ExportName)
+		}
+		return ref, nil
+	}
+
+	return compRef{}, fmt.Errorf("function %d has unknown source", idx)
+}
+
+// instanceRef resolves component instance idx of pre to its definition.
+func (pre *InstancePre) instanceRef(idx uint32) (compRef, error) {
+	comp := pre.component.Raw
+	if int(idx) >= len(comp.InstanceIndexSpace) {
+		return compRef{}, fmt.Errorf("instance %d out of range", idx)
+	}
+
+	entry := comp.InstanceIndexSpace[idx]
+	switch entry.Source {
+	case component.InstanceSourceImport:
+		name := comp.Imports[entry.Index].Name
+		if pre.parent == nil {
+			return compRef{host: name}, nil
+		}
+		arg, ok := pre.args[name]
+		if !ok || arg.Sort != component.SortInstance {
+			return compRef{}, fmt.Errorf("import %q: missing instance argument", name)
+		}
+		return pre.parent.instanceRef(arg.Index)
+
+	case component.InstanceSourceDefined:
+		return compRef{pre: pre, index: idx}, nil
+
+	case component.InstanceSourceAlias:
+		base, err := pre.instanceRef(entry.Index)
+		if err != nil {
+			return compRef{}, err
+		}
+		ref, sort, err := base.export(entry.Name)
+		if err != nil {
+			return compRef{}, err
+		}
+		if sort != component.SortInstance {
+			return compRef{}, fmt.Errorf("export %q is not an instance", entry.Name)
+		}
+		return ref, nil
+
+	case component.InstanceSourceExport:
+		return pre.instanceRef(entry.Index)
+	}
+
+	return compRef{}, fmt.Errorf("instance %d has unknown source", idx)
+}
+
+// parsed returns the instance section entry r refers to.
+func (r compRef) parsed() *component.ParsedInstance {
+	comp := r.pre.component.Raw
+	return comp.Instances[comp.InstanceIndexSpace[r.index].Index].Parsed
+}
+
+// export resolves the function or instance the instance r exports as name.
+// Host instances are taken to export functions only.
+func (r compRef) export(name string) (compRef, byte, error) {
+	if r.pre == nil {
+		return compRef{host: r.host + "#" + name}, component.SortFunc, nil
+	}
+
+	parsed := r.parsed()
+	switch parsed.Kind {
+	case component.InstanceInstantiate:
+		child := r.pre.nested[r.pre.nestedIndex[r.index]]
+		for _, exp := range child.component.Raw.Exports {
+			if exp.Name == name {
+				return child.resolveSort(exp.Sort, exp.SortIndex, name)
+			}
+		}
+	case component.InstanceFromExports:
+		for _, arg := range parsed.Args {
+			if arg.Name == name {
+				return r.pre.resolveSort(arg.Sort, arg.Index, name)
+			}
+		}
+	}
+	return compRef{}, 0, fmt.Errorf("instance %d has no export %q", r.index, name)
+}
+
+func (pre *InstancePre) resolveSort(sort byte, idx uint32, name string) (compRef, byte, error) {
+	var ref compRef
+	var err error
+	switch sort {
+	case component.SortFunc:
+		ref, err = pre.funcRef(idx)
+	case component.SortInstance:
+		ref, err = pre.instanceRef(idx)
+	default:
+		return compRef{}, 0, fmt.Errorf("export %q has unsupported sort 0x%02x", name, sort)
+	}
+	return ref, sort, err
+}
+
+// funcs lists the functions exported by the instance r.
+func (r compRef) funcs() ([]namedRef, error) {
+	var funcs []namedRef
+	add := func(pre *InstancePre, name string, idx uint32) error {
+		ref, err := pre.funcRef(idx)
+		if err != nil {
+			return fmt.Errorf("export %q: %w", name, err)
+		}
+		funcs = append(funcs, namedRef{name: name, ref: ref})
+		return nil
+	}
+
+	parsed := r.parsed()
+	switch parsed.Kind {
+	case component.InstanceInstantiate:
+		child := r.pre.nested[r.pre.nestedIndex[r.index]]
+		for _, exp := range child.component.Raw.Exports {
+			if exp.Sort != component.SortFunc {
+				continue
+			}
+			if err := add(child, exp.Name, exp.SortIndex); err != nil {
+				return nil, err
+			}
+		}
+	case component.InstanceFromExports:
+		for _, arg := range parsed.Args {
+			if arg.Sort != component.SortFunc {
+				continue
+			}
+			if err := add(r.pre, arg.Name, arg.Index); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return funcs, nil
+}
+
+// lowerSignature returns the core signature of a lowered function, passing
+// arguments and results through memory past the flattening limits.
+func lowerSignature(params, results []wit.Type) (flatParams, flatResults []api.ValueType) {
+	flatParams = component.FlattenTypes(params)
+	if len(flatParams) > component.MaxFlatParams {
+		flatParams = []api.ValueType{api.ValueTypeI32}
+	}
+	flatResults = component.FlattenTypes(results)
+	if len(flatResults) > component.MaxFlatResults {
+		flatParams = append(slices.Clip(flatParams), api.ValueTypeI32)
+		flatResults = nil
+	}
+	return flatParams, flatResults
+}
+
+// adapterFunc returns a host function calling the function funcIdx lifted
+// by owner. Arguments are lifted out of the calling instance's memory and
+// results lowered back into it. Failures trap the calling instance.
+func adapterFunc(owner *InstancePre, funcIdx uint32, params, results []wit.Type) api.GoModuleFunc {
+	flatParams := component.FlattenTypes(params)
+	indirectParams := len(flatParams) > component.MaxFlatParams
+	indirectResults := len(component.FlattenTypes(results)) > component.MaxFlatResults
+	retptrSlot := len(flatParams)
+	if indirectParams {
+		retptrSlot = 1
+	}
+
+	return func(ctx context.Context, mod api.Module, stack []uint64) {
+		caller := lookupInstanceFromCaller(mod)
+		if caller == nil {
+			caller = InstanceFromContext(ctx)
+		}
+		if caller == nil {
+			panic(fmt.Errorf("linker: no instance calls function %d of nested component", funcIdx))
+		}
+		target := caller.instanceOf(owner)
+		if target == nil {
+			panic(fmt.Errorf("linker: nested component of function %d is not instantiated", funcIdx))
+		}
+
+		mem := memory.WrapMemory(mod.Memory())
+		var args []any
+		var err error
+		if indirectParams {
+			args, err = caller.loadValues(params, uint32(stack[0]), mem, "param")
+		} else {
+			args, err = caller.decoder.DecodeResults(params, stack, mem)
+		}
+		if err != nil {
+			panic(fmt.Errorf("linker: lift arguments: %w", err))
+		}
+
+		res, err := target.call(ctx, target.liftedExport(funcIdx), args)
+		if err != nil {
+			panic(err)
+		}
+		if len(results) == 0 {
+			return
+		}
+
+		alloc := memory.WrapAllocator(ctx, mod.ExportedFunction("cabi_realloc"))
+		if indirectResults {
+			if err := caller.storeValues(results, res, uint32(stack[retptrSlot]), mem, alloc); err != nil {
+				panic(fmt.Errorf("linker: lower results: %w", err))
+			}
+			return
+		}
+		flat, err := caller.encoder.EncodeParams(results, res, mem, alloc, nil)
+		if err != nil {
+			panic(fmt.Errorf("linker: lower results: %w", err))
+		}
+		copy(stack, flat)
+	}
+}
+
+// storeValues stores values laid out one after another from base, the
+// inverse of loadValues.
+func (inst *Instance) storeValues(types []wit.Type, values []any, base uint32, mem transcoder.Memory, alloc transcoder.Allocator) error {
+	offset := uint32(0)
+	for i, t := range types {
+		layout := inst.layoutCalc.Calculate(t)
+		if layout.Align > 0 {
+			offset = (offset + layout.Align - 1) &^ (layout.Align - 1)
+		}
+		if err := inst.encoder.StoreValue(t, values[i], base+offset, mem, alloc, nil); err != nil {
+			return fmt.Errorf("store [%d]: %w", i, err)
+		}
+		offset += layout.Size
+	}
+	return nil
+}
+
+// instanceOf returns the instance of pre in inst's tree of component
+// instances, or nil when it is not instantiated yet.
+func (inst *Instance) instanceOf(pre *InstancePre) *Instance {
+	root := inst
+	for root.parent != nil {
+		root = root.parent
+	}
+	for _, pos := range pre.path {
+		if pos >= len(root.nested) {
+			return nil
+		}
+		root = root.nested[pos]
+	}
+	return root
+}
+
+// liftedExport returns the function funcIdx lifted by inst, resolved once.
+func (inst *Instance) liftedExport(funcIdx uint32) Export {
+	if exp, ok := inst.lifted[funcIdx]; ok {
+		return exp
+	}
+	comp := inst.pre.component.Raw
+	exp := Export{Name: fmt.Sprintf("func %d", funcIdx)}
+	exp.CoreFunc, exp.Canon = inst.resolveCompFuncWithCanon(comp, inst.pre.compFuncSources, funcIdx)
+	if inst.lifted == nil {
+		inst.lifted = make(map[uint32]Export)
+	}
+	inst.lifted[funcIdx] = exp
+	return exp
+}
+
+// nestedExport resolves an export of function funcIdx lifted by a nested
+// component instance.
+func (inst *Instance) nestedExport(name string, funcIdx uint32) (Export, bool) {
+	if len(inst.nested) == 0 {
+		return Export{}, false
+	}
+	ref, err := inst.pre.funcRef(funcIdx)
+	if err != nil || ref.pre == nil || ref.pre == inst.pre {
+		return Export{}, false
+	}
+	owner := inst.instanceOf(ref.pre)
+	if owner == nil {
+		return Export{}, false
+	}
+	exp := owner.liftedExport(ref.index)
+	exp.Name = name
+	exp.owner = owner
+	return exp, true
+}
+
+// Lifter returns the nested component instance that lifts export name, or
+// nil when inst lifts it itself or has no such export.
+func (inst *Instance) Lifter(name string) *Instance {
+	return inst.exports[name].owner
+}
+
+// addNestedInstanceExports adds "<name>#<func>" exports for the functions of
+// an exported instance that nested component instances lift.
+func (inst *Instance) addNestedInstanceExports(name string, instIdx uint32) {
+	if len(inst.nested) == 0 {
+		return
+	}
+	ref, err := inst.pre.instanceRef(instIdx)
+	if err != nil || ref.pre == nil {
+		return
+	}
+	funcs, err := ref.funcs()
+	if err != nil {
+		return
+	}
+	for _, f := range funcs {
+		if f.ref.pre == nil || f.ref.pre == inst.pre {
+			continue
+		}
+		owner := inst.instanceOf(f.ref.pre)
+		if owner == nil {
+			continue
+		}
+		exp := owner.liftedExport(f.ref.index)
+		exp.Name = name + "#" + f.name
+		exp.owner = owner
+		inst.exports[exp.Name] = exp
+	}
+}
@@ -0,0 +1,294 @@
+package linker
+
+import (
+	"context"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"github.com/wippyai/wasm-runtime/component"
+)
+
+const minimalHost = "test:minimal/host@0.1.0"
+
+func testSection(id byte, payload ...byte) []byte {
+	out := []byte{id}
+	size := uint32(len(payload))
+	for {
+		b := byte(size & 0x7f)
+		size >>= 7
+		if size != 0 {
+			b |= 0x80
+		}
+		out = append(out, b)
+		if size == 0 {
+			break
+		}
+	}
+	return append(out, payload...)
+}
+
+func testName(s string) []byte {
+	return append([]byte{byte(len(s))}, s...)
+}
+
+func concatBytes(parts ...[]byte) []byte {
+	var out []byte
+	for _, p := range parts {
+		out = append(out, p...)
+	}
+	return out
+}
+
+// nestedMinimal builds a component that imports the host interface of
+// minimal.wasm, embeds minimal.wasm as component 0 and appends sections.
+func nestedMinimal(t *testing.T, sections ...[]byte) *component.ValidatedComponent {
+	t.Helper()
+	inner, err := os.ReadFile("../testbed/minimal.wasm")
+	if err != nil {
+		t.Skip("minimal.wasm not found")
+	}
+
+	data := []byte{0x00, 0x61, 0x73, 0x6d, 0x0d, 0x00, 0x01, 0x00}
+	// type 0: instance { type 0 = func(a: u32, b: u32) -> u32; export add: func(type 0) }
+	data = append(data, testSection(7,
+		0x01, 0x42, 0x02,
+		0x01, 0x40, 0x02, 0x01, 'a', 0x79, 0x01, 'b', 0x79, 0x00, 0x79,
+		0x04, 0x00, 0x03, 'a', 'd', 'd', 0x01, 0x00)...)
+	// instance 0: import of the host interface
+	data = append(data, testSection(10, concatBytes([]byte{0x01, 0x00}, testName(minimalHost), []byte{0x05, 0x00})...)...)
+	data = append(data, testSection(4, inner...)...)
+	for _, s := range sections {
+		data = append(data, s...)
+	}
+
+	validated, err := component.DecodeAndValidate(data)
+	if err != nil {
+		t.Fatalf("decode and validate: %v", err)
+	}
+	return validated
+}
+
+// instantiateSection instantiates component 0 with the host import bound to instance.
+func instantiateSection(instance byte) []byte {
+	return testSection(5, concatBytes([]byte{0x01, 0x00, 0x00, 0x01}, testName(minimalHost), []byte{0x05, instance})...)
+}
+
+// aliasFuncSection aliases function name of instance.
+func aliasFuncSection(instance byte, name string) []byte {
+	return testSection(6, concatBytes([]byte{0x01, 0x01, 0x00, instance}, testName(name))...)
+}
+
+// exportFuncSection exports func as name.
+func exportFuncSection(name string, fn byte) []byte {
+	return testSection(11, concatBytes([]byte{0x01, 0x00}, testName(name), []byte{0x01, fn, 0x00})...)
+}
+
+func defineMinimalHost(l *Linker) {
+	l.Namespace(minimalHost).DefineFunc("add", func(ctx context.Context, mod api.Module, stack []uint64) {
+		stack[0] = uint64(uint32(stack[0]) + uint32(stack[1]))
+	}, []api.ValueType{api.ValueTypeI32, api.ValueTypeI32}, []api.ValueType{api.ValueTypeI32})
+}
+
+func TestNested_ExportsOfNestedInstance(t *testing.T) {
+	validated := nestedMinimal(t,
+		instantiateSection(0),                     // instance 1 = minimal(host)
+		aliasFuncSection(1, "compute"),            // func 0
+		aliasFuncSection(1, "compute-using-host"), // func 1
+		exportFuncSection("compute", 0),
+		exportFuncSection("compute-using-host", 1),
+	)
+	if len(validated.Components) != 1 {
+		t.Fatalf("validated %d nested components, want 1", len(validated.Components))
+	}
+
+	ctx := context.Background()
+	rt := wazero.NewRuntime(ctx)
+	defer rt.Close(ctx)
+
+	l := NewWithDefaults(rt)
+	defineMinimalHost(l)
+
+	pre, err := l.Instantiate(ctx, validated)
+	if err != nil {
+		t.Fatalf("Instantiate error: %v", err)
+	}
+	defer pre.Close(ctx)
+
+	inst, err := pre.NewInstance(ctx)
+	if err != nil {
+		t.Fatalf("NewInstance error: %v", err)
+	}
+	defer inst.Close(ctx)
+
+	results, err := inst.Call(ctx, "compute", uint32(7), uint32(8))
+	if err != nil {
+		t.Fatalf("compute: %v", err)
+	}
+	if len(results) != 1 || results[0] != uint32(56) {
+		t.Errorf("compute(7, 8) = %v, want [56]", results)
+	}
+
+	results, err = inst.Call(ctx, "compute-using-host", uint32(7), uint32(8))
+	if err != nil {
+		t.Fatalf("compute-using-host: %v", err)
+	}
+	if len(results) != 1 || results[0] != uint32(15) {
+		t.Errorf("compute-using-host(7, 8) = %v, want [15]", results)
+	}
+
+	raw, err := inst.CallRaw(ctx, "compute", 3, 4)
+	if err != nil {
+		t.Fatalf("CallRaw compute: %v", err)
+	}
+	if len(raw) != 1 || raw[0] != 12 {
+		t.Errorf("CallRaw compute(3, 4) = %v, want [12]", raw)
+	}
+}
+
+func TestNested_SiblingSatisfiesImport(t *testing.T) {
+	// B's host "add" is A's "compute", so B's compute-using-host multiplies
+	validated := nestedMinimal(t,
+		instantiateSection(0),          // instance 1 = A
+		aliasFuncSection(1, "compute"), // func 0
+		// instance 2 = { add: func 0 }
+		testSection(5, concatBytes([]byte{0x01, 0x01, 0x01, 0x00}, testName("add"), []byte{0x01, 0x00})...),
+		instantiateSection(2),                     // instance 3 = B
+		aliasFuncSection(3, "compute-using-host"), // func 1
+		exportFuncSection("compute-using-host", 1),
+	)
+
+	ctx := context.Background()
+	rt := wazero.NewRuntime(ctx)
+	defer rt.Close(ctx)
+
+	l := NewWithDefaults(rt)
+	defineMinimalHost(l)
+
+	pre, err := l.Instantiate(ctx, validated)
+	if err != nil {
+		t.Fatalf("Instantiate error: %v", err)
+	}
+	defer pre.Close(ctx)
+
+	inst, err := pre.NewInstance(ctx)
+	if err != nil {
+		t.Fatalf("NewInstance error: %v", err)
+	}
+	defer inst.Close(ctx)
+
+	results, err := inst.Call(ctx, "compute-using-host", uint32(7), uint32(8))
+	if err != nil {
+		t.Fatalf("compute-using-host: %v", err)
+	}
+	if len(results) != 1 || results[0] != uint32(56) {
+		t.Errorf("compute-using-host(7, 8) = %v, want [56]", results)
+	}
+}
+
+func TestNested_UnresolvedHostImport(t *testing.T) {
+	// Host functions missing for a nested component fail at call time, as
+	// they do for the outermost one
+	validated := nestedMinimal(t,
+		instantiateSection(0),
+		aliasFuncSection(1, "compute-using-host"),
+		exportFuncSection("compute-using-host", 0),
+	)
+
+	ctx := context.Background()
+	rt := wazero.NewRuntime(ctx)
+	defer rt.Close(ctx)
+
+	pre, err := NewWithDefaults(rt).Instantiate(ctx, validated)
+	if err != nil {
+		t.Fatalf("Instantiate error: %v", err)
+	}
+	defer pre.Close(ctx)
+
+	inst, err := pre.NewInstance(ctx)
+	if err != nil {
+		t.Fatalf("NewInstance error: %v", err)
+	}
+	defer inst.Close(ctx)
+
+	_, err = inst.Call(ctx, "compute-using-host", uint32(1), uint32(2))
+	if err == nil {
+		t.Fatal("expected error for unresolved host import")
+	}
+	if !strings.Contains(err.Error(), minimalHost+"#add") {
+		t.Errorf("error %q should name the import", err)
+	}
+}
+
+func TestNested_ImportedComponentUnsupported(t *testing.T) {
+	pre := &InstancePre{
+		component: &component.ValidatedComponent{Raw: &component.Component{
+			ComponentIndexSpace: []int{-1},
+		}},
+	}
+	_, err := pre.instantiateChild(context.Background(), &component.ParsedInstance{Kind: component.InstanceInstantiate})
+	if err == nil || !strings.Contains(err.Error(), "not supported") {
+		t.Errorf("instantiateChild() error = %v, want unsupported component", err)
+	}
+}
+
+func TestHostHandler_OwnLinkerSkipsLookup(t *testing.T) {
+	validated := loadTestComponent(t, "../testbed/minimal.wasm")
+	if validated == nil {
+		t.Skip("minimal.wasm not found")
+	}
+
+	ctx := context.Background()
+	rt := wazero.NewRuntime(ctx)
+	defer rt.Close(ctx)
+
+	other := NewWithDefaults(rt)
+	other.Namespace(minimalHost).DefineFunc("add", func(ctx context.Context, mod api.Module, stack []uint64) {
+		stack[0] = uint64(uint32(stack[0]) * uint32(stack[1]))
+	}, []api.ValueType{api.ValueTypeI32, api.ValueTypeI32}, []api.ValueType{api.ValueTypeI32})
+	l := NewWithDefaults(rt)
+	defineMinimalHost(l)
+
+	call := func(l *Linker) (*InstancePre, []any) {
+		pre, err := l.Instantiate(ctx, validated)
+		if err != nil {
+			t.Fatalf("Instantiate error: %v", err)
+		}
+		t.Cleanup(func() { pre.Close(ctx) })
+		inst, err := pre.NewInstance(ctx)
+		if err != nil {
+			t.Fatalf("NewInstance error: %v", err)
+		}
+		t.Cleanup(func() { inst.Close(ctx) })
+		results, err := inst.Call(ctx, "compute-using-host", uint32(7), uint32(8))
+		if err != nil {
+			t.Fatalf("compute-using-host: %v", err)
+		}
+		return pre, results
+	}
+
+	// other builds the shared host module, so l's instances must look up
+	// their own handler, and other's must not
+	otherPre, results := call(other)
+	if results[0] != uint32(56) {
+		t.Errorf("other: compute-using-host(7, 8) = %v, want [56]", results)
+	}
+	pre, results := call(l)
+	if results[0] != uint32(15) {
+		t.Errorf("compute-using-host(7, 8) = %v, want [15]", results)
+	}
+	otherPre.hostFuncs.Range(func(key, _ any) bool {
+		t.Errorf("owner linker looked up %v", key)
+		return true
+	})
+	looked := false
+	pre.hostFuncs.Range(func(_, _ any) bool {
+		looked = true
+		return false
+	})
+	if !looked {
+		t.Error("second linker used the owner's handler without a lookup")
+	}
+}
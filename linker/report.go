@@ -0,0 +1,316 @@
+package linker
+
+import (
+	"maps"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/wippyai/wasm-runtime/component"
+	"github.com/wippyai/wasm-runtime/wasm"
+)
+
+// ProviderKind identifies what satisfies an import.
+type ProviderKind uint8
+
+const (
+	// ProviderNone means the import is unresolved.
+	ProviderNone ProviderKind = iota
+	// ProviderHost is a function defined in a linker namespace.
+	ProviderHost
+	// ProviderVirtual is a VirtualInstance registered with the Resolver.
+	ProviderVirtual
+	// ProviderModule is a module registered with the Resolver.
+	ProviderModule
+	// ProviderStub is a stub defined under Options.StubImports.
+	ProviderStub
+	// ProviderUnused marks imports none of whose functions are lowered,
+	// such as interfaces imported only for their types.
+	ProviderUnused
+)
+
+func (k ProviderKind) String() string {
+	switch k {
+	case ProviderHost:
+		return "host"
+	case ProviderVirtual:
+		return "virtual"
+	case ProviderModule:
+		return "module"
+	case ProviderStub:
+		return "stub"
+	case ProviderUnused:
+		return "unused"
+	default:
+		return "unresolved"
+	}
+}
+
+// ImportReport describes how a component import is resolved.
+type ImportReport struct {
+	Name      string // import name: "wasi:io/streams@0.2.0"
+	Interface string // name without version: "wasi:io/streams"
+	Version   string // requested version, empty when unversioned
+	Resolved  string // providing namespace or instance: "wasi:io/streams@0.2.3"
+	// Candidates lists the namespaces defining the interface at other
+	// versions when no compatible one exists.
+	Candidates []string
+	Funcs      []FuncReport
+	Provider   ProviderKind
+}
+
+// SemverMatched reports whether the import resolved to a namespace of a
+// different, compatible version.
+func (r *ImportReport) SemverMatched() bool {
+	return r.Resolved != "" && r.Resolved != r.Name
+}
+
+// FuncReport describes how an imported function is bound.
+type FuncReport struct {
+	Name     string // function name: "[method]output-stream.write"
+	Resolved string // full path of the bound function
+	// Candidates lists definitions of the function at other versions of
+	// its namespace when it is unresolved or stubbed.
+	Candidates []string
+	Provider   ProviderKind
+}
+
+// Explain reports how the host function path resolves: the function it
+// binds to with semver matching, or the nearest candidates when none
+// matches.
+func (l *Linker) Explain(path string) FuncReport {
+	nsPath, fn, err := splitFuncPath(path)
+	if err != nil {
+		return FuncReport{Name: path}
+	}
+	r := FuncReport{Name: fn}
+	if def := l.Resolve(path); def != nil {
+		r.Provider = ProviderHost
+		r.Resolved = def.path
+		if r.Resolved == "" {
+			r.Resolved = path
+		}
+		return r
+	}
+	r.Candidates = l.Root().candidates(nsPath, fn)
+	return r
+}
+
+// Imports reports how each lowered function is resolved against the
+// linker's namespaces, grouped by the interface importing it.
+func (l *Linker) Imports(lowers []*component.LowerDef) []ImportReport {
+	return l.reportImports(nil, lowerPaths(lowers), nil)
+}
+
+// Imports reports how each interface the component imports is resolved,
+// in import order, followed by the modules its core modules import from
+// the host. Imports of nested components are satisfied by the enclosing
+// component and are not listed.
+func (pre *InstancePre) Imports() []ImportReport {
+	return pre.report(pre.canonLowers(), pre.stubs)
+}
+
+// ComponentImports reports the imports of c as InstancePre.Imports does,
+// without instantiating it. Imports Instantiate fails on are reported
+// unresolved, with their candidates; under Options.StubImports they are
+// reported as the stubs Instantiate defines.
+func (l *Linker) ComponentImports(c *component.ValidatedComponent) []ImportReport {
+	pre := &InstancePre{linker: l, component: c}
+	if len(c.Raw.CoreInstances) > 0 {
+		pre.graph = component.NewInstanceGraphWithComponent(c.Raw.CoreInstances, c.Raw)
+	}
+	if len(c.Raw.TypeIndexSpace) > 0 {
+		pre.typeResolver = component.NewTypeResolverWithInstances(c.Raw.TypeIndexSpace, c.Raw.InstanceTypes)
+	}
+	lowers := pre.canonLowers()
+	return pre.report(lowers, pre.stubLowers(lowers))
+}
+
+// report reports the component's imports, given its lowers and the stubs
+// defined for them.
+func (pre *InstancePre) report(lowers []*component.LowerDef, stubs map[string]*FuncDef) []ImportReport {
+	var names []string
+	for _, imp := range pre.component.Raw.Imports {
+		if imp.ExternKind == component.ExternInstance {
+			names = append(names, imp.Name)
+		}
+	}
+
+	core := pre.hostCoreImports()
+	if pre.linker.options.StubImports != StubNone && len(core) > 0 {
+		// Unresolved core imports are stubbed as resolveBindings binds them
+		withCore := make(map[string]*FuncDef, len(stubs)+len(core))
+		maps.Copy(withCore, stubs)
+		for _, path := range core {
+			if pre.linker.Resolve(path) == nil {
+				withCore[path] = &FuncDef{Name: path, path: path}
+			}
+		}
+		stubs = withCore
+	}
+	return pre.linker.reportImports(names, append(lowerPaths(lowers), core...), stubs)
+}
+
+// hostCoreImports returns the paths of the functions core modules import
+// from the host rather than from other core instances, which
+// resolveBindings binds to linker functions.
+func (pre *InstancePre) hostCoreImports() []string {
+	if pre.graph == nil {
+		return nil
+	}
+	var paths []string
+	for _, mi := range pre.graph.ModuleInstantiations() {
+		if mi.ModuleIndex >= len(pre.component.Raw.CoreModules) {
+			continue
+		}
+		m, err := wasm.ParseModule(pre.component.Raw.CoreModules[mi.ModuleIndex])
+		if err != nil {
+			continue
+		}
+		for _, imp := range m.Imports {
+			if imp.Desc.Kind != wasm.KindFunc || imp.Module == LimiterModuleName {
+				continue
+			}
+			provided := slices.ContainsFunc(mi.Args, func(arg component.CoreInstanceArg) bool {
+				return arg.Name == imp.Module
+			})
+			if !provided {
+				paths = append(paths, imp.Module+"#"+imp.Name)
+			}
+		}
+	}
+	return paths
+}
+
+// lowerPaths returns the function paths of lowers.
+func lowerPaths(lowers []*component.LowerDef) []string {
+	paths := make([]string, len(lowers))
+	for i, lower := range lowers {
+		paths[i] = lower.Name
+	}
+	return paths
+}
+
+// reportImports reports the imports named, followed by the interfaces of
+// the function paths not among them.
+func (l *Linker) reportImports(names, paths []string, stubs map[string]*FuncDef) []ImportReport {
+	funcs := make(map[string][]string)
+	for _, path := range paths {
+		nsPath, fn, err := splitFuncPath(path)
+		if err != nil {
+			continue // lowers of functions that are not imports
+		}
+		if _, ok := funcs[nsPath]; !ok && !slices.Contains(names, nsPath) {
+			names = append(names, nsPath)
+		}
+		if !slices.Contains(funcs[nsPath], fn) {
+			funcs[nsPath] = append(funcs[nsPath], fn)
+		}
+	}
+
+	l.mu.RLock()
+	resolver := l.resolver
+	l.mu.RUnlock()
+
+	reports := make([]ImportReport, 0, len(names))
+	for _, name := range names {
+		r := ImportReport{Name: name, Interface: name}
+		if i := strings.LastIndexByte(name, '@'); i >= 0 {
+			r.Interface, r.Version = name[:i], name[i+1:]
+		}
+		if resolver != nil {
+			if resolver.GetInstance(name) != nil {
+				r.Provider, r.Resolved = ProviderVirtual, name
+			} else if resolver.GetModule(name) != nil {
+				r.Provider, r.Resolved = ProviderModule, name
+			}
+		}
+		if r.Provider == ProviderNone {
+			l.reportFuncs(&r, funcs[name], stubs)
+		}
+		reports = append(reports, r)
+	}
+	return reports
+}
+
+// reportFuncs binds the functions fns of import r and derives the import's
+// provider from theirs: unresolved if any is, else stub if any is.
+func (l *Linker) reportFuncs(r *ImportReport, fns []string, stubs map[string]*FuncDef) {
+	if len(fns) == 0 {
+		r.Provider = ProviderUnused
+		if ns := l.Root().resolveNamespace(r.Name, l.options.SemverMatching); ns != nil {
+			r.Resolved = ns.FullPath()
+		}
+		return
+	}
+
+	sort.Strings(fns)
+	r.Provider = ProviderHost
+	for _, fn := range fns {
+		path := r.Name + "#" + fn
+		f := l.Explain(path)
+		if f.Provider == ProviderNone && stubs[path] != nil {
+			f.Provider = ProviderStub
+		}
+		switch {
+		case f.Provider == ProviderNone:
+			r.Provider = ProviderNone
+		case f.Provider == ProviderStub && r.Provider == ProviderHost:
+			r.Provider = ProviderStub
+		case f.Provider == ProviderHost && r.Resolved == "":
+			r.Resolved = f.Resolved[:strings.LastIndexByte(f.Resolved, '#')]
+		}
+		r.Funcs = append(r.Funcs, f)
+	}
+	if r.Resolved == "" {
+		r.Candidates = l.Root().candidates(r.Name, "")
+	}
+}
+
+// candidates returns the namespaces of the last segment of nsPath at any
+// version, newest first. When fn is not empty, it returns the paths of fn
+// in the namespaces defining it.
+func (ns *Namespace) candidates(nsPath, fn string) []string {
+	parent := ns
+	last := nsPath
+	if i := strings.LastIndexByte(nsPath, '/'); i >= 0 {
+		parent = ns.resolveNamespace(nsPath[:i], true)
+		last = nsPath[i+1:]
+	}
+	if parent == nil {
+		return nil
+	}
+	name, _ := parseNameVersion(last)
+
+	var found []*Namespace
+	for _, child := range parent.AllChildren() {
+		if child.name == name && (fn == "" || child.GetFunc(fn) != nil) {
+			found = append(found, child)
+		}
+	}
+	sort.Slice(found, func(i, j int) bool { return newer(found[i].version, found[j].version) })
+
+	var out []string
+	for _, child := range found {
+		if fn != "" {
+			out = append(out, child.FullPath()+"#"+fn)
+		} else {
+			out = append(out, child.FullPath())
+		}
+	}
+	return out
+}
+
+// newer orders versions newest first, unversioned last.
+func newer(a, b *Version) bool {
+	switch {
+	case a == nil || b == nil:
+		return b == nil && a != nil
+	case a.Major != b.Major:
+		return a.Major > b.Major
+	case a.Minor != b.Minor:
+		return a.Minor > b.Minor
+	default:
+		return a.Patch > b.Patch
+	}
+}
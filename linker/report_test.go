@@ -0,0 +1,202 @@
+package linker
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+
+	"github.com/wippyai/wasm-runtime/component"
+	"github.com/wippyai/wasm-runtime/wat"
+)
+
+func TestExplain(t *testing.T) {
+	ctx := context.Background()
+	rt := wazero.NewRuntime(ctx)
+	defer rt.Close(ctx)
+
+	l := New(rt, DefaultOptions())
+	noop := func(context.Context, api.Module, []uint64) {}
+	l.Namespace("wasi:io/streams@0.2.8").DefineFunc("read", noop, nil, nil)
+	l.Namespace("wasi:io/streams@0.1.0").DefineFunc("read", noop, nil, nil)
+	l.Namespace("wasi:io/streams@0.1.0").DefineFunc("old", noop, nil, nil)
+
+	tests := []struct {
+		path string
+		want FuncReport
+	}{
+		{
+			path: "wasi:io/streams@0.2.8#read",
+			want: FuncReport{Name: "read", Provider: ProviderHost, Resolved: "wasi:io/streams@0.2.8#read"},
+		},
+		{
+			path: "wasi:io/streams@0.2.3#read",
+			want: FuncReport{Name: "read", Provider: ProviderHost, Resolved: "wasi:io/streams@0.2.8#read"},
+		},
+		{
+			path: "wasi:io/streams@1.0.0#read",
+			want: FuncReport{Name: "read", Candidates: []string{"wasi:io/streams@0.2.8#read", "wasi:io/streams@0.1.0#read"}},
+		},
+		{
+			path: "wasi:io/streams@0.2.3#old",
+			want: FuncReport{Name: "old", Candidates: []string{"wasi:io/streams@0.1.0#old"}},
+		},
+		{
+			path: "wasi:io/poll@0.2.3#poll",
+			want: FuncReport{Name: "poll"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			if got := l.Explain(tt.path); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Explain() = %+v, want %+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLinkerImports(t *testing.T) {
+	ctx := context.Background()
+	rt := wazero.NewRuntime(ctx)
+	defer rt.Close(ctx)
+
+	l := New(rt, DefaultOptions())
+	noop := func(context.Context, api.Module, []uint64) {}
+	l.Namespace("wasi:io/streams@0.2.8").DefineFunc("read", noop, nil, nil)
+	l.Namespace("wasi:io/streams@0.2.8").DefineFunc("write", noop, nil, nil)
+	l.Namespace("my:pkg/log@2.0.0").DefineFunc("write", noop, nil, nil)
+	l.Resolver().RegisterInstance("my:pkg/api@1.0.0", NewVirtualInstance("my:pkg/api@1.0.0"))
+
+	lowers := []*component.LowerDef{
+		{Name: "wasi:io/streams@0.2.3#write"},
+		{Name: "wasi:io/streams@0.2.3#read"},
+		{Name: "my:pkg/log@1.2.0#write"},
+		{Name: "my:pkg/api@1.0.0#f"},
+		{Name: "lower_3"},
+	}
+	got := l.Imports(lowers)
+	want := []ImportReport{
+		{
+			Name:      "wasi:io/streams@0.2.3",
+			Interface: "wasi:io/streams",
+			Version:   "0.2.3",
+			Resolved:  "wasi:io/streams@0.2.8",
+			Provider:  ProviderHost,
+			Funcs: []FuncReport{
+				{Name: "read", Provider: ProviderHost, Resolved: "wasi:io/streams@0.2.8#read"},
+				{Name: "write", Provider: ProviderHost, Resolved: "wasi:io/streams@0.2.8#write"},
+			},
+		},
+		{
+			Name:       "my:pkg/log@1.2.0",
+			Interface:  "my:pkg/log",
+			Version:    "1.2.0",
+			Candidates: []string{"my:pkg/log@2.0.0"},
+			Funcs: []FuncReport{
+				{Name: "write", Candidates: []string{"my:pkg/log@2.0.0#write"}},
+			},
+		},
+		{
+			Name:      "my:pkg/api@1.0.0",
+			Interface: "my:pkg/api",
+			Version:   "1.0.0",
+			Resolved:  "my:pkg/api@1.0.0",
+			Provider:  ProviderVirtual,
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Imports() =\n%+v\nwant\n%+v", got, want)
+	}
+	if !got[0].SemverMatched() || got[2].SemverMatched() {
+		t.Errorf("SemverMatched() = %v, %v", got[0].SemverMatched(), got[2].SemverMatched())
+	}
+}
+
+func TestInstancePreImports(t *testing.T) {
+	validated := loadTestComponent(t, "../testbed/minimal.wasm")
+	if validated == nil {
+		t.Skip("minimal.wasm not found")
+	}
+	ctx := context.Background()
+	rt := wazero.NewRuntime(ctx)
+	defer rt.Close(ctx)
+
+	opts := DefaultOptions()
+	opts.StubImports = StubTrap
+	pre, err := New(rt, opts).Instantiate(ctx, validated)
+	if err != nil {
+		t.Fatalf("Instantiate error: %v", err)
+	}
+	defer pre.Close(ctx)
+
+	want := []ImportReport{{
+		Name:      minimalHost,
+		Interface: "test:minimal/host",
+		Version:   "0.1.0",
+		Provider:  ProviderStub,
+		Funcs:     []FuncReport{{Name: "add", Provider: ProviderStub}},
+	}}
+	if got := pre.Imports(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Imports() = %+v, want %+v", got, want)
+	}
+}
+
+func TestComponentImports(t *testing.T) {
+	// A core module importing from the host an interface the linker
+	// defines only at an older version
+	wasmBytes, err := wat.Compile(`(module
+		(import "test:drift/host@0.2.0" "f" (func (param i32) (result i32)))
+		(func (export "g") (result i32) i32.const 1 call 0))`)
+	if err != nil {
+		t.Fatalf("wat compile: %v", err)
+	}
+	c := &component.ValidatedComponent{Raw: &component.Component{
+		CoreModules: [][]byte{wasmBytes},
+		CoreInstances: []component.CoreInstance{
+			{Parsed: &component.ParsedCoreInstance{Kind: component.CoreInstanceInstantiate}},
+		},
+	}}
+
+	ctx := context.Background()
+	rt := wazero.NewRuntime(ctx)
+	defer rt.Close(ctx)
+
+	l := New(rt, DefaultOptions())
+	noop := func(context.Context, api.Module, []uint64) {}
+	l.Namespace("test:drift/host@0.1.0").DefineFunc("f", noop, nil, nil)
+	if _, err := l.Instantiate(ctx, c); err == nil {
+		t.Fatal("Instantiate should fail on the unresolved import without stubs")
+	}
+
+	want := []ImportReport{{
+		Name:       "test:drift/host@0.2.0",
+		Interface:  "test:drift/host",
+		Version:    "0.2.0",
+		Candidates: []string{"test:drift/host@0.1.0"},
+		Funcs: []FuncReport{{
+			Name:       "f",
+			Candidates: []string{"test:drift/host@0.1.0#f"},
+		}},
+	}}
+	if got := l.ComponentImports(c); !reflect.DeepEqual(got, want) {
+		t.Errorf("ComponentImports() = %+v, want %+v", got, want)
+	}
+
+	opts := DefaultOptions()
+	opts.StubImports = StubTrap
+	stubbed := New(rt, opts)
+	pre, err := stubbed.Instantiate(ctx, c)
+	if err != nil {
+		t.Fatalf("Instantiate error: %v", err)
+	}
+	defer pre.Close(ctx)
+	got := stubbed.ComponentImports(c)
+	if len(got) != 1 || got[0].Provider != ProviderStub {
+		t.Errorf("ComponentImports() with stubs = %+v, want a stub", got)
+	}
+	if !reflect.DeepEqual(pre.Imports(), got) {
+		t.Errorf("Imports() = %+v, want %+v", pre.Imports(), got)
+	}
+}
@@ -3,6 +3,8 @@ package linker
 import (
 	"fmt"
 	"sync"
+
+	wasmruntime "github.com/wippyai/wasm-runtime"
 )
 
 // Handle is a resource handle (32-bit unsigned integer per spec)
@@ -10,8 +12,9 @@ type Handle uint32
 
 // ResourceStore manages multiple resource tables by type
 type ResourceStore struct {
-	tables map[uint32]*ResourceTable
-	mu     sync.RWMutex
+	tables  map[uint32]*ResourceTable
+	limiter wasmruntime.ResourceLimiter
+	mu      sync.RWMutex
 }
 
 // NewResourceStore creates an empty resource store.
@@ -21,6 +24,15 @@ func NewResourceStore() *ResourceStore {
 	}
 }
 
+// NewResourceStoreWithLimiter creates an empty resource store whose tables
+// consult limiter before creating handles.
+func NewResourceStoreWithLimiter(limiter wasmruntime.ResourceLimiter) *ResourceStore {
+	return &ResourceStore{
+		tables:  make(map[uint32]*ResourceTable),
+		limiter: limiter,
+	}
+}
+
 // Table returns or creates a resource table for the given type ID
 func (s *ResourceStore) Table(typeID uint32) *ResourceTable {
 	s.mu.Lock()
@@ -31,6 +43,7 @@ func (s *ResourceStore) Table(typeID uint32) *ResourceTable {
 	}
 
 	t := NewResourceTable(nil)
+	t.limiter = s.limiter
 	s.tables[typeID] = t
 	return t
 }
@@ -45,10 +58,21 @@ func (s *ResourceStore) TableWithDtor(typeID uint32, dtor func(rep uint32)) *Res
 	}
 
 	t := NewResourceTable(dtor)
+	t.limiter = s.limiter
 	s.tables[typeID] = t
 	return t
 }
 
+// Close closes every table, releasing their live handles to the limiter.
+func (s *ResourceStore) Close() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for _, t := range s.tables {
+		t.Close()
+	}
+}
+
 // ResourceEntry represents an entry in the resource table
 type ResourceEntry struct {
 	Rep       uint32 // The representation value
@@ -58,6 +82,7 @@ type ResourceEntry struct {
 
 // ResourceTable manages resources of a single type
 type ResourceTable struct {
+	limiter  wasmruntime.ResourceLimiter
 	dtor     func(rep uint32)
 	entries  []ResourceEntry
 	freeList []Handle
@@ -71,11 +96,36 @@ func NewResourceTable(dtor func(rep uint32)) *ResourceTable {
 	}
 }
 
+// SetLimiter sets the limiter consulted before handles are created.
+func (t *ResourceTable) SetLimiter(limiter wasmruntime.ResourceLimiter) {
+	t.mu.Lock()
+	t.limiter = limiter
+	t.mu.Unlock()
+}
+
 // New creates a resource with ref count 1.
+// New panics with the limiter's error when the handle limit is reached,
+// which traps the calling guest. Use NewChecked outside guest calls.
 func (t *ResourceTable) New(rep uint32) Handle {
+	h, err := t.NewChecked(rep)
+	if err != nil {
+		panic(err)
+	}
+	return h
+}
+
+// NewChecked creates a resource with ref count 1, returning the limiter's
+// error when the handle limit is reached.
+func (t *ResourceTable) NewChecked(rep uint32) (Handle, error) {
 	t.mu.Lock()
 	defer t.mu.Unlock()
 
+	if t.limiter != nil {
+		if err := t.limiter.Acquire(wasmruntime.ResourceHandle); err != nil {
+			return 0, err
+		}
+	}
+
 	entry := ResourceEntry{
 		Rep:      rep,
 		RefCount: 1,
@@ -86,13 +136,13 @@ func (t *ResourceTable) New(rep uint32) Handle {
 		handle := t.freeList[len(t.freeList)-1]
 		t.freeList = t.freeList[:len(t.freeList)-1]
 		t.entries[handle] = entry
-		return handle
+		return handle, nil
 	}
 
 	// Allocate new slot
 	handle := Handle(len(t.entries))
 	t.entries = append(t.entries, entry)
-	return handle
+	return handle, nil
 }
 
 // Rep returns the representation value, or false if invalid/dropped.
@@ -134,6 +184,9 @@ func (t *ResourceTable) Drop(h Handle) (rep uint32, needsDtor bool, err error) {
 	if entry.RefCount == 0 {
 		rep = entry.Rep
 		t.freeList = append(t.freeList, h)
+		if t.limiter != nil {
+			t.limiter.Release(wasmruntime.ResourceHandle)
+		}
 		return rep, t.dtor != nil, nil
 	}
 
@@ -209,6 +262,24 @@ func (t *ResourceTable) Len() int {
 	return count
 }
 
+// Close drops every live handle without running destructors, releasing
+// each to the limiter. Call it when the owning instance goes away.
+func (t *ResourceTable) Close() {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for h := range t.entries {
+		if t.entries[h].RefCount == 0 {
+			continue
+		}
+		t.entries[h] = ResourceEntry{}
+		t.freeList = append(t.freeList, Handle(h))
+		if t.limiter != nil {
+			t.limiter.Release(wasmruntime.ResourceHandle)
+		}
+	}
+}
+
 // RunDestructor invokes the destructor if one was configured.
 // RunDestructor is called automatically by ResourceDrop when appropriate.
 func (t *ResourceTable) RunDestructor(rep uint32) {
@@ -0,0 +1,308 @@
+package linker
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/tetratelabs/wazero/api"
+	"go.bytecodealliance.org/wit"
+
+	"github.com/wippyai/wasm-runtime/component"
+	"github.com/wippyai/wasm-runtime/errors"
+	"github.com/wippyai/wasm-runtime/linker/internal/memory"
+	"github.com/wippyai/wasm-runtime/transcoder"
+)
+
+// StubMode selects how imports no host function provides are defined.
+type StubMode uint8
+
+const (
+	// StubNone fails instantiation on unresolved core imports. Unresolved
+	// component imports trap when called.
+	StubNone StubMode = iota
+	// StubTrap defines unresolved imports as functions that trap with a
+	// missing_import error naming the import.
+	StubTrap
+	// StubDefault defines unresolved imports as functions returning the
+	// default value of their result type: zero numbers, false, empty
+	// strings and lists, none, no flags, the first case of enums and
+	// variants, and ok, or else err, of results. Resource handles are u32
+	// in component binaries and default to 0, which is never a valid
+	// handle. Functions whose result has no default trap as with StubTrap.
+	StubDefault
+)
+
+func (m StubMode) String() string {
+	switch m {
+	case StubTrap:
+		return "trap"
+	case StubDefault:
+		return "default"
+	default:
+		return "none"
+	}
+}
+
+// Diagnostic reports an unresolved import the linker defined as a stub.
+type Diagnostic struct {
+	Import   string // namespace#function
+	Message  string
+	Instance int      // core instance importing the function, -1 for component imports
+	Stub     StubMode // StubTrap or StubDefault
+}
+
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%s: %s", d.Import, d.Message)
+}
+
+// Diagnostics returns the unresolved imports of the component and its
+// nested components that were defined as stubs, sorted by import.
+func (pre *InstancePre) Diagnostics() []Diagnostic {
+	out := append([]Diagnostic(nil), pre.diagnostics...)
+	for _, child := range pre.nested {
+		out = append(out, child.Diagnostics()...)
+	}
+	sort.SliceStable(out, func(i, j int) bool { return out[i].Import < out[j].Import })
+	return out
+}
+
+// Diagnostics returns the unresolved imports defined as stubs when the
+// instance's component was linked. See InstancePre.Diagnostics.
+func (inst *Instance) Diagnostics() []Diagnostic {
+	return inst.pre.Diagnostics()
+}
+
+// resolveImport resolves a host function path, falling back to the stub
+// defined for it.
+func (pre *InstancePre) resolveImport(path string) *FuncDef {
+	if def := pre.linker.Resolve(path); def != nil {
+		return def
+	}
+	return pre.stubs[path]
+}
+
+// stubCoreImport defines the unresolved core import path of core instance
+// instanceIdx as a stub. Core imports carry no WIT types, so default stubs
+// return zeros.
+func (pre *InstancePre) stubCoreImport(instanceIdx int, path string, results []api.ValueType) *FuncDef {
+	mode := pre.linker.options.StubImports
+	def := &FuncDef{Name: path, path: path, ResultTypes: results}
+	msg := "core import defined to return zeros"
+	if mode == StubTrap {
+		def.Handler = trapStub(path)
+		msg = "core import defined to trap"
+	} else {
+		def.Handler = zeroStub(len(results))
+	}
+	pre.diagnostics = append(pre.diagnostics, Diagnostic{Import: path, Message: msg, Instance: instanceIdx, Stub: mode})
+	return def
+}
+
+// stubLowers defines a stub for each lowered component import no host
+// function provides.
+func (pre *InstancePre) stubLowers(lowers []*component.LowerDef) map[string]*FuncDef {
+	mode := pre.linker.options.StubImports
+	if mode == StubNone {
+		return nil
+	}
+
+	stubs := make(map[string]*FuncDef)
+	for _, lower := range lowers {
+		path := lower.Name
+		if _, ok := stubs[path]; ok || pre.linker.Resolve(path) != nil {
+			continue
+		}
+		if !strings.Contains(path, "#") {
+			continue // lowers of functions that are not imports
+		}
+		params, results := lowerSignature(lower.Params, lower.Results)
+		def := &FuncDef{Name: path, path: path, ParamTypes: params, ResultTypes: results}
+
+		stub, msg := StubTrap, "component import defined to trap"
+		if mode == StubDefault {
+			if h, ok := defaultStub(lower.Params, lower.Results); ok {
+				def.Handler = h
+				stub, msg = StubDefault, "component import defined to return defaults"
+			} else {
+				msg = "component import defined to trap: result has no default value"
+			}
+		}
+		if def.Handler == nil {
+			def.Handler = trapStub(path)
+		}
+		stubs[path] = def
+		pre.diagnostics = append(pre.diagnostics, Diagnostic{Import: path, Message: msg, Instance: -1, Stub: stub})
+	}
+	return stubs
+}
+
+// missingImport is the error an unresolved import traps with.
+func missingImport(path string) *errors.Error {
+	return errors.New(errors.PhaseRuntime, errors.KindMissingImport).
+		Detail("unresolved import %s called", path).
+		Build()
+}
+
+func trapStub(path string) api.GoModuleFunc {
+	return func(context.Context, api.Module, []uint64) {
+		panic(missingImport(path))
+	}
+}
+
+func zeroStub(results int) api.GoModuleFunc {
+	return func(_ context.Context, _ api.Module, stack []uint64) {
+		clear(stack[:results])
+	}
+}
+
+// defaultStub returns a lowered function returning the default values of
+// results, or false when a result has none.
+func defaultStub(params, results []wit.Type) (api.GoModuleFunc, bool) {
+	values := make([]any, len(results))
+	for i, t := range results {
+		v, ok := defaultValue(t)
+		if !ok {
+			return nil, false
+		}
+		values[i] = v
+	}
+
+	flatParams := component.FlattenTypes(params)
+	retptrSlot := len(flatParams)
+	if len(flatParams) > component.MaxFlatParams {
+		retptrSlot = 1
+	}
+	encoder := transcoder.NewEncoder()
+
+	if len(component.FlattenTypes(results)) <= component.MaxFlatResults {
+		// Defaults never allocate, so they lower without a memory
+		flat, err := encoder.EncodeParams(results, values, nil, nil, nil)
+		if err != nil {
+			return nil, false
+		}
+		return func(_ context.Context, _ api.Module, stack []uint64) {
+			copy(stack, flat)
+		}, true
+	}
+
+	layouts := transcoder.NewLayoutCalculator()
+	return func(_ context.Context, mod api.Module, stack []uint64) {
+		mem := memory.WrapMemory(mod.Memory())
+		base := uint32(stack[retptrSlot])
+		offset := uint32(0)
+		for i, t := range results {
+			layout := layouts.Calculate(t)
+			if layout.Align > 0 {
+				offset = (offset + layout.Align - 1) &^ (layout.Align - 1)
+			}
+			// Zero the payloads of cases a default does not write
+			if err := mem.Write(base+offset, make([]byte, layout.Size)); err != nil {
+				panic(fmt.Errorf("linker: store default result: %w", err))
+			}
+			if err := encoder.StoreValue(t, values[i], base+offset, mem, nil, nil); err != nil {
+				panic(fmt.Errorf("linker: store default result: %w", err))
+			}
+			offset += layout.Size
+		}
+	}, true
+}
+
+// defaultValue returns the default value of t in the form the transcoder
+// lowers, or false when t has none.
+func defaultValue(t wit.Type) (any, bool) {
+	switch t := t.(type) {
+	case wit.Bool:
+		return false, true
+	case wit.U8:
+		return uint8(0), true
+	case wit.S8:
+		return int8(0), true
+	case wit.U16:
+		return uint16(0), true
+	case wit.S16:
+		return int16(0), true
+	case wit.U32:
+		return uint32(0), true
+	case wit.S32:
+		return int32(0), true
+	case wit.U64:
+		return uint64(0), true
+	case wit.S64:
+		return int64(0), true
+	case wit.F32:
+		return float32(0), true
+	case wit.F64:
+		return float64(0), true
+	case wit.Char:
+		return rune(0), true
+	case wit.String:
+		return "", true
+	case *wit.TypeDef:
+		return defaultKind(t.Kind)
+	}
+	return nil, false
+}
+
+func defaultKind(kind wit.TypeDefKind) (any, bool) {
+	switch k := kind.(type) {
+	case *wit.Record:
+		m := make(map[string]any, len(k.Fields))
+		for _, f := range k.Fields {
+			v, ok := defaultValue(f.Type)
+			if !ok {
+				return nil, false
+			}
+			m[f.Name] = v
+		}
+		return m, true
+	case *wit.Tuple:
+		vs := make([]any, len(k.Types))
+		for i, t := range k.Types {
+			v, ok := defaultValue(t)
+			if !ok {
+				return nil, false
+			}
+			vs[i] = v
+		}
+		return vs, true
+	case *wit.List:
+		return []any{}, true
+	case *wit.Option:
+		return nil, true
+	case *wit.Result:
+		if v, ok := defaultCase(k.OK); ok {
+			return map[string]any{"ok": v}, true
+		}
+		if v, ok := defaultCase(k.Err); ok {
+			return map[string]any{"err": v}, true
+		}
+		return nil, false
+	case *wit.Variant:
+		for _, c := range k.Cases {
+			if v, ok := defaultCase(c.Type); ok {
+				return map[string]any{c.Name: v}, true
+			}
+		}
+		return nil, false
+	case *wit.Enum:
+		if len(k.Cases) == 0 {
+			return nil, false
+		}
+		return k.Cases[0].Name, true
+	case *wit.Flags:
+		return map[string]bool{}, true
+	case wit.Type:
+		return defaultValue(k)
+	}
+	return nil, false
+}
+
+// defaultCase returns the default payload of a case, nil without one.
+func defaultCase(t wit.Type) (any, bool) {
+	if t == nil {
+		return nil, true
+	}
+	return defaultValue(t)
+}
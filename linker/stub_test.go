@@ -0,0 +1,208 @@
+package linker
+
+import (
+	"context"
+	stderrors "errors"
+	"reflect"
+	"testing"
+
+	"github.com/tetratelabs/wazero"
+	"go.bytecodealliance.org/wit"
+
+	"github.com/wippyai/wasm-runtime/component"
+	"github.com/wippyai/wasm-runtime/errors"
+	"github.com/wippyai/wasm-runtime/wat"
+)
+
+func TestStubImports_Component(t *testing.T) {
+	validated := loadTestComponent(t, "../testbed/minimal.wasm")
+	if validated == nil {
+		t.Skip("minimal.wasm not found")
+	}
+
+	tests := []struct {
+		want    []any
+		message string
+		mode    StubMode
+		trap    bool
+	}{
+		{mode: StubTrap, trap: true, message: "component import defined to trap"},
+		{mode: StubDefault, want: []any{uint32(0)}, message: "component import defined to return defaults"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.mode.String(), func(t *testing.T) {
+			ctx := context.Background()
+			rt := wazero.NewRuntime(ctx)
+			defer rt.Close(ctx)
+
+			opts := DefaultOptions()
+			opts.StubImports = tt.mode
+			pre, err := New(rt, opts).Instantiate(ctx, validated)
+			if err != nil {
+				t.Fatalf("Instantiate error: %v", err)
+			}
+			defer pre.Close(ctx)
+
+			want := []Diagnostic{{Import: minimalHost + "#add", Message: tt.message, Instance: -1, Stub: tt.mode}}
+			if got := pre.Diagnostics(); !reflect.DeepEqual(got, want) {
+				t.Errorf("Diagnostics() = %v, want %v", got, want)
+			}
+
+			inst, err := pre.NewInstance(ctx)
+			if err != nil {
+				t.Fatalf("NewInstance error: %v", err)
+			}
+			defer inst.Close(ctx)
+			if len(inst.Diagnostics()) != 1 {
+				t.Errorf("Instance.Diagnostics() = %v", inst.Diagnostics())
+			}
+
+			results, err := inst.Call(ctx, "compute-using-host", uint32(1), uint32(2))
+			if tt.trap {
+				missing := &errors.Error{Phase: errors.PhaseRuntime, Kind: errors.KindMissingImport}
+				if !stderrors.Is(err, missing) {
+					t.Errorf("Call() error = %v, want missing import", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Call() error: %v", err)
+			}
+			if !reflect.DeepEqual(results, tt.want) {
+				t.Errorf("Call() = %v, want %v", results, tt.want)
+			}
+		})
+	}
+}
+
+func TestStubImports_Core(t *testing.T) {
+	wasmBytes, err := wat.Compile(`(module
+		(import "env" "f" (func (param i32) (result i32 i64)))
+		(func (export "g") (result i32) i32.const 1 call 0 drop))`)
+	if err != nil {
+		t.Fatalf("wat compile: %v", err)
+	}
+	c := &component.ValidatedComponent{Raw: &component.Component{
+		CoreModules: [][]byte{wasmBytes},
+		CoreInstances: []component.CoreInstance{
+			{Parsed: &component.ParsedCoreInstance{Kind: component.CoreInstanceInstantiate}},
+		},
+	}}
+
+	ctx := context.Background()
+	rt := wazero.NewRuntime(ctx)
+	defer rt.Close(ctx)
+
+	if _, err := NewWithDefaults(rt).Instantiate(ctx, c); err == nil {
+		t.Fatal("Instantiate should fail on the unresolved import without stubs")
+	}
+
+	opts := DefaultOptions()
+	opts.StubImports = StubDefault
+	pre, err := New(rt, opts).Instantiate(ctx, c)
+	if err != nil {
+		t.Fatalf("Instantiate error: %v", err)
+	}
+	defer pre.Close(ctx)
+
+	want := []Diagnostic{{Import: "env#f", Message: "core import defined to return zeros", Instance: 0, Stub: StubDefault}}
+	if got := pre.Diagnostics(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Diagnostics() = %v, want %v", got, want)
+	}
+	if def := pre.bindings[0].FuncDef; len(def.ParamTypes) != 1 || len(def.ResultTypes) != 2 {
+		t.Errorf("stub signature = %v -> %v", def.ParamTypes, def.ResultTypes)
+	}
+
+	inst, err := pre.NewInstance(ctx)
+	if err != nil {
+		t.Fatalf("NewInstance error: %v", err)
+	}
+	inst.Close(ctx)
+}
+
+func TestDefaultValue(t *testing.T) {
+	own := &wit.TypeDef{Kind: &wit.Own{}}
+	tests := []struct {
+		typ  wit.Type
+		want any
+		name string
+		ok   bool
+	}{
+		{name: "u32", typ: wit.U32{}, want: uint32(0), ok: true},
+		{name: "string", typ: wit.String{}, want: "", ok: true},
+		{name: "list", typ: &wit.TypeDef{Kind: &wit.List{Type: wit.U8{}}}, want: []any{}, ok: true},
+		{name: "option", typ: &wit.TypeDef{Kind: &wit.Option{Type: own}}, want: nil, ok: true},
+		{name: "enum", typ: &wit.TypeDef{Kind: &wit.Enum{Cases: []wit.EnumCase{{Name: "a"}, {Name: "b"}}}}, want: "a", ok: true},
+		{
+			name: "record",
+			typ: &wit.TypeDef{Kind: &wit.Record{Fields: []wit.Field{
+				{Name: "x", Type: wit.S64{}},
+				{Name: "y", Type: wit.Bool{}},
+			}}},
+			want: map[string]any{"x": int64(0), "y": false},
+			ok:   true,
+		},
+		{name: "result ok", typ: &wit.TypeDef{Kind: &wit.Result{OK: wit.U8{}, Err: wit.String{}}}, want: map[string]any{"ok": uint8(0)}, ok: true},
+		{name: "result err", typ: &wit.TypeDef{Kind: &wit.Result{OK: own, Err: wit.String{}}}, want: map[string]any{"err": ""}, ok: true},
+		{
+			name: "variant",
+			typ: &wit.TypeDef{Kind: &wit.Variant{Cases: []wit.Case{
+				{Name: "handle", Type: own},
+				{Name: "none"},
+			}}},
+			want: map[string]any{"none": nil},
+			ok:   true,
+		},
+		{name: "own", typ: own},
+		{name: "tuple with own", typ: &wit.TypeDef{Kind: &wit.Tuple{Types: []wit.Type{wit.U32{}, own}}}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, ok := defaultValue(tt.typ)
+			if ok != tt.ok || !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("defaultValue() = %v, %v, want %v, %v", got, ok, tt.want, tt.ok)
+			}
+		})
+	}
+}
+
+func TestDefaultStub_Retptr(t *testing.T) {
+	ctx := context.Background()
+	rt := wazero.NewRuntime(ctx)
+	defer rt.Close(ctx)
+
+	wasmBytes, err := wat.Compile(`(module (memory (export "memory") 1))`)
+	if err != nil {
+		t.Fatalf("wat compile: %v", err)
+	}
+	mod, err := rt.Instantiate(ctx, wasmBytes)
+	if err != nil {
+		t.Fatalf("instantiate: %v", err)
+	}
+	defer mod.Close(ctx)
+
+	// option<tuple<string, u32>> flattens to 4 values, returned through memory
+	result := &wit.TypeDef{Kind: &wit.Option{Type: &wit.TypeDef{Kind: &wit.Tuple{Types: []wit.Type{wit.String{}, wit.U32{}}}}}}
+	stub, ok := defaultStub([]wit.Type{wit.U32{}}, []wit.Type{result})
+	if !ok {
+		t.Fatal("defaultStub() has no default")
+	}
+
+	const retptr = 64
+	garbage := []byte{0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	mod.Memory().Write(retptr, garbage)
+	stub(ctx, mod, []uint64{7, retptr})
+
+	got, _ := mod.Memory().Read(retptr, 16)
+	if !reflect.DeepEqual(got, make([]byte, 16)) {
+		t.Errorf("stored result = %v, want zeros", got)
+	}
+}
+
+func TestZeroStub(t *testing.T) {
+	stack := []uint64{1, 2, 3}
+	zeroStub(2)(context.Background(), nil, stack)
+	if !reflect.DeepEqual(stack, []uint64{0, 0, 3}) {
+		t.Errorf("stack = %v", stack)
+	}
+}
@@ -0,0 +1,154 @@
+package linker
+
+import (
+	"github.com/wippyai/wasm-runtime/errors"
+	internalwasm "github.com/wippyai/wasm-runtime/linker/internal/wasm"
+	"github.com/wippyai/wasm-runtime/wasm/dwarf"
+)
+
+// FunctionNames returns the function names in the name section of a core
+// module, keyed by function index.
+func FunctionNames(wasmBytes []byte) map[uint32]string {
+	return internalwasm.ParseFuncNames(wasmBytes)
+}
+
+// ModuleSymbols describes a compiled core module for trap backtraces: the
+// function names of its name section and the DWARF of the module it was
+// compiled from.
+type ModuleSymbols struct {
+	Names  map[uint32]string // name section of the compiled module
+	Source *dwarf.Map        // nil without DWARF
+}
+
+// NewModuleSymbols returns the symbols of compiled, which the runtime
+// rewrote from original; compiled may be original itself.
+func NewModuleSymbols(original, compiled []byte) *ModuleSymbols {
+	return &ModuleSymbols{
+		Names:  FunctionNames(compiled),
+		Source: dwarf.NewMap(original, compiled),
+	}
+}
+
+// FuncIndex returns the index of the function named name. Names found at
+// different indexes are ambiguous and reported as not found.
+func (s *ModuleSymbols) FuncIndex(_, name string) (uint32, bool) {
+	var found uint32
+	var ok bool
+	for idx, n := range s.Names {
+		if n != name {
+			continue
+		}
+		if ok && idx != found {
+			return 0, false
+		}
+		found, ok = idx, true
+	}
+	return found, ok
+}
+
+// contains reports whether f, a frame of the compiled module, may be in
+// this module: its offset is in the body of its function or, without an
+// offset, its function has its name.
+func (s *ModuleSymbols) contains(f *errors.Frame) bool {
+	if s.Source == nil || f.Index < 0 {
+		return false
+	}
+	idx := uint32(f.Index)
+	switch {
+	case f.Offset != 0:
+		return s.Source.Contains(idx, f.Offset)
+	case f.Name != "":
+		return s.Names[idx] == f.Name
+	}
+	_, ok := s.Source.Index(idx)
+	return ok
+}
+
+// Locate implements errors.Symbols for a frame of this module. Frames
+// without an offset get the declaration of their function.
+func (s *ModuleSymbols) Locate(f *errors.Frame) bool {
+	if !s.contains(f) {
+		return false
+	}
+	idx := uint32(f.Index)
+	orig, ok := s.Source.Index(idx)
+	if !ok {
+		return false
+	}
+	var locs []dwarf.Location
+	var offset uint64
+	if f.Offset != 0 {
+		locs, offset = s.Source.Lookup(idx, f.Offset)
+	} else if loc, found := s.Source.Function(idx); found {
+		locs = []dwarf.Location{loc}
+	}
+	f.Index, f.Offset, f.Source = int(orig), offset, nil
+	for _, loc := range locs {
+		line := loc.String()
+		if loc.Inlined {
+			line += " (inlined)"
+		}
+		f.Source = append(f.Source, line)
+	}
+	return true
+}
+
+// FuncIndex returns the core function index of the function named name
+// in the name section of one of the instance's core modules, including
+// those of nested instances. Names found at different indexes are
+// ambiguous and reported as not found. FuncIndex implements
+// errors.Symbols for trap backtraces; module is not used, as the engine
+// reports name section module names rather than instance names.
+func (inst *Instance) FuncIndex(module, name string) (uint32, bool) {
+	var found uint32
+	var ok bool
+	for _, in := range inst.withNested() {
+		for _, symbols := range in.pre.symbols {
+			idx, has := symbols.FuncIndex(module, name)
+			if !has {
+				continue
+			}
+			if ok && idx != found {
+				return 0, false
+			}
+			found, ok = idx, true
+		}
+	}
+	return found, ok
+}
+
+// Locate implements errors.Symbols: it resolves f through the DWARF of
+// the one core module, possibly nested, that f can be in.
+func (inst *Instance) Locate(f *errors.Frame) bool {
+	var match *ModuleSymbols
+	for _, in := range inst.withNested() {
+		for _, symbols := range in.pre.symbols {
+			if !symbols.contains(f) {
+				continue
+			}
+			if match != nil {
+				return false
+			}
+			match = symbols
+		}
+	}
+	return match != nil && match.Locate(f)
+}
+
+// withNested returns inst followed by its nested instances, depth first.
+func (inst *Instance) withNested() []*Instance {
+	all := []*Instance{inst}
+	for _, nested := range inst.nested {
+		all = append(all, nested.withNested()...)
+	}
+	return all
+}
+
+// trapError converts a trap from a call of export into an errors.KindTrap
+// error with a backtrace.
+func (inst *Instance) trapError(export string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return errors.TrapError(errors.PhaseRuntime, export, err, inst)
+}
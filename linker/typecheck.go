@@ -0,0 +1,252 @@
+package linker
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"go.bytecodealliance.org/wit"
+	"go.uber.org/zap"
+
+	"github.com/wippyai/wasm-runtime/component"
+	"github.com/wippyai/wasm-runtime/errors"
+	"github.com/wippyai/wasm-runtime/transcoder"
+)
+
+var contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+
+// CheckSignature reports where goType, the Go function type of a host
+// function, does not match the WIT signature of lower. Parameters and
+// results are checked with the transcoder.Compiler rules they are lifted
+// and lowered with, relaxed to the forms the dynamic transcoder accepts:
+//   - a leading context.Context parameter is allowed
+//   - interface types, such as any, match every WIT type
+//   - option<T> may be returned as a T that is always some
+//   - result<T, E> may be returned as (T, E) or, without T, as E, where E
+//     is a pointer or interface that is nil for ok; E is not checked
+//
+// Lowers without resolved types are not checked.
+func CheckSignature(c *transcoder.Compiler, lower *component.LowerDef, goType reflect.Type) []errors.SignatureMismatch {
+	if lower.Params == nil || goType == nil || goType.Kind() != reflect.Func {
+		return nil
+	}
+	ns, fn := lower.Name, ""
+	if i := strings.LastIndexByte(lower.Name, '#'); i >= 0 {
+		ns, fn = lower.Name[:i], lower.Name[i+1:]
+	}
+	var out []errors.SignatureMismatch
+	mismatch := func(position, witType, goType, reason string) {
+		out = append(out, errors.SignatureMismatch{
+			Namespace: ns,
+			Function:  fn,
+			Position:  position,
+			WitType:   witType,
+			GoType:    goType,
+			Reason:    reason,
+		})
+	}
+
+	in := make([]reflect.Type, 0, goType.NumIn())
+	for i := range goType.NumIn() {
+		in = append(in, goType.In(i))
+	}
+	if len(in) > 0 && in[0] == contextType {
+		in = in[1:]
+	}
+	if len(in) != len(lower.Params) {
+		mismatch("params", witParams(lower), goTypes(in), fmt.Sprintf("%d parameters, want %d", len(in), len(lower.Params)))
+	} else {
+		for i, t := range lower.Params {
+			if err := checkType(c, t, in[i]); err != nil {
+				mismatch("param "+paramName(lower, i), witName(t), in[i].String(), reason(err))
+			}
+		}
+	}
+
+	if lower.Results == nil {
+		return out
+	}
+	results := make([]reflect.Type, 0, goType.NumOut())
+	for i := range goType.NumOut() {
+		results = append(results, goType.Out(i))
+	}
+	if len(lower.Results) == 1 {
+		if r := resultKind(lower.Results[0]); r != nil {
+			if pos, err := checkResult(c, lower.Results[0], r, results); pos != "" {
+				mismatch(pos, witName(lower.Results[0]), goTypes(results), err)
+			}
+			return out
+		}
+	}
+	if len(results) != len(lower.Results) {
+		mismatch("results", witTypes(lower.Results), goTypes(results), fmt.Sprintf("%d results, want %d", len(results), len(lower.Results)))
+		return out
+	}
+	for i, t := range lower.Results {
+		if err := checkResultType(c, t, results[i]); err != nil {
+			mismatch("result", witName(t), results[i].String(), reason(err))
+		}
+	}
+	return out
+}
+
+// checkType compiles t for goType, ignoring the interface values the
+// compiler leaves to dynamic transcoding.
+func checkType(c *transcoder.Compiler, t wit.Type, goType reflect.Type) error {
+	if goType.Kind() == reflect.Interface {
+		return nil
+	}
+	_, err := c.Compile(t, goType)
+	var e *errors.Error
+	if stderrors.As(err, &e) && e.GoType == "interface {}" {
+		return nil
+	}
+	return err
+}
+
+// checkResultType is checkType allowing option<T> results returned as T.
+func checkResultType(c *transcoder.Compiler, t wit.Type, goType reflect.Type) error {
+	if td, ok := t.(*wit.TypeDef); ok {
+		if o, ok := td.Kind.(*wit.Option); ok && goType.Kind() != reflect.Pointer {
+			return checkType(c, o.Type, goType)
+		}
+	}
+	return checkType(c, t, goType)
+}
+
+// checkResult checks the Go results returned for a result<T, E>. It
+// returns the position and reason of a mismatch, or "" when they match.
+func checkResult(c *transcoder.Compiler, t wit.Type, r *wit.Result, results []reflect.Type) (string, string) {
+	switch {
+	case len(results) == 2:
+		if !nilable(results[1]) {
+			return "result", fmt.Sprintf("error result %s is not a pointer or interface", results[1])
+		}
+		if r.OK == nil {
+			return "results", "2 results, want 1 for a result without ok type"
+		}
+		if err := checkType(c, r.OK, results[0]); err != nil {
+			return "result", "ok: " + reason(err)
+		}
+	case len(results) == 1:
+		// result<_, E> returned as a nilable E
+		if r.OK == nil && nilable(results[0]) {
+			return "", ""
+		}
+		if err := checkType(c, t, results[0]); err != nil {
+			return "result", reason(err)
+		}
+	default:
+		return "results", fmt.Sprintf("%d results, want 1 or 2", len(results))
+	}
+	return "", ""
+}
+
+func nilable(t reflect.Type) bool {
+	return t.Kind() == reflect.Pointer || t.Kind() == reflect.Interface
+}
+
+// canonLowers returns the canon lowers of the component sorted by name, or
+// nil when its types cannot be resolved.
+func (pre *InstancePre) canonLowers() []*component.LowerDef {
+	if pre.typeResolver == nil {
+		return nil
+	}
+	reg, err := component.NewCanonRegistry(pre.component.Raw, pre.typeResolver)
+	if err != nil {
+		Logger().Warn("cannot resolve canon lowers", zap.Error(err))
+		return nil
+	}
+	lowers := reg.AllLowers()
+	sort.Slice(lowers, func(i, j int) bool { return lowers[i].Name < lowers[j].Name })
+	return lowers
+}
+
+// checkHostTypes checks the typed host functions bound to lowers.
+func (pre *InstancePre) checkHostTypes(lowers []*component.LowerDef) error {
+	c := transcoder.NewCompiler()
+	var mismatches []errors.SignatureMismatch
+	for _, lower := range lowers {
+		def := pre.linker.Resolve(lower.Name)
+		if def == nil || def.GoType == nil {
+			continue
+		}
+		mismatches = append(mismatches, CheckSignature(c, lower, def.GoType)...)
+	}
+	if len(mismatches) == 0 {
+		return nil
+	}
+	return instError("type_check", -1, "", "host functions do not match import signatures",
+		&errors.SignatureMismatchError{Mismatches: mismatches})
+}
+
+func resultKind(t wit.Type) *wit.Result {
+	if td, ok := t.(*wit.TypeDef); ok {
+		r, _ := td.Kind.(*wit.Result)
+		return r
+	}
+	return nil
+}
+
+func paramName(lower *component.LowerDef, i int) string {
+	if i < len(lower.ParamNames) && lower.ParamNames[i] != "" {
+		return lower.ParamNames[i]
+	}
+	return fmt.Sprint(i)
+}
+
+func witName(t wit.Type) string {
+	if t == nil {
+		return "_"
+	}
+	if td, ok := t.(*wit.TypeDef); ok && td.Name != nil {
+		return *td.Name
+	}
+	return strings.Join(strings.Fields(t.WIT(nil, "")), " ")
+}
+
+func witParams(lower *component.LowerDef) string {
+	parts := make([]string, len(lower.Params))
+	for i, t := range lower.Params {
+		parts[i] = paramName(lower, i) + ": " + witName(t)
+	}
+	return "(" + strings.Join(parts, ", ") + ")"
+}
+
+func witTypes(ts []wit.Type) string {
+	parts := make([]string, len(ts))
+	for i, t := range ts {
+		parts[i] = witName(t)
+	}
+	return "(" + strings.Join(parts, ", ") + ")"
+}
+
+func goTypes(ts []reflect.Type) string {
+	parts := make([]string, len(ts))
+	for i, t := range ts {
+		parts[i] = t.String()
+	}
+	return "(" + strings.Join(parts, ", ") + ")"
+}
+
+// reason describes a transcoder.Compiler error without its phase.
+func reason(err error) string {
+	var e *errors.Error
+	if !stderrors.As(err, &e) {
+		return err.Error()
+	}
+	msg := e.Detail
+	if msg == "" && e.WitType != "" {
+		msg = e.GoType + " is not a Go " + e.WitType
+	}
+	if msg == "" {
+		msg = string(e.Kind)
+	}
+	if len(e.Path) > 0 {
+		msg = strings.Join(e.Path, ".") + ": " + msg
+	}
+	return msg
+}
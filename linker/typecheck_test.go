@@ -0,0 +1,138 @@
+package linker
+
+import (
+	"context"
+	stderrors "errors"
+	"reflect"
+	"testing"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+	"go.bytecodealliance.org/wit"
+
+	"github.com/wippyai/wasm-runtime/component"
+	"github.com/wippyai/wasm-runtime/errors"
+	"github.com/wippyai/wasm-runtime/transcoder"
+)
+
+func TestCheckHostTypes(t *testing.T) {
+	validated := loadTestComponent(t, "../testbed/minimal.wasm")
+	if validated == nil {
+		t.Skip("minimal.wasm not found")
+	}
+
+	tests := []struct {
+		name   string
+		goType reflect.Type
+		want   []string // mismatch positions
+	}{
+		{name: "match", goType: reflect.TypeOf(func(context.Context, uint32, uint32) uint32 { return 0 })},
+		{name: "untyped", goType: nil},
+		{
+			name:   "mismatch",
+			goType: reflect.TypeOf(func(context.Context, int, uint32) string { return "" }),
+			want:   []string{"param a", "result"},
+		},
+		{
+			name:   "count",
+			goType: reflect.TypeOf(func(uint32) (uint32, uint32) { return 0, 0 }),
+			want:   []string{"params", "results"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			rt := wazero.NewRuntime(ctx)
+			defer rt.Close(ctx)
+
+			l := New(rt, DefaultOptions())
+			i32 := []api.ValueType{api.ValueTypeI32}
+			l.Namespace(minimalHost).DefineTypedFunc("add", func(_ context.Context, _ api.Module, stack []uint64) {
+				stack[0] = stack[0] + stack[1]
+			}, []api.ValueType{api.ValueTypeI32, api.ValueTypeI32}, i32, tt.goType)
+
+			pre, err := l.Instantiate(ctx, validated)
+			if tt.want == nil {
+				if err != nil {
+					t.Fatalf("Instantiate error: %v", err)
+				}
+				pre.Close(ctx)
+				return
+			}
+			if err == nil {
+				pre.Close(ctx)
+				t.Fatal("Instantiate succeeded, want signature mismatch")
+			}
+
+			var mismatch *errors.SignatureMismatchError
+			if !stderrors.As(err, &mismatch) {
+				t.Fatalf("error = %v, want SignatureMismatchError", err)
+			}
+			var got []string
+			for _, m := range mismatch.Mismatches {
+				if m.Namespace != minimalHost || m.Function != "add" {
+					t.Errorf("mismatch of %s#%s", m.Namespace, m.Function)
+				}
+				got = append(got, m.Position)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("positions = %v, want %v\n%v", got, tt.want, err)
+			}
+		})
+	}
+}
+
+func TestCheckSignature(t *testing.T) {
+	resultU32 := &wit.TypeDef{Kind: &wit.Result{OK: wit.U32{}, Err: wit.String{}}}
+	resultErr := &wit.TypeDef{Kind: &wit.Result{Err: wit.String{}}}
+	optionU32 := &wit.TypeDef{Kind: &wit.Option{Type: wit.U32{}}}
+	listU8 := &wit.TypeDef{Kind: &wit.List{Type: wit.U8{}}}
+
+	tests := []struct {
+		fn      any
+		name    string
+		params  []wit.Type
+		results []wit.Type
+		want    []string
+	}{
+		{name: "context", params: []wit.Type{wit.U32{}}, fn: func(context.Context, uint32) {}},
+		{name: "no context", params: []wit.Type{wit.U32{}}, fn: func(uint32) {}},
+		{name: "int", params: []wit.Type{wit.U32{}}, fn: func(int) {}, want: []string{"param 0"}},
+		{name: "any", params: []wit.Type{listU8}, fn: func(any) {}},
+		{name: "list", params: []wit.Type{listU8}, fn: func([]byte) {}},
+		{name: "list mismatch", params: []wit.Type{listU8}, fn: func(string) {}, want: []string{"param 0"}},
+		{name: "result pair", results: []wit.Type{resultU32}, fn: func() (uint32, *string) { return 0, nil }},
+		{name: "result error", results: []wit.Type{resultU32}, fn: func() (uint32, error) { return 0, nil }},
+		{name: "result ok mismatch", results: []wit.Type{resultU32}, fn: func() (int64, error) { return 0, nil }, want: []string{"result"}},
+		{name: "result err value", results: []wit.Type{resultU32}, fn: func() (uint32, string) { return 0, "" }, want: []string{"result"}},
+		{name: "result err only", results: []wit.Type{resultErr}, fn: func() *string { return nil }},
+		{name: "result count", results: []wit.Type{resultU32}, fn: func() {}, want: []string{"results"}},
+		{name: "option pointer", results: []wit.Type{optionU32}, fn: func() *uint32 { return nil }},
+		{name: "option value", results: []wit.Type{optionU32}, fn: func() uint32 { return 0 }},
+		{name: "option mismatch", results: []wit.Type{optionU32}, fn: func() string { return "" }, want: []string{"result"}},
+		{name: "results", results: []wit.Type{wit.U32{}}, fn: func() {}, want: []string{"results"}},
+	}
+	c := transcoder.NewCompiler()
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			params := tt.params
+			if params == nil {
+				params = []wit.Type{}
+			}
+			lower := &component.LowerDef{Name: "test:pkg/api#f", Params: params, Results: tt.results}
+			if lower.Results == nil {
+				lower.Results = []wit.Type{}
+			}
+			var got []string
+			for _, m := range CheckSignature(c, lower, reflect.TypeOf(tt.fn)) {
+				if m.Namespace != "test:pkg/api" || m.Function != "f" {
+					t.Errorf("mismatch of %s#%s", m.Namespace, m.Function)
+				}
+				got = append(got, m.Position)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CheckSignature() positions = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,30 @@
+// Package profile attributes the wall time of guest calls to guest
+// functions, host imports and canonical ABI transcoding, and writes it as
+// a pprof profile.
+//
+// Profiling needs function listeners compiled into every module, so it is
+// enabled when the runtime is created, then switched on per instance or
+// per call:
+//
+//	rt, err := runtime.NewWithConfig(ctx, runtime.Config{Profiling: true})
+//	// ...
+//	p := profile.New()
+//	inst.SetProfiler(p)                                // every call of inst
+//	inst.Call(profile.WithProfiler(ctx, p), "run", 42) // or a single call
+//
+//	f, _ := os.Create("guest.pb.gz")
+//	p.WriteTo(f) // go tool pprof -http=: guest.pb.gz
+//
+// Each stack starts at the called export. Time before the guest function
+// runs is reported under [lower], time after it returns under [lift];
+// cabi_realloc and cabi_post_* calls made while transcoding appear below
+// those frames. Functions are named from the name section, falling back to
+// export names and indices; with DWARF, each function carries the file and
+// line it is declared at.
+//
+// Samples have two values: calls, the number of times the stack was
+// entered, and wall, the nanoseconds spent in its leaf.
+//
+// Users of package linker or engine install the listeners themselves by
+// compiling and instantiating under a context from Listen.
+package profile
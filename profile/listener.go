@@ -0,0 +1,118 @@
+package profile
+
+import (
+	"context"
+	"strings"
+
+	"github.com/tetratelabs/wazero/api"
+	"github.com/tetratelabs/wazero/experimental"
+
+	"github.com/wippyai/wasm-runtime/wasm/dwarf"
+)
+
+type listeningKey struct{}
+
+// Listen returns a context under which compiled modules and host modules
+// report their calls to the profiler recording the call. Listeners are
+// fixed at compile time and slow every call, so engines install them only
+// when profiling is enabled.
+func Listen(ctx context.Context) context.Context {
+	ctx = context.WithValue(ctx, listeningKey{}, true)
+	return experimental.WithFunctionListenerFactory(ctx, &factory{})
+}
+
+// ListenModule is Listen for compiling a core module. Source files and
+// lines of its functions are read through sources, the DWARF of the
+// module as loaded; it may be nil. It returns ctx unchanged unless ctx
+// came from Listen.
+func ListenModule(ctx context.Context, sources *dwarf.Map) context.Context {
+	if !Listening(ctx) {
+		return ctx
+	}
+	return experimental.WithFunctionListenerFactory(ctx, &factory{sources: sources})
+}
+
+// Listening reports whether ctx came from Listen.
+func Listening(ctx context.Context) bool {
+	on, _ := ctx.Value(listeningKey{}).(bool)
+	return on
+}
+
+// factory creates a listener per function of one module
+type factory struct {
+	sources *dwarf.Map
+}
+
+func (f *factory) NewFunctionListener(def api.FunctionDefinition) experimental.FunctionListener {
+	return &listener{fn: newFuncInfo(def), factory: f, index: def.Index()}
+}
+
+// listener moves the call recorded in ctx in and out of one function
+type listener struct {
+	fn      *funcInfo
+	factory *factory
+	index   uint32
+}
+
+func (l *listener) Before(ctx context.Context, _ api.Module, _ api.FunctionDefinition, _ []uint64, _ experimental.StackIterator) {
+	c := callFromContext(ctx)
+	if c == nil {
+		return
+	}
+	l.fn.once.Do(l.resolveSource)
+	c.enter(l.fn)
+}
+
+func (l *listener) After(ctx context.Context, _ api.Module, _ api.FunctionDefinition, _ []uint64) {
+	if c := callFromContext(ctx); c != nil {
+		c.leave()
+	}
+}
+
+func (l *listener) Abort(ctx context.Context, _ api.Module, _ api.FunctionDefinition, _ error) {
+	if c := callFromContext(ctx); c != nil {
+		c.leave()
+	}
+}
+
+// resolveSource sets the declaration file and line of the function from
+// DWARF
+func (l *listener) resolveSource() {
+	loc, ok := l.factory.sources.Function(l.index)
+	if !ok {
+		return
+	}
+	l.fn.file = loc.File
+	l.fn.line = loc.Line
+	if l.fn.unnamed && loc.Function != "" {
+		l.fn.name = loc.Function
+	}
+}
+
+func newFuncInfo(def api.FunctionDefinition) *funcInfo {
+	fn := &funcInfo{system: def.DebugName(), file: def.ModuleName()}
+	switch {
+	case def.GoFunction() != nil:
+		// Host functions are only meaningful with their module
+		fn.name = def.DebugName()
+	case def.Name() != "":
+		fn.name = def.Name()
+	case len(def.ExportNames()) > 0:
+		fn.name = def.ExportNames()[0]
+	default:
+		// "module.$index", or ".$index" in modules without a name
+		fn.name = strings.TrimPrefix(def.DebugName(), ".")
+		fn.unnamed = true
+	}
+	fn.canon = isCanonFunc(fn.name)
+	for _, name := range def.ExportNames() {
+		fn.canon = fn.canon || isCanonFunc(name)
+	}
+	return fn
+}
+
+// isCanonFunc reports the canonical ABI helpers the host calls while
+// lowering arguments and lifting results
+func isCanonFunc(name string) bool {
+	return name == "cabi_realloc" || strings.HasPrefix(name, "cabi_post_")
+}
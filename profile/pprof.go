@@ -0,0 +1,206 @@
+package profile
+
+import (
+	"compress/gzip"
+	"io"
+	"time"
+)
+
+// Field numbers of the pprof profile.proto messages
+const (
+	profileSampleType        = 1
+	profileSample            = 2
+	profileLocation          = 4
+	profileFunction          = 5
+	profileStringTable       = 6
+	profileTimeNanos         = 9
+	profileDurationNanos     = 10
+	profilePeriodType        = 11
+	profilePeriod            = 12
+	profileDefaultSampleType = 14
+
+	valueTypeType = 1
+	valueTypeUnit = 2
+
+	sampleLocationID = 1
+	sampleValue      = 2
+
+	locationID   = 1
+	locationLine = 4
+
+	lineFunctionID = 1
+	lineLine       = 2
+
+	functionID         = 1
+	functionName       = 2
+	functionSystemName = 3
+	functionFilename   = 4
+	functionStartLine  = 5
+)
+
+// WriteTo writes the recorded samples as a gzip-compressed pprof profile,
+// readable by go tool pprof. Each sample is a call stack, leaf first, with
+// its call count and wall time spent in the leaf.
+func (p *Profiler) WriteTo(w io.Writer) (int64, error) {
+	p.mu.Lock()
+	data := p.encode()
+	p.mu.Unlock()
+
+	cw := &countingWriter{w: w}
+	zw := gzip.NewWriter(cw)
+	if _, err := zw.Write(data); err != nil {
+		return cw.n, err
+	}
+	err := zw.Close()
+	return cw.n, err
+}
+
+// encode builds the uncompressed profile. p.mu must be held.
+func (p *Profiler) encode() []byte {
+	e := &encoder{strings: map[string]int64{"": 0}, stringList: []string{""}}
+	var out buffer
+
+	out.message(profileSampleType, e.valueType("calls", "count"))
+	out.message(profileSampleType, e.valueType("wall", "nanoseconds"))
+
+	ids := make(map[*funcInfo]uint64)
+	var funcs []*funcInfo
+	var stack []uint64
+	var walk func(n *node)
+	walk = func(n *node) {
+		if n.fn != nil {
+			id, ok := ids[n.fn]
+			if !ok {
+				id = uint64(len(funcs) + 1)
+				ids[n.fn] = id
+				funcs = append(funcs, n.fn)
+			}
+			stack = append(stack, id)
+			if n.calls > 0 || n.nanos > 0 {
+				var s buffer
+				s.packed(sampleLocationID, reversed(stack))
+				s.packed(sampleValue, []uint64{uint64(n.calls), uint64(n.nanos)})
+				out.message(profileSample, s)
+			}
+		}
+		for _, c := range n.children {
+			walk(c)
+		}
+		if n.fn != nil {
+			stack = stack[:len(stack)-1]
+		}
+	}
+	walk(p.root)
+
+	// Locations and functions are one to one; a location has no address
+	// because guest code has none the host can meaningfully report
+	for i, fn := range funcs {
+		id := uint64(i + 1)
+		var line buffer
+		line.varint(lineFunctionID, id)
+		line.varint(lineLine, uint64(fn.line))
+		var loc buffer
+		loc.varint(locationID, id)
+		loc.message(locationLine, line)
+		out.message(profileLocation, loc)
+
+		var f buffer
+		f.varint(functionID, id)
+		f.varint(functionName, uint64(e.str(fn.name)))
+		f.varint(functionSystemName, uint64(e.str(fn.system)))
+		f.varint(functionFilename, uint64(e.str(fn.file)))
+		f.varint(functionStartLine, uint64(fn.line))
+		out.message(profileFunction, f)
+	}
+
+	out.varint(profileTimeNanos, uint64(p.start.UnixNano()))
+	out.varint(profileDurationNanos, uint64(time.Since(p.start)))
+	out.message(profilePeriodType, e.valueType("wall", "nanoseconds"))
+	out.varint(profilePeriod, 1)
+	out.varint(profileDefaultSampleType, uint64(e.str("wall")))
+
+	for _, s := range e.stringList {
+		out.bytes(profileStringTable, []byte(s))
+	}
+	return out
+}
+
+func reversed(ids []uint64) []uint64 {
+	out := make([]uint64, len(ids))
+	for i, id := range ids {
+		out[len(ids)-1-i] = id
+	}
+	return out
+}
+
+// encoder interns strings into the profile string table
+type encoder struct {
+	strings    map[string]int64
+	stringList []string
+}
+
+func (e *encoder) str(s string) int64 {
+	if i, ok := e.strings[s]; ok {
+		return i
+	}
+	i := int64(len(e.stringList))
+	e.strings[s] = i
+	e.stringList = append(e.stringList, s)
+	return i
+}
+
+func (e *encoder) valueType(typ, unit string) buffer {
+	var b buffer
+	b.varint(valueTypeType, uint64(e.str(typ)))
+	b.varint(valueTypeUnit, uint64(e.str(unit)))
+	return b
+}
+
+// buffer is an encoded protobuf message
+type buffer []byte
+
+func (b *buffer) uvarint(v uint64) {
+	for v >= 0x80 {
+		*b = append(*b, byte(v)|0x80)
+		v >>= 7
+	}
+	*b = append(*b, byte(v))
+}
+
+// varint writes a varint field, omitting zero as proto3 does
+func (b *buffer) varint(field int, v uint64) {
+	if v == 0 {
+		return
+	}
+	b.uvarint(uint64(field) << 3)
+	b.uvarint(v)
+}
+
+func (b *buffer) bytes(field int, data []byte) {
+	b.uvarint(uint64(field)<<3 | 2)
+	b.uvarint(uint64(len(data)))
+	*b = append(*b, data...)
+}
+
+func (b *buffer) message(field int, m buffer) {
+	b.bytes(field, m)
+}
+
+func (b *buffer) packed(field int, vs []uint64) {
+	var p buffer
+	for _, v := range vs {
+		p.uvarint(v)
+	}
+	b.bytes(field, p)
+}
+
+type countingWriter struct {
+	w io.Writer
+	n int64
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	return n, err
+}
@@ -0,0 +1,176 @@
+package profile
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Pseudo-frames for time spent in the canonical ABI around a guest call
+const (
+	FrameLower = "[lower]" // encoding arguments into guest memory
+	FrameLift  = "[lift]"  // decoding results out of guest memory
+)
+
+// Profiler accumulates time per call stack of guest functions, host
+// imports and canonical ABI transcoding. One Profiler may record many
+// calls concurrently.
+type Profiler struct {
+	start   time.Time
+	root    *node
+	pseudo  map[string]*funcInfo
+	mu      sync.Mutex
+	elapsed time.Duration // total recorded time, for the profile duration
+}
+
+// funcInfo describes one profiled function
+type funcInfo struct {
+	name    string // name section name or export name
+	system  string // engine debug name, "module.$index" when unnamed
+	file    string // source file from DWARF, or the module name
+	line    int
+	once    sync.Once
+	unnamed bool // no name section or export name
+	canon   bool // canonical ABI helper such as cabi_realloc
+}
+
+// node is a call stack: the path from the root to node
+type node struct {
+	fn       *funcInfo
+	parent   *node
+	children map[*funcInfo]*node
+	calls    int64
+	nanos    int64 // self time
+}
+
+func (n *node) child(fn *funcInfo) *node {
+	if c, ok := n.children[fn]; ok {
+		return c
+	}
+	if n.children == nil {
+		n.children = make(map[*funcInfo]*node)
+	}
+	c := &node{fn: fn, parent: n}
+	n.children[fn] = c
+	return c
+}
+
+// New creates an empty profiler.
+func New() *Profiler {
+	return &Profiler{
+		start:  time.Now(),
+		root:   &node{},
+		pseudo: make(map[string]*funcInfo),
+	}
+}
+
+// Reset discards recorded samples. Calls in progress keep recording into
+// the discarded stacks.
+func (p *Profiler) Reset() {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.start = time.Now()
+	p.root = &node{}
+	p.elapsed = 0
+}
+
+// pseudoFunc returns the function for a name that has no guest code,
+// such as an export or a transcoding frame. p.mu must be held.
+func (p *Profiler) pseudoFunc(name string) *funcInfo {
+	fn, ok := p.pseudo[name]
+	if !ok {
+		fn = &funcInfo{name: name, system: name}
+		p.pseudo[name] = fn
+	}
+	return fn
+}
+
+type profilerKey struct{}
+
+type callKey struct{}
+
+// WithProfiler returns a context that profiles calls made with it,
+// overriding the profiler of the instance.
+func WithProfiler(ctx context.Context, p *Profiler) context.Context {
+	return context.WithValue(ctx, profilerKey{}, p)
+}
+
+// FromContext returns the profiler set by WithProfiler, or nil.
+func FromContext(ctx context.Context) *Profiler {
+	p, _ := ctx.Value(profilerKey{}).(*Profiler)
+	return p
+}
+
+// call records one export call. Function listeners find it in the call's
+// context; it is used by one goroutine at a time.
+type call struct {
+	p     *Profiler
+	root  *node // the export
+	node  *node // current stack
+	last  time.Time
+	depth int
+}
+
+// Start begins recording a call of export. The returned context must be
+// passed to the call, and done called when it returns. Time before the
+// first guest function is attributed to FrameLower, time after the last
+// to FrameLift.
+func (p *Profiler) Start(ctx context.Context, export string) (context.Context, func()) {
+	c := &call{p: p}
+	p.mu.Lock()
+	c.root = p.root.child(p.pseudoFunc(export))
+	c.root.calls++
+	c.node = c.root.child(p.pseudoFunc(FrameLower))
+	p.mu.Unlock()
+	c.last = time.Now()
+
+	return context.WithValue(ctx, callKey{}, c), func() {
+		p.mu.Lock()
+		c.tick()
+		p.mu.Unlock()
+	}
+}
+
+func callFromContext(ctx context.Context) *call {
+	c, _ := ctx.Value(callKey{}).(*call)
+	return c
+}
+
+// tick attributes the time since the last event to the current stack.
+// c.p.mu must be held.
+func (c *call) tick() {
+	now := time.Now()
+	d := now.Sub(c.last)
+	c.last = now
+	c.node.nanos += int64(d)
+	c.p.elapsed += d
+}
+
+func (c *call) enter(fn *funcInfo) {
+	c.p.mu.Lock()
+	defer c.p.mu.Unlock()
+	c.tick()
+	// Canonical ABI helpers called by the host belong to the transcoding
+	// frame they run in
+	if c.depth == 0 && !fn.canon {
+		c.node = c.root
+	}
+	c.node = c.node.child(fn)
+	c.node.calls++
+	c.depth++
+}
+
+func (c *call) leave() {
+	c.p.mu.Lock()
+	defer c.p.mu.Unlock()
+	c.tick()
+	if c.depth == 0 {
+		return
+	}
+	c.depth--
+	fn := c.node.fn
+	c.node = c.node.parent
+	if c.depth == 0 && !fn.canon {
+		c.node = c.root.child(c.p.pseudoFunc(FrameLift))
+	}
+}
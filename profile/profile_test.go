@@ -0,0 +1,283 @@
+package profile
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/tetratelabs/wazero"
+
+	"github.com/wippyai/wasm-runtime/wasm/dwarf"
+	"github.com/wippyai/wasm-runtime/wat"
+)
+
+// decoded is the part of a pprof profile the tests check: sample stacks,
+// root first and joined by ";", to their values
+type decoded struct {
+	samples map[string][]uint64
+	types   []string
+}
+
+func readVarint(b []byte) (uint64, []byte) {
+	var v uint64
+	for shift := uint(0); len(b) > 0; shift += 7 {
+		c := b[0]
+		b = b[1:]
+		v |= uint64(c&0x7f) << shift
+		if c < 0x80 {
+			break
+		}
+	}
+	return v, b
+}
+
+// fields splits a message into field number and payload pairs; varint
+// payloads are returned re-encoded
+func fields(b []byte) (nums []int, vals [][]byte) {
+	for len(b) > 0 {
+		var key uint64
+		key, b = readVarint(b)
+		start := b
+		switch key & 7 {
+		case 0:
+			_, b = readVarint(b)
+			vals = append(vals, start[:len(start)-len(b)])
+		case 2:
+			var n uint64
+			n, b = readVarint(b)
+			vals = append(vals, b[:n])
+			b = b[n:]
+		}
+		nums = append(nums, int(key>>3))
+	}
+	return nums, vals
+}
+
+func varints(b []byte) []uint64 {
+	var out []uint64
+	for len(b) > 0 {
+		var v uint64
+		v, b = readVarint(b)
+		out = append(out, v)
+	}
+	return out
+}
+
+func decode(t *testing.T, p *Profiler) decoded {
+	t.Helper()
+	var buf bytes.Buffer
+	if _, err := p.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	zr, err := gzip.NewReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := io.ReadAll(zr)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var strs []string
+	var samples, locations, functions, sampleTypes [][]byte
+	nums, vals := fields(data)
+	for i, n := range nums {
+		switch n {
+		case profileStringTable:
+			strs = append(strs, string(vals[i]))
+		case profileSample:
+			samples = append(samples, vals[i])
+		case profileLocation:
+			locations = append(locations, vals[i])
+		case profileFunction:
+			functions = append(functions, vals[i])
+		case profileSampleType:
+			sampleTypes = append(sampleTypes, vals[i])
+		}
+	}
+	if len(strs) == 0 || strs[0] != "" {
+		t.Fatalf("string table = %q, want \"\" first", strs)
+	}
+
+	funcNames := make(map[uint64]string)
+	for _, f := range functions {
+		var id uint64
+		nums, vals := fields(f)
+		for i, n := range nums {
+			v, _ := readVarint(vals[i])
+			switch n {
+			case functionID:
+				id = v
+			case functionName:
+				funcNames[id] = strs[v]
+			}
+		}
+	}
+	locFuncs := make(map[uint64]string)
+	for _, l := range locations {
+		var id uint64
+		nums, vals := fields(l)
+		for i, n := range nums {
+			switch n {
+			case locationID:
+				id, _ = readVarint(vals[i])
+			case locationLine:
+				lnums, lvals := fields(vals[i])
+				for j, ln := range lnums {
+					if ln == lineFunctionID {
+						fid, _ := readVarint(lvals[j])
+						locFuncs[id] = funcNames[fid]
+					}
+				}
+			}
+		}
+	}
+
+	d := decoded{samples: make(map[string][]uint64)}
+	for _, st := range sampleTypes {
+		nums, vals := fields(st)
+		for i, n := range nums {
+			if n == valueTypeType {
+				v, _ := readVarint(vals[i])
+				d.types = append(d.types, strs[v])
+			}
+		}
+	}
+	for _, s := range samples {
+		var stack []string
+		var values []uint64
+		nums, vals := fields(s)
+		for i, n := range nums {
+			switch n {
+			case sampleLocationID:
+				for _, id := range varints(vals[i]) {
+					stack = append([]string{locFuncs[id]}, stack...)
+				}
+			case sampleValue:
+				values = varints(vals[i])
+			}
+		}
+		d.samples[strings.Join(stack, ";")] = values
+	}
+	return d
+}
+
+func (d decoded) stacks() []string {
+	var out []string
+	for s := range d.samples {
+		out = append(out, s)
+	}
+	sort.Strings(out)
+	return out
+}
+
+const guestWAT = `(module
+	(import "env" "tick" (func $tick))
+	(func $hot (param i32) (result i32)
+		(call $tick)
+		(i32.mul (local.get 0) (local.get 0)))
+	(func (export "run") (param i32) (result i32)
+		(call $hot (call $hot (local.get 0))))
+	(func (export "cabi_realloc") (param i32 i32 i32 i32) (result i32)
+		(i32.const 0)))
+`
+
+func TestListen(t *testing.T) {
+	ctx := Listen(context.Background())
+	r := wazero.NewRuntime(ctx)
+	defer r.Close(ctx)
+
+	_, err := r.NewHostModuleBuilder("env").
+		NewFunctionBuilder().
+		WithFunc(func() {}).
+		Export("tick").
+		Instantiate(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	module, err := wat.Compile(guestWAT)
+	if err != nil {
+		t.Fatal(err)
+	}
+	compiled, err := r.CompileModule(ListenModule(ctx, dwarf.NewMap(module, module)), module)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mod, err := r.InstantiateModule(ctx, compiled, wazero.NewModuleConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := New()
+	callCtx, done := p.Start(context.Background(), "my:pkg/api#run")
+	// The host allocates arguments, calls the export and frees its results
+	if _, err := mod.ExportedFunction("cabi_realloc").Call(callCtx, 0, 0, 1, 8); err != nil {
+		t.Fatal(err)
+	}
+	res, err := mod.ExportedFunction("run").Call(callCtx, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res[0] != 81 {
+		t.Fatalf("run(3) = %d, want 81", res[0])
+	}
+	done()
+
+	// Calls outside Start are not recorded
+	if _, err := mod.ExportedFunction("run").Call(context.Background(), 2); err != nil {
+		t.Fatal(err)
+	}
+
+	d := decode(t, p)
+	if strings.Join(d.types, ",") != "calls,wall" {
+		t.Errorf("sample types = %v", d.types)
+	}
+	wantCalls := map[string]uint64{
+		"my:pkg/api#run":                      1,
+		"my:pkg/api#run;[lower]":              0,
+		"my:pkg/api#run;[lower];cabi_realloc": 1,
+		"my:pkg/api#run;run":                  1,
+		"my:pkg/api#run;run;hot":              2,
+		"my:pkg/api#run;run;hot;env.tick":     2,
+		"my:pkg/api#run;[lift]":               0,
+	}
+	for stack, calls := range wantCalls {
+		v, ok := d.samples[stack]
+		if !ok {
+			if calls > 0 {
+				t.Errorf("no sample for %s in %v", stack, d.stacks())
+			}
+			continue
+		}
+		if v[0] != calls {
+			t.Errorf("%s: calls = %d, want %d", stack, v[0], calls)
+		}
+	}
+	if len(d.samples) > len(wantCalls) {
+		t.Errorf("unexpected stacks: %v", d.stacks())
+	}
+}
+
+func TestProfiler_Reset(t *testing.T) {
+	p := New()
+	_, done := p.Start(context.Background(), "run")
+	done()
+	if d := decode(t, p); len(d.samples) == 0 {
+		t.Fatal("no samples after a call")
+	}
+	p.Reset()
+	if d := decode(t, p); len(d.samples) != 0 {
+		t.Errorf("samples after Reset: %v", d.stacks())
+	}
+}
+
+func TestListenModule_NotListening(t *testing.T) {
+	ctx := context.Background()
+	if got := ListenModule(ctx, nil); got != ctx || Listening(got) {
+		t.Error("ListenModule installed listeners without Listen")
+	}
+}
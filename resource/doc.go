@@ -41,6 +41,15 @@
 //	value, ok := table.GetTyped(fileHandle, FileTypeID) // ok
 //	value, ok := table.GetTyped(fileHandle, SocketTypeID) // !ok
 //
+// # Typed Tables
+//
+// NewTypedTable gives a type-safe view of one type ID in a UnifiedTable:
+//
+//	files := resource.NewTypedTable[*File](table, FileTypeID)
+//	handle := files.Insert(f)
+//	f, ok := files.Borrow(handle) // cannot be removed until returned
+//	files.ReturnBorrow(handle)
+//
 // # Observers
 //
 // Register observers to track resource lifecycle events:
@@ -80,6 +80,55 @@ func (t *UnifiedTable) Remove(handle Handle) (any, bool) {
 	return value, true
 }
 
+// Take removes a resource without calling its destructor, for ownership
+// transfers out of the table. Returns (value, true) if found.
+func (t *UnifiedTable) Take(handle Handle) (any, bool) {
+	typeID, _ := t.backend.TypeID(handle)
+	value, ok := t.backend.Drop(handle)
+	if !ok {
+		return nil, false
+	}
+
+	t.notify(Event{
+		Type:   EventDropped,
+		Handle: handle,
+		TypeID: typeID,
+		Value:  value,
+	})
+
+	return value, true
+}
+
+// Borrow marks a resource as lent out. Borrowed resources cannot be
+// removed until every borrow is returned.
+func (t *UnifiedTable) Borrow(handle Handle) bool {
+	if !t.backend.Borrow(handle) {
+		return false
+	}
+	typeID, _ := t.backend.TypeID(handle)
+	t.notify(Event{
+		Type:   EventBorrowed,
+		Handle: handle,
+		TypeID: typeID,
+	})
+	return true
+}
+
+// ReturnBorrow ends a borrow started by Borrow. It returns false if the
+// handle is no longer valid or was not borrowed.
+func (t *UnifiedTable) ReturnBorrow(handle Handle) bool {
+	if !t.backend.ReturnBorrow(handle) {
+		return false
+	}
+	typeID, _ := t.backend.TypeID(handle)
+	t.notify(Event{
+		Type:   EventBorrowReturned,
+		Handle: handle,
+		TypeID: typeID,
+	})
+	return true
+}
+
 // Subscribe adds an observer for lifecycle events.
 func (t *UnifiedTable) Subscribe(o Observer) {
 	t.obsMu.Lock()
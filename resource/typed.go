@@ -0,0 +1,108 @@
+package resource
+
+var _ TypedTable[any] = (*TypedUnifiedTable[any])(nil)
+
+// TypedUnifiedTable implements TypedTable for the resources of one type ID
+// in a UnifiedTable. Several typed tables may share a unified table.
+type TypedUnifiedTable[T any] struct {
+	table  *UnifiedTable
+	typeID uint32
+}
+
+// NewTypedTable returns a typed view of the resources of typeID in table.
+func NewTypedTable[T any](table *UnifiedTable, typeID uint32) *TypedUnifiedTable[T] {
+	return &TypedUnifiedTable[T]{table: table, typeID: typeID}
+}
+
+// Insert adds a value and returns its handle, or 0 if the table is closed.
+func (t *TypedUnifiedTable[T]) Insert(value T) Handle {
+	return t.table.Insert(t.typeID, value)
+}
+
+// Get retrieves a value by handle.
+func (t *TypedUnifiedTable[T]) Get(handle Handle) (T, bool) {
+	value, ok := t.table.GetTyped(handle, t.typeID)
+	return typedValue[T](value, ok)
+}
+
+// Remove drops a resource, calling Dropper.Drop, and returns (value, true) if found.
+// Resources with outstanding borrows are not removed.
+func (t *TypedUnifiedTable[T]) Remove(handle Handle) (T, bool) {
+	if !t.owns(handle) {
+		var zero T
+		return zero, false
+	}
+	value, ok := t.table.Remove(handle)
+	return typedValue[T](value, ok)
+}
+
+// Take removes a resource without calling Dropper.Drop, transferring
+// ownership of the value to the caller.
+func (t *TypedUnifiedTable[T]) Take(handle Handle) (T, bool) {
+	if !t.owns(handle) {
+		var zero T
+		return zero, false
+	}
+	value, ok := t.table.Take(handle)
+	return typedValue[T](value, ok)
+}
+
+// Borrow marks a handle as lent out and returns its value. Borrowed
+// resources cannot be removed until every borrow is returned.
+func (t *TypedUnifiedTable[T]) Borrow(handle Handle) (T, bool) {
+	value, ok := t.table.GetTyped(handle, t.typeID)
+	if !ok || !t.table.Borrow(handle) {
+		var zero T
+		return zero, false
+	}
+	return typedValue[T](value, true)
+}
+
+// ReturnBorrow ends a borrow started by Borrow. It returns false if the
+// handle is no longer valid or was not borrowed.
+func (t *TypedUnifiedTable[T]) ReturnBorrow(handle Handle) bool {
+	return t.owns(handle) && t.table.ReturnBorrow(handle)
+}
+
+// Len returns the number of active resources of this type.
+func (t *TypedUnifiedTable[T]) Len() int {
+	n := 0
+	t.Each(func(Handle, T) bool {
+		n++
+		return true
+	})
+	return n
+}
+
+// Each iterates over all active resources of this type.
+func (t *TypedUnifiedTable[T]) Each(fn func(Handle, T) bool) {
+	t.table.backend.Each(func(h Handle, typeID uint32, value any) bool {
+		if typeID != t.typeID {
+			return true
+		}
+		v, ok := value.(T)
+		if !ok {
+			return true
+		}
+		return fn(h, v)
+	})
+}
+
+// TypeID returns the type ID of the resources in this table.
+func (t *TypedUnifiedTable[T]) TypeID() uint32 {
+	return t.typeID
+}
+
+func (t *TypedUnifiedTable[T]) owns(handle Handle) bool {
+	typeID, ok := t.table.backend.TypeID(handle)
+	return ok && typeID == t.typeID
+}
+
+func typedValue[T any](value any, ok bool) (T, bool) {
+	if !ok {
+		var zero T
+		return zero, false
+	}
+	v, ok := value.(T)
+	return v, ok
+}
@@ -0,0 +1,96 @@
+package resource
+
+import (
+	"testing"
+)
+
+func TestTypedTable_Basic(t *testing.T) {
+	table := NewTable()
+	counters := NewTypedTable[*dropCounter](table, 1)
+	names := NewTypedTable[string](table, 2)
+
+	d := &dropCounter{}
+	h := counters.Insert(d)
+	if h == 0 {
+		t.Fatal("Expected non-zero handle")
+	}
+	nh := names.Insert("name")
+
+	got, ok := counters.Get(h)
+	if !ok || got != d {
+		t.Fatalf("Get() = %v, %v", got, ok)
+	}
+	if _, ok := counters.Get(nh); ok {
+		t.Fatal("Get of another type's handle should fail")
+	}
+	if _, ok := names.Remove(h); ok {
+		t.Fatal("Remove of another type's handle should fail")
+	}
+
+	if counters.Len() != 1 || names.Len() != 1 {
+		t.Fatalf("Len() = %d, %d, want 1, 1", counters.Len(), names.Len())
+	}
+
+	var seen []Handle
+	counters.Each(func(h Handle, _ *dropCounter) bool {
+		seen = append(seen, h)
+		return true
+	})
+	if len(seen) != 1 || seen[0] != h {
+		t.Fatalf("Each() visited %v, want [%d]", seen, h)
+	}
+
+	if _, ok := counters.Remove(h); !ok {
+		t.Fatal("Remove failed")
+	}
+	if d.count != 1 {
+		t.Fatalf("Expected Drop() to be called once, called %d times", d.count)
+	}
+	if table.Len() != 1 {
+		t.Fatalf("Expected 1 resource left, got %d", table.Len())
+	}
+}
+
+func TestTypedTable_Borrow(t *testing.T) {
+	table := NewTable()
+	obs := &testObserver{}
+	table.Subscribe(obs)
+	counters := NewTypedTable[*dropCounter](table, 1)
+
+	d := &dropCounter{}
+	h := counters.Insert(d)
+
+	if got, ok := counters.Borrow(h); !ok || got != d {
+		t.Fatalf("Borrow() = %v, %v", got, ok)
+	}
+	if _, ok := counters.Remove(h); ok {
+		t.Fatal("Remove should fail while borrowed")
+	}
+	if !counters.ReturnBorrow(h) {
+		t.Fatal("ReturnBorrow failed")
+	}
+	if counters.ReturnBorrow(h) {
+		t.Fatal("ReturnBorrow without a borrow should fail")
+	}
+
+	got, ok := counters.Take(h)
+	if !ok || got != d {
+		t.Fatalf("Take() = %v, %v", got, ok)
+	}
+	if d.count != 0 {
+		t.Fatal("Take should not call Drop()")
+	}
+	if counters.ReturnBorrow(h) {
+		t.Fatal("ReturnBorrow of a taken handle should fail")
+	}
+
+	want := []EventType{EventCreated, EventBorrowed, EventBorrowReturned, EventDropped}
+	if len(obs.events) != len(want) {
+		t.Fatalf("Expected %d events, got %d", len(want), len(obs.events))
+	}
+	for i, e := range obs.events {
+		if e.Type != want[i] {
+			t.Errorf("event %d = %v, want %v", i, e.Type, want[i])
+		}
+	}
+}
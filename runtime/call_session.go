@@ -5,11 +5,13 @@ import (
 	"fmt"
 
 	"github.com/wippyai/wasm-runtime/engine"
+	"github.com/wippyai/wasm-runtime/errors"
 )
 
 // CallSession wraps engine.CallSession for step-based async execution.
 type CallSession struct {
-	session *engine.CallSession
+	session   *engine.CallSession
+	resources *hostResources
 }
 
 // Step advances execution. Pass nil on first call, then resume with YieldResult.
@@ -17,7 +19,12 @@ func (cs *CallSession) Step(ctx context.Context, yr *engine.YieldResult) (engine
 	if cs == nil || cs.session == nil {
 		return engine.StepResult{}, fmt.Errorf("call session is nil")
 	}
-	return cs.session.Step(ctx, yr)
+	sr, err := cs.session.Step(withHostResources(ctx, cs.resources), yr)
+	if err != nil {
+		err = errors.TrapError(errors.PhaseRuntime, cs.session.Name(), err, cs.session)
+		sr.Error = err
+	}
+	return sr, err
 }
 
 // LiftResult decodes raw wasm results into Go values.
@@ -1316,3 +1316,50 @@ func BenchmarkEchoListString_10_CallInto(b *testing.B) {
 		}
 	}
 }
+
+func TestInvoke(t *testing.T) {
+	skipIfNoComplex(t)
+	rt, inst := setupComplexInstance(t)
+	ctx := context.Background()
+	defer rt.Close(ctx)
+	defer inst.Close(ctx)
+
+	type point struct {
+		X int32 `wit:"x"`
+		Y int32 `wit:"y"`
+	}
+	var p point
+	if err := inst.Invoke(ctx, "echo-point", &p, point{X: 3, Y: 4}); err != nil {
+		t.Fatal(err)
+	}
+	if p.X != 3 || p.Y != 4 {
+		t.Errorf("echo-point: got %+v, want {3 4}", p)
+	}
+
+	type errorInfo struct {
+		Code    uint32 `wit:"code"`
+		Message string `wit:"message"`
+	}
+	var res struct {
+		Ok  *int32
+		Err *errorInfo
+	}
+	if err := inst.Invoke(ctx, "try-divide", &res, int32(10), int32(0)); err != nil {
+		t.Fatal(err)
+	}
+	if res.Ok != nil || res.Err == nil || res.Err.Message != "division by zero" {
+		t.Errorf("try-divide: got ok=%v err=%+v", res.Ok, res.Err)
+	}
+
+	var sum int64
+	if err := inst.Invoke(ctx, "sum-list", &sum, []int32{1, 2, 3}); err != nil {
+		t.Fatal(err)
+	}
+	if sum != 6 {
+		t.Errorf("sum-list: got %d, want 6", sum)
+	}
+
+	if err := inst.Invoke(ctx, "no-such-export", nil); err == nil {
+		t.Error("expected error for unknown export")
+	}
+}
@@ -0,0 +1,749 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	"go.bytecodealliance.org/wit"
+
+	"github.com/wippyai/wasm-runtime/component"
+	"github.com/wippyai/wasm-runtime/errors"
+	"github.com/wippyai/wasm-runtime/linker"
+)
+
+// Link satisfies the imports of m from the exports of provider, composing
+// components at runtime instead of precomposing their binaries. An import
+// ns#name is wired to the provider export of the same name; a provider
+// exporting a newer semver-compatible version of the interface also
+// matches. Imports the provider does not export are left to the host
+// registry.
+//
+// Arguments are lifted out of the importing instance's memory and lowered
+// into the provider's, and results travel back the same way. Handle types
+// match only handles of the same kind and resource name. When the provider
+// is a component defining the resource, an own handle it returns moves
+// out of its table into one the link keeps per importing instance, an
+// own handle passed to it moves back, and a borrow is lent as the
+// resource's rep for the duration of the call; `[resource-drop]r` then
+// drops the importer's handle and runs the provider's destructor. A core
+// module provider manages its handles itself, so they pass through
+// unchanged and `[resource-drop]r` is forwarded to its
+// ns#[resource-drop]r export when it has one.
+//
+// Link must be called before m is compiled or instantiated. The provider
+// must outlive every instance of m and, like any Instance, is not safe for
+// concurrent use.
+func (m *Module) Link(provider *Instance) error {
+	if err := m.checkLink(provider); err != nil {
+		return err
+	}
+
+	exports := provider.module.wazeroModule.ExportNames()
+	linked := 0
+	resources := make(map[string]map[string]bool) // import namespace -> resource names
+	providerNS := make(map[string]string)         // import namespace -> provider namespace
+
+	for _, lower := range m.wazeroModule.Lowers() {
+		ns, name, ok := strings.Cut(lower.Name, "#")
+		if !ok {
+			continue
+		}
+		export := matchExport(exports, ns, name)
+		if export == "" {
+			continue
+		}
+		if err := m.linkLower(lower, ns, name, provider, export); err != nil {
+			return err
+		}
+		linked++
+
+		if r := resourceOf(name); r != "" {
+			if resources[ns] == nil {
+				resources[ns] = make(map[string]bool)
+			}
+			resources[ns][r] = true
+		}
+		providerNS[ns], _, _ = strings.Cut(export, "#")
+	}
+
+	if linked == 0 {
+		return errors.NotFound(errors.PhaseLinking, "imports satisfied by", "provider")
+	}
+
+	for ns, names := range resources {
+		for r := range names {
+			var handler any
+			if provider.module.isComponent {
+				res, err := m.linkedResource(provider, providerNS[ns], r)
+				if err != nil {
+					return err
+				}
+				handler = res.drop
+			} else {
+				drop := providerNS[ns] + "#[resource-drop]" + r
+				if provider.wazeroInstance.GetExportedFunction(drop) == nil {
+					continue
+				}
+				handler = dropForwarder(provider, drop)
+			}
+			if err := m.wazeroModule.RegisterHostFuncTyped(ns, "[resource-drop]"+r, handler); err != nil {
+				return errors.Registration(errors.PhaseLinking, ns, "[resource-drop]"+r, err)
+			}
+		}
+	}
+	return nil
+}
+
+// LinkFunc wires the single import namespace#name of m to the export of
+// provider, for imports whose provider export has a different name. Both
+// must have the same WIT signature. The rules of Link apply.
+func (m *Module) LinkFunc(namespace, name string, provider *Instance, export string) error {
+	if err := m.checkLink(provider); err != nil {
+		return err
+	}
+	lower := m.wazeroModule.FindLower(namespace, name)
+	if lower == nil {
+		return errors.NotFound(errors.PhaseLinking, "import", namespace+"#"+name)
+	}
+	return m.linkLower(lower, namespace, name, provider, export)
+}
+
+func (m *Module) checkLink(provider *Instance) error {
+	if provider == nil || provider.module == nil {
+		return errors.NotInitialized(errors.PhaseLinking, "provider")
+	}
+	if !m.isComponent {
+		return errors.InvalidInput(errors.PhaseLinking, "only components have imports to link")
+	}
+	if m.wazeroModule.Linked() {
+		return errors.InvalidInput(errors.PhaseLinking, "module already compiled; link before Compile or Instantiate")
+	}
+	return nil
+}
+
+// linkLower registers a host function for lower that forwards to export.
+func (m *Module) linkLower(lower *component.LowerDef, namespace, name string, provider *Instance, export string) error {
+	params, results, err := provider.module.GetFunctionTypes(export)
+	if err != nil {
+		return err
+	}
+	path := namespace + "#" + name
+
+	// A component provider's lift resolves handles to u32 like any lower;
+	// its handle types come with the lift.
+	wantParams, wantResults := params, results
+	var lift *component.LiftDef
+	if provider.module.isComponent {
+		lift = provider.module.wazeroModule.FindLift(export)
+		wantParams, wantResults = lift.HandleParams, lift.HandleResults
+	}
+	if err := sameSignature(path, lower.HandleParams, lower.HandleResults, wantParams, wantResults); err != nil {
+		return err
+	}
+
+	var t *transfer
+	if lift != nil {
+		exportNS, _, _ := strings.Cut(export, "#")
+		for _, r := range handleResources(append(append([]wit.Type{}, wantParams...), wantResults...)) {
+			res, err := m.linkedResource(provider, exportNS, r)
+			if err != nil {
+				return err
+			}
+			if t == nil {
+				t = &transfer{resources: make(map[string]*linkedResource), params: wantParams, results: wantResults}
+			}
+			t.resources[r] = res
+		}
+	}
+	handler := forwarder(provider, export, params, results, t)
+	if err := m.wazeroModule.RegisterHostFuncTyped(namespace, name, handler); err != nil {
+		return errors.Registration(errors.PhaseLinking, namespace, name, err)
+	}
+	return nil
+}
+
+var (
+	contextType = reflect.TypeOf((*context.Context)(nil)).Elem()
+	anyType     = reflect.TypeOf((*any)(nil)).Elem()
+)
+
+// forwarder builds a handler taking and returning untyped values, so the
+// importer's lower wrapper lifts arguments dynamically and lowers the
+// provider's results into the importer's memory. t, when set, moves the
+// handles in arguments and results between the two instances. A provider
+// failure panics, which traps the importing call with the error.
+func forwarder(provider *Instance, export string, params, results []wit.Type, t *transfer) any {
+	in := make([]reflect.Type, 1+len(params))
+	in[0] = contextType
+	for i := range params {
+		in[i+1] = anyType
+	}
+	out := make([]reflect.Type, len(results))
+	for i := range out {
+		out[i] = anyType
+	}
+
+	fn := reflect.MakeFunc(reflect.FuncOf(in, out, false), func(args []reflect.Value) []reflect.Value {
+		ctx := args[0].Interface().(context.Context)
+		vals := make([]any, len(params))
+		for i := range vals {
+			vals[i] = args[i+1].Interface()
+		}
+		if t != nil {
+			defer t.lowerParams(ctx, vals)()
+		}
+
+		res, err := provider.CallWithTypes(ctx, export, params, results, vals...)
+		if err != nil {
+			panic(fmt.Errorf("call linked export %s: %w", export, err))
+		}
+		if t != nil {
+			res = t.liftResults(ctx, res)
+		}
+
+		rets := make([]reflect.Value, len(results))
+		switch len(results) {
+		case 0:
+		case 1:
+			rets[0] = anyValue(res)
+		default:
+			multi, _ := res.([]any)
+			for i := range rets {
+				var v any
+				if i < len(multi) {
+					v = multi[i]
+				}
+				rets[i] = anyValue(v)
+			}
+		}
+		return rets
+	})
+	return fn.Interface()
+}
+
+// linkedResourceBase offsets the resource store type IDs of linked
+// resources past the importer's own type index space.
+const linkedResourceBase = 1 << 31
+
+// linkedResource is a resource a component provider defines, seen from
+// the importing instances. Each importer holds its handles in its own
+// resource store under typeID, with the provider's rep as their rep.
+type linkedResource struct {
+	provider *linker.Instance
+	name     string
+	typeID   uint32 // importer store type ID
+	provided uint32 // provider store type ID
+}
+
+// linkedResource returns the linked resource r of the provider interface
+// iface, registering it on first use.
+func (m *Module) linkedResource(provider *Instance, iface, r string) (*linkedResource, error) {
+	key := iface + "#" + r
+	if res, ok := m.linked[key]; ok {
+		return res, nil
+	}
+	li := provider.wazeroInstance.LinkerInstance()
+	if li == nil {
+		return nil, errors.Unsupported(errors.PhaseLinking, "resources of single-module providers")
+	}
+	provided, ok := li.ExportedResource(key)
+	if !ok {
+		if provided, ok = li.ExportedResource(r); !ok {
+			return nil, errors.NotFound(errors.PhaseLinking, "exported resource", key)
+		}
+	}
+	if m.linked == nil {
+		m.linked = make(map[string]*linkedResource)
+	}
+	res := &linkedResource{
+		provider: li,
+		name:     key,
+		typeID:   linkedResourceBase + uint32(len(m.linked)),
+		provided: provided,
+	}
+	m.linked[key] = res
+	return res, nil
+}
+
+// table returns the handle table of the importing instance calling with
+// ctx. Dropping its last handle runs the provider's destructor.
+func (r *linkedResource) table(ctx context.Context) *linker.ResourceTable {
+	importer := linker.InstanceFromContext(ctx)
+	if importer == nil {
+		panic(fmt.Errorf("linked resource %s: no importing instance in context", r.name))
+	}
+	return importer.Resources().TableWithDtor(r.typeID, func(rep uint32) {
+		r.provider.Resources().Table(r.provided).RunDestructor(rep)
+	})
+}
+
+// drop implements [resource-drop] for importers of r.
+func (r *linkedResource) drop(ctx context.Context, handle uint32) {
+	table := r.table(ctx)
+	rep, needsDtor, err := table.Drop(linker.Handle(handle))
+	if err != nil {
+		panic(fmt.Errorf("drop linked resource %s: %w", r.name, err))
+	}
+	if needsDtor {
+		table.RunDestructor(rep)
+	}
+}
+
+// transfer moves the handles of a linked call between the importer's
+// tables and the tables of the provider defining their resources.
+type transfer struct {
+	resources map[string]*linkedResource
+	params    []wit.Type
+	results   []wit.Type
+}
+
+// lowerParams rewrites the importer's handles in vals for the provider:
+// owns move to the provider's table and borrows are lent as reps. The
+// returned func ends the borrows once the call returns.
+func (t *transfer) lowerParams(ctx context.Context, vals []any) func() {
+	var lent []func()
+	for i := range vals {
+		vals[i] = mapHandles(t.params[i], vals[i], func(kind, resource string, handle uint32) uint32 {
+			res := t.resources[resource]
+			table := res.table(ctx)
+			h := linker.Handle(handle)
+			rep, ok := table.Rep(h)
+			if !ok {
+				panic(fmt.Errorf("linked resource %s: invalid handle %d", res.name, handle))
+			}
+			if kind == "borrow" {
+				if err := table.Borrow(h); err != nil {
+					panic(fmt.Errorf("linked resource %s: %w", res.name, err))
+				}
+				lent = append(lent, func() { _ = table.EndBorrow(h) })
+				return rep
+			}
+			if _, _, err := table.Drop(h); err != nil {
+				panic(fmt.Errorf("linked resource %s: %w", res.name, err))
+			}
+			return uint32(res.provider.Resources().Table(res.provided).New(rep))
+		})
+	}
+	return func() {
+		for _, end := range lent {
+			end()
+		}
+	}
+}
+
+// liftResults moves the own handles in the provider's results into the
+// importer's tables.
+func (t *transfer) liftResults(ctx context.Context, res any) any {
+	move := func(_, resource string, handle uint32) uint32 {
+		r := t.resources[resource]
+		provided := r.provider.Resources().Table(r.provided)
+		h := linker.Handle(handle)
+		rep, ok := provided.Rep(h)
+		if !ok {
+			panic(fmt.Errorf("linked resource %s: provider returned invalid handle %d", r.name, handle))
+		}
+		// Ownership moves to the importer, so the destructor does not run
+		if _, _, err := provided.Drop(h); err != nil {
+			panic(fmt.Errorf("linked resource %s: %w", r.name, err))
+		}
+		return uint32(r.table(ctx).New(rep))
+	}
+	switch len(t.results) {
+	case 0:
+		return res
+	case 1:
+		return mapHandles(t.results[0], res, move)
+	}
+	multi, _ := res.([]any)
+	for i := range multi {
+		if i < len(t.results) {
+			multi[i] = mapHandles(t.results[i], multi[i], move)
+		}
+	}
+	return res
+}
+
+// mapHandles replaces each handle in v, a dynamically lifted value of type
+// t, with the result of fn.
+func mapHandles(t wit.Type, v any, fn func(kind, resource string, handle uint32) uint32) any {
+	if v == nil || !hasHandle(t) {
+		return v
+	}
+	if kind, resource := handleOf(t); kind != "" {
+		if h, ok := v.(uint32); ok {
+			return fn(kind, resource, h)
+		}
+		return v
+	}
+	td, ok := t.(*wit.TypeDef)
+	if !ok {
+		return v
+	}
+	switch k := td.Kind.(type) {
+	case wit.Type:
+		return mapHandles(k, v, fn)
+	case *wit.Record:
+		if m, ok := v.(map[string]any); ok {
+			for _, f := range k.Fields {
+				if fv, ok := m[f.Name]; ok {
+					m[f.Name] = mapHandles(f.Type, fv, fn)
+				}
+			}
+		}
+	case *wit.Tuple:
+		if items, ok := v.([]any); ok {
+			for i := range items {
+				if i < len(k.Types) {
+					items[i] = mapHandles(k.Types[i], items[i], fn)
+				}
+			}
+		}
+	case *wit.List:
+		if items, ok := v.([]any); ok {
+			for i := range items {
+				items[i] = mapHandles(k.Type, items[i], fn)
+			}
+		}
+	case *wit.Option:
+		return mapHandles(k.Type, v, fn)
+	case *wit.Result:
+		if m, ok := v.(map[string]any); ok {
+			if ok := m["ok"]; ok != nil {
+				m["ok"] = mapHandles(k.OK, ok, fn)
+			}
+			if e := m["err"]; e != nil {
+				m["err"] = mapHandles(k.Err, e, fn)
+			}
+		}
+	case *wit.Variant:
+		if m, ok := v.(map[string]any); ok {
+			for _, c := range k.Cases {
+				if cv := m[c.Name]; cv != nil && c.Type != nil {
+					m[c.Name] = mapHandles(c.Type, cv, fn)
+				}
+			}
+		}
+	}
+	return v
+}
+
+// hasHandle reports whether a value of type t can contain a handle.
+func hasHandle(t wit.Type) bool {
+	if isHandle(t) {
+		return true
+	}
+	td, ok := t.(*wit.TypeDef)
+	if !ok {
+		return false
+	}
+	switch k := td.Kind.(type) {
+	case wit.Type:
+		return hasHandle(k)
+	case *wit.Record:
+		for _, f := range k.Fields {
+			if hasHandle(f.Type) {
+				return true
+			}
+		}
+	case *wit.Tuple:
+		for _, e := range k.Types {
+			if hasHandle(e) {
+				return true
+			}
+		}
+	case *wit.Variant:
+		for _, c := range k.Cases {
+			if c.Type != nil && hasHandle(c.Type) {
+				return true
+			}
+		}
+	case *wit.List:
+		return hasHandle(k.Type)
+	case *wit.Option:
+		return hasHandle(k.Type)
+	case *wit.Result:
+		return (k.OK != nil && hasHandle(k.OK)) || (k.Err != nil && hasHandle(k.Err))
+	}
+	return false
+}
+
+// handleResources returns the names of the resources whose handles values
+// of types can contain.
+func handleResources(types []wit.Type) []string {
+	var names []string
+	seen := make(map[string]bool)
+	var walk func(t wit.Type)
+	walk = func(t wit.Type) {
+		if t == nil || !hasHandle(t) {
+			return
+		}
+		if _, resource := handleOf(t); resource != "" {
+			if !seen[resource] {
+				seen[resource] = true
+				names = append(names, resource)
+			}
+			return
+		}
+		td := t.(*wit.TypeDef)
+		switch k := td.Kind.(type) {
+		case wit.Type:
+			walk(k)
+		case *wit.Record:
+			for _, f := range k.Fields {
+				walk(f.Type)
+			}
+		case *wit.Tuple:
+			for _, e := range k.Types {
+				walk(e)
+			}
+		case *wit.Variant:
+			for _, c := range k.Cases {
+				walk(c.Type)
+			}
+		case *wit.List:
+			walk(k.Type)
+		case *wit.Option:
+			walk(k.Type)
+		case *wit.Result:
+			walk(k.OK)
+			walk(k.Err)
+		}
+	}
+	for _, t := range types {
+		walk(t)
+	}
+	return names
+}
+
+func dropForwarder(provider *Instance, export string) func(context.Context, uint32) {
+	params := []wit.Type{wit.U32{}}
+	return func(ctx context.Context, handle uint32) {
+		if _, err := provider.CallWithTypes(ctx, export, params, nil, handle); err != nil {
+			panic(fmt.Errorf("call linked export %s: %w", export, err))
+		}
+	}
+}
+
+// anyValue wraps v in a reflect.Value of interface type, so nil results
+// such as a none option stay valid return values.
+func anyValue(v any) reflect.Value {
+	rv := reflect.New(anyType).Elem()
+	if v != nil {
+		rv.Set(reflect.ValueOf(v))
+	}
+	return rv
+}
+
+// matchExport returns the export satisfying the import ns#name: the same
+// name, or the same function in a semver-compatible version of ns.
+func matchExport(exports []string, ns, name string) string {
+	want := ns + "#" + name
+	base, version, versioned := splitVersion(ns)
+	match := ""
+	for _, export := range exports {
+		if export == want {
+			return export
+		}
+		exportNS, exportName, ok := strings.Cut(export, "#")
+		if !ok || exportName != name || !versioned {
+			continue
+		}
+		exportBase, exportVersion, ok := splitVersion(exportNS)
+		if ok && exportBase == base && exportVersion.Compatible(version) {
+			match = export
+		}
+	}
+	return match
+}
+
+// splitVersion splits "wasi:io/streams@0.2.1" into its path and version.
+func splitVersion(ns string) (string, linker.Version, bool) {
+	idx := strings.LastIndexByte(ns, '@')
+	if idx < 0 {
+		return ns, linker.Version{}, false
+	}
+	v, ok := linker.ParseVersion(ns[idx+1:])
+	return ns[:idx], v, ok
+}
+
+// resourceOf returns the resource a [constructor], [method] or [static]
+// function belongs to.
+func resourceOf(name string) string {
+	for _, prefix := range []string{"[constructor]", "[method]", "[static]"} {
+		if rest, ok := strings.CutPrefix(name, prefix); ok {
+			r, _, _ := strings.Cut(rest, ".")
+			return r
+		}
+	}
+	return ""
+}
+
+func sameSignature(path string, params, results, wantParams, wantResults []wit.Type) error {
+	if len(params) != len(wantParams) || len(results) != len(wantResults) {
+		return errors.New(errors.PhaseLinking, errors.KindTypeMismatch).
+			Path(path).
+			Detail("import takes %d params and returns %d results, export takes %d and returns %d",
+				len(params), len(results), len(wantParams), len(wantResults)).
+			Build()
+	}
+	check := func(kind string, i int, got, want wit.Type) error {
+		if sameType(got, want) {
+			return nil
+		}
+		return errors.New(errors.PhaseLinking, errors.KindTypeMismatch).
+			Path(path).
+			WitType(typeName(want)).
+			Detail("%s %d: import has %s, export has %s", kind, i, typeName(got), typeName(want)).
+			Build()
+	}
+	for i := range params {
+		if err := check("param", i, params[i], wantParams[i]); err != nil {
+			return err
+		}
+	}
+	for i := range results {
+		if err := check("result", i, results[i], wantResults[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// sameType reports whether a and b have the same structure. Types from
+// different components never share definitions, so names of type
+// definitions are ignored; field, case and flag names must match.
+func sameType(a, b wit.Type) bool {
+	a, b = unalias(a), unalias(b)
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	if isHandle(a) || isHandle(b) {
+		ka, ra := handleOf(a)
+		kb, rb := handleOf(b)
+		return ka == kb && ra != "" && ra == rb
+	}
+	da, aok := a.(*wit.TypeDef)
+	db, bok := b.(*wit.TypeDef)
+	if !aok || !bok {
+		return !aok && !bok && reflect.TypeOf(a) == reflect.TypeOf(b)
+	}
+
+	switch ka := da.Kind.(type) {
+	case *wit.Record:
+		kb, ok := db.Kind.(*wit.Record)
+		if !ok || len(ka.Fields) != len(kb.Fields) {
+			return false
+		}
+		for i, f := range ka.Fields {
+			if f.Name != kb.Fields[i].Name || !sameType(f.Type, kb.Fields[i].Type) {
+				return false
+			}
+		}
+		return true
+	case *wit.Variant:
+		kb, ok := db.Kind.(*wit.Variant)
+		if !ok || len(ka.Cases) != len(kb.Cases) {
+			return false
+		}
+		for i, c := range ka.Cases {
+			if c.Name != kb.Cases[i].Name || !sameType(c.Type, kb.Cases[i].Type) {
+				return false
+			}
+		}
+		return true
+	case *wit.Enum:
+		kb, ok := db.Kind.(*wit.Enum)
+		if !ok || len(ka.Cases) != len(kb.Cases) {
+			return false
+		}
+		for i, c := range ka.Cases {
+			if c.Name != kb.Cases[i].Name {
+				return false
+			}
+		}
+		return true
+	case *wit.Flags:
+		kb, ok := db.Kind.(*wit.Flags)
+		if !ok || len(ka.Flags) != len(kb.Flags) {
+			return false
+		}
+		for i, f := range ka.Flags {
+			if f.Name != kb.Flags[i].Name {
+				return false
+			}
+		}
+		return true
+	case *wit.Tuple:
+		kb, ok := db.Kind.(*wit.Tuple)
+		if !ok || len(ka.Types) != len(kb.Types) {
+			return false
+		}
+		for i, t := range ka.Types {
+			if !sameType(t, kb.Types[i]) {
+				return false
+			}
+		}
+		return true
+	case *wit.List:
+		kb, ok := db.Kind.(*wit.List)
+		return ok && sameType(ka.Type, kb.Type)
+	case *wit.Option:
+		kb, ok := db.Kind.(*wit.Option)
+		return ok && sameType(ka.Type, kb.Type)
+	case *wit.Result:
+		kb, ok := db.Kind.(*wit.Result)
+		return ok && sameType(ka.OK, kb.OK) && sameType(ka.Err, kb.Err)
+	}
+	return reflect.TypeOf(da.Kind) == reflect.TypeOf(db.Kind)
+}
+
+func isHandle(t wit.Type) bool {
+	kind, _ := handleOf(t)
+	return kind != ""
+}
+
+// handleOf returns "own" or "borrow" and the resource name of a handle
+// type, or "" when t is not a handle.
+func handleOf(t wit.Type) (kind, resource string) {
+	td, ok := t.(*wit.TypeDef)
+	if !ok {
+		return "", ""
+	}
+	var res *wit.TypeDef
+	switch k := td.Kind.(type) {
+	case *wit.Own:
+		kind, res = "own", k.Type
+	case *wit.Borrow:
+		kind, res = "borrow", k.Type
+	default:
+		return "", ""
+	}
+	if res != nil && res.Name != nil {
+		resource = *res.Name
+	}
+	return kind, resource
+}
+
+// unalias follows type aliases to the underlying type.
+func unalias(t wit.Type) wit.Type {
+	for {
+		td, ok := t.(*wit.TypeDef)
+		if !ok {
+			return t
+		}
+		inner, ok := td.Kind.(wit.Type)
+		if !ok {
+			return t
+		}
+		t = inner
+	}
+}
+
+func typeName(t wit.Type) string {
+	if t == nil {
+		return "_"
+	}
+	return t.WIT(nil, "")
+}
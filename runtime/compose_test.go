@@ -0,0 +1,556 @@
+package runtime
+
+import (
+	"context"
+	stderrors "errors"
+	"os"
+	"testing"
+
+	"go.bytecodealliance.org/wit"
+
+	"github.com/wippyai/wasm-runtime/errors"
+	"github.com/wippyai/wasm-runtime/wat"
+)
+
+// stringsProviderWAT implements test:strings/host in a core module with
+// its own memory, so linked calls copy strings between two memories.
+const stringsProviderWAT = `(module
+	(memory (export "memory") 1)
+	(global $heap (mut i32) (i32.const 1024))
+	(global $log-ptr (mut i32) (i32.const 0))
+	(global $log-len (mut i32) (i32.const 0))
+
+	(func $alloc (export "cabi_realloc") (param i32 i32 i32 i32) (result i32)
+		(local $p i32)
+		(local.set $p (global.get $heap))
+		(global.set $heap (i32.add (global.get $heap) (local.get 3)))
+		(local.get $p))
+
+	(func (export "test:strings/host@0.1.0#concat") (param $a i32) (param $alen i32) (param $b i32) (param $blen i32) (result i32)
+		(local $p i32)
+		(local.set $p (call $alloc (i32.const 0) (i32.const 0) (i32.const 1) (i32.add (local.get $alen) (local.get $blen))))
+		(memory.copy (local.get $p) (local.get $a) (local.get $alen))
+		(memory.copy (i32.add (local.get $p) (local.get $alen)) (local.get $b) (local.get $blen))
+		(i32.store (i32.const 16) (local.get $p))
+		(i32.store (i32.const 20) (i32.add (local.get $alen) (local.get $blen)))
+		(i32.const 16))
+
+	(func (export "test:strings/host@0.1.0#log") (param $msg i32) (param $len i32)
+		(global.set $log-ptr (local.get $msg))
+		(global.set $log-len (local.get $len)))
+
+	(func (export "last-log") (result i32)
+		(i32.store (i32.const 32) (global.get $log-ptr))
+		(i32.store (i32.const 36) (global.get $log-len))
+		(i32.const 32)))
+`
+
+const stringsProviderWIT = `
+package test:strings@0.1.0;
+
+interface host {
+	log: func(msg: string);
+	concat: func(a: string, b: string) -> string;
+}
+
+world provider {
+	export host;
+	export last-log: func() -> string;
+}
+`
+
+// counterProviderWAT implements the test:counter/host counter resource.
+// Handles index a table of counts; drops are counted.
+const counterProviderWAT = `(module
+	(memory 1)
+	(global $next (mut i32) (i32.const 0))
+	(global $drops (mut i32) (i32.const 0))
+
+	(func (export "test:counter/host@0.1.0#[constructor]counter") (result i32)
+		(global.set $next (i32.add (global.get $next) (i32.const 1)))
+		(global.get $next))
+
+	(func (export "test:counter/host@0.1.0#[method]counter.increment") (param $self i32)
+		(i32.store (i32.shl (local.get $self) (i32.const 2))
+			(i32.add (i32.load (i32.shl (local.get $self) (i32.const 2))) (i32.const 1))))
+
+	(func (export "test:counter/host@0.1.0#[method]counter.get") (param $self i32) (result i32)
+		(i32.load (i32.shl (local.get $self) (i32.const 2))))
+
+	(func (export "test:counter/host@0.1.0#[resource-drop]counter") (param $self i32)
+		(global.set $drops (i32.add (global.get $drops) (i32.const 1))))
+
+	(func (export "drops") (result i32)
+		(global.get $drops)))
+`
+
+const counterProviderWIT = `
+package test:counter@0.1.0;
+
+interface host {
+	resource counter {
+		constructor();
+		increment: func();
+		get: func() -> u32;
+	}
+}
+
+world provider {
+	export host;
+	export drops: func() -> u32;
+}
+`
+
+func loadTestbed(t *testing.T, name string) []byte {
+	t.Helper()
+	wasm, err := os.ReadFile("../testbed/" + name)
+	if err != nil {
+		t.Skipf("%s not found: %v", name, err)
+	}
+	return wasm
+}
+
+func TestLink_ComponentToComponent(t *testing.T) {
+	ctx := context.Background()
+	wasm := loadTestbed(t, "minimal.wasm")
+
+	rt, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.Close(ctx)
+
+	host := &MinimalHost{}
+	if err := rt.RegisterHost(host); err != nil {
+		t.Fatal(err)
+	}
+
+	providerMod, err := rt.LoadComponent(ctx, wasm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider, err := providerMod.Instantiate(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer provider.Close(ctx)
+
+	consumerMod, err := rt.LoadComponent(ctx, wasm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The consumer's add is served by the provider's compute (a * b).
+	if err := consumerMod.LinkFunc("test:minimal/host@0.1.0", "add", provider, "compute"); err != nil {
+		t.Fatalf("LinkFunc: %v", err)
+	}
+	consumer, err := consumerMod.Instantiate(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer consumer.Close(ctx)
+
+	got, err := consumer.CallWithTypes(ctx, "compute-using-host",
+		[]wit.Type{wit.U32{}, wit.U32{}}, []wit.Type{wit.U32{}}, uint32(7), uint32(8))
+	if err != nil {
+		t.Fatalf("compute-using-host: %v", err)
+	}
+	if got != uint32(56) {
+		t.Errorf("compute-using-host(7, 8) = %v, want 56", got)
+	}
+	if len(host.adds) != 0 {
+		t.Errorf("host add called %d times, want 0", len(host.adds))
+	}
+}
+
+func TestLink_StringsAcrossMemories(t *testing.T) {
+	ctx := context.Background()
+	wasm := loadTestbed(t, "strings.wasm")
+
+	rt, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.Close(ctx)
+
+	providerMod, err := rt.LoadWAT(ctx, stringsProviderWAT, stringsProviderWIT)
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider, err := providerMod.Instantiate(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer provider.Close(ctx)
+
+	consumerMod, err := rt.LoadComponent(ctx, wasm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := consumerMod.Link(provider); err != nil {
+		t.Fatalf("Link: %v", err)
+	}
+	consumer, err := consumerMod.Instantiate(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer consumer.Close(ctx)
+
+	got, err := consumer.Call(ctx, "process", "composed")
+	if err != nil {
+		t.Fatalf("process: %v", err)
+	}
+	if got != "composed!" {
+		t.Errorf("process = %q, want %q", got, "composed!")
+	}
+
+	logged, err := provider.Call(ctx, "last-log")
+	if err != nil {
+		t.Fatalf("last-log: %v", err)
+	}
+	if logged != "composed" {
+		t.Errorf("provider logged %q, want %q", logged, "composed")
+	}
+}
+
+func TestLink_ResourceOwnership(t *testing.T) {
+	ctx := context.Background()
+	wasm := loadTestbed(t, "counter.wasm")
+
+	rt, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.Close(ctx)
+
+	providerMod, err := rt.LoadWAT(ctx, counterProviderWAT, counterProviderWIT)
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider, err := providerMod.Instantiate(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer provider.Close(ctx)
+
+	consumerMod, err := rt.LoadComponent(ctx, wasm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := consumerMod.Link(provider); err != nil {
+		t.Fatalf("Link: %v", err)
+	}
+	consumer, err := consumerMod.Instantiate(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer consumer.Close(ctx)
+
+	got, err := consumer.Call(ctx, "run-test", uint32(5))
+	if err != nil {
+		t.Fatalf("run-test: %v", err)
+	}
+	if got != uint32(5) {
+		t.Errorf("run-test(5) = %v, want 5", got)
+	}
+
+	drops, err := provider.Call(ctx, "drops")
+	if err != nil {
+		t.Fatalf("drops: %v", err)
+	}
+	if drops == uint32(0) {
+		t.Error("consumer dropped its counter, but the provider saw no drop")
+	}
+}
+
+// counterComponentImplWAT implements the test:counter/host counter
+// resource inside a component: reps index a table of counts, and the
+// destructor counts drops.
+const counterComponentImplWAT = `(module
+	(memory 1)
+	(global $next (mut i32) (i32.const 0))
+	(global $drops (mut i32) (i32.const 0))
+
+	(func (export "alloc") (result i32)
+		(global.set $next (i32.add (global.get $next) (i32.const 1)))
+		(global.get $next))
+
+	(func (export "test:counter/host@0.1.0#[method]counter.increment") (param $rep i32)
+		(i32.store (i32.shl (local.get $rep) (i32.const 2))
+			(i32.add (i32.load (i32.shl (local.get $rep) (i32.const 2))) (i32.const 1))))
+
+	(func (export "test:counter/host@0.1.0#[method]counter.get") (param $rep i32) (result i32)
+		(i32.load (i32.shl (local.get $rep) (i32.const 2))))
+
+	(func (export "dtor") (param $rep i32)
+		(global.set $drops (i32.add (global.get $drops) (i32.const 1))))
+
+	(func (export "drops") (result i32)
+		(global.get $drops)))
+`
+
+// counterComponentGlueWAT implements the constructor on top of resource.new.
+const counterComponentGlueWAT = `(module
+	(import "" "new" (func $new (param i32) (result i32)))
+	(import "" "alloc" (func $alloc (result i32)))
+	(func (export "test:counter/host@0.1.0#[constructor]counter") (result i32)
+		(call $new (call $alloc))))
+`
+
+// counterProviderComponent builds a component exporting the
+// test:counter/host@0.1.0 interface with its counter resource, and
+// drops() -> u32.
+func counterProviderComponent(t *testing.T) []byte {
+	t.Helper()
+	impl, err := wat.Compile(counterComponentImplWAT)
+	if err != nil {
+		t.Fatal(err)
+	}
+	glue, err := wat.Compile(counterComponentGlueWAT)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	const iface = "test:counter/host@0.1.0"
+	aliasCore := func(instance byte, name string) []byte {
+		return concatBytes([]byte{0x00, 0x00, 0x01, instance}, testName(name))
+	}
+	export := func(name string, sort, idx byte) []byte {
+		return concatBytes([]byte{0x00}, testName(name), []byte{sort, idx, 0x00})
+	}
+	inline := func(name string, sort, idx byte) []byte {
+		return concatBytes([]byte{0x00}, testName(name), []byte{sort, idx})
+	}
+
+	return concatBytes(
+		[]byte{0x00, 0x61, 0x73, 0x6d, 0x0d, 0x00, 0x01, 0x00},
+		testSection(1, impl...),
+		// core instance 0 = impl
+		testSection(2, 0x01, 0x00, 0x00, 0x00),
+		// core funcs 0, 1 = impl.dtor, impl.alloc
+		testSection(6, concatBytes([]byte{0x02}, aliasCore(0, "dtor"), aliasCore(0, "alloc"))...),
+		// type 0 = resource (rep i32) (dtor 0)
+		testSection(7, 0x01, 0x3f, 0x7f, 0x01, 0x00),
+		// core func 2 = resource.new 0
+		testSection(8, 0x01, 0x02, 0x00),
+		testSection(1, glue...),
+		// core instance 1 = {new: core func 2, alloc: core func 1}, core instance 2 = glue(instance 1)
+		testSection(2, concatBytes(
+			[]byte{0x02},
+			[]byte{0x01, 0x02}, testName("new"), []byte{0x00, 0x02}, testName("alloc"), []byte{0x00, 0x01},
+			[]byte{0x00, 0x01, 0x01}, testName(""), []byte{0x12, 0x01},
+		)...),
+		// core funcs 3, 4, 5, 6 = glue.ctor, impl.increment, impl.get, impl.drops
+		testSection(6, concatBytes(
+			[]byte{0x04},
+			aliasCore(2, iface+"#[constructor]counter"),
+			aliasCore(0, iface+"#[method]counter.increment"),
+			aliasCore(0, iface+"#[method]counter.get"),
+			aliasCore(0, "drops"),
+		)...),
+		// type 1 = func() -> own<0>, type 2 = func(self: borrow<0>),
+		// type 3 = func(self: borrow<0>) -> u32, type 4 = func() -> u32
+		testSection(7, concatBytes(
+			[]byte{0x04},
+			[]byte{0x40, 0x00, 0x00, 0x69, 0x00},
+			[]byte{0x40, 0x01}, testName("self"), []byte{0x68, 0x00, 0x01, 0x00},
+			[]byte{0x40, 0x01}, testName("self"), []byte{0x68, 0x00, 0x00, 0x79},
+			[]byte{0x40, 0x00, 0x00, 0x79},
+		)...),
+		// funcs 0, 1, 2, 3 = lifts of core funcs 3, 4, 5, 6
+		testSection(8, 0x01, 0x00, 0x00, 0x03, 0x00, 0x01),
+		testSection(8, 0x01, 0x00, 0x00, 0x04, 0x00, 0x02),
+		testSection(8, 0x01, 0x00, 0x00, 0x05, 0x00, 0x03),
+		testSection(8, 0x01, 0x00, 0x00, 0x06, 0x00, 0x04),
+		// instance 0 = the interface's type and functions
+		testSection(5, concatBytes(
+			[]byte{0x01, 0x01, 0x04},
+			inline("counter", 0x03, 0x00),
+			inline("[constructor]counter", 0x01, 0x00),
+			inline("[method]counter.increment", 0x01, 0x01),
+			inline("[method]counter.get", 0x01, 0x02),
+		)...),
+		testSection(11, concatBytes(
+			[]byte{0x02},
+			export(iface, 0x05, 0x00),
+			export("drops", 0x01, 0x03),
+		)...),
+	)
+}
+
+func TestLink_ResourceOwnershipBetweenComponents(t *testing.T) {
+	ctx := context.Background()
+	wasm := loadTestbed(t, "counter.wasm")
+
+	rt, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.Close(ctx)
+
+	providerMod, err := rt.LoadComponent(ctx, counterProviderComponent(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider, err := providerMod.Instantiate(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer provider.Close(ctx)
+
+	consumerMod, err := rt.LoadComponent(ctx, wasm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := consumerMod.Link(provider); err != nil {
+		t.Fatalf("Link: %v", err)
+	}
+	consumer, err := consumerMod.Instantiate(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer consumer.Close(ctx)
+
+	for round := 1; round <= 2; round++ {
+		got, err := consumer.Call(ctx, "run-test", uint32(5))
+		if err != nil {
+			t.Fatalf("run-test: %v", err)
+		}
+		if got != uint32(5) {
+			t.Errorf("run-test(5) = %v, want 5", got)
+		}
+
+		drops, err := provider.Call(ctx, "drops")
+		if err != nil {
+			t.Fatalf("drops: %v", err)
+		}
+		if drops != uint32(round) {
+			t.Errorf("after %d runs the provider destroyed %v counters, want %d", round, drops, round)
+		}
+	}
+
+	// The constructed handles moved to the consumer, so the provider's
+	// table holds none of them.
+	li := provider.wazeroInstance.LinkerInstance()
+	typeID, ok := li.ExportedResource("test:counter/host@0.1.0#counter")
+	if !ok {
+		t.Fatal("provider does not export counter")
+	}
+	if n := li.Resources().Table(typeID).Len(); n != 0 {
+		t.Errorf("provider table holds %d handles, want 0", n)
+	}
+}
+
+func TestSameType_Handles(t *testing.T) {
+	handle := func(kind wit.TypeDefKind, name string) wit.Type {
+		res := &wit.TypeDef{Name: &name, Kind: &wit.Resource{}}
+		switch k := kind.(type) {
+		case *wit.Own:
+			k.Type = res
+		case *wit.Borrow:
+			k.Type = res
+		}
+		return &wit.TypeDef{Kind: kind}
+	}
+	tests := []struct {
+		name string
+		a, b wit.Type
+		want bool
+	}{
+		{"same own", handle(&wit.Own{}, "counter"), handle(&wit.Own{}, "counter"), true},
+		{"same borrow", handle(&wit.Borrow{}, "counter"), handle(&wit.Borrow{}, "counter"), true},
+		{"own vs borrow", handle(&wit.Own{}, "counter"), handle(&wit.Borrow{}, "counter"), false},
+		{"other resource", handle(&wit.Own{}, "counter"), handle(&wit.Own{}, "timer"), false},
+		{"own vs u32", handle(&wit.Own{}, "counter"), wit.U32{}, false},
+		{"u32 vs borrow", wit.U32{}, handle(&wit.Borrow{}, "counter"), false},
+	}
+	for _, tt := range tests {
+		if got := sameType(tt.a, tt.b); got != tt.want {
+			t.Errorf("%s: sameType = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestLink_SignatureMismatch(t *testing.T) {
+	ctx := context.Background()
+	wasm := loadTestbed(t, "minimal.wasm")
+
+	rt, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.Close(ctx)
+
+	providerMod, err := rt.LoadWAT(ctx, stringsProviderWAT, stringsProviderWIT)
+	if err != nil {
+		t.Fatal(err)
+	}
+	provider, err := providerMod.Instantiate(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer provider.Close(ctx)
+
+	consumerMod, err := rt.LoadComponent(ctx, wasm)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = consumerMod.LinkFunc("test:minimal/host@0.1.0", "add", provider, "test:strings/host@0.1.0#concat")
+	if !stderrors.Is(err, &errors.Error{Phase: errors.PhaseLinking, Kind: errors.KindTypeMismatch}) {
+		t.Errorf("LinkFunc error = %v, want a linking type mismatch", err)
+	}
+
+	err = consumerMod.Link(provider)
+	if !stderrors.Is(err, &errors.Error{Phase: errors.PhaseLinking, Kind: errors.KindNotFound}) {
+		t.Errorf("Link error = %v, want not found", err)
+	}
+}
+
+func TestLink_AfterInstantiate(t *testing.T) {
+	ctx := context.Background()
+	wasm := loadTestbed(t, "minimal.wasm")
+
+	rt, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.Close(ctx)
+
+	if err := rt.RegisterHost(&MinimalHost{}); err != nil {
+		t.Fatal(err)
+	}
+	mod, err := rt.LoadComponent(ctx, wasm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inst, err := mod.Instantiate(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer inst.Close(ctx)
+
+	err = mod.LinkFunc("test:minimal/host@0.1.0", "add", inst, "compute")
+	if !stderrors.Is(err, &errors.Error{Phase: errors.PhaseLinking, Kind: errors.KindInvalidInput}) {
+		t.Errorf("LinkFunc after Instantiate = %v, want invalid input", err)
+	}
+}
+
+func TestMatchExport(t *testing.T) {
+	exports := []string{"a:b/c@0.1.4#f", "a:b/c@0.2.0#g", "x:y/z#h", "plain"}
+	tests := []struct {
+		ns, name, want string
+	}{
+		{"a:b/c@0.1.4", "f", "a:b/c@0.1.4#f"},
+		{"a:b/c@0.1.0", "f", "a:b/c@0.1.4#f"},
+		{"a:b/c@0.1.5", "f", ""},
+		{"a:b/c@0.1.0", "g", "a:b/c@0.2.0#g"},
+		{"a:b/c@1.0.0", "f", ""},
+		{"x:y/z", "h", "x:y/z#h"},
+		{"x:y/z", "plain", ""},
+	}
+	for _, tt := range tests {
+		if got := matchExport(exports, tt.ns, tt.name); got != tt.want {
+			t.Errorf("matchExport(%s, %s) = %q, want %q", tt.ns, tt.name, got, tt.want)
+		}
+	}
+}
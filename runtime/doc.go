@@ -38,6 +38,10 @@
 //
 //	mod, err := rt.LoadWASM(ctx, wasmBytes, witText)
 //
+// witText is parsed by package witparse and may be a full WIT document
+// (records, variants, resources, use, ...) or a plain signature list such
+// as "add: func(a: s32, b: s32) -> s32".
+//
 // # Host Functions
 //
 // Register Go functions as WASI/custom host implementations:
@@ -51,6 +55,79 @@
 //	// Or implement the Host interface for a full namespace
 //	rt.RegisterHost(myWASIImplementation)
 //
+// # Unresolved Imports
+//
+// Config.StubImports lets components instantiate with imports no host
+// provides. linker.StubTrap stubs trap when called; linker.StubDefault
+// stubs return the default value of their WIT result type. The stubbed
+// imports are listed by Instance.Diagnostics:
+//
+//	rt, _ := runtime.NewWithConfig(ctx, runtime.Config{StubImports: linker.StubDefault})
+//	...
+//	for _, d := range inst.Diagnostics() {
+//	    log.Printf("stubbed %s", d)
+//	}
+//
+// Module.Imports reports the namespace and version each import resolves
+// to, and the nearest candidates of those that do not resolve.
+//
+// # Host Middleware
+//
+// Use wraps the host imports matching WIT or wildcard patterns.
+// Middleware sees the lifted arguments and can delegate, substitute
+// results, fail with a WIT error value, or trap:
+//
+//	rt.Use(func(ctx context.Context, call *runtime.HostCall, next runtime.HostHandler) ([]any, error) {
+//	    if !allowed(ctx) {
+//	        return call.ErrorResult(&filesystem.Error{Code: filesystem.ErrorAccess})
+//	    }
+//	    return next(ctx, call)
+//	}, "wasi:filesystem/types#[method]descriptor.write")
+//
+// # Host Resources
+//
+// DefineResource implements a WIT resource with Go values. Handlers take
+// and return T; handles, borrows and [resource-drop] are managed by a
+// resource.TypedTable:
+//
+//	files := runtime.DefineResource[*File]("my:fs/api@1.0.0", "file")
+//	files.Constructor(func(ctx context.Context, path string) (*File, error) { ... })
+//	files.Method("read", func(ctx context.Context, f *File, n uint32) []byte { ... })
+//	files.Static("open-temp", func(ctx context.Context) *File { ... })
+//	rt.RegisterHost(files)
+//
+// Every instance has its own handle table, so one instance cannot use
+// another's handles; closing an instance drops the handles it still
+// holds. Handlers reach the calling instance's table with files.Table(ctx).
+//
+// # Guest Resources
+//
+// Resources a component defines and exports are used through a
+// ResourceRef. Methods are called by their WIT name; Drop runs the guest
+// destructor:
+//
+//	ref, err := inst.NewResource(ctx, "my:pkg/api#counter", uint32(5))
+//	n, err := ref.Call(ctx, "get")
+//	err = ref.Drop(ctx)
+//
+// A ref that becomes unreachable without Drop is dropped on the next
+// resource operation of its instance.
+//
+// # Composition
+//
+// Module.Link satisfies a component's imports from the exports of another
+// instance, lifting and lowering values across the two memories:
+//
+//	provider, err := providerMod.Instantiate(ctx)
+//	// ...
+//	if err := consumerMod.Link(provider); err != nil {
+//	    log.Fatal(err)
+//	}
+//	consumer, err := consumerMod.Instantiate(ctx)
+//
+// LinkFunc wires a single import to an export of a different name.
+// Imports the provider does not cover fall back to registered hosts.
+//
 // # WASI Support
 //
 // WASI preview2 host implementations are available:
@@ -96,6 +173,35 @@
 //
 //	// Now async host functions can suspend/resume the guest
 //
+// # Traps
+//
+// A guest trap during Call, CallWithTypes, Invoke or CallSession.Step is
+// returned as an errors.KindTrap error carrying an errors.Trap with the
+// trap code and a wasm backtrace.
+//
+// # Profiling
+//
+// A runtime created with Config.Profiling records calls into a
+// profile.Profiler set per instance with SetProfiler, or per call with
+// profile.WithProfiler, and writes pprof profiles of guest functions,
+// host imports and transcoding:
+//
+//	rt, err := runtime.NewWithConfig(ctx, runtime.Config{Profiling: true})
+//	// ...
+//	p := profile.New()
+//	err = inst.SetProfiler(p)
+//	p.WriteTo(f)
+//
+// # Tracing
+//
+// SetInterceptor reports export calls, host import calls and host
+// resource events to a trace.Interceptor; trace.NewZapLogger and
+// trace.NewSlogLogger log them:
+//
+//	rt.SetInterceptor(trace.NewSlogLogger(slog.Default()))
+//
+// Like RegisterHost, it must be called before loading modules.
+//
 // # Thread Safety
 //
 // Runtime and Module are safe for concurrent use. You can call
@@ -113,4 +219,22 @@
 //
 // Always close instances when done. Closing releases WASM memory and
 // bridge module references.
+//
+// # Resource Limits
+//
+// InstantiateWithLimiter checks memory growth, table growth, instance
+// count and resource handles against a wasmruntime.ResourceLimiter.
+// Share one limiter across instances to enforce a per-tenant budget, and
+// pass it to preview2.WASI.WithResourceLimiter to cap open files, sockets
+// and outgoing HTTP requests:
+//
+//	limiter := wasmruntime.NewLimiter(wasmruntime.Limits{
+//	    MemoryBytes: 64 << 20,
+//	    Instances:   8,
+//	    Sockets:     16,
+//	})
+//	inst, err := mod.InstantiateWithLimiter(ctx, limiter)
+//
+// A rejected request traps the guest; the returned error matches
+// errors.KindResourceExhausted via errors.Is.
 package runtime
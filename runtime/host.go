@@ -8,6 +8,7 @@ import (
 
 	"github.com/wippyai/wasm-runtime/engine"
 	"github.com/wippyai/wasm-runtime/errors"
+	"github.com/wippyai/wasm-runtime/trace"
 )
 
 // Host is the interface for struct-based host modules.
@@ -18,8 +19,9 @@ type Host interface {
 }
 
 type HostRegistry struct {
-	funcs map[string]map[string]*HostFunc
-	mu    sync.RWMutex
+	funcs      map[string]map[string]*HostFunc
+	middleware []middleware
+	mu         sync.RWMutex
 }
 
 type HostFunc struct {
@@ -151,16 +153,28 @@ func (r *HostRegistry) RegisterFunc(namespace, name string, fn any) error {
 // Bind registers host functions with a WazeroModule.
 // Version matching: X.Y.Z satisfies imports at X.Y.W where W <= Z.
 func (r *HostRegistry) Bind(mod *engine.WazeroModule) error {
+	return r.bind(mod, nil)
+}
+
+// bind is Bind reporting host calls to tr when it is not nil.
+func (r *HostRegistry) bind(mod *engine.WazeroModule, tr trace.Interceptor) error {
 	r.mu.RLock()
 	defer r.mu.RUnlock()
 
 	for namespace, funcs := range r.funcs {
 		for name, hf := range funcs {
+			handler := hf.Handler
+			if mws := r.chain(namespace, name); len(mws) > 0 {
+				handler = applyMiddleware(mws, namespace, name, handler)
+			}
+			if tr != nil {
+				handler = traceHost(tr, namespace, name, handler)
+			}
 			var err error
 			if hf.IsAsync {
-				err = mod.RegisterHostFuncTypedAsync(namespace, name, hf.Handler)
+				err = mod.RegisterHostFuncTypedAsync(namespace, name, handler)
 			} else {
-				err = mod.RegisterHostFuncTyped(namespace, name, hf.Handler)
+				err = mod.RegisterHostFuncTyped(namespace, name, handler)
 			}
 			if err != nil {
 				if strings.Contains(err.Error(), "no canon lower found") {
@@ -0,0 +1,49 @@
+package runtime
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/wippyai/wasm-runtime/linker"
+)
+
+func TestModule_Imports(t *testing.T) {
+	ctx := context.Background()
+	wasmBytes, err := os.ReadFile("../testbed/minimal.wasm")
+	if err != nil {
+		t.Skip("minimal.wasm not found")
+	}
+
+	rt, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.Close(ctx)
+
+	// A newer compatible version satisfies the 0.1.0 import
+	if err := rt.RegisterFunc("test:minimal/host@0.1.5", "add", func(ctx context.Context, a, b uint32) uint32 {
+		return a + b
+	}); err != nil {
+		t.Fatal(err)
+	}
+	mod, err := rt.LoadComponent(ctx, wasmBytes)
+	if err != nil {
+		t.Fatalf("load component: %v", err)
+	}
+
+	reports, err := mod.Imports(ctx)
+	if err != nil {
+		t.Fatalf("Imports() error: %v", err)
+	}
+	if len(reports) != 1 {
+		t.Fatalf("Imports() = %+v, want 1 report", reports)
+	}
+	r := reports[0]
+	if r.Provider != linker.ProviderHost || r.Resolved != "test:minimal/host@0.1.5" || !r.SemverMatched() {
+		t.Errorf("report = %+v, want host test:minimal/host@0.1.5", r)
+	}
+	if len(r.Funcs) != 1 || r.Funcs[0].Resolved != "test:minimal/host@0.1.5#add" {
+		t.Errorf("Funcs = %+v", r.Funcs)
+	}
+}
@@ -2,16 +2,62 @@ package runtime
 
 import (
 	"context"
+	"reflect"
 
 	"go.bytecodealliance.org/wit"
 
 	"github.com/wippyai/wasm-runtime/engine"
 	"github.com/wippyai/wasm-runtime/errors"
+	"github.com/wippyai/wasm-runtime/linker"
+	"github.com/wippyai/wasm-runtime/profile"
 )
 
 type Instance struct {
 	module         *Module
 	wazeroInstance *engine.WazeroInstance
+	refs           *resourceRefs
+	resources      *hostResources
+	profiler       *profile.Profiler
+}
+
+// SetProfiler records every call of the instance into p, or stops
+// recording when p is nil. A profiler in the call context set with
+// profile.WithProfiler takes precedence. The runtime must be created with
+// Config.Profiling.
+func (i *Instance) SetProfiler(p *profile.Profiler) error {
+	if i.module == nil {
+		return errors.NotInitialized(errors.PhaseRuntime, "module")
+	}
+	if p != nil && !i.module.runtime.engine.Profiling() {
+		return errors.Unsupported(errors.PhaseRuntime, "profiling requires a runtime created with Config.Profiling")
+	}
+	i.profiler = p
+	return nil
+}
+
+// Diagnostics returns the imports defined as stubs under
+// Config.StubImports when the instance's component was linked.
+func (i *Instance) Diagnostics() []linker.Diagnostic {
+	if i.wazeroInstance == nil {
+		return nil
+	}
+	if li := i.wazeroInstance.LinkerInstance(); li != nil {
+		return li.Diagnostics()
+	}
+	return nil
+}
+
+// profile starts recording a call of name when a profiler is set. The
+// returned function must be called when the call returns.
+func (i *Instance) profile(ctx context.Context, name string) (context.Context, func()) {
+	p := profile.FromContext(ctx)
+	if p == nil {
+		p = i.profiler
+	}
+	if p == nil {
+		return ctx, func() {}
+	}
+	return p.Start(ctx, name)
 }
 
 // Call invokes an exported function with automatic type inference.
@@ -21,8 +67,16 @@ func (i *Instance) Call(ctx context.Context, name string, args ...any) (any, err
 	if i.module == nil {
 		return nil, errors.NotInitialized(errors.PhaseRuntime, "module")
 	}
+	ctx, end := i.begin(ctx, name, args)
+	res, err := i.call(ctx, name, args)
+	end(resultList(res), err)
+	return res, err
+}
+
+func (i *Instance) call(ctx context.Context, name string, args []any) (any, error) {
 	if i.module.isComponent {
-		return i.wazeroInstance.CallWithLift(ctx, name, args...)
+		res, err := i.wazeroInstance.CallWithLift(ctx, name, args...)
+		return res, i.trapError(name, err)
 	}
 
 	if i.module.witText != "" {
@@ -30,7 +84,8 @@ func (i *Instance) Call(ctx context.Context, name string, args ...any) (any, err
 		if err != nil {
 			return nil, errors.Wrap(errors.PhaseRuntime, errors.KindNotFound, err, "get function types from WIT")
 		}
-		return i.wazeroInstance.CallWithTypes(ctx, name, params, results, args...)
+		res, err := i.wazeroInstance.CallWithTypes(ctx, name, params, results, args...)
+		return res, i.trapError(name, err)
 	}
 
 	return nil, errors.InvalidInput(errors.PhaseRuntime, "Call() requires a component or WIT definitions; use CallWithTypes() for native WASM without WIT")
@@ -38,18 +93,76 @@ func (i *Instance) Call(ctx context.Context, name string, args ...any) (any, err
 
 // CallWithTypes invokes an exported function with explicit WIT types.
 func (i *Instance) CallWithTypes(ctx context.Context, name string, params, results []wit.Type, args ...any) (any, error) {
-	return i.wazeroInstance.CallWithTypes(ctx, name, params, results, args...)
+	ctx, end := i.begin(ctx, name, args)
+	res, err := i.wazeroInstance.CallWithTypes(ctx, name, params, results, args...)
+	err = i.trapError(name, err)
+	end(resultList(res), err)
+	return res, err
 }
 
 // CallInto decodes results directly into result without intermediate allocation.
 // result must be a pointer. For strings, the result references WASM memory and
 // is only valid while the instance is alive.
 func (i *Instance) CallInto(ctx context.Context, name string, params, results []wit.Type, result any, args ...any) error {
-	return i.wazeroInstance.CallInto(ctx, name, params, results, result, args...)
+	ctx, end := i.begin(ctx, name, args)
+	err := i.trapError(name, i.wazeroInstance.CallInto(ctx, name, params, results, result, args...))
+	end(intoResults(result), err)
+	return err
+}
+
+// Invoke calls an exported function and decodes its result into result,
+// using the export's own WIT types. result must be a pointer, or nil for
+// exports without results. Generated export bindings call through Invoke.
+func (i *Instance) Invoke(ctx context.Context, name string, result any, args ...any) error {
+	if i.module == nil {
+		return errors.NotInitialized(errors.PhaseRuntime, "module")
+	}
+	params, results, err := i.module.GetFunctionTypes(name)
+	if err != nil {
+		return err
+	}
+	ctx, end := i.begin(ctx, name, args)
+	err = i.trapError(name, i.wazeroInstance.CallInto(ctx, name, params, results, result, args...))
+	end(intoResults(result), err)
+	return err
+}
+
+// resultList returns the results of a call for tracing
+func resultList(res any) []any {
+	if res == nil {
+		return nil
+	}
+	return []any{res}
+}
+
+// intoResults returns the value a CallInto result pointer points to
+func intoResults(result any) []any {
+	rv := reflect.ValueOf(result)
+	if rv.Kind() != reflect.Pointer || rv.IsNil() {
+		return resultList(result)
+	}
+	return []any{rv.Elem().Interface()}
+}
+
+// trapError converts a guest trap during a call of name into an
+// errors.KindTrap error with a backtrace. Other errors pass through.
+func (i *Instance) trapError(name string, err error) error {
+	if err == nil {
+		return nil
+	}
+	return errors.TrapError(errors.PhaseRuntime, name, err, i.wazeroInstance)
 }
 
 func (i *Instance) Close(ctx context.Context) error {
-	return i.wazeroInstance.Close(ctx)
+	if i.refs != nil {
+		i.refs.mu.Lock()
+		i.refs.closed = true
+		i.refs.pending = nil
+		i.refs.mu.Unlock()
+	}
+	err := i.wazeroInstance.Close(ctx)
+	i.resources.close()
+	return err
 }
 
 // EnableAsyncify initializes asyncify support.
@@ -68,11 +181,11 @@ func (i *Instance) Scheduler() *engine.Scheduler {
 
 // StartCall creates a step-based call session for async scheduler integration.
 func (i *Instance) StartCall(ctx context.Context, name string, args ...any) (*CallSession, error) {
-	session, err := i.wazeroInstance.StartCall(ctx, name, args...)
+	session, err := i.wazeroInstance.StartCall(withHostResources(ctx, i.resources), name, args...)
 	if err != nil {
 		return nil, err
 	}
-	return &CallSession{session: session}, nil
+	return &CallSession{session: session, resources: i.resources}, nil
 }
 
 // RunAsync executes a function with asyncify event loop support.
@@ -0,0 +1,150 @@
+package runtime
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+
+	"go.bytecodealliance.org/wit"
+
+	wasmruntime "github.com/wippyai/wasm-runtime"
+	"github.com/wippyai/wasm-runtime/errors"
+)
+
+const limiterWAT = `(module
+	(memory (export "memory") 1)
+	(table $t 1 funcref)
+	(func $f)
+	(elem declare func $f)
+
+	(func (export "grow_memory") (param $pages i32) (result i32)
+		(memory.grow (local.get $pages))
+	)
+
+	(func (export "grow_table") (param $n i32) (result i32)
+		(table.grow $t (ref.func $f) (local.get $n))
+	)
+
+	(func (export "call_f") (result i32)
+		(call $f)
+		(i32.const 7)
+	)
+)`
+
+func isResourceExhausted(err error) bool {
+	return stderrors.Is(err, &errors.Error{Phase: errors.PhaseRuntime, Kind: errors.KindResourceExhausted})
+}
+
+func TestInstantiateWithLimiter(t *testing.T) {
+	ctx := context.Background()
+	rt, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.Close(ctx)
+
+	mod, err := rt.LoadWAT(ctx, limiterWAT, "")
+	if err != nil {
+		t.Fatalf("LoadWAT: %v", err)
+	}
+
+	limiter := wasmruntime.NewLimiter(wasmruntime.Limits{
+		MemoryBytes:   3 * 65536,
+		TableElements: 4,
+		Instances:     1,
+	})
+
+	inst, err := mod.InstantiateWithLimiter(ctx, limiter)
+	if err != nil {
+		t.Fatalf("InstantiateWithLimiter: %v", err)
+	}
+
+	u32 := []wit.Type{wit.U32{}}
+	s32 := []wit.Type{wit.S32{}}
+
+	t.Run("instance limit", func(t *testing.T) {
+		_, err := mod.InstantiateWithLimiter(ctx, limiter)
+		if !isResourceExhausted(err) {
+			t.Fatalf("second instance: got %v, want resource_exhausted", err)
+		}
+	})
+
+	t.Run("function indices preserved", func(t *testing.T) {
+		result, err := inst.CallWithTypes(ctx, "call_f", nil, s32)
+		if err != nil {
+			t.Fatalf("call_f: %v", err)
+		}
+		if result != int32(7) {
+			t.Errorf("call_f = %v, want 7", result)
+		}
+	})
+
+	t.Run("memory within limit", func(t *testing.T) {
+		result, err := inst.CallWithTypes(ctx, "grow_memory", u32, s32, uint32(2))
+		if err != nil {
+			t.Fatalf("grow_memory: %v", err)
+		}
+		if result != int32(1) {
+			t.Errorf("grow_memory = %v, want 1", result)
+		}
+	})
+
+	t.Run("table within limit", func(t *testing.T) {
+		result, err := inst.CallWithTypes(ctx, "grow_table", u32, s32, uint32(3))
+		if err != nil {
+			t.Fatalf("grow_table: %v", err)
+		}
+		if result != int32(1) {
+			t.Errorf("grow_table = %v, want 1", result)
+		}
+	})
+
+	t.Run("table over limit traps", func(t *testing.T) {
+		_, err := inst.CallWithTypes(ctx, "grow_table", u32, s32, uint32(1))
+		if !isResourceExhausted(err) {
+			t.Fatalf("grow_table: got %v, want resource_exhausted", err)
+		}
+	})
+
+	if err := inst.Close(ctx); err != nil {
+		t.Fatalf("Close: %v", err)
+	}
+	if n := limiter.Count(wasmruntime.ResourceInstance); n != 0 {
+		t.Errorf("instances after close = %d, want 0", n)
+	}
+
+	t.Run("memory over limit traps", func(t *testing.T) {
+		inst, err := mod.InstantiateWithLimiter(ctx, limiter)
+		if err != nil {
+			t.Fatalf("InstantiateWithLimiter: %v", err)
+		}
+		defer inst.Close(ctx)
+
+		_, err = inst.CallWithTypes(ctx, "grow_memory", u32, s32, uint32(3))
+		if !isResourceExhausted(err) {
+			t.Fatalf("grow_memory: got %v, want resource_exhausted", err)
+		}
+	})
+}
+
+func TestInstantiateWithLimiter_InitialMemory(t *testing.T) {
+	ctx := context.Background()
+	rt, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.Close(ctx)
+
+	mod, err := rt.LoadWAT(ctx, `(module (memory (export "memory") 4))`, "")
+	if err != nil {
+		t.Fatalf("LoadWAT: %v", err)
+	}
+
+	limiter := wasmruntime.NewLimiter(wasmruntime.Limits{MemoryBytes: 65536})
+	if _, err := mod.InstantiateWithLimiter(ctx, limiter); !isResourceExhausted(err) {
+		t.Fatalf("got %v, want resource_exhausted", err)
+	}
+	if n := limiter.Count(wasmruntime.ResourceInstance); n != 0 {
+		t.Errorf("instances after failed instantiation = %d, want 0", n)
+	}
+}
@@ -0,0 +1,210 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/wippyai/wasm-runtime/asyncify"
+)
+
+// HostCall is a host import call passed through middleware. Args and the
+// results of the handler hold lifted Go values, without the
+// context.Context parameter and the trailing error result.
+type HostCall struct {
+	results   []reflect.Type
+	Namespace string
+	Function  string
+	Args      []any
+}
+
+// HostHandler runs the rest of the chain for a host import call.
+type HostHandler func(ctx context.Context, call *HostCall) ([]any, error)
+
+// Middleware wraps host import calls. It may inspect or replace
+// call.Args, delegate to next, return its own results, or fail the call.
+// A returned error traps the guest; a WIT error result, such as the
+// error-code of a result<_, error-code>, is returned with ErrorResult.
+type Middleware func(ctx context.Context, call *HostCall, next HostHandler) ([]any, error)
+
+// middleware is a registered Middleware with its import patterns
+type middleware struct {
+	match asyncify.ImportMatcher
+	fn    Middleware
+}
+
+// Use adds mw to the host imports matching any of patterns. Patterns
+// follow asyncify.NewWITMatcher ("wasi:http/outgoing-handler#handle",
+// "wasi:filesystem/types@0.2.*") and asyncify.NewWildcardMatcher
+// ("module.name", "module.*", "*"). Middleware added first runs first.
+// Like host functions, middleware must be added before loading modules.
+func (r *HostRegistry) Use(mw Middleware, patterns ...string) {
+	r.UseMatcher(asyncify.NewCompositeMatcher(
+		asyncify.NewWITMatcher(patterns),
+		asyncify.NewWildcardMatcher(patterns),
+	), mw)
+}
+
+// UseMatcher adds mw to the host imports m matches.
+func (r *HostRegistry) UseMatcher(m asyncify.ImportMatcher, mw Middleware) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.middleware = append(r.middleware, middleware{match: m, fn: mw})
+}
+
+// Use adds mw to the host imports matching any of patterns. See
+// HostRegistry.Use.
+func (r *Runtime) Use(mw Middleware, patterns ...string) {
+	r.hosts.Use(mw, patterns...)
+}
+
+// ErrorResult returns the results of a call that fails with the WIT error
+// value e: zero values with e as the last result, the error case of a
+// handler returning (T, *Error) or similar. It returns an error, which
+// traps the guest, if e does not fit the last result.
+func (c *HostCall) ErrorResult(e any) ([]any, error) {
+	if len(c.results) == 0 {
+		return nil, fmt.Errorf("%s#%s has no result for error %v", c.Namespace, c.Function, e)
+	}
+	results := c.zero()
+	last := c.results[len(c.results)-1]
+	if e == nil || !reflect.TypeOf(e).AssignableTo(last) {
+		return nil, fmt.Errorf("%s#%s: error %T does not fit result %s", c.Namespace, c.Function, e, last)
+	}
+	results[len(results)-1] = e
+	return results, nil
+}
+
+func (c *HostCall) zero() []any {
+	out := make([]any, len(c.results))
+	for i, t := range c.results {
+		out[i] = reflect.Zero(t).Interface()
+	}
+	return out
+}
+
+// chain returns the middleware that applies to namespace#name. r.mu must
+// be held.
+func (r *HostRegistry) chain(namespace, name string) []Middleware {
+	var out []Middleware
+	for _, m := range r.middleware {
+		if m.match.Match(namespace, name) {
+			out = append(out, m.fn)
+		}
+	}
+	return out
+}
+
+// applyMiddleware wraps handler so calls run through mws. The context of
+// the handler, when it takes one, is the context the chain passes on.
+func applyMiddleware(mws []Middleware, namespace, name string, handler any) any {
+	fv := reflect.ValueOf(handler)
+	ft := fv.Type()
+	hasCtx := ft.NumIn() > 0 && ft.In(0) == contextType
+	hasErr := ft.NumOut() > 0 && ft.Out(ft.NumOut()-1) == errorType
+
+	argStart := 0
+	if hasCtx {
+		argStart = 1
+	}
+	numResults := ft.NumOut()
+	if hasErr {
+		numResults--
+	}
+	resultTypes := make([]reflect.Type, numResults)
+	for i := range resultTypes {
+		resultTypes[i] = ft.Out(i)
+	}
+
+	// call runs the handler itself at the end of the chain
+	call := func(ctx context.Context, c *HostCall) ([]any, error) {
+		if len(c.Args) != ft.NumIn()-argStart {
+			return nil, fmt.Errorf("%s#%s: %d arguments, want %d", namespace, name, len(c.Args), ft.NumIn()-argStart)
+		}
+		in := make([]reflect.Value, 0, ft.NumIn())
+		if hasCtx {
+			in = append(in, reflect.ValueOf(&ctx).Elem())
+		}
+		for i, a := range c.Args {
+			v, err := toValue(a, ft.In(argStart+i))
+			if err != nil {
+				return nil, fmt.Errorf("%s#%s argument %d: %w", namespace, name, i, err)
+			}
+			in = append(in, v)
+		}
+		out := fv.Call(in)
+		var err error
+		if hasErr {
+			err, _ = out[len(out)-1].Interface().(error)
+			out = out[:len(out)-1]
+		}
+		return values(out), err
+	}
+
+	next := HostHandler(call)
+	for i := len(mws) - 1; i >= 0; i-- {
+		mw, inner := mws[i], next
+		next = func(ctx context.Context, c *HostCall) ([]any, error) {
+			return mw(ctx, c, inner)
+		}
+	}
+	run := next
+
+	return reflect.MakeFunc(ft, func(in []reflect.Value) []reflect.Value {
+		ctx := context.Background()
+		if hasCtx {
+			if c, ok := in[0].Interface().(context.Context); ok && c != nil {
+				ctx = c
+			}
+		}
+		c := &HostCall{Namespace: namespace, Function: name, Args: values(in[argStart:]), results: resultTypes}
+		results, err := run(ctx, c)
+		if err != nil && !hasErr {
+			panic(err)
+		}
+
+		out := make([]reflect.Value, ft.NumOut())
+		if err == nil && len(results) != numResults {
+			err = fmt.Errorf("%s#%s: middleware returned %d results, want %d", namespace, name, len(results), numResults)
+			if !hasErr {
+				panic(err)
+			}
+		}
+		for i := range numResults {
+			v := reflect.Zero(ft.Out(i))
+			if err == nil {
+				var convErr error
+				if v, convErr = toValue(results[i], ft.Out(i)); convErr != nil {
+					panic(fmt.Errorf("%s#%s result %d: %w", namespace, name, i, convErr))
+				}
+			}
+			out[i] = v
+		}
+		if hasErr {
+			errVal := reflect.Zero(errorType)
+			if err != nil {
+				errVal = reflect.ValueOf(&err).Elem()
+			}
+			out[numResults] = errVal
+		}
+		return out
+	}).Interface()
+}
+
+// toValue converts a value from the chain to parameter or result type t.
+// nil becomes the zero value.
+func toValue(a any, t reflect.Type) (reflect.Value, error) {
+	if a == nil {
+		return reflect.Zero(t), nil
+	}
+	v := reflect.ValueOf(a)
+	if !v.Type().AssignableTo(t) {
+		return reflect.Value{}, fmt.Errorf("%T is not %s", a, t)
+	}
+	if v.Type() != t {
+		conv := reflect.New(t).Elem()
+		conv.Set(v)
+		return conv, nil
+	}
+	return v, nil
+}
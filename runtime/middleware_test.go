@@ -0,0 +1,125 @@
+package runtime
+
+import (
+	"context"
+	stderrors "errors"
+	"strings"
+	"testing"
+)
+
+type writeError struct {
+	code string
+}
+
+func TestApplyMiddleware(t *testing.T) {
+	write := func(ctx context.Context, fd uint32, data []byte) (uint64, *writeError) {
+		return uint64(len(data)), nil
+	}
+
+	var seen []any
+	deny := func(ctx context.Context, call *HostCall, next HostHandler) ([]any, error) {
+		seen = call.Args
+		if call.Args[0].(uint32) == 3 {
+			return call.ErrorResult(&writeError{code: "access"})
+		}
+		return next(ctx, call)
+	}
+	wrapped := applyMiddleware([]Middleware{deny}, "wasi:filesystem/types@0.2.0", "[method]descriptor.write", write).(func(context.Context, uint32, []byte) (uint64, *writeError))
+
+	if n, err := wrapped(context.Background(), 1, []byte("abc")); n != 3 || err != nil {
+		t.Errorf("allowed write = %d, %v", n, err)
+	}
+	if len(seen) != 2 || seen[0] != uint32(1) {
+		t.Errorf("middleware saw %v", seen)
+	}
+	if n, err := wrapped(context.Background(), 3, []byte("abc")); n != 0 || err == nil || err.code != "access" {
+		t.Errorf("denied write = %d, %v", n, err)
+	}
+
+	// Middleware run in the order added; a mock never reaches the handler
+	var order []string
+	logMW := func(ctx context.Context, call *HostCall, next HostHandler) ([]any, error) {
+		order = append(order, "log")
+		return next(ctx, call)
+	}
+	mock := func(ctx context.Context, call *HostCall, next HostHandler) ([]any, error) {
+		order = append(order, "mock")
+		return []any{uint64(99), nil}, nil
+	}
+	wrapped = applyMiddleware([]Middleware{logMW, mock}, "ns", "write", write).(func(context.Context, uint32, []byte) (uint64, *writeError))
+	if n, _ := wrapped(context.Background(), 1, nil); n != 99 {
+		t.Errorf("mocked write = %d, want 99", n)
+	}
+	if strings.Join(order, ",") != "log,mock" {
+		t.Errorf("order = %v", order)
+	}
+}
+
+func TestApplyMiddleware_Trap(t *testing.T) {
+	get := func(ctx context.Context) uint32 { return 1 }
+	limited := stderrors.New("rate limited")
+	wrapped := applyMiddleware([]Middleware{func(ctx context.Context, call *HostCall, next HostHandler) ([]any, error) {
+		return nil, limited
+	}}, "ns", "get", get).(func(context.Context) uint32)
+
+	defer func() {
+		if p := recover(); p != limited {
+			t.Errorf("panic = %v, want the middleware error", p)
+		}
+	}()
+	wrapped(context.Background())
+}
+
+func TestHostRegistry_Use(t *testing.T) {
+	ctx := context.Background()
+	wasm := loadTestbed(t, "counter.wasm")
+
+	rt, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.Close(ctx)
+
+	dropped := 0
+	def, err := defineCounter(&dropped)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rt.RegisterHost(def); err != nil {
+		t.Fatal(err)
+	}
+	var calls []string
+	rt.Use(func(ctx context.Context, call *HostCall, next HostHandler) ([]any, error) {
+		calls = append(calls, call.Function)
+		return next(ctx, call)
+	}, "test:counter/host@0.1.*")
+	rt.Use(func(ctx context.Context, call *HostCall, next HostHandler) ([]any, error) {
+		return []any{uint32(42)}, nil
+	}, "test:counter/host#[method]counter.get")
+	rt.Use(func(ctx context.Context, call *HostCall, next HostHandler) ([]any, error) {
+		t.Errorf("middleware for another interface ran for %s", call.Function)
+		return next(ctx, call)
+	}, "wasi:io/streams#*")
+
+	mod, err := rt.LoadComponent(ctx, wasm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inst, err := mod.Instantiate(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer inst.Close(ctx)
+
+	got, err := inst.Call(ctx, "run-test", uint32(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != uint32(42) {
+		t.Errorf("run-test(3) = %v, want the mocked 42", got)
+	}
+	want := "[constructor]counter,[method]counter.increment,[method]counter.increment,[method]counter.increment,[method]counter.get,[resource-drop]counter"
+	if strings.Join(calls, ",") != want {
+		t.Errorf("calls = %v", calls)
+	}
+}
@@ -2,14 +2,16 @@ package runtime
 
 import (
 	"context"
-	"regexp"
 	"strings"
 	"sync"
 
 	"go.bytecodealliance.org/wit"
 
+	wasmruntime "github.com/wippyai/wasm-runtime"
 	"github.com/wippyai/wasm-runtime/engine"
 	"github.com/wippyai/wasm-runtime/errors"
+	"github.com/wippyai/wasm-runtime/linker"
+	"github.com/wippyai/wasm-runtime/witparse"
 )
 
 type Module struct {
@@ -17,6 +19,7 @@ type Module struct {
 	runtime       *Runtime
 	wazeroModule  *engine.WazeroModule
 	funcTypes     map[string]*funcSignature
+	linked        map[string]*linkedResource // resources of linked component providers
 	witText       string
 	funcTypesOnce sync.Once
 	isComponent   bool
@@ -33,30 +36,41 @@ func (m *Module) Compile(ctx context.Context) error {
 }
 
 func (m *Module) Instantiate(ctx context.Context) (*Instance, error) {
-	wazeroInstance, err := m.wazeroModule.InstantiateWithConfig(ctx, &engine.InstanceConfig{})
-	if err != nil {
-		return nil, errors.Instantiation(err)
-	}
-
-	return &Instance{
-		module:         m,
-		wazeroInstance: wazeroInstance,
-	}, nil
+	return m.instantiate(ctx, &engine.InstanceConfig{})
 }
 
 // InstantiateWithAsyncify creates an instance with asyncify transformation.
 // Use for components calling async host functions (e.g., WASI HTTP).
 func (m *Module) InstantiateWithAsyncify(ctx context.Context) (*Instance, error) {
-	wazeroInstance, err := m.wazeroModule.InstantiateWithConfig(ctx, &engine.InstanceConfig{
+	return m.instantiate(ctx, &engine.InstanceConfig{
 		EnableAsyncify: true,
 	})
+}
+
+// InstantiateWithLimiter creates an instance whose memory growth, table
+// growth, instance count and resource handles are checked by limiter.
+// Pass the same limiter to several instances to share one budget.
+// Exceeding a limit traps the guest with an errors.KindResourceExhausted cause.
+func (m *Module) InstantiateWithLimiter(ctx context.Context, limiter wasmruntime.ResourceLimiter) (*Instance, error) {
+	return m.instantiate(ctx, &engine.InstanceConfig{
+		ResourceLimiter: limiter,
+	})
+}
+
+// instantiate creates an instance with its own host resource tables,
+// which start functions already use.
+func (m *Module) instantiate(ctx context.Context, cfg *engine.InstanceConfig) (*Instance, error) {
+	resources := &hostResources{}
+	wazeroInstance, err := m.wazeroModule.InstantiateWithConfig(withHostResources(ctx, resources), cfg)
 	if err != nil {
+		resources.close()
 		return nil, errors.Instantiation(err)
 	}
 
 	return &Instance{
 		module:         m,
 		wazeroInstance: wazeroInstance,
+		resources:      resources,
 	}, nil
 }
 
@@ -76,23 +90,46 @@ func (m *Module) Exports() []Export {
 	return exports
 }
 
+// Imports reports how each interface the module imports resolves against
+// the registered hosts: the namespace and version each function binds to,
+// stubs, and the nearest candidates of unresolved functions. It compiles
+// the module as Compile does.
+func (m *Module) Imports(ctx context.Context) ([]linker.ImportReport, error) {
+	reports, err := m.wazeroModule.Imports(ctx)
+	if err != nil {
+		return nil, errors.Instantiation(err)
+	}
+	return reports, nil
+}
+
 type funcSignature struct {
 	params  []wit.Type
 	results []wit.Type
 }
 
 // GetFunctionTypes returns WIT param and result types for a function.
-// Parses witText lazily on first call.
+// Components answer from their canon lift definitions; core modules use
+// the WIT text given to LoadWASM or LoadWAT.
 func (m *Module) GetFunctionTypes(name string) ([]wit.Type, []wit.Type, error) {
-	m.funcTypesOnce.Do(func() {
-		m.funcTypes, m.funcTypesErr = parseWitFunctions(m.witText)
-	})
+	if m.isComponent {
+		lift := m.wazeroModule.FindLift(name)
+		if lift == nil {
+			return nil, nil, errors.NotFound(errors.PhaseRuntime, "function", name)
+		}
+		return lift.Params, lift.Results, nil
+	}
 
-	if m.funcTypesErr != nil {
-		return nil, nil, m.funcTypesErr
+	if err := m.loadFuncTypes(); err != nil {
+		return nil, nil, err
 	}
 
 	sig, ok := m.funcTypes[name]
+	if !ok {
+		// Core modules may export interface functions as iface#name.
+		if _, fn, found := strings.Cut(name, "#"); found {
+			sig, ok = m.funcTypes[fn]
+		}
+	}
 	if !ok {
 		return nil, nil, errors.NotFound(errors.PhaseRuntime, "function", name)
 	}
@@ -100,61 +137,34 @@ func (m *Module) GetFunctionTypes(name string) ([]wit.Type, []wit.Type, error) {
 	return sig.params, sig.results, nil
 }
 
-// parseWitFunctions extracts function signatures from WIT text.
-// Pattern: [export] name: func(params) -> result;
-func parseWitFunctions(witText string) (map[string]*funcSignature, error) {
-	funcs := make(map[string]*funcSignature)
+// loadFuncTypes parses witText once and caches the result.
+func (m *Module) loadFuncTypes() error {
+	m.funcTypesOnce.Do(func() {
+		m.funcTypes, m.funcTypesErr = parseWitFunctions(m.witText)
+	})
+	return m.funcTypesErr
+}
 
-	funcPattern := regexp.MustCompile(`(?:export\s+)?([a-zA-Z_][a-zA-Z0-9_-]*)\s*:\s*func\s*\(([^)]*)\)(?:\s*->\s*([^;]+))?`)
+// parseWitFunctions extracts function signatures from WIT text: a full
+// WIT document or a bare list of `name: func(...)` signatures.
+func parseWitFunctions(witText string) (map[string]*funcSignature, error) {
+	res, err := witparse.Parse(witText)
+	if err != nil {
+		return nil, err
+	}
 
-	matches := funcPattern.FindAllStringSubmatch(witText, -1)
-	for _, match := range matches {
-		name := match[1]
-		paramsStr := strings.TrimSpace(match[2])
-		resultStr := ""
-		if len(match) > 3 {
-			resultStr = strings.TrimSpace(match[3])
+	funcs := make(map[string]*funcSignature)
+	for name, f := range witparse.Functions(res) {
+		sig := &funcSignature{
+			params:  make([]wit.Type, len(f.Params)),
+			results: make([]wit.Type, len(f.Results)),
 		}
-
-		sig := &funcSignature{}
-
-		if paramsStr != "" {
-			paramParts := splitParams(paramsStr)
-			for _, p := range paramParts {
-				typStr := p
-				if idx := strings.LastIndex(p, ":"); idx != -1 {
-					typStr = strings.TrimSpace(p[idx+1:])
-				}
-				t, err := parseWitType(typStr)
-				if err != nil {
-					return nil, errors.Wrap(errors.PhaseParse, errors.KindInvalidData, err, "parse param type "+typStr)
-				}
-				sig.params = append(sig.params, t)
-			}
+		for i, p := range f.Params {
+			sig.params[i] = p.Type
 		}
-
-		if resultStr != "" && resultStr != "()" {
-			if strings.HasPrefix(resultStr, "(") && strings.HasSuffix(resultStr, ")") {
-				inner := strings.TrimPrefix(strings.TrimSuffix(resultStr, ")"), "(")
-				if inner != "" {
-					parts := splitParams(inner)
-					for _, part := range parts {
-						t, err := parseWitType(strings.TrimSpace(part))
-						if err != nil {
-							return nil, errors.Wrap(errors.PhaseParse, errors.KindInvalidData, err, "parse result type "+part)
-						}
-						sig.results = append(sig.results, t)
-					}
-				}
-			} else {
-				t, err := parseWitType(resultStr)
-				if err != nil {
-					return nil, errors.Wrap(errors.PhaseParse, errors.KindInvalidData, err, "parse result type "+resultStr)
-				}
-				sig.results = []wit.Type{t}
-			}
+		for i, r := range f.Results {
+			sig.results[i] = r.Type
 		}
-
 		funcs[name] = sig
 	}
 
@@ -164,43 +174,3 @@ func parseWitFunctions(witText string) (map[string]*funcSignature, error) {
 
 	return funcs, nil
 }
-
-// splitParams splits parameter list, handling nested parens.
-func splitParams(s string) []string {
-	var result []string
-	var current strings.Builder
-	depth := 0
-
-	for _, ch := range s {
-		switch ch {
-		case '(':
-			depth++
-			current.WriteRune(ch)
-		case ')':
-			depth--
-			current.WriteRune(ch)
-		case ',':
-			if depth == 0 {
-				if str := strings.TrimSpace(current.String()); str != "" {
-					result = append(result, str)
-				}
-				current.Reset()
-			} else {
-				current.WriteRune(ch)
-			}
-		default:
-			current.WriteRune(ch)
-		}
-	}
-
-	if str := strings.TrimSpace(current.String()); str != "" {
-		result = append(result, str)
-	}
-
-	return result
-}
-
-func parseWitType(s string) (wit.Type, error) {
-	s = strings.TrimSpace(s)
-	return wit.ParseType(s)
-}
@@ -162,68 +162,6 @@ func TestParseWitFunctions_NoResult(t *testing.T) {
 	}
 }
 
-func TestSplitParams(t *testing.T) {
-	tests := []struct {
-		input    string
-		expected []string
-	}{
-		{"a: s32, b: s32", []string{"a: s32", "b: s32"}},
-		{"x: u32, y: s32", []string{"x: u32", "y: s32"}},
-		{"nested: u64", []string{"nested: u64"}},
-		{"", []string{}},
-		{"single: u64", []string{"single: u64"}},
-		{" a : s32 , b : s32 ", []string{"a : s32", "b : s32"}},
-		{"a: s32, b: f32, c: bool", []string{"a: s32", "b: f32", "c: bool"}},
-	}
-
-	for _, tc := range tests {
-		t.Run(tc.input, func(t *testing.T) {
-			result := splitParams(tc.input)
-			if len(result) != len(tc.expected) {
-				t.Errorf("expected %d parts, got %d: %v", len(tc.expected), len(result), result)
-				return
-			}
-			for i, exp := range tc.expected {
-				if result[i] != exp {
-					t.Errorf("part %d: expected %q, got %q", i, exp, result[i])
-				}
-			}
-		})
-	}
-}
-
-func TestParseWitType(t *testing.T) {
-	tests := []struct {
-		input string
-		valid bool
-	}{
-		{"s32", true},
-		{"s64", true},
-		{"u8", true},
-		{"u16", true},
-		{"u32", true},
-		{"u64", true},
-		{"f32", true},
-		{"f64", true},
-		{"bool", true},
-		{"string", true},
-		{"char", true},
-		{"invalid-type-xyz", false},
-	}
-
-	for _, tc := range tests {
-		t.Run(tc.input, func(t *testing.T) {
-			_, err := parseWitType(tc.input)
-			if tc.valid && err != nil {
-				t.Errorf("expected valid, got error: %v", err)
-			}
-			if !tc.valid && err == nil {
-				t.Error("expected error for invalid type")
-			}
-		})
-	}
-}
-
 // Minimal valid WASM module (no exports)
 var minimalWASM = []byte{
 	0x00, 0x61, 0x73, 0x6d, // magic
@@ -0,0 +1,121 @@
+package runtime
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	stderrors "errors"
+	"io"
+	"testing"
+
+	"github.com/wippyai/wasm-runtime/errors"
+	"github.com/wippyai/wasm-runtime/profile"
+	"github.com/wippyai/wasm-runtime/wat"
+)
+
+// profileWAT calls the unexported $hot from run.
+const profileWAT = `(module
+	(func $hot (param i32) (result i32)
+		(i32.mul (local.get 0) (local.get 0)))
+	(func (export "run") (param i32) (result i32)
+		(call $hot (call $hot (local.get 0)))))
+`
+
+// profileStrings returns the uncompressed profile written by p.
+func profileStrings(t *testing.T, p *profile.Profiler) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	if _, err := p.WriteTo(&buf); err != nil {
+		t.Fatal(err)
+	}
+	zr, err := gzip.NewReader(&buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := io.ReadAll(zr)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestInstance_SetProfiler(t *testing.T) {
+	ctx := context.Background()
+	rt, err := NewWithConfig(ctx, Config{Profiling: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.Close(ctx)
+
+	module, err := wat.Compile(profileWAT)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mod, err := rt.LoadWASM(ctx, module, "run: func(x: u32) -> u32")
+	if err != nil {
+		t.Fatal(err)
+	}
+	inst, err := mod.Instantiate(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer inst.Close(ctx)
+
+	p := profile.New()
+	if err := inst.SetProfiler(p); err != nil {
+		t.Fatal(err)
+	}
+	res, err := inst.Call(ctx, "run", uint32(3))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if res != uint32(81) {
+		t.Fatalf("run(3) = %v, want 81", res)
+	}
+	data := profileStrings(t, p)
+	for _, name := range []string{"run", "hot", profile.FrameLower, profile.FrameLift, "wall", "nanoseconds"} {
+		if !bytes.Contains(data, []byte(name)) {
+			t.Errorf("profile lacks %q", name)
+		}
+	}
+
+	// A profiler in the context overrides the instance's
+	if err := inst.SetProfiler(nil); err != nil {
+		t.Fatal(err)
+	}
+	perCall := profile.New()
+	if _, err := inst.Call(profile.WithProfiler(ctx, perCall), "run", uint32(2)); err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(profileStrings(t, perCall), []byte("hot")) {
+		t.Error("per-call profile lacks hot")
+	}
+}
+
+func TestInstance_SetProfilerDisabled(t *testing.T) {
+	ctx := context.Background()
+	rt, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.Close(ctx)
+
+	module, err := wat.Compile(profileWAT)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mod, err := rt.LoadWASM(ctx, module, "run: func(x: u32) -> u32")
+	if err != nil {
+		t.Fatal(err)
+	}
+	inst, err := mod.Instantiate(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer inst.Close(ctx)
+
+	err = inst.SetProfiler(profile.New())
+	if !stderrors.Is(err, &errors.Error{Phase: errors.PhaseRuntime, Kind: errors.KindUnsupported}) {
+		t.Errorf("SetProfiler() = %v, want unsupported", err)
+	}
+}
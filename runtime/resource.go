@@ -0,0 +1,434 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"sync"
+
+	"github.com/wippyai/wasm-runtime/errors"
+	"github.com/wippyai/wasm-runtime/resource"
+)
+
+// Own marks a handler parameter that takes ownership of a resource. The
+// handle is removed from the table without calling Dropper.Drop, so the
+// handler becomes responsible for the value.
+type Own[T any] struct {
+	Value T
+}
+
+// ResourceDef defines a host-implemented resource type backed by Go values
+// of type T. Handlers take and return T instead of raw handles:
+//
+//   - a parameter of type T borrows the handle for the call
+//   - a parameter of type Own[T] takes ownership of the handle
+//   - a result of type T creates a new owned handle
+//
+// Borrowed handles are validated when the handler returns; a handle
+// dropped or taken during the call traps the caller. The
+// [resource-drop] import removes the handle and calls Dropper.Drop.
+//
+// Each instance has its own handle table, created on first use; closing
+// the instance drops the handles it still holds.
+//
+// ResourceDef implements Host, so it is registered with Runtime.RegisterHost:
+//
+//	counters := runtime.DefineResource[*Counter]("test:counter/host@0.1.0", "counter")
+//	counters.Constructor(func(ctx context.Context) *Counter { return &Counter{} })
+//	counters.Method("get", func(ctx context.Context, c *Counter) uint32 { return c.n })
+//	rt.RegisterHost(counters)
+type ResourceDef[T any] struct {
+	funcs     map[string]any
+	live      map[*resource.UnifiedTable]struct{} // tables of open instances
+	namespace string
+	name      string
+	observers []resource.Observer
+	mu        sync.Mutex
+}
+
+var (
+	errorType = reflect.TypeOf((*error)(nil)).Elem()
+	handleTyp = reflect.TypeOf(uint32(0))
+)
+
+// DefineResource starts the definition of resource name in the WIT
+// interface namespace. The [resource-drop] handler is defined implicitly.
+func DefineResource[T any](namespace, name string) *ResourceDef[T] {
+	d := &ResourceDef[T]{
+		funcs:     make(map[string]any),
+		live:      make(map[*resource.UnifiedTable]struct{}),
+		namespace: namespace,
+		name:      name,
+	}
+	d.funcs["[resource-drop]"+name] = d.drop
+	return d
+}
+
+// Namespace returns the WIT interface the resource belongs to.
+func (d *ResourceDef[T]) Namespace() string {
+	return d.namespace
+}
+
+// Name returns the WIT resource name.
+func (d *ResourceDef[T]) Name() string {
+	return d.name
+}
+
+// Table returns the handle table of the resource in the instance whose
+// call ctx belongs to, for handlers of other resources that create or look
+// up values of this type. Table returns nil outside an instance's call or
+// after the instance is closed.
+func (d *ResourceDef[T]) Table(ctx context.Context) resource.TypedTable[T] {
+	if t := d.table(ctx); t != nil {
+		return t
+	}
+	return nil
+}
+
+// table returns the typed handle table of the calling instance, or nil.
+func (d *ResourceDef[T]) table(ctx context.Context) *resource.TypedUnifiedTable[T] {
+	unified := hostResourcesFrom(ctx).table(d, d.open, d.release)
+	if unified == nil {
+		return nil
+	}
+	return resource.NewTypedTable[T](unified, 0)
+}
+
+// open creates an instance's table, reporting to the current observers.
+func (d *ResourceDef[T]) open() *resource.UnifiedTable {
+	t := resource.NewTable()
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for _, o := range d.observers {
+		t.Subscribe(o)
+	}
+	d.live[t] = struct{}{}
+	return t
+}
+
+// release forgets the table of a closed instance.
+func (d *ResourceDef[T]) release(t *resource.UnifiedTable) {
+	d.mu.Lock()
+	delete(d.live, t)
+	d.mu.Unlock()
+}
+
+// Subscribe reports the events of every instance's table to o.
+func (d *ResourceDef[T]) Subscribe(o resource.Observer) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.observers = append(d.observers, o)
+	for t := range d.live {
+		t.Subscribe(o)
+	}
+}
+
+// Unsubscribe stops reporting to o.
+func (d *ResourceDef[T]) Unsubscribe(o resource.Observer) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for i, obs := range d.observers {
+		if obs == o {
+			d.observers = append(d.observers[:i], d.observers[i+1:]...)
+			break
+		}
+	}
+	for t := range d.live {
+		t.Unsubscribe(o)
+	}
+}
+
+// resourceTable returns the handles table for Runtime.SetInterceptor
+func (d *ResourceDef[T]) resourceTable() (string, observable) {
+	return d.namespace + "#" + d.name, d
+}
+
+// Register returns the WIT import names and handlers of the resource.
+func (d *ResourceDef[T]) Register() map[string]any {
+	funcs := make(map[string]any, len(d.funcs))
+	for name, fn := range d.funcs {
+		funcs[name] = fn
+	}
+	return funcs
+}
+
+// Close drops every live handle of the resource in every instance,
+// calling Dropper.Drop.
+func (d *ResourceDef[T]) Close() error {
+	d.mu.Lock()
+	tables := make([]*resource.UnifiedTable, 0, len(d.live))
+	for t := range d.live {
+		tables = append(tables, t)
+	}
+	d.mu.Unlock()
+	for _, t := range tables {
+		t.Clear()
+	}
+	return nil
+}
+
+// Constructor defines [constructor]name. fn returns T, or (T, error) where
+// a non-nil error traps the caller.
+func (d *ResourceDef[T]) Constructor(fn any) error {
+	ft, err := d.funcType("[constructor]", fn)
+	if err != nil {
+		return err
+	}
+	tType := reflect.TypeFor[T]()
+	ok := ft.NumOut() == 1 && ft.Out(0) == tType ||
+		ft.NumOut() == 2 && ft.Out(0) == tType && ft.Out(1) == errorType
+	if !ok {
+		return d.signatureError("[constructor]", ft, "constructor must return %s or (%s, error)", tType, tType)
+	}
+	d.funcs["[constructor]"+d.name] = d.wrap("[constructor]"+d.name, fn, ft.NumOut() == 2)
+	return nil
+}
+
+// Method defines [method]name.method. The first parameter after an
+// optional context.Context is the borrowed self of type T.
+func (d *ResourceDef[T]) Method(method string, fn any) error {
+	if method == "" {
+		return errors.InvalidInput(errors.PhaseHost, "method name cannot be empty")
+	}
+	ft, err := d.funcType("[method]", fn)
+	if err != nil {
+		return err
+	}
+	self := 0
+	if ft.NumIn() > 0 && ft.In(0) == contextType {
+		self = 1
+	}
+	if ft.NumIn() <= self || ft.In(self) != reflect.TypeFor[T]() {
+		return d.signatureError("[method]", ft, "method must take self of type %s", reflect.TypeFor[T]())
+	}
+	name := "[method]" + d.name + "." + method
+	d.funcs[name] = d.wrap(name, fn, false)
+	return nil
+}
+
+// Static defines [static]name.function.
+func (d *ResourceDef[T]) Static(function string, fn any) error {
+	if function == "" {
+		return errors.InvalidInput(errors.PhaseHost, "function name cannot be empty")
+	}
+	if _, err := d.funcType("[static]", fn); err != nil {
+		return err
+	}
+	name := "[static]" + d.name + "." + function
+	d.funcs[name] = d.wrap(name, fn, false)
+	return nil
+}
+
+func (d *ResourceDef[T]) funcType(kind string, fn any) (reflect.Type, error) {
+	if d.name == "" {
+		return nil, errors.InvalidInput(errors.PhaseHost, "resource name cannot be empty")
+	}
+	ft := reflect.TypeOf(fn)
+	if ft == nil || ft.Kind() != reflect.Func {
+		return nil, errors.New(errors.PhaseHost, errors.KindTypeMismatch).
+			Path(kind + d.name).
+			GoType(fmt.Sprintf("%T", fn)).
+			Detail("handler must be a function").
+			Build()
+	}
+	if ft.IsVariadic() {
+		return nil, d.signatureError(kind, ft, "handler cannot be variadic")
+	}
+	return ft, nil
+}
+
+func (d *ResourceDef[T]) signatureError(kind string, ft reflect.Type, format string, args ...any) error {
+	return errors.New(errors.PhaseHost, errors.KindTypeMismatch).
+		Path(kind+d.name).
+		GoType(ft.String()).
+		Detail(format, args...).
+		Build()
+}
+
+// wrap adapts fn to a handler taking and returning raw handles in place of
+// T. The handler always takes a context.Context, which finds the calling
+// instance's table. With trapErr, a trailing error result traps instead
+// of being lowered.
+func (d *ResourceDef[T]) wrap(name string, fn any, trapErr bool) any {
+	fv := reflect.ValueOf(fn)
+	ft := fv.Type()
+	tType := reflect.TypeFor[T]()
+	ownType := reflect.TypeFor[Own[T]]()
+	hasCtx := ft.NumIn() > 0 && ft.In(0) == contextType
+
+	in := []reflect.Type{contextType}
+	for i := range ft.NumIn() {
+		t := ft.In(i)
+		if t == tType || t == ownType {
+			t = handleTyp
+		}
+		if i > 0 || !hasCtx {
+			in = append(in, t)
+		}
+	}
+	numOut := ft.NumOut()
+	if trapErr {
+		numOut--
+	}
+	out := make([]reflect.Type, numOut)
+	for i := range out {
+		out[i] = ft.Out(i)
+		if out[i] == tType {
+			out[i] = handleTyp
+		}
+	}
+
+	return reflect.MakeFunc(reflect.FuncOf(in, out, false), func(args []reflect.Value) []reflect.Value {
+		ctx, _ := args[0].Interface().(context.Context)
+		if !hasCtx {
+			args = args[1:]
+		}
+		table := d.table(ctx)
+		if table == nil {
+			panic(fmt.Errorf("%s: no instance %s table in the call context", name, d.name))
+		}
+
+		var borrowed []resource.Handle
+		returned := false
+		defer func() {
+			if !returned {
+				for _, h := range borrowed {
+					table.ReturnBorrow(h)
+				}
+			}
+		}()
+
+		callArgs := make([]reflect.Value, len(args))
+		for i, arg := range args {
+			switch ft.In(i) {
+			case tType:
+				h := resource.Handle(arg.Uint())
+				v, ok := table.Borrow(h)
+				if !ok {
+					panic(fmt.Errorf("%s: invalid %s handle %d", name, d.name, h))
+				}
+				borrowed = append(borrowed, h)
+				callArgs[i] = reflect.ValueOf(&v).Elem()
+			case ownType:
+				h := resource.Handle(arg.Uint())
+				v, ok := table.Take(h)
+				if !ok {
+					panic(fmt.Errorf("%s: invalid or borrowed %s handle %d", name, d.name, h))
+				}
+				callArgs[i] = reflect.ValueOf(Own[T]{Value: v})
+			default:
+				callArgs[i] = arg
+			}
+		}
+
+		res := fv.Call(callArgs)
+
+		returned = true
+		for _, h := range borrowed {
+			if !table.ReturnBorrow(h) {
+				panic(fmt.Errorf("%s: borrowed %s handle %d was dropped during the call", name, d.name, h))
+			}
+		}
+
+		if trapErr {
+			if err, _ := res[len(res)-1].Interface().(error); err != nil {
+				panic(fmt.Errorf("%s: %w", name, err))
+			}
+			res = res[:len(res)-1]
+		}
+		for i, r := range res {
+			if ft.Out(i) != tType {
+				continue
+			}
+			v, _ := r.Interface().(T)
+			h := table.Insert(v)
+			if h == 0 {
+				panic(fmt.Errorf("%s: %s table closed", name, d.name))
+			}
+			res[i] = reflect.ValueOf(uint32(h))
+		}
+		return res
+	}).Interface()
+}
+
+// drop implements [resource-drop]name. Dropping an unknown or borrowed
+// handle traps.
+func (d *ResourceDef[T]) drop(ctx context.Context, handle uint32) {
+	table := d.table(ctx)
+	if table == nil {
+		panic(fmt.Errorf("[resource-drop]%s: no instance table in the call context", d.name))
+	}
+	if _, ok := table.Remove(resource.Handle(handle)); !ok {
+		panic(fmt.Errorf("[resource-drop]%s: invalid or borrowed handle %d", d.name, handle))
+	}
+}
+
+// hostResources holds the host resource tables of one instance, each
+// opened by its ResourceDef on first use.
+type hostResources struct {
+	tables map[any]hostTable
+	mu     sync.Mutex
+	closed bool
+}
+
+type hostTable struct {
+	table   *resource.UnifiedTable
+	release func(*resource.UnifiedTable)
+}
+
+type hostResourcesKey struct{}
+
+// withHostResources returns ctx scoping host resources to r.
+func withHostResources(ctx context.Context, r *hostResources) context.Context {
+	if r == nil {
+		return ctx
+	}
+	return context.WithValue(ctx, hostResourcesKey{}, r)
+}
+
+func hostResourcesFrom(ctx context.Context) *hostResources {
+	if ctx == nil {
+		return nil
+	}
+	r, _ := ctx.Value(hostResourcesKey{}).(*hostResources)
+	return r
+}
+
+// table returns the table of def, opening it on first use. It returns nil
+// when r is nil or closed.
+func (r *hostResources) table(def any, open func() *resource.UnifiedTable, release func(*resource.UnifiedTable)) *resource.UnifiedTable {
+	if r == nil {
+		return nil
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.closed {
+		return nil
+	}
+	if t, ok := r.tables[def]; ok {
+		return t.table
+	}
+	if r.tables == nil {
+		r.tables = make(map[any]hostTable)
+	}
+	t := open()
+	r.tables[def] = hostTable{table: t, release: release}
+	return t
+}
+
+// close drops the handles left in every table, calling Dropper.Drop.
+func (r *hostResources) close() {
+	if r == nil {
+		return
+	}
+	r.mu.Lock()
+	tables := r.tables
+	r.tables = nil
+	r.closed = true
+	r.mu.Unlock()
+	for _, t := range tables {
+		t.table.Clear()
+		_ = t.table.Close()
+		t.release(t.table)
+	}
+}
@@ -0,0 +1,194 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	goruntime "runtime"
+	"strings"
+	"sync"
+
+	"github.com/wippyai/wasm-runtime/errors"
+	"github.com/wippyai/wasm-runtime/linker"
+)
+
+// ResourceRef is an owned handle to a resource exported by a component.
+// Methods are called by their WIT name, and Drop runs the guest
+// destructor. A ref that becomes unreachable without Drop is released on
+// the next resource operation of its instance, never from the garbage
+// collector's goroutine. Like Instance, a ResourceRef is not safe for
+// concurrent use.
+type ResourceRef struct {
+	inst    *Instance
+	cleanup goruntime.Cleanup
+	name    string // export name: "counter" or "my:pkg/api#counter"
+	typeID  uint32
+	handle  uint32
+	dropped bool
+}
+
+// resourceRefs tracks the resource refs of an instance. Handles of refs
+// collected without Drop are queued here by their cleanup and dropped on
+// the instance's goroutine.
+type resourceRefs struct {
+	pending []pendingDrop
+	mu      sync.Mutex
+	closed  bool
+}
+
+type pendingDrop struct {
+	refs   *resourceRefs
+	typeID uint32
+	handle uint32
+}
+
+// NewResource calls the [constructor] export of resource and returns a ref
+// owning the new handle. resource is the exported resource name, prefixed
+// by its interface for interface exports: "my:pkg/api#counter".
+func (i *Instance) NewResource(ctx context.Context, resource string, args ...any) (*ResourceRef, error) {
+	if _, err := i.resourceInstance(); err != nil {
+		return nil, err
+	}
+	res, err := i.Call(ctx, resourceFunc(resource, "[constructor]", ""), args...)
+	if err != nil {
+		return nil, err
+	}
+	handle, ok := res.(uint32)
+	if !ok {
+		return nil, errors.New(errors.PhaseRuntime, errors.KindTypeMismatch).
+			Path(resource).
+			GoType(fmt.Sprintf("%T", res)).
+			Detail("constructor did not return a handle").
+			Build()
+	}
+	return i.Resource(resource, handle)
+}
+
+// Resource wraps an owned handle of resource returned by an export other
+// than the constructor. The ref takes over dropping the handle.
+func (i *Instance) Resource(resource string, handle uint32) (*ResourceRef, error) {
+	li, err := i.resourceInstance()
+	if err != nil {
+		return nil, err
+	}
+	typeID, ok := li.ExportedResource(resource)
+	if !ok {
+		return nil, errors.NotFound(errors.PhaseRuntime, "exported resource", resource)
+	}
+
+	ref := &ResourceRef{
+		inst:   i,
+		name:   resource,
+		typeID: typeID,
+		handle: handle,
+	}
+	ref.cleanup = goruntime.AddCleanup(ref, func(p pendingDrop) {
+		p.refs.mu.Lock()
+		p.refs.pending = append(p.refs.pending, p)
+		p.refs.mu.Unlock()
+	}, pendingDrop{refs: i.refs, typeID: typeID, handle: handle})
+	return ref, nil
+}
+
+// resourceInstance returns the linker instance holding resource handles,
+// after dropping the handles of refs collected since the last call.
+func (i *Instance) resourceInstance() (*linker.Instance, error) {
+	if i.refs == nil {
+		i.refs = &resourceRefs{}
+	}
+	i.refs.mu.Lock()
+	closed := i.refs.closed
+	pending := i.refs.pending
+	i.refs.pending = nil
+	i.refs.mu.Unlock()
+	if closed {
+		return nil, errors.InvalidInput(errors.PhaseRuntime, "instance closed")
+	}
+
+	li := i.wazeroInstance.LinkerInstance()
+	if li == nil {
+		return nil, errors.Unsupported(errors.PhaseRuntime, "resources of single-module components")
+	}
+	for _, p := range pending {
+		// A failing destructor of an abandoned resource has no caller to report to
+		_ = dropResource(li, p.typeID, p.handle)
+	}
+	return li, nil
+}
+
+// Handle returns the raw handle, for passing the resource to exports that
+// take it as own or borrow.
+func (r *ResourceRef) Handle() uint32 {
+	return r.handle
+}
+
+// Name returns the exported resource name.
+func (r *ResourceRef) Name() string {
+	return r.name
+}
+
+// Call calls the [method] export named method with the resource as self.
+// The handle is borrowed for the duration of the call.
+func (r *ResourceRef) Call(ctx context.Context, method string, args ...any) (any, error) {
+	li, err := r.use()
+	if err != nil {
+		return nil, err
+	}
+	table := li.Resources().Table(r.typeID)
+	if err := table.Borrow(linker.Handle(r.handle)); err != nil {
+		return nil, errors.Wrap(errors.PhaseRuntime, errors.KindInvalidInput, err, r.name)
+	}
+	defer table.EndBorrow(linker.Handle(r.handle))
+
+	// The component implements the resource, so self is lowered as its rep
+	rep, err := linker.ResourceRep(li.Resources(), r.typeID, linker.Handle(r.handle))
+	if err != nil {
+		return nil, errors.Wrap(errors.PhaseRuntime, errors.KindInvalidInput, err, r.name)
+	}
+	callArgs := append([]any{rep}, args...)
+	return r.inst.Call(ctx, resourceFunc(r.name, "[method]", "."+method), callArgs...)
+}
+
+// Drop drops the handle, running the guest destructor. Using the ref
+// afterwards returns an error.
+func (r *ResourceRef) Drop(ctx context.Context) error {
+	li, err := r.use()
+	if err != nil {
+		return err
+	}
+	r.dropped = true
+	r.cleanup.Stop()
+	return dropResource(li, r.typeID, r.handle)
+}
+
+func (r *ResourceRef) use() (*linker.Instance, error) {
+	if r.dropped {
+		return nil, errors.InvalidInput(errors.PhaseRuntime, fmt.Sprintf("resource %s handle %d already dropped", r.name, r.handle))
+	}
+	return r.inst.resourceInstance()
+}
+
+// dropResource drops handle through the resource store, converting a
+// trapping destructor into an error.
+func dropResource(li *linker.Instance, typeID, handle uint32) (err error) {
+	defer func() {
+		if p := recover(); p != nil {
+			err = errors.New(errors.PhaseRuntime, errors.KindInvalidData).
+				Detail("resource destructor: %v", p).
+				Build()
+		}
+	}()
+	if err := linker.ResourceDrop(li.Resources(), typeID, linker.Handle(handle)); err != nil {
+		return errors.Wrap(errors.PhaseRuntime, errors.KindInvalidInput, err, "drop resource")
+	}
+	return nil
+}
+
+// resourceFunc returns the export name of a resource function:
+// "my:pkg/api#counter" with "[method]" and ".get" becomes
+// "my:pkg/api#[method]counter.get".
+func resourceFunc(resource, kind, suffix string) string {
+	if iface, name, ok := strings.Cut(resource, "#"); ok {
+		return iface + "#" + kind + name + suffix
+	}
+	return kind + resource + suffix
+}
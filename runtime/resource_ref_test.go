@@ -0,0 +1,266 @@
+package runtime
+
+import (
+	"context"
+	stderrors "errors"
+	goruntime "runtime"
+	"testing"
+	"time"
+
+	"github.com/wippyai/wasm-runtime/errors"
+	"github.com/wippyai/wasm-runtime/wat"
+)
+
+// counterImplWAT implements the counter resource: the rep is the count,
+// and the destructor adds the rep of each destroyed counter to drops.
+const counterImplWAT = `(module
+	(global $drops (mut i32) (i32.const 0))
+	(func (export "get") (param $rep i32) (result i32)
+		(local.get $rep))
+	(func (export "dtor") (param $rep i32)
+		(global.set $drops (i32.add (global.get $drops) (local.get $rep))))
+	(func (export "drops") (result i32)
+		(global.get $drops)))
+`
+
+// counterGlueWAT implements the constructor on top of resource.new.
+const counterGlueWAT = `(module
+	(import "" "new" (func $new (param i32) (result i32)))
+	(func (export "ctor") (param $n i32) (result i32)
+		(call $new (local.get $n))))
+`
+
+func testSection(id byte, payload ...byte) []byte {
+	out := []byte{id}
+	size := uint32(len(payload))
+	for {
+		b := byte(size & 0x7f)
+		size >>= 7
+		if size != 0 {
+			b |= 0x80
+		}
+		out = append(out, b)
+		if size == 0 {
+			break
+		}
+	}
+	return append(out, payload...)
+}
+
+func testName(s string) []byte {
+	return append([]byte{byte(len(s))}, s...)
+}
+
+func concatBytes(parts ...[]byte) []byte {
+	var out []byte
+	for _, p := range parts {
+		out = append(out, p...)
+	}
+	return out
+}
+
+// resourceExportComponent builds a component exporting resource counter
+// with [constructor]counter(n: u32), [method]counter.get() -> u32 and
+// drops() -> u32.
+func resourceExportComponent(t *testing.T) []byte {
+	t.Helper()
+	impl, err := wat.Compile(counterImplWAT)
+	if err != nil {
+		t.Fatal(err)
+	}
+	glue, err := wat.Compile(counterGlueWAT)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	aliasCore := func(instance byte, name string) []byte {
+		return concatBytes([]byte{0x00, 0x00, 0x01, instance}, testName(name))
+	}
+	export := func(name string, sort, idx byte) []byte {
+		return concatBytes([]byte{0x00}, testName(name), []byte{sort, idx, 0x00})
+	}
+
+	return concatBytes(
+		[]byte{0x00, 0x61, 0x73, 0x6d, 0x0d, 0x00, 0x01, 0x00},
+		testSection(1, impl...),
+		// core instance 0 = impl
+		testSection(2, 0x01, 0x00, 0x00, 0x00),
+		// core func 0 = impl.dtor
+		testSection(6, concatBytes([]byte{0x01}, aliasCore(0, "dtor"))...),
+		// type 0 = resource (rep i32) (dtor 0)
+		testSection(7, 0x01, 0x3f, 0x7f, 0x01, 0x00),
+		// core func 1 = resource.new 0
+		testSection(8, 0x01, 0x02, 0x00),
+		testSection(1, glue...),
+		// core instance 1 = {new: core func 1}, core instance 2 = glue(instance 1)
+		testSection(2, concatBytes(
+			[]byte{0x02},
+			[]byte{0x01, 0x01}, testName("new"), []byte{0x00, 0x01},
+			[]byte{0x00, 0x01, 0x01}, testName(""), []byte{0x12, 0x01},
+		)...),
+		// core funcs 2, 3, 4 = glue.ctor, impl.get, impl.drops
+		testSection(6, concatBytes([]byte{0x03}, aliasCore(2, "ctor"), aliasCore(0, "get"), aliasCore(0, "drops"))...),
+		// type 1 = func(n: u32) -> own<0>, type 2 = func(self: borrow<0>) -> u32, type 3 = func() -> u32
+		testSection(7, concatBytes(
+			[]byte{0x03},
+			[]byte{0x40, 0x01}, testName("n"), []byte{0x79, 0x00, 0x69, 0x00},
+			[]byte{0x40, 0x01}, testName("self"), []byte{0x68, 0x00, 0x00, 0x79},
+			[]byte{0x40, 0x00, 0x00, 0x79},
+		)...),
+		// funcs 0, 1, 2 = lifts of core funcs 2, 3, 4
+		testSection(8, 0x01, 0x00, 0x00, 0x02, 0x00, 0x01),
+		testSection(8, 0x01, 0x00, 0x00, 0x03, 0x00, 0x02),
+		testSection(8, 0x01, 0x00, 0x00, 0x04, 0x00, 0x03),
+		testSection(11, concatBytes(
+			[]byte{0x04},
+			export("counter", 0x03, 0x00),
+			export("[constructor]counter", 0x01, 0x00),
+			export("[method]counter.get", 0x01, 0x01),
+			export("drops", 0x01, 0x02),
+		)...),
+	)
+}
+
+func newResourceInstance(t *testing.T, ctx context.Context) (*Runtime, *Instance) {
+	t.Helper()
+	rt, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mod, err := rt.LoadComponent(ctx, resourceExportComponent(t))
+	if err != nil {
+		rt.Close(ctx)
+		t.Fatal(err)
+	}
+	inst, err := mod.Instantiate(ctx)
+	if err != nil {
+		rt.Close(ctx)
+		t.Fatal(err)
+	}
+	return rt, inst
+}
+
+func TestResourceRef_CallAndDrop(t *testing.T) {
+	ctx := context.Background()
+	rt, inst := newResourceInstance(t, ctx)
+	defer rt.Close(ctx)
+	defer inst.Close(ctx)
+
+	a, err := inst.NewResource(ctx, "counter", uint32(5))
+	if err != nil {
+		t.Fatalf("NewResource: %v", err)
+	}
+	b, err := inst.NewResource(ctx, "counter", uint32(7))
+	if err != nil {
+		t.Fatalf("NewResource: %v", err)
+	}
+	if a.Handle() == b.Handle() {
+		t.Fatalf("both counters have handle %d", a.Handle())
+	}
+
+	for ref, want := range map[*ResourceRef]uint32{a: 5, b: 7} {
+		got, err := ref.Call(ctx, "get")
+		if err != nil {
+			t.Fatalf("get: %v", err)
+		}
+		if got != want {
+			t.Errorf("get() = %v, want %d", got, want)
+		}
+	}
+
+	if err := a.Drop(ctx); err != nil {
+		t.Fatalf("Drop: %v", err)
+	}
+	drops, err := inst.Call(ctx, "drops")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if drops != uint32(5) {
+		t.Errorf("drops = %v, want 5 after the destructor ran for rep 5", drops)
+	}
+
+	if _, err := a.Call(ctx, "get"); !stderrors.Is(err, &errors.Error{Phase: errors.PhaseRuntime, Kind: errors.KindInvalidInput}) {
+		t.Errorf("Call after Drop: error = %v, want invalid input", err)
+	}
+	if err := a.Drop(ctx); err == nil {
+		t.Error("second Drop should fail")
+	}
+	if got, err := b.Call(ctx, "get"); err != nil || got != uint32(7) {
+		t.Errorf("get() on the other counter = %v, %v", got, err)
+	}
+}
+
+func TestResourceRef_AfterClose(t *testing.T) {
+	ctx := context.Background()
+	rt, inst := newResourceInstance(t, ctx)
+	defer rt.Close(ctx)
+
+	ref, err := inst.NewResource(ctx, "counter", uint32(1))
+	if err != nil {
+		t.Fatalf("NewResource: %v", err)
+	}
+	if err := inst.Close(ctx); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := ref.Call(ctx, "get"); err == nil {
+		t.Error("Call after instance Close should fail")
+	}
+	if err := ref.Drop(ctx); err == nil {
+		t.Error("Drop after instance Close should fail")
+	}
+	if _, err := inst.NewResource(ctx, "counter", uint32(1)); err == nil {
+		t.Error("NewResource after instance Close should fail")
+	}
+}
+
+func TestResourceRef_Unreachable(t *testing.T) {
+	ctx := context.Background()
+	rt, inst := newResourceInstance(t, ctx)
+	defer rt.Close(ctx)
+	defer inst.Close(ctx)
+
+	func() {
+		if _, err := inst.NewResource(ctx, "counter", uint32(3)); err != nil {
+			t.Fatalf("NewResource: %v", err)
+		}
+	}()
+
+	// The cleanup only queues the handle; the destructor runs on the next
+	// resource operation
+	for range 50 {
+		goruntime.GC()
+		inst.refs.mu.Lock()
+		n := len(inst.refs.pending)
+		inst.refs.mu.Unlock()
+		if n > 0 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	if drops, _ := inst.Call(ctx, "drops"); drops != uint32(0) {
+		t.Fatalf("drops = %v before the next resource operation, want 0", drops)
+	}
+
+	if _, err := inst.NewResource(ctx, "counter", uint32(4)); err != nil {
+		t.Fatalf("NewResource: %v", err)
+	}
+	if drops, _ := inst.Call(ctx, "drops"); drops != uint32(3) {
+		t.Errorf("drops = %v, want 3 after the unreachable counter was released", drops)
+	}
+}
+
+func TestResourceRef_UnknownResource(t *testing.T) {
+	ctx := context.Background()
+	rt, inst := newResourceInstance(t, ctx)
+	defer rt.Close(ctx)
+	defer inst.Close(ctx)
+
+	_, err := inst.Resource("missing", 0)
+	if !stderrors.Is(err, &errors.Error{Phase: errors.PhaseRuntime, Kind: errors.KindNotFound}) {
+		t.Errorf("Resource(missing) error = %v, want not found", err)
+	}
+	if got := resourceFunc("my:pkg/api#counter", "[method]", ".get"); got != "my:pkg/api#[method]counter.get" {
+		t.Errorf("resourceFunc() = %q", got)
+	}
+}
@@ -0,0 +1,243 @@
+package runtime
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+
+	"github.com/wippyai/wasm-runtime/errors"
+	"github.com/wippyai/wasm-runtime/resource"
+)
+
+type hostCounter struct {
+	dropped *int
+	n       uint32
+}
+
+func (c *hostCounter) Drop() {
+	*c.dropped++
+}
+
+func defineCounter(dropped *int) (*ResourceDef[*hostCounter], error) {
+	def := DefineResource[*hostCounter]("test:counter/host@0.1.0", "counter")
+	if err := def.Constructor(func(ctx context.Context) *hostCounter {
+		return &hostCounter{dropped: dropped}
+	}); err != nil {
+		return nil, err
+	}
+	if err := def.Method("increment", func(ctx context.Context, c *hostCounter) {
+		c.n++
+	}); err != nil {
+		return nil, err
+	}
+	if err := def.Method("get", func(ctx context.Context, c *hostCounter) uint32 {
+		return c.n
+	}); err != nil {
+		return nil, err
+	}
+	return def, nil
+}
+
+func TestDefineResource_Counter(t *testing.T) {
+	ctx := context.Background()
+	wasm := loadTestbed(t, "counter.wasm")
+
+	rt, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.Close(ctx)
+
+	dropped := 0
+	def, err := defineCounter(&dropped)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rt.RegisterHost(def); err != nil {
+		t.Fatal(err)
+	}
+
+	mod, err := rt.LoadComponent(ctx, wasm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inst, err := mod.Instantiate(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer inst.Close(ctx)
+
+	got, err := inst.Call(ctx, "run-test", uint32(4))
+	if err != nil {
+		t.Fatalf("run-test: %v", err)
+	}
+	if got != uint32(4) {
+		t.Errorf("run-test(4) = %v, want 4", got)
+	}
+	if dropped != 1 {
+		t.Errorf("Drop called %d times, want 1", dropped)
+	}
+	if n := def.Table(withHostResources(ctx, inst.resources)).Len(); n != 0 {
+		t.Errorf("%d counters left in the table, want 0", n)
+	}
+
+	// Handles an instance still holds are dropped when it closes
+	other, err := mod.Instantiate(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	def.Table(withHostResources(ctx, other.resources)).Insert(&hostCounter{dropped: &dropped})
+	if n := def.Table(withHostResources(ctx, inst.resources)).Len(); n != 0 {
+		t.Errorf("a counter of another instance shows in the table: %d counters, want 0", n)
+	}
+	if err := other.Close(ctx); err != nil {
+		t.Fatal(err)
+	}
+	if dropped != 2 {
+		t.Errorf("Drop called %d times after Close, want 2", dropped)
+	}
+}
+
+func TestDefineResource_Handles(t *testing.T) {
+	ctx := withHostResources(context.Background(), &hostResources{})
+	dropped := 0
+	def, err := defineCounter(&dropped)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := def.Static("take", func(ctx context.Context, c Own[*hostCounter]) uint32 {
+		return c.Value.n
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	funcs := def.Register()
+	for _, name := range []string{
+		"[constructor]counter",
+		"[method]counter.increment",
+		"[method]counter.get",
+		"[static]counter.take",
+		"[resource-drop]counter",
+	} {
+		if funcs[name] == nil {
+			t.Errorf("Register() is missing %s", name)
+		}
+	}
+
+	ctor := funcs["[constructor]counter"].(func(context.Context) uint32)
+	increment := funcs["[method]counter.increment"].(func(context.Context, uint32))
+	take := funcs["[static]counter.take"].(func(context.Context, uint32) uint32)
+	drop := funcs["[resource-drop]counter"].(func(context.Context, uint32))
+
+	h := ctor(ctx)
+	increment(ctx, h)
+	increment(ctx, h)
+	if got := take(ctx, h); got != 2 {
+		t.Errorf("take() = %d, want 2", got)
+	}
+	if dropped != 0 {
+		t.Error("ownership transfer should not call Drop")
+	}
+
+	// The handle is gone: borrowing or dropping it traps
+	assertPanics(t, "increment of taken handle", func() { increment(ctx, h) })
+	assertPanics(t, "drop of taken handle", func() { drop(ctx, h) })
+
+	h = ctor(ctx)
+	var inner func(context.Context, uint32)
+	if err := def.Method("drop-self", func(ctx context.Context, c *hostCounter) {
+		inner(ctx, h)
+	}); err != nil {
+		t.Fatal(err)
+	}
+	inner = drop
+	dropSelf := def.Register()["[method]counter.drop-self"].(func(context.Context, uint32))
+	assertPanics(t, "drop of borrowed handle", func() { dropSelf(ctx, h) })
+
+	// The borrow ended with the trap, so the handle can be dropped
+	drop(ctx, h)
+	if dropped != 1 {
+		t.Errorf("Drop called %d times, want 1", dropped)
+	}
+}
+
+func TestDefineResource_InvalidHandlers(t *testing.T) {
+	def := DefineResource[*hostCounter]("test:counter/host@0.1.0", "counter")
+	mismatch := &errors.Error{Phase: errors.PhaseHost, Kind: errors.KindTypeMismatch}
+
+	if err := def.Constructor(func() uint32 { return 0 }); !stderrors.Is(err, mismatch) {
+		t.Errorf("Constructor returning uint32: error = %v, want type mismatch", err)
+	}
+	if err := def.Method("get", func(ctx context.Context, n uint32) uint32 { return n }); !stderrors.Is(err, mismatch) {
+		t.Errorf("Method without self: error = %v, want type mismatch", err)
+	}
+	if err := def.Static("make", "not a function"); !stderrors.Is(err, mismatch) {
+		t.Errorf("Static with non-function: error = %v, want type mismatch", err)
+	}
+	if err := def.Method("", func(c *hostCounter) {}); err == nil {
+		t.Error("Method with empty name should fail")
+	}
+}
+
+func TestDefineResource_Table(t *testing.T) {
+	dropped := 0
+	def, err := defineCounter(&dropped)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx := withHostResources(context.Background(), &hostResources{})
+	var table resource.TypedTable[*hostCounter] = def.Table(ctx)
+	table.Insert(&hostCounter{dropped: &dropped})
+	table.Insert(&hostCounter{dropped: &dropped})
+
+	if err := def.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if dropped != 2 || table.Len() != 0 {
+		t.Errorf("Close dropped %d counters and left %d, want 2 and 0", dropped, table.Len())
+	}
+}
+
+func TestDefineResource_TablePerInstance(t *testing.T) {
+	dropped := 0
+	def, err := defineCounter(&dropped)
+	if err != nil {
+		t.Fatal(err)
+	}
+	funcs := def.Register()
+	ctor := funcs["[constructor]counter"].(func(context.Context) uint32)
+	get := funcs["[method]counter.get"].(func(context.Context, uint32) uint32)
+
+	a, b := &hostResources{}, &hostResources{}
+	ctxA := withHostResources(context.Background(), a)
+	ctxB := withHostResources(context.Background(), b)
+
+	h := ctor(ctxA)
+	assertPanics(t, "handle of another instance", func() { get(ctxB, h) })
+	assertPanics(t, "call outside an instance", func() { get(context.Background(), h) })
+	if def.Table(context.Background()) != nil {
+		t.Error("Table outside an instance should be nil")
+	}
+	if def.Table(ctxA).Len() != 1 || def.Table(ctxB).Len() != 0 {
+		t.Errorf("tables hold %d and %d counters, want 1 and 0", def.Table(ctxA).Len(), def.Table(ctxB).Len())
+	}
+
+	a.close()
+	if dropped != 1 {
+		t.Errorf("Drop called %d times after closing the instance, want 1", dropped)
+	}
+	if def.Table(ctxA) != nil {
+		t.Error("Table of a closed instance should be nil")
+	}
+	b.close()
+}
+
+func assertPanics(t *testing.T, what string, fn func()) {
+	t.Helper()
+	defer func() {
+		if recover() == nil {
+			t.Errorf("%s: expected a trap", what)
+		}
+	}()
+	fn()
+}
@@ -2,19 +2,45 @@ package runtime
 
 import (
 	"context"
+	"sync"
 
 	"github.com/wippyai/wasm-runtime/component"
 	"github.com/wippyai/wasm-runtime/engine"
 	"github.com/wippyai/wasm-runtime/errors"
+	"github.com/wippyai/wasm-runtime/linker"
+	"github.com/wippyai/wasm-runtime/trace"
 )
 
 type Runtime struct {
-	engine *engine.WazeroEngine
-	hosts  *HostRegistry
+	engine      *engine.WazeroEngine
+	hosts       *HostRegistry
+	interceptor trace.Interceptor
+	tables      []tracedTable
+	traceMu     sync.Mutex
+}
+
+// Config configures a Runtime
+type Config struct {
+	// Profiling compiles modules so calls can be profiled with
+	// Instance.SetProfiler or profile.WithProfiler. It slows every call.
+	Profiling bool
+
+	// StubImports defines the component imports no host function
+	// provides as stubs that trap or return defaults when called, instead
+	// of failing to instantiate. Instance.Diagnostics lists them.
+	StubImports linker.StubMode
 }
 
 func New(ctx context.Context) (*Runtime, error) {
-	eng, err := engine.NewWazeroEngine(ctx)
+	return NewWithConfig(ctx, Config{})
+}
+
+// NewWithConfig creates a runtime with cfg.
+func NewWithConfig(ctx context.Context, cfg Config) (*Runtime, error) {
+	eng, err := engine.NewWazeroEngineWithConfig(ctx, &engine.Config{
+		EnableProfiling: cfg.Profiling,
+		StubImports:     cfg.StubImports,
+	})
 	if err != nil {
 		return nil, errors.Load("create engine", err)
 	}
@@ -35,7 +61,13 @@ func (r *Runtime) Close(ctx context.Context) error {
 // Must be called BEFORE loading modules that import these functions.
 // Method names are converted from PascalCase to kebab-case (GetValue -> get-value).
 func (r *Runtime) RegisterHost(h Host) error {
-	return r.hosts.RegisterHost(h)
+	if err := r.hosts.RegisterHost(h); err != nil {
+		return err
+	}
+	if th, ok := h.(tableHost); ok {
+		r.traceTable(th.resourceTable())
+	}
+	return nil
 }
 
 func (r *Runtime) RegisterFunc(namespace, name string, fn any) error {
@@ -58,7 +90,7 @@ func (r *Runtime) LoadComponent(ctx context.Context, wasm []byte) (*Module, erro
 		return nil, errors.Load("load module", err)
 	}
 
-	if err := r.hosts.Bind(wazeroModule); err != nil {
+	if err := r.hosts.bind(wazeroModule, r.tracer()); err != nil {
 		return nil, errors.Load("bind hosts", err)
 	}
 
@@ -71,7 +103,9 @@ func (r *Runtime) LoadComponent(ctx context.Context, wasm []byte) (*Module, erro
 
 // LoadWASM loads a core WebAssembly module (not Component Model).
 // witText provides function signatures for type-safe calls since core
-// modules lack type metadata.
+// modules lack type metadata. It may be a full WIT document or a list of
+// `name: func(...)` signatures; it is parsed here so syntax errors surface
+// at load time with their line and column.
 func (r *Runtime) LoadWASM(ctx context.Context, wasm []byte, witText string) (*Module, error) {
 	if component.IsComponent(wasm) {
 		return nil, errors.InvalidInput(errors.PhaseLoad, "use LoadComponent for component binaries")
@@ -82,14 +116,20 @@ func (r *Runtime) LoadWASM(ctx context.Context, wasm []byte, witText string) (*M
 		return nil, errors.Load("load module", err)
 	}
 
-	if err := r.hosts.Bind(wazeroModule); err != nil {
+	if err := r.hosts.bind(wazeroModule, r.tracer()); err != nil {
 		return nil, errors.Load("bind hosts", err)
 	}
 
-	return &Module{
+	mod := &Module{
 		runtime:      r,
 		wazeroModule: wazeroModule,
 		isComponent:  false,
 		witText:      witText,
-	}, nil
+	}
+	if witText != "" {
+		if err := mod.loadFuncTypes(); err != nil {
+			return nil, err
+		}
+	}
+	return mod, nil
 }
@@ -0,0 +1,47 @@
+package runtime
+
+import (
+	"context"
+	"os"
+	"testing"
+
+	"github.com/wippyai/wasm-runtime/linker"
+)
+
+func TestConfig_StubImports(t *testing.T) {
+	ctx := context.Background()
+	wasmBytes, err := os.ReadFile("../testbed/minimal.wasm")
+	if err != nil {
+		t.Skip("minimal.wasm not found")
+	}
+
+	rt, err := NewWithConfig(ctx, Config{StubImports: linker.StubDefault})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.Close(ctx)
+
+	// No host provides test:minimal/host
+	mod, err := rt.LoadComponent(ctx, wasmBytes)
+	if err != nil {
+		t.Fatalf("load component: %v", err)
+	}
+	inst, err := mod.Instantiate(ctx)
+	if err != nil {
+		t.Fatalf("instantiate: %v", err)
+	}
+	defer inst.Close(ctx)
+
+	diags := inst.Diagnostics()
+	if len(diags) != 1 || diags[0].Import != "test:minimal/host@0.1.0#add" || diags[0].Stub != linker.StubDefault {
+		t.Errorf("Diagnostics() = %v", diags)
+	}
+
+	result, err := inst.Call(ctx, "compute-using-host", uint32(3), uint32(4))
+	if err != nil {
+		t.Fatalf("call: %v", err)
+	}
+	if result != uint32(0) {
+		t.Errorf("compute-using-host(3, 4) = %v, want 0 from the stub", result)
+	}
+}
@@ -0,0 +1,149 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/wippyai/wasm-runtime/resource"
+	"github.com/wippyai/wasm-runtime/trace"
+)
+
+// observable is a resource table with lifecycle observers
+type observable interface {
+	Subscribe(resource.Observer)
+	Unsubscribe(resource.Observer)
+}
+
+// tracedTable is a host resource table reported to the interceptor
+type tracedTable struct {
+	table    observable
+	observer resource.Observer
+	name     string
+}
+
+// SetInterceptor reports export calls, host import calls and host
+// resource events to i, or stops reporting when i is nil. Like
+// RegisterHost, it must be called before loading modules: host imports
+// are traced when a module binds them.
+func (r *Runtime) SetInterceptor(i trace.Interceptor) {
+	r.traceMu.Lock()
+	defer r.traceMu.Unlock()
+	r.interceptor = i
+	for k := range r.tables {
+		r.observe(&r.tables[k])
+	}
+}
+
+// traceTable reports the events of table to the interceptor, now and
+// after later calls of SetInterceptor.
+func (r *Runtime) traceTable(name string, table observable) {
+	r.traceMu.Lock()
+	defer r.traceMu.Unlock()
+	r.tables = append(r.tables, tracedTable{name: name, table: table})
+	r.observe(&r.tables[len(r.tables)-1])
+}
+
+// observe replaces the observer of t. r.traceMu must be held.
+func (r *Runtime) observe(t *tracedTable) {
+	if t.observer != nil {
+		t.table.Unsubscribe(t.observer)
+		t.observer = nil
+	}
+	if r.interceptor != nil {
+		t.observer = trace.Observer(r.interceptor, t.name)
+		t.table.Subscribe(t.observer)
+	}
+}
+
+func (r *Runtime) tracer() trace.Interceptor {
+	r.traceMu.Lock()
+	defer r.traceMu.Unlock()
+	return r.interceptor
+}
+
+// tableHost is implemented by hosts owning a resource table
+type tableHost interface {
+	resourceTable() (string, observable)
+}
+
+// traceHost wraps a host handler so its calls are reported to i. The
+// context.Context parameter, when present, carries the call so guest
+// calls the handler makes nest under it.
+func traceHost(i trace.Interceptor, namespace, name string, handler any) any {
+	fv := reflect.ValueOf(handler)
+	ft := fv.Type()
+	hasCtx := ft.NumIn() > 0 && ft.In(0) == contextType
+	hasErr := ft.NumOut() > 0 && ft.Out(ft.NumOut()-1) == errorType
+
+	return reflect.MakeFunc(ft, func(in []reflect.Value) (out []reflect.Value) {
+		call := &trace.Call{Kind: trace.KindImport, Namespace: namespace, Function: name}
+		args := in
+		ctx := context.Background()
+		if hasCtx {
+			args = in[1:]
+			if c, ok := in[0].Interface().(context.Context); ok && c != nil {
+				ctx = c
+			}
+		}
+		call.Args = values(args)
+		ctx = trace.Begin(ctx, i, call)
+		if hasCtx {
+			in = append([]reflect.Value{reflect.ValueOf(ctx)}, args...)
+		}
+
+		defer func() {
+			if p := recover(); p != nil {
+				call.Err = fmt.Errorf("host panic: %v", p)
+				trace.End(ctx, i, call)
+				panic(p)
+			}
+		}()
+		out = fv.Call(in)
+
+		results := out
+		if hasErr {
+			results = out[:len(out)-1]
+			if err, _ := out[len(out)-1].Interface().(error); err != nil {
+				call.Err = err
+			}
+		}
+		call.Results = values(results)
+		trace.End(ctx, i, call)
+		return out
+	}).Interface()
+}
+
+func values(vs []reflect.Value) []any {
+	if len(vs) == 0 {
+		return nil
+	}
+	out := make([]any, len(vs))
+	for i, v := range vs {
+		out[i] = v.Interface()
+	}
+	return out
+}
+
+// begin starts the profiling and tracing of an export call, whose host
+// resources live in i's tables. The returned function ends them with the
+// call's results.
+func (i *Instance) begin(ctx context.Context, name string, args []any) (context.Context, func(results []any, err error)) {
+	ctx = withHostResources(ctx, i.resources)
+	ctx, done := i.profile(ctx, name)
+	var tr trace.Interceptor
+	if i.module != nil {
+		tr = i.module.runtime.tracer()
+	}
+	if tr == nil {
+		return ctx, func([]any, error) { done() }
+	}
+	call := &trace.Call{Kind: trace.KindExport, Args: args, Source: i.wazeroInstance.Source(name)}
+	call.Namespace, call.Function = trace.SplitName(name)
+	ctx = trace.Begin(ctx, tr, call)
+	return ctx, func(results []any, err error) {
+		done()
+		call.Results, call.Err = results, err
+		trace.End(ctx, tr, call)
+	}
+}
@@ -0,0 +1,155 @@
+package runtime
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+
+	wasmruntime "github.com/wippyai/wasm-runtime"
+	"github.com/wippyai/wasm-runtime/resource"
+	"github.com/wippyai/wasm-runtime/trace"
+)
+
+// recorder records calls as "kind name depth" and resource events as
+// "table event"
+type recorder struct {
+	events []string
+	mu     sync.Mutex
+}
+
+func (r *recorder) add(s string) {
+	r.mu.Lock()
+	r.events = append(r.events, s)
+	r.mu.Unlock()
+}
+
+func (r *recorder) Before(ctx context.Context, call *trace.Call) context.Context {
+	r.add(fmt.Sprintf("%s %s %d", call.Kind, call.Name(), call.Depth()))
+	return ctx
+}
+
+func (r *recorder) After(ctx context.Context, call *trace.Call) {
+	if trace.FromContext(ctx) != call {
+		r.add("wrong context for " + call.Name())
+	}
+	r.add(fmt.Sprintf("return %s %v %v", call.Function, call.Results, call.Err))
+}
+
+func (r *recorder) Resource(e trace.ResourceEvent) {
+	if e.Type == resource.EventCreated || e.Type == resource.EventDropped {
+		r.add(fmt.Sprintf("%s %d", e.Table, e.Type))
+	}
+}
+
+func TestRuntime_SetInterceptor(t *testing.T) {
+	ctx := context.Background()
+	wasm := loadTestbed(t, "counter.wasm")
+
+	rt, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.Close(ctx)
+
+	dropped := 0
+	def, err := defineCounter(&dropped)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := rt.RegisterHost(def); err != nil {
+		t.Fatal(err)
+	}
+	rec := &recorder{}
+	rt.SetInterceptor(rec)
+
+	mod, err := rt.LoadComponent(ctx, wasm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	inst, err := mod.Instantiate(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer inst.Close(ctx)
+
+	if _, err := inst.Call(ctx, "run-test", uint32(2)); err != nil {
+		t.Fatal(err)
+	}
+
+	const ns = "test:counter/host@0.1.0"
+	want := []string{
+		"export run-test 0",
+		"import " + ns + "#[constructor]counter 1",
+		ns + "#counter 0",
+		"return [constructor]counter [1] <nil>",
+		"import " + ns + "#[method]counter.increment 1",
+		"return [method]counter.increment [] <nil>",
+		"import " + ns + "#[method]counter.increment 1",
+		"return [method]counter.increment [] <nil>",
+		"import " + ns + "#[method]counter.get 1",
+		"return [method]counter.get [2] <nil>",
+		"import " + ns + "#[resource-drop]counter 1",
+		ns + "#counter 1",
+		"return [resource-drop]counter [] <nil>",
+		"return run-test [2] <nil>",
+	}
+	if got := strings.Join(rec.events, "\n"); got != strings.Join(want, "\n") {
+		t.Errorf("events:\n%s\nwant:\n%s", got, strings.Join(want, "\n"))
+	}
+
+	// Removing the interceptor stops resource events
+	rt.SetInterceptor(nil)
+	n := len(rec.events)
+	def.Table(withHostResources(ctx, inst.resources)).Insert(&hostCounter{dropped: &dropped})
+	if len(rec.events) != n {
+		t.Errorf("events after SetInterceptor(nil): %v", rec.events[n:])
+	}
+}
+
+// lastCall keeps the last call started
+type lastCall struct {
+	call *trace.Call
+}
+
+func (l *lastCall) Before(ctx context.Context, call *trace.Call) context.Context {
+	l.call = call
+	return ctx
+}
+
+func (l *lastCall) After(context.Context, *trace.Call) {}
+
+func (l *lastCall) Resource(trace.ResourceEvent) {}
+
+func TestRuntime_InterceptorSource(t *testing.T) {
+	ctx := context.Background()
+	rt, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.Close(ctx)
+	last := &lastCall{}
+	rt.SetInterceptor(last)
+
+	module, err := os.ReadFile("testdata/dwarf_trap.wasm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mod, err := rt.LoadWASM(ctx, module, "run: func()")
+	if err != nil {
+		t.Fatal(err)
+	}
+	inst, err := mod.InstantiateWithLimiter(ctx, wasmruntime.NewLimiter(wasmruntime.Limits{TableElements: 4}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer inst.Close(ctx)
+
+	// run traps; its call still carries where it is declared
+	_, _ = inst.Call(ctx, "run")
+	if last.call == nil || last.call.Source != "lib.rs:9" {
+		t.Errorf("call = %+v, want source lib.rs:9", last.call)
+	}
+}
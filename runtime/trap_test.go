@@ -0,0 +1,197 @@
+package runtime
+
+import (
+	"context"
+	stderrors "errors"
+	"os"
+	"slices"
+	"strings"
+	"testing"
+
+	wasmruntime "github.com/wippyai/wasm-runtime"
+	"github.com/wippyai/wasm-runtime/errors"
+	"github.com/wippyai/wasm-runtime/wat"
+)
+
+// trapWAT traps in its first function, named with a Rust mangled name,
+// called from the exported run.
+const trapWAT = `(module
+	(func $_ZN4demo4boom17h0123456789abcdefE (result i32)
+		unreachable)
+	(func $run (export "run") (result i32)
+		(call $_ZN4demo4boom17h0123456789abcdefE))
+	(func (export "div") (param i32) (result i32)
+		(i32.div_u (i32.const 1) (local.get 0))))
+`
+
+func trapOf(t *testing.T, err error) *errors.Trap {
+	t.Helper()
+	if !stderrors.Is(err, &errors.Error{Phase: errors.PhaseRuntime, Kind: errors.KindTrap}) {
+		t.Fatalf("error = %v, want a trap", err)
+	}
+	var e *errors.Error
+	stderrors.As(err, &e)
+	if e.Trap == nil {
+		t.Fatal("trap error has no Trap")
+	}
+	return e.Trap
+}
+
+func TestCall_TrapBacktrace(t *testing.T) {
+	ctx := context.Background()
+	rt, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.Close(ctx)
+
+	module, err := wat.Compile(trapWAT)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mod, err := rt.LoadWASM(ctx, module, "run: func() -> u32\ndiv: func(d: u32) -> u32")
+	if err != nil {
+		t.Fatal(err)
+	}
+	inst, err := mod.Instantiate(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer inst.Close(ctx)
+
+	_, err = inst.Call(ctx, "run")
+	trap := trapOf(t, err)
+	if trap.Code != errors.TrapUnreachable {
+		t.Errorf("code = %s, want unreachable", trap.Code)
+	}
+	if trap.Export != "run" || trap.Interface != "" {
+		t.Errorf("export = %q, interface = %q", trap.Export, trap.Interface)
+	}
+	if len(trap.Frames) != 2 {
+		t.Fatalf("frames = %v, want 2", trap.Frames)
+	}
+	if f := trap.Frames[0]; f.Name != "demo::boom" || f.Index != 0 {
+		t.Errorf("frame 0 = %+v, want demo::boom at index 0", f)
+	}
+	if f := trap.Frames[1]; f.Name != "run" || f.Index != 1 {
+		t.Errorf("frame 1 = %+v, want run at index 1", f)
+	}
+	if msg := err.Error(); !strings.Contains(msg, "wasm backtrace:") || !strings.Contains(msg, "demo::boom (func 0)") {
+		t.Errorf("error message lacks the backtrace:\n%s", msg)
+	}
+
+	// Unnamed functions keep their index
+	_, err = inst.Call(ctx, "div", uint32(0))
+	trap = trapOf(t, err)
+	if trap.Code != errors.TrapIntegerDivideByZero {
+		t.Errorf("code = %s, want integer_divide_by_zero", trap.Code)
+	}
+	if len(trap.Frames) != 1 || trap.Frames[0].Index != 2 || trap.Frames[0].Name != "" {
+		t.Errorf("frames = %+v, want unnamed func 2", trap.Frames)
+	}
+
+	// The instance stays usable after a trap
+	if got, err := inst.Call(ctx, "div", uint32(1)); err != nil || got != uint32(1) {
+		t.Errorf("div(1) = %v, %v", got, err)
+	}
+}
+
+// trapComponent lifts run from trapWAT as "my:demo/api#run".
+func trapComponent(t *testing.T) []byte {
+	t.Helper()
+	module, err := wat.Compile(trapWAT)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return concatBytes(
+		[]byte{0x00, 0x61, 0x73, 0x6d, 0x0d, 0x00, 0x01, 0x00},
+		testSection(1, module...),
+		testSection(2, 0x01, 0x00, 0x00, 0x00),
+		testSection(6, concatBytes([]byte{0x01, 0x00, 0x00, 0x01, 0x00}, testName("run"))...),
+		testSection(7, 0x01, 0x40, 0x00, 0x00, 0x79),
+		testSection(8, 0x01, 0x00, 0x00, 0x00, 0x00, 0x00),
+		testSection(11, concatBytes([]byte{0x01, 0x00}, testName("run"), []byte{0x01, 0x00, 0x00})...),
+	)
+}
+
+func TestCall_TrapInComponent(t *testing.T) {
+	ctx := context.Background()
+	rt, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.Close(ctx)
+
+	mod, err := rt.LoadComponent(ctx, trapComponent(t))
+	if err != nil {
+		t.Fatal(err)
+	}
+	inst, err := mod.Instantiate(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer inst.Close(ctx)
+
+	_, err = inst.Call(ctx, "run")
+	trap := trapOf(t, err)
+	if trap.Code != errors.TrapUnreachable {
+		t.Errorf("code = %s, want unreachable", trap.Code)
+	}
+	if len(trap.Frames) < 2 || trap.Frames[0].Name != "demo::boom" || trap.Frames[0].Index != 0 {
+		t.Errorf("frames = %+v, want demo::boom first", trap.Frames)
+	}
+
+	li := inst.wazeroInstance.LinkerInstance()
+	if li == nil {
+		t.Fatal("component has no linker instance")
+	}
+	_, err = li.Call(ctx, "run")
+	if trap := trapOf(t, err); trap.Export != "run" || trap.Frames[1].Name != "run" {
+		t.Errorf("linker trap = %+v", trap)
+	}
+}
+
+func TestCall_TrapSourceLines(t *testing.T) {
+	ctx := context.Background()
+	rt, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.Close(ctx)
+
+	// run, function 1 at [0x10, 0x30), grows its table at 0x1a and traps
+	// at 0x1d, in code inlined from helper; see wasm/dwarf's testSections
+	module, err := os.ReadFile("testdata/dwarf_trap.wasm")
+	if err != nil {
+		t.Fatal(err)
+	}
+	mod, err := rt.LoadWASM(ctx, module, "run: func()")
+	if err != nil {
+		t.Fatal(err)
+	}
+	plain, err := mod.Instantiate(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer plain.Close(ctx)
+	// The limiter recompiles run with table.grow instrumented, which moves
+	// the trap to 0x22 and the function to index 2
+	limited, err := mod.InstantiateWithLimiter(ctx, wasmruntime.NewLimiter(wasmruntime.Limits{TableElements: 4}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer limited.Close(ctx)
+
+	want := errors.Frame{Index: 1, Offset: 0x1d, Source: []string{"lib.rs:3:9 (inlined)", "lib.rs:20:7"}}
+	for name, inst := range map[string]*Instance{"plain": plain, "limited": limited} {
+		_, err := inst.Call(ctx, "run")
+		trap := trapOf(t, err)
+		if len(trap.Frames) != 1 {
+			t.Fatalf("%s: frames = %+v, want 1", name, trap.Frames)
+		}
+		f := trap.Frames[0]
+		if f.Index != want.Index || f.Offset != want.Offset || !slices.Equal(f.Source, want.Source) {
+			t.Errorf("%s: frame = %+v, want %+v", name, f, want)
+		}
+	}
+}
@@ -15,6 +15,7 @@ import (
 // RegisterWASI registers all WASI Preview2 host implementations.
 func (r *Runtime) RegisterWASI(wasi *preview2.WASI) error {
 	resources := wasi.Resources()
+	r.traceTable("wasi", resources)
 
 	// registerHost wraps RegisterHost with structured error
 	registerHost := func(h Host, namespace string) error {
@@ -3,15 +3,22 @@ package runtime
 import (
 	"context"
 
+	"github.com/wippyai/wasm-runtime/component"
 	"github.com/wippyai/wasm-runtime/errors"
 	"github.com/wippyai/wasm-runtime/wat"
 )
 
+// LoadWAT compiles WAT text and loads the result. A (module ...) is loaded
+// as core WASM typed by witTypes; a (component ...) is loaded as a
+// component, which carries its own types, and witTypes is ignored.
 func (r *Runtime) LoadWAT(ctx context.Context, watText, witTypes string) (*Module, error) {
 	wasm, err := wat.Compile(watText)
 	if err != nil {
 		return nil, errors.ParseFailed("WAT", err)
 	}
 
+	if component.IsComponent(wasm) {
+		return r.LoadComponent(ctx, wasm)
+	}
 	return r.LoadWASM(ctx, wasm, witTypes)
 }
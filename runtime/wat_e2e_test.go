@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/wippyai/wasm-runtime/wat"
 	"go.bytecodealliance.org/wit"
 )
 
@@ -554,3 +555,136 @@ func TestWAT_E2E_DataSection(t *testing.T) {
 		t.Errorf("strlen = %v, want %d", result, len(expected))
 	}
 }
+
+// TestWAT_E2E_Component tests a component written in WAT through full stack
+func TestWAT_E2E_Component(t *testing.T) {
+	ctx := context.Background()
+	rt, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.Close(ctx)
+
+	mod, err := rt.LoadWAT(ctx, `(component
+		(core module $m
+			(memory (export "memory") 1)
+			(global $top (mut i32) (i32.const 1024))
+			(func (export "cabi_realloc") (param i32 i32 i32 i32) (result i32)
+				(global.get $top)
+				(global.set $top (i32.add (global.get $top) (local.get 3))))
+			(func (export "add") (param i32 i32) (result i32)
+				(i32.add (local.get 0) (local.get 1)))
+			(func (export "len") (param i32 i32) (result i32)
+				(local.get 1)))
+		(core instance $i (instantiate $m))
+		(func (export "add") (param "a" u32) (param "b" u32) (result u32)
+			(canon lift (core func $i "add")))
+		(func (export "len") (param "s" string) (result u32)
+			(canon lift (core func $i "len") (memory $i "memory") (realloc (func $i "cabi_realloc")))))`, "")
+	if err != nil {
+		t.Fatalf("LoadWAT: %v", err)
+	}
+
+	inst, err := mod.Instantiate(ctx)
+	if err != nil {
+		t.Fatalf("Instantiate: %v", err)
+	}
+	defer inst.Close(ctx)
+
+	sum, err := inst.Call(ctx, "add", uint32(2), uint32(40))
+	if err != nil {
+		t.Fatalf("add: %v", err)
+	}
+	if sum != uint32(42) {
+		t.Errorf("add(2, 40) = %v, want 42", sum)
+	}
+
+	n, err := inst.Call(ctx, "len", "hello")
+	if err != nil {
+		t.Fatalf("len: %v", err)
+	}
+	if n != uint32(5) {
+		t.Errorf("len(\"hello\") = %v, want 5", n)
+	}
+}
+
+// TestWAT_E2E_ComposedComponent loads a component that, like the output of
+// wac or wasm-tools compose, instantiates a nested component and re-exports
+// its functions.
+func TestWAT_E2E_ComposedComponent(t *testing.T) {
+	ctx := context.Background()
+	rt, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.Close(ctx)
+
+	wasm, err := wat.Compile(`(component
+		(component $impl
+			(core module $m
+				(memory (export "memory") 1)
+				(global $top (mut i32) (i32.const 1024))
+				(data (i32.const 8) "\10\00\00\00\05\00\00\00")
+				(data (i32.const 16) "hello")
+				(func (export "cabi_realloc") (param i32 i32 i32 i32) (result i32)
+					(global.get $top)
+					(global.set $top (i32.add (global.get $top) (local.get 3))))
+				(func (export "add") (param i32 i32) (result i32)
+					(i32.add (local.get 0) (local.get 1)))
+				(func (export "len") (param i32 i32) (result i32)
+					(local.get 1))
+				(func (export "greeting") (result i32)
+					(i32.const 8)))
+			(core instance $i (instantiate $m))
+			(func (export "add") (param "a" u32) (param "b" u32) (result u32)
+				(canon lift (core func $i "add")))
+			(func (export "len") (param "s" string) (result u32)
+				(canon lift (core func $i "len") (memory $i "memory") (realloc (func $i "cabi_realloc"))))
+			(func (export "greeting") (result string)
+				(canon lift (core func $i "greeting") (memory $i "memory"))))
+		(instance $a (instantiate $impl))
+		(alias export $a "add" (func $add))
+		(alias export $a "len" (func $len))
+		(alias export $a "greeting" (func $greeting))
+		(export "add" (func $add))
+		(export "len" (func $len))
+		(export "greeting" (func $greeting)))`)
+	if err != nil {
+		t.Fatalf("Compile: %v", err)
+	}
+
+	mod, err := rt.LoadComponent(ctx, wasm)
+	if err != nil {
+		t.Fatalf("LoadComponent: %v", err)
+	}
+	inst, err := mod.Instantiate(ctx)
+	if err != nil {
+		t.Fatalf("Instantiate: %v", err)
+	}
+	defer inst.Close(ctx)
+
+	sum, err := inst.Call(ctx, "add", uint32(2), uint32(40))
+	if err != nil {
+		t.Fatalf("add: %v", err)
+	}
+	if sum != uint32(42) {
+		t.Errorf("add(2, 40) = %v, want 42", sum)
+	}
+
+	n, err := inst.Call(ctx, "len", "hello")
+	if err != nil {
+		t.Fatalf("len: %v", err)
+	}
+	if n != uint32(5) {
+		t.Errorf("len(\"hello\") = %v, want 5", n)
+	}
+
+	// Results are read from the nested instance's memory
+	greeting, err := inst.Call(ctx, "greeting")
+	if err != nil {
+		t.Fatalf("greeting: %v", err)
+	}
+	if greeting != "hello" {
+		t.Errorf("greeting() = %q, want hello", greeting)
+	}
+}
@@ -0,0 +1,142 @@
+package runtime
+
+import (
+	"context"
+	stderrors "errors"
+	"testing"
+
+	"github.com/wippyai/wasm-runtime/errors"
+	"github.com/wippyai/wasm-runtime/witparse"
+)
+
+const witE2EWAT = `(module
+	(memory (export "memory") 1)
+	(global $heap (mut i32) (i32.const 1024))
+
+	(func (export "cabi_realloc") (param i32 i32 i32 i32) (result i32)
+		(local $p i32)
+		(local.set $p (global.get $heap))
+		(global.set $heap (i32.add (global.get $heap) (local.get 3)))
+		(local.get $p))
+
+	;; make-point: func(x: s32, y: s32) -> point
+	(func (export "make-point") (param $x i32) (param $y i32) (result i32)
+		(i32.store (i32.const 16) (local.get $x))
+		(i32.store (i32.const 20) (local.get $y))
+		(i32.const 16))
+
+	;; sum: func(p: point) -> s32
+	(func (export "sum") (param i32 i32) (result i32)
+		(i32.add (local.get 0) (local.get 1)))
+
+	;; byte-len: func(s: string) -> u32
+	(func (export "byte-len") (param i32 i32) (result i32)
+		(local.get 1))
+
+	;; classify: func(n: s32) -> sign
+	(func (export "classify") (param $n i32) (result i32)
+		(if (result i32) (i32.lt_s (local.get $n) (i32.const 0))
+			(then (i32.const 0))
+			(else (if (result i32) (i32.eqz (local.get $n))
+				(then (i32.const 1))
+				(else (i32.const 2)))))))
+`
+
+const witE2ETypes = `
+package test:shapes;
+
+interface types {
+	/// A point on the plane.
+	record point { x: s32, y: s32 }
+	enum sign { negative, zero, positive }
+}
+
+world shapes {
+	use types.{point, sign};
+
+	export make-point: func(x: s32, y: s32) -> point;
+	export sum: func(p: point) -> s32;
+	export byte-len: func(s: string) -> u32;
+	export classify: func(n: s32) -> sign;
+}
+`
+
+func TestWIT_E2E_ComplexTypes(t *testing.T) {
+	ctx := context.Background()
+	rt, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.Close(ctx)
+
+	mod, err := rt.LoadWAT(ctx, witE2EWAT, witE2ETypes)
+	if err != nil {
+		t.Fatalf("LoadWAT: %v", err)
+	}
+	inst, err := mod.Instantiate(ctx)
+	if err != nil {
+		t.Fatalf("Instantiate: %v", err)
+	}
+	defer inst.Close(ctx)
+
+	type Point struct {
+		X int32
+		Y int32
+	}
+
+	var p Point
+	if err := inst.Invoke(ctx, "make-point", &p, int32(3), int32(-7)); err != nil {
+		t.Fatalf("make-point: %v", err)
+	}
+	if p != (Point{X: 3, Y: -7}) {
+		t.Errorf("make-point = %+v, want {3 -7}", p)
+	}
+
+	var sum int32
+	if err := inst.Invoke(ctx, "sum", &sum, map[string]any{"x": int32(40), "y": int32(2)}); err != nil {
+		t.Fatalf("sum: %v", err)
+	}
+	if sum != 42 {
+		t.Errorf("sum = %d, want 42", sum)
+	}
+
+	n, err := inst.Call(ctx, "byte-len", "héllo")
+	if err != nil {
+		t.Fatalf("byte-len: %v", err)
+	}
+	if n != uint32(6) {
+		t.Errorf("byte-len = %v, want 6", n)
+	}
+
+	var sign uint8
+	if err := inst.Invoke(ctx, "classify", &sign, int32(-5)); err != nil {
+		t.Fatalf("classify: %v", err)
+	}
+	if sign != 0 {
+		t.Errorf("classify(-5) = %d, want 0 (negative)", sign)
+	}
+}
+
+func TestWIT_E2E_SyntaxErrorPosition(t *testing.T) {
+	ctx := context.Background()
+	rt, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rt.Close(ctx)
+
+	_, err = rt.LoadWAT(ctx, `(module)`, "add: func(a: s32) -> s32\nsub: func(a: s32 -> s32\n")
+	if err == nil {
+		t.Fatal("expected error for malformed WIT")
+	}
+	if !stderrors.Is(err, &errors.Error{Phase: errors.PhaseParse, Kind: errors.KindInvalidData}) {
+		t.Errorf("error = %v, want parse error", err)
+	}
+	var pe *witparse.PosError
+	if !stderrors.As(err, &pe) {
+		t.Fatalf("error %v does not carry a position", err)
+	}
+	if pe.Line != 2 || pe.Column != 18 {
+		t.Errorf("position = %d:%d, want 2:18", pe.Line, pe.Column)
+	}
+}
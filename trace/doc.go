@@ -0,0 +1,34 @@
+// Package trace defines the interceptor a runtime reports guest calls
+// and resource lifecycle events to, with zap and slog implementations.
+//
+// An Interceptor sees every export call with its lifted arguments and
+// results, every host import call the guest makes, and the create, drop
+// and borrow events of host resource tables:
+//
+//	rt.SetInterceptor(trace.NewZapLogger(logger))
+//
+// Calls nest through the context: Before returns the context the call
+// runs with, and a host import called during an export, or an export
+// called from a host import, gets the enclosing call as Parent. A tracing
+// exporter starts a span in Before and ends it in After:
+//
+//	func (t *tracer) Before(ctx context.Context, call *trace.Call) context.Context {
+//	    ctx, _ = t.otel.Start(ctx, call.Name())
+//	    return ctx
+//	}
+//
+//	func (t *tracer) After(ctx context.Context, call *trace.Call) {
+//	    span := oteltrace.SpanFromContext(ctx)
+//	    if call.Err != nil {
+//	        span.RecordError(call.Err)
+//	    }
+//	    span.End()
+//	}
+//
+// Export calls of modules built with debug info carry the file and line
+// of the guest function in Source, resolved from DWARF against the module
+// as loaded.
+//
+// Host handlers without a context.Context parameter cannot pass the call
+// on, so exports they call start at depth 0.
+package trace
@@ -0,0 +1,130 @@
+package trace
+
+import (
+	"context"
+	"log/slog"
+
+	"go.uber.org/zap"
+
+	"github.com/wippyai/wasm-runtime/resource"
+)
+
+// eventNames are the log messages of resource events
+var eventNames = map[resource.EventType]string{
+	resource.EventCreated:        "resource created",
+	resource.EventDropped:        "resource dropped",
+	resource.EventBorrowed:       "resource borrowed",
+	resource.EventBorrowReturned: "resource borrow returned",
+}
+
+// ZapLogger logs calls at debug level and failed calls at warn level.
+// Arguments and results are logged only when Values is set.
+type ZapLogger struct {
+	Logger *zap.Logger
+	Values bool
+}
+
+// NewZapLogger returns an interceptor logging to logger.
+func NewZapLogger(logger *zap.Logger) *ZapLogger {
+	return &ZapLogger{Logger: logger}
+}
+
+func (l *ZapLogger) Before(ctx context.Context, call *Call) context.Context {
+	if ce := l.Logger.Check(zap.DebugLevel, call.Kind.String()+" call"); ce != nil {
+		fields := []zap.Field{zap.String("function", call.Name()), zap.Int("depth", call.Depth())}
+		if call.Source != "" {
+			fields = append(fields, zap.String("source", call.Source))
+		}
+		if l.Values {
+			fields = append(fields, zap.Any("args", call.Args))
+		}
+		ce.Write(fields...)
+	}
+	return ctx
+}
+
+func (l *ZapLogger) After(_ context.Context, call *Call) {
+	level := zap.DebugLevel
+	if call.Err != nil {
+		level = zap.WarnLevel
+	}
+	ce := l.Logger.Check(level, call.Kind.String()+" return")
+	if ce == nil {
+		return
+	}
+	fields := []zap.Field{
+		zap.String("function", call.Name()),
+		zap.Int("depth", call.Depth()),
+		zap.Duration("duration", call.Duration),
+	}
+	if l.Values {
+		fields = append(fields, zap.Any("results", call.Results))
+	}
+	if call.Err != nil {
+		fields = append(fields, zap.Error(call.Err))
+	}
+	ce.Write(fields...)
+}
+
+func (l *ZapLogger) Resource(e ResourceEvent) {
+	l.Logger.Debug(eventNames[e.Type],
+		zap.String("table", e.Table),
+		zap.Uint32("handle", uint32(e.Handle)),
+		zap.Uint32("type_id", e.TypeID))
+}
+
+// SlogLogger logs calls at debug level and failed calls at warn level.
+// Arguments and results are logged only when Values is set.
+type SlogLogger struct {
+	Logger *slog.Logger
+	Values bool
+}
+
+// NewSlogLogger returns an interceptor logging to logger.
+func NewSlogLogger(logger *slog.Logger) *SlogLogger {
+	return &SlogLogger{Logger: logger}
+}
+
+func (l *SlogLogger) Before(ctx context.Context, call *Call) context.Context {
+	if !l.Logger.Enabled(ctx, slog.LevelDebug) {
+		return ctx
+	}
+	attrs := []slog.Attr{slog.String("function", call.Name()), slog.Int("depth", call.Depth())}
+	if call.Source != "" {
+		attrs = append(attrs, slog.String("source", call.Source))
+	}
+	if l.Values {
+		attrs = append(attrs, slog.Any("args", call.Args))
+	}
+	l.Logger.LogAttrs(ctx, slog.LevelDebug, call.Kind.String()+" call", attrs...)
+	return ctx
+}
+
+func (l *SlogLogger) After(ctx context.Context, call *Call) {
+	level := slog.LevelDebug
+	if call.Err != nil {
+		level = slog.LevelWarn
+	}
+	if !l.Logger.Enabled(ctx, level) {
+		return
+	}
+	attrs := []slog.Attr{
+		slog.String("function", call.Name()),
+		slog.Int("depth", call.Depth()),
+		slog.Duration("duration", call.Duration),
+	}
+	if l.Values {
+		attrs = append(attrs, slog.Any("results", call.Results))
+	}
+	if call.Err != nil {
+		attrs = append(attrs, slog.Any("error", call.Err))
+	}
+	l.Logger.LogAttrs(ctx, level, call.Kind.String()+" return", attrs...)
+}
+
+func (l *SlogLogger) Resource(e ResourceEvent) {
+	l.Logger.Debug(eventNames[e.Type],
+		slog.String("table", e.Table),
+		slog.Uint64("handle", uint64(e.Handle)),
+		slog.Uint64("type_id", uint64(e.TypeID)))
+}
@@ -0,0 +1,120 @@
+package trace
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/wippyai/wasm-runtime/resource"
+)
+
+// Kind tells export calls from host import calls
+type Kind uint8
+
+const (
+	// KindExport is a call from the host into a guest export
+	KindExport Kind = iota
+	// KindImport is a call from a guest into a host import
+	KindImport
+)
+
+func (k Kind) String() string {
+	if k == KindImport {
+		return "import"
+	}
+	return "export"
+}
+
+// Call describes one export or host import call. Args and Results hold
+// lifted Go values; a context.Context parameter is not included.
+type Call struct {
+	Start     time.Time
+	Err       error
+	Parent    *Call  // enclosing call, nil at the outermost call
+	Namespace string // WIT interface, empty for root-level functions
+	Function  string
+	Source    string // file:line of the guest function from DWARF; empty for imports and without debug info
+	Args      []any
+	Results   []any
+	Duration  time.Duration // set before After
+	Kind      Kind
+}
+
+// Name returns namespace#function, or the function at the root level.
+func (c *Call) Name() string {
+	if c.Namespace == "" {
+		return c.Function
+	}
+	return c.Namespace + "#" + c.Function
+}
+
+// Depth returns the number of enclosing calls.
+func (c *Call) Depth() int {
+	d := 0
+	for p := c.Parent; p != nil; p = p.Parent {
+		d++
+	}
+	return d
+}
+
+// ResourceEvent is a resource lifecycle event of a host resource table
+type ResourceEvent struct {
+	Table string // namespace#resource, or the table name
+	resource.Event
+}
+
+// Interceptor observes guest calls. Before returns the context the call
+// runs with, so spans started there are parents of the spans of calls the
+// call makes. Implementations must be safe for concurrent use.
+type Interceptor interface {
+	Before(ctx context.Context, call *Call) context.Context
+	After(ctx context.Context, call *Call)
+	Resource(event ResourceEvent)
+}
+
+type callKey struct{}
+
+// FromContext returns the call ctx runs in, or nil.
+func FromContext(ctx context.Context) *Call {
+	c, _ := ctx.Value(callKey{}).(*Call)
+	return c
+}
+
+// Begin starts call: it links the call to the one ctx runs in, calls
+// i.Before and returns the context to run the call with.
+func Begin(ctx context.Context, i Interceptor, call *Call) context.Context {
+	call.Parent = FromContext(ctx)
+	call.Start = time.Now()
+	ctx = i.Before(ctx, call)
+	return context.WithValue(ctx, callKey{}, call)
+}
+
+// End completes a call started with Begin and calls i.After.
+func End(ctx context.Context, i Interceptor, call *Call) {
+	call.Duration = time.Since(call.Start)
+	i.After(ctx, call)
+}
+
+// SplitName splits an export or import name at the last '#' into the WIT
+// interface and the function.
+func SplitName(name string) (namespace, function string) {
+	if i := strings.LastIndexByte(name, '#'); i >= 0 {
+		return name[:i], name[i+1:]
+	}
+	return "", name
+}
+
+// Observer returns a resource.Observer that reports the events of a table
+// to i as coming from table.
+func Observer(i Interceptor, table string) resource.Observer {
+	return &observer{i: i, table: table}
+}
+
+type observer struct {
+	i     Interceptor
+	table string
+}
+
+func (o *observer) OnResourceEvent(e resource.Event) {
+	o.i.Resource(ResourceEvent{Table: o.table, Event: e})
+}
@@ -0,0 +1,94 @@
+package trace
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"testing"
+
+	"go.uber.org/zap"
+	zapobserver "go.uber.org/zap/zaptest/observer"
+
+	"github.com/wippyai/wasm-runtime/resource"
+)
+
+func TestBegin_Nesting(t *testing.T) {
+	l := NewSlogLogger(slog.New(slog.DiscardHandler))
+	outer := &Call{Kind: KindExport, Function: "run"}
+	ctx := Begin(context.Background(), l, outer)
+	inner := &Call{Kind: KindImport, Namespace: "my:pkg/api", Function: "get"}
+	innerCtx := Begin(ctx, l, inner)
+
+	if inner.Parent != outer || inner.Depth() != 1 || outer.Depth() != 0 {
+		t.Errorf("inner parent = %v, depth = %d", inner.Parent, inner.Depth())
+	}
+	if FromContext(innerCtx) != inner || FromContext(ctx) != outer {
+		t.Error("FromContext does not return the current call")
+	}
+	if inner.Name() != "my:pkg/api#get" || outer.Name() != "run" {
+		t.Errorf("names = %q, %q", inner.Name(), outer.Name())
+	}
+}
+
+func TestSplitName(t *testing.T) {
+	if ns, fn := SplitName("my:pkg/api@1.0.0#greet"); ns != "my:pkg/api@1.0.0" || fn != "greet" {
+		t.Errorf("SplitName() = %q, %q", ns, fn)
+	}
+	if ns, fn := SplitName("run"); ns != "" || fn != "run" {
+		t.Errorf("SplitName() = %q, %q", ns, fn)
+	}
+}
+
+func TestSlogLogger(t *testing.T) {
+	var buf bytes.Buffer
+	logger := NewSlogLogger(slog.New(slog.NewTextHandler(&buf, &slog.HandlerOptions{Level: slog.LevelDebug})))
+	logger.Values = true
+
+	call := &Call{Kind: KindImport, Namespace: "my:pkg/api", Function: "get", Args: []any{uint32(7)}}
+	ctx := Begin(context.Background(), logger, call)
+	call.Err = fmt.Errorf("boom")
+	End(ctx, logger, call)
+	Observer(logger, "files").OnResourceEvent(resource.Event{Type: resource.EventCreated, Handle: 3})
+
+	out := buf.String()
+	for _, s := range []string{
+		`level=DEBUG msg="import call" function=my:pkg/api#get`,
+		"args=[7]",
+		`level=WARN msg="import return"`,
+		"error=boom",
+		`msg="resource created" table=files handle=3`,
+	} {
+		if !strings.Contains(out, s) {
+			t.Errorf("log lacks %q:\n%s", s, out)
+		}
+	}
+}
+
+func TestZapLogger(t *testing.T) {
+	core, logs := zapobserver.New(zap.DebugLevel)
+	logger := NewZapLogger(zap.New(core))
+
+	call := &Call{Kind: KindExport, Function: "run", Source: "src/lib.rs:12", Args: []any{"secret"}}
+	ctx := Begin(context.Background(), logger, call)
+	call.Results = []any{uint32(1)}
+	End(ctx, logger, call)
+
+	entries := logs.All()
+	if len(entries) != 2 {
+		t.Fatalf("logged %d entries, want 2", len(entries))
+	}
+	if entries[0].Message != "export call" || entries[1].Message != "export return" {
+		t.Errorf("messages = %q, %q", entries[0].Message, entries[1].Message)
+	}
+	if _, ok := entries[0].ContextMap()["args"]; ok {
+		t.Error("args logged without Values")
+	}
+	if entries[0].ContextMap()["source"] != "src/lib.rs:12" {
+		t.Errorf("call fields = %v", entries[0].ContextMap())
+	}
+	if entries[1].ContextMap()["function"] != "run" {
+		t.Errorf("fields = %v", entries[1].ContextMap())
+	}
+}
@@ -522,20 +522,21 @@ func (c *Compiler) compileVariant(v *wit.Variant, goType reflect.Type, layout La
 	for i, vc := range v.Cases {
 		cc := CompiledCase{Name: vc.Name}
 
-		// Find the Go struct field for this case
+		// Find the Go struct field for this case, matched like record fields
+		caseGoType := reflect.TypeOf((*any)(nil)).Elem()
 		if goType.Kind() == reflect.Struct {
-			for j := 0; j < goType.NumField(); j++ {
-				f := goType.Field(j)
-				if strings.EqualFold(f.Name, vc.Name) {
-					cc.GoOffset = f.Offset
-					break
+			if f, found := c.findGoField(goType, vc.Name); found {
+				cc.GoOffset = f.Offset
+				caseGoType = f.Type
+				// Dereference pointer - the field is *T but WIT type is T
+				if caseGoType.Kind() == reflect.Ptr {
+					caseGoType = caseGoType.Elem()
 				}
 			}
 		}
 
 		if vc.Type != nil {
 			casePath := append(append([]string{}, path...), vc.Name)
-			caseGoType := getVariantCaseGoType(goType, vc.Name, vc.Type)
 			caseType, err := c.compile(vc.Type, caseGoType, casePath)
 			if err != nil {
 				return nil, err
@@ -597,22 +598,6 @@ func getResultErrGoType(goType reflect.Type, witType wit.Type) reflect.Type {
 	return reflect.TypeOf((*any)(nil)).Elem()
 }
 
-func getVariantCaseGoType(goType reflect.Type, caseName string, witType wit.Type) reflect.Type {
-	if goType.Kind() == reflect.Struct {
-		for i := 0; i < goType.NumField(); i++ {
-			f := goType.Field(i)
-			if strings.EqualFold(f.Name, caseName) {
-				// Dereference pointer - the field is *T but WIT type is T
-				if f.Type.Kind() == reflect.Ptr {
-					return f.Type.Elem()
-				}
-				return f.Type
-			}
-		}
-	}
-	return reflect.TypeOf((*any)(nil)).Elem()
-}
-
 func (c *Compiler) compileOwn(o *wit.Own, goType reflect.Type, layout LayoutInfo, path []string) (*CompiledType, error) {
 	// Own<T> is represented as a u32 handle on the stack
 	// Go type should be Own[T] or uint32
@@ -327,6 +327,42 @@ func TestCompiler_ResultNoPayload(t *testing.T) {
 	}
 }
 
+func TestCompiler_VariantCaseNames(t *testing.T) {
+	c := NewCompiler()
+
+	variantType := &wit.TypeDef{
+		Kind: &wit.Variant{
+			Cases: []wit.Case{
+				{Name: "ok"},
+				{Name: "not-found", Type: wit.String{}},
+				{Name: "would-block", Type: wit.U32{}},
+			},
+		},
+	}
+
+	type Status struct {
+		OK         *struct{}
+		NotFound   *string
+		WouldBlock *uint32 `wit:"would-block"`
+	}
+
+	ct, err := c.Compile(variantType, reflect.TypeOf(Status{}))
+	if err != nil {
+		t.Fatalf("Compile failed: %v", err)
+	}
+
+	st := reflect.TypeOf(Status{})
+	for i, name := range []string{"OK", "NotFound", "WouldBlock"} {
+		f, _ := st.FieldByName(name)
+		if ct.Cases[i].GoOffset != f.Offset {
+			t.Errorf("case %q: GoOffset = %d, want %d", ct.Cases[i].Name, ct.Cases[i].GoOffset, f.Offset)
+		}
+	}
+	if ct.Cases[1].Type == nil || ct.Cases[1].Type.Kind != KindString {
+		t.Errorf("not-found payload not compiled as string")
+	}
+}
+
 func TestNewCompiler(t *testing.T) {
 	c := NewCompiler()
 	if c == nil {
@@ -3754,3 +3754,31 @@ func TestEncoder_EncodeListToMemory(t *testing.T) {
 		}
 	})
 }
+
+// Test StoreValue round-trips through LoadValue
+func TestStoreValue_RoundTrip(t *testing.T) {
+	enc := NewEncoder()
+	dec := NewDecoder()
+	mem := newMockMemory(4096)
+	alloc := newMockAllocator(mem)
+
+	recordType := &wit.TypeDef{
+		Kind: &wit.Record{Fields: []wit.Field{
+			{Name: "id", Type: wit.U32{}},
+			{Name: "name", Type: wit.String{}},
+		}},
+	}
+	value := map[string]any{"id": uint32(7), "name": "seven"}
+
+	if err := enc.StoreValue(recordType, value, 16, mem, alloc, nil); err != nil {
+		t.Fatalf("StoreValue failed: %v", err)
+	}
+	result, err := dec.LoadValue(recordType, 16, mem)
+	if err != nil {
+		t.Fatalf("LoadValue failed: %v", err)
+	}
+	got := result.(map[string]any)
+	if got["id"] != uint32(7) || got["name"] != "seven" {
+		t.Errorf("got %v, want %v", got, value)
+	}
+}
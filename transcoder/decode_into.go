@@ -210,6 +210,10 @@ func (d *Decoder) decodeTypeDefInto(t *wit.TypeDef, flat []uint64, offset int, m
 	case *wit.Variant:
 		return d.decodeVariantInto(kind, flat, offset, mem, ptr)
 
+	case *wit.Own, *wit.Borrow:
+		*(*uint32)(ptr) = uint32(flat[offset])
+		return 1, nil
+
 	case wit.Type:
 		return d.decodeValueIntoWithCount(kind, flat, offset, mem, ptr)
 
@@ -704,6 +704,8 @@ func (d *Decoder) liftTypeDef(t *wit.TypeDef, flat []uint64, mem Memory, path []
 		return d.liftTuple(kind, flat, mem, path)
 	case *wit.Enum:
 		return d.liftEnum(kind, flat, path)
+	case *wit.Own, *wit.Borrow:
+		return d.liftValue(wit.U32{}, flat, mem, path)
 	case *wit.Flags:
 		return d.liftFlags(kind, flat, path)
 	case *wit.Result:
@@ -1437,6 +1439,9 @@ func (d *Decoder) loadTypeDef(t *wit.TypeDef, addr uint32, mem Memory, path []st
 		}
 		return result, nil
 
+	case *wit.Own, *wit.Borrow:
+		return d.loadValue(wit.U32{}, addr, mem, path)
+
 	case wit.Type:
 		return d.loadValue(kind, addr, mem, path)
 
@@ -176,3 +176,39 @@ func TestDecoder_DecodeResultsFloat(t *testing.T) {
 		t.Fatalf("expected 1 result, got %d", len(results))
 	}
 }
+
+func TestDecoder_Handles(t *testing.T) {
+	d := NewDecoder()
+	e := NewEncoder()
+	mem := &mockMemory{data: make([]byte, 1024)}
+
+	res := &wit.TypeDef{Kind: &wit.Resource{}}
+	own := &wit.TypeDef{Kind: &wit.Own{Type: res}}
+	borrow := &wit.TypeDef{Kind: &wit.Borrow{Type: res}}
+
+	results, err := d.DecodeResults([]wit.Type{own, borrow}, []uint64{3, 4}, mem)
+	if err != nil {
+		t.Fatalf("DecodeResults handles failed: %v", err)
+	}
+	if len(results) != 2 || results[0] != uint32(3) || results[1] != uint32(4) {
+		t.Errorf("expected [3 4], got %v", results)
+	}
+
+	mem.WriteU32(8, 7)
+	result, err := d.LoadValue(own, 8, mem)
+	if err != nil {
+		t.Fatalf("LoadValue own failed: %v", err)
+	}
+	if result != uint32(7) {
+		t.Errorf("expected 7, got %v", result)
+	}
+
+	opt := &wit.TypeDef{Kind: &wit.Option{Type: own}}
+	flat, err := e.EncodeParams([]wit.Type{opt}, []any{uint32(9)}, mem, nil, nil)
+	if err != nil {
+		t.Fatalf("EncodeParams option<own> failed: %v", err)
+	}
+	if len(flat) != 2 || flat[0] != 1 || flat[1] != 9 {
+		t.Errorf("expected [1 9], got %v", flat)
+	}
+}
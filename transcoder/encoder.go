@@ -87,6 +87,11 @@ func (e *Encoder) EncodeParams(paramTypes []wit.Type, values []any, mem Memory,
 	return result, nil
 }
 
+// StoreValue writes value in its memory layout at addr, the inverse of Decoder.LoadValue.
+func (e *Encoder) StoreValue(witType wit.Type, value any, addr uint32, mem Memory, alloc Allocator, allocList *AllocationList) error {
+	return e.storeValue(witType, value, addr, mem, alloc, allocList, nil)
+}
+
 // tryFastEncode returns true if handled via compiled path, false to fall back to dynamic path.
 func (e *Encoder) tryFastEncode(witType wit.Type, value any, flat *[]uint64, mem Memory, alloc Allocator, allocList *AllocationList) bool {
 	typeDef, ok := witType.(*wit.TypeDef)
@@ -808,6 +813,8 @@ func (e *Encoder) flattenTypeDef(t *wit.TypeDef, value any, mem Memory, alloc Al
 		return e.flattenTuple(kind, value, mem, alloc, allocList, flat, path)
 	case *wit.Enum:
 		return e.flattenEnum(kind, value, flat, path)
+	case *wit.Own, *wit.Borrow:
+		return e.flattenValue(wit.U32{}, value, mem, alloc, allocList, flat, path)
 	case *wit.Flags:
 		return e.flattenFlags(kind, value, flat, path)
 	case *wit.Result:
@@ -1595,6 +1602,9 @@ func (e *Encoder) storeTypeDef(t *wit.TypeDef, value any, addr uint32, mem Memor
 		}
 		return nil
 
+	case *wit.Own, *wit.Borrow:
+		return e.storeValue(wit.U32{}, value, addr, mem, alloc, allocList, path)
+
 	case wit.Type:
 		return e.storeValue(kind, value, addr, mem, alloc, allocList, path)
 
@@ -58,6 +58,8 @@ func (c *Calculator) calculateTypeDef(t *wit.TypeDef) Info {
 		info = c.calculateTuple(kind)
 	case *wit.Flags:
 		info = c.calculateFlags(kind)
+	case *wit.Own, *wit.Borrow:
+		info = Info{Size: 4, Align: 4}
 	case wit.Type:
 		info = c.Calculate(kind)
 	default:
@@ -2,11 +2,13 @@ package filesystem
 
 import (
 	"context"
+	"errors"
 	"os"
 	"path/filepath"
 	"testing"
 
 	"github.com/wippyai/wasm-runtime/wasi/preview2"
+	"github.com/wippyai/wasm-runtime/wasi/preview2/io"
 )
 
 func TestTypesHost_MethodDescriptorGetType(t *testing.T) {
@@ -292,6 +294,38 @@ func TestTypesHost_InvalidHandles(t *testing.T) {
 	}
 }
 
+func TestTypesHost_FilesystemErrorCode(t *testing.T) {
+	resources := preview2.NewResourceTable()
+	host := NewTypesHost(resources)
+	streams := io.NewStreamsHost(resources)
+	ctx := context.Background()
+
+	// Reading a directory through a stream fails with a filesystem error
+	dir, err := os.Open(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer dir.Close()
+	stream := resources.Add(preview2.NewInputStreamResource(dir))
+	_, streamErr := streams.MethodInputStreamRead(ctx, stream, 10)
+	if streamErr == nil || !streamErr.LastOpFailed {
+		t.Fatalf("read = %+v, want last-operation-failed", streamErr)
+	}
+	code := host.FilesystemErrorCode(ctx, streamErr.LastOpFailedErr)
+	if code == nil || *code != ErrorIsDirectory {
+		t.Errorf("FilesystemErrorCode() = %v, want is-directory", code)
+	}
+
+	// Errors from elsewhere have no filesystem error code
+	other := resources.Add(preview2.WrapError(errors.New("connection reset")))
+	if code := host.FilesystemErrorCode(ctx, other); code != nil {
+		t.Errorf("FilesystemErrorCode(other) = %v, want none", *code)
+	}
+	if code := host.FilesystemErrorCode(ctx, 9999); code != nil {
+		t.Errorf("FilesystemErrorCode(9999) = %v, want none", *code)
+	}
+}
+
 func TestTypesHost_Namespace(t *testing.T) {
 	resources := preview2.NewResourceTable()
 	host := NewTypesHost(resources)
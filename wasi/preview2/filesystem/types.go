@@ -9,7 +9,9 @@ import (
 	"syscall"
 	"time"
 
+	wasmruntime "github.com/wippyai/wasm-runtime"
 	"github.com/wippyai/wasm-runtime/wasi/preview2"
+	"github.com/wippyai/wasm-runtime/wasi/preview2/clocks"
 )
 
 type TypesHost struct {
@@ -180,11 +182,24 @@ func (h *TypesHost) resolvePath(desc *preview2.DescriptorResource, path string)
 	return fullPath, nil
 }
 
-func (h *TypesHost) FilesystemErrorCode(_ context.Context, err *Error) ErrorCode {
-	if err == nil {
-		return ErrorIo
+// FilesystemErrorCode returns the filesystem error code of the stream
+// error borrowed as err. Errors that did not come from the filesystem
+// have none.
+func (h *TypesHost) FilesystemErrorCode(_ context.Context, err uint32) *ErrorCode {
+	r, ok := h.resources.Get(err)
+	if !ok {
+		return nil
+	}
+	e, ok := r.(*preview2.ErrorResource)
+	if !ok {
+		return nil
+	}
+	var pathErr *os.PathError
+	if !errors.As(e.Unwrap(), &pathErr) {
+		return nil
 	}
-	return err.Code
+	code := mapOSError(pathErr).Code
+	return &code
 }
 
 func (h *TypesHost) MethodDescriptorRead(_ context.Context, self uint32, length uint64, offset uint64) ([]byte, *Error) {
@@ -300,15 +315,31 @@ func (h *TypesHost) MethodDescriptorStat(_ context.Context, self uint32) (*Descr
 		return nil, mapOSError(osErr)
 	}
 
-	return &DescriptorStat{
-		Type: fileInfoToDescriptorType(info),
-		Size: uint64(info.Size()),
-	}, nil
+	return newDescriptorStat(info), nil
 }
 
 type DescriptorStat struct {
-	Type DescriptorType
-	Size uint64
+	Type                      DescriptorType
+	LinkCount                 uint64
+	Size                      uint64
+	DataAccessTimestamp       *clocks.Datetime
+	DataModificationTimestamp *clocks.Datetime
+	StatusChangeTimestamp     *clocks.Datetime
+}
+
+// newDescriptorStat converts info. os.FileInfo carries neither link counts
+// nor access and status change times, so only the modification time is set.
+func newDescriptorStat(info os.FileInfo) *DescriptorStat {
+	mtime := info.ModTime()
+	return &DescriptorStat{
+		Type:      fileInfoToDescriptorType(info),
+		LinkCount: 1,
+		Size:      uint64(info.Size()),
+		DataModificationTimestamp: &clocks.Datetime{
+			Seconds:     uint64(mtime.Unix()),
+			Nanoseconds: uint32(mtime.Nanosecond()),
+		},
+	}
 }
 
 func (h *TypesHost) MethodDescriptorSeek(_ context.Context, self uint32, offset int64, whence uint8) (uint64, *Error) {
@@ -382,7 +413,11 @@ func (h *TypesHost) MethodDescriptorOpenAt(_ context.Context, self uint32, _ uin
 	}
 
 	newDesc := preview2.NewDescriptorResource(fullPath, info.IsDir(), desc.ReadOnly())
-	handle := h.resources.Add(newDesc)
+	handle, limitErr := h.resources.AddLimited(newDesc, wasmruntime.ResourceFile)
+	if limitErr != nil {
+		// WASI has no "too many open files" code; quota is the closest match
+		return 0, &Error{Code: ErrorQuota}
+	}
 	return handle, nil
 }
 
@@ -677,10 +712,7 @@ func (h *TypesHost) MethodDescriptorStatAt(_ context.Context, self uint32, pathF
 		return nil, mapOSError(osErr)
 	}
 
-	return &DescriptorStat{
-		Type: fileInfoToDescriptorType(info),
-		Size: uint64(info.Size()),
-	}, nil
+	return newDescriptorStat(info), nil
 }
 
 func (h *TypesHost) MethodDescriptorSymlinkAt(_ context.Context, self uint32, oldPath string, newPath string) *Error {
@@ -9,6 +9,7 @@ import (
 	"sync"
 	"time"
 
+	wasmruntime "github.com/wippyai/wasm-runtime"
 	"github.com/wippyai/wasm-runtime/wasi/preview2"
 )
 
@@ -257,7 +258,10 @@ func (h *OutgoingHandlerHost) Handle(ctx context.Context, requestHandle uint32,
 	}
 
 	future := &futureIncomingResponseResource{}
-	futureHandle := h.resources.Add(future)
+	futureHandle, limitErr := h.resources.AddLimited(future, wasmruntime.ResourceHTTPRequest)
+	if limitErr != nil {
+		return 0, 1
+	}
 
 	go func() {
 		resp, err := h.client.Do(httpReq)
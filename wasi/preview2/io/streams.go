@@ -19,6 +19,17 @@ func (h *StreamsHost) Namespace() string {
 	return "wasi:io/streams@0.2.8"
 }
 
+// failed returns the stream error for err. Errors other than stream errors
+// fail the operation with an error resource the guest can inspect, for
+// example with wasi:filesystem's filesystem-error-code.
+func (h *StreamsHost) failed(err error) *preview2.StreamError {
+	var se *preview2.StreamError
+	if errors.As(err, &se) {
+		return se
+	}
+	return &preview2.StreamError{LastOpFailed: true, LastOpFailedErr: h.resources.Add(preview2.WrapError(err))}
+}
+
 func (h *StreamsHost) MethodInputStreamRead(_ context.Context, self uint32, length uint64) ([]byte, *preview2.StreamError) {
 	r, ok := h.resources.Get(self)
 	if !ok {
@@ -33,11 +44,7 @@ func (h *StreamsHost) MethodInputStreamRead(_ context.Context, self uint32, leng
 
 	data, err := stream.Read(length)
 	if err != nil {
-		var se *preview2.StreamError
-		if errors.As(err, &se) {
-			return nil, se
-		}
-		return nil, &preview2.StreamError{LastOpFailed: true}
+		return nil, h.failed(err)
 	}
 
 	return data, nil
@@ -60,11 +67,7 @@ func (h *StreamsHost) MethodInputStreamSkip(_ context.Context, self uint32, leng
 
 	data, err := stream.Read(length)
 	if err != nil {
-		var se *preview2.StreamError
-		if errors.As(err, &se) {
-			return 0, se
-		}
-		return 0, &preview2.StreamError{LastOpFailed: true}
+		return 0, h.failed(err)
 	}
 
 	return uint64(len(data)), nil
@@ -95,11 +98,7 @@ func (h *StreamsHost) MethodOutputStreamCheckWrite(_ context.Context, self uint3
 
 	size, err := stream.CheckWrite()
 	if err != nil {
-		var se *preview2.StreamError
-		if errors.As(err, &se) {
-			return 0, se
-		}
-		return 0, &preview2.StreamError{LastOpFailed: true}
+		return 0, h.failed(err)
 	}
 
 	return size, nil
@@ -119,11 +118,7 @@ func (h *StreamsHost) MethodOutputStreamWrite(_ context.Context, self uint32, co
 
 	err := stream.Write(contents)
 	if err != nil {
-		var se *preview2.StreamError
-		if errors.As(err, &se) {
-			return se
-		}
-		return &preview2.StreamError{LastOpFailed: true}
+		return h.failed(err)
 	}
 
 	return nil
@@ -142,7 +137,7 @@ func (h *StreamsHost) MethodOutputStreamFlush(_ context.Context, self uint32) *p
 	// Check if the stream supports flushing
 	if flusher, ok := r.(interface{ Flush() error }); ok {
 		if err := flusher.Flush(); err != nil {
-			return &preview2.StreamError{LastOpFailed: true}
+			return h.failed(err)
 		}
 	}
 	return nil
@@ -177,11 +172,7 @@ func (h *StreamsHost) MethodOutputStreamWriteZeroes(_ context.Context, self uint
 	zeroes := make([]byte, length)
 	err := stream.Write(zeroes)
 	if err != nil {
-		var se *preview2.StreamError
-		if errors.As(err, &se) {
-			return se
-		}
-		return &preview2.StreamError{LastOpFailed: true}
+		return h.failed(err)
 	}
 
 	return nil
@@ -214,20 +205,12 @@ func (h *StreamsHost) MethodOutputStreamSplice(_ context.Context, self uint32, s
 
 	data, err := srcStream.Read(length)
 	if err != nil {
-		var se *preview2.StreamError
-		if errors.As(err, &se) {
-			return 0, se
-		}
-		return 0, &preview2.StreamError{LastOpFailed: true}
+		return 0, h.failed(err)
 	}
 
 	err = dstStream.Write(data)
 	if err != nil {
-		var se *preview2.StreamError
-		if errors.As(err, &se) {
-			return 0, se
-		}
-		return 0, &preview2.StreamError{LastOpFailed: true}
+		return 0, h.failed(err)
 	}
 
 	return uint64(len(data)), nil
@@ -6,8 +6,10 @@ import (
 	"errors"
 	"io"
 	"os"
+	"sync"
 	"time"
 
+	wasmruntime "github.com/wippyai/wasm-runtime"
 	"github.com/wippyai/wasm-runtime/resource"
 )
 
@@ -20,7 +22,8 @@ const DefaultBufferSize = 65536
 // ResourceTable manages WASI preview2 resource handles.
 // It is an adapter over the unified resource.WASITable.
 type ResourceTable struct {
-	table *resource.WASITable
+	table   *resource.WASITable
+	limiter wasmruntime.ResourceLimiter
 }
 
 // Resource is a WASI preview2 resource that can be managed by ResourceTable.
@@ -57,9 +60,54 @@ func NewResourceTable() *ResourceTable {
 	}
 }
 
+// SetLimiter sets the limiter consulted before handles, files, sockets and
+// HTTP requests are created. Call before the table is shared with a guest.
+func (t *ResourceTable) SetLimiter(limiter wasmruntime.ResourceLimiter) {
+	t.limiter = limiter
+}
+
 // Add stores a resource and returns a stable handle.
+// Add panics with the limiter's error when the handle limit is reached,
+// which traps the calling guest.
 func (t *ResourceTable) Add(r Resource) uint32 {
-	return uint32(t.table.Add(&resourceAdapter{r}))
+	adapter := &resourceAdapter{resource: r}
+	if t.limiter != nil {
+		if err := t.limiter.Acquire(wasmruntime.ResourceHandle); err != nil {
+			panic(err)
+		}
+		adapter.limiter = t.limiter
+		adapter.kinds = []wasmruntime.ResourceKind{wasmruntime.ResourceHandle}
+	}
+	return t.insert(adapter)
+}
+
+// AddLimited stores a resource that counts against kind as well as the
+// handle limit. The limiter's error is returned instead of trapping so hosts
+// can report a WASI error code. Both units are released on Remove.
+func (t *ResourceTable) AddLimited(r Resource, kind wasmruntime.ResourceKind) (uint32, error) {
+	adapter := &resourceAdapter{resource: r}
+	if t.limiter != nil {
+		if err := t.limiter.Acquire(kind); err != nil {
+			return 0, err
+		}
+		if err := t.limiter.Acquire(wasmruntime.ResourceHandle); err != nil {
+			t.limiter.Release(kind)
+			return 0, err
+		}
+		adapter.limiter = t.limiter
+		adapter.kinds = []wasmruntime.ResourceKind{kind, wasmruntime.ResourceHandle}
+	}
+	return t.insert(adapter), nil
+}
+
+// insert adds adapter to the table, releasing its limiter units if the
+// table is closed and the resource was not stored.
+func (t *ResourceTable) insert(adapter *resourceAdapter) uint32 {
+	handle := uint32(t.table.Add(adapter))
+	if handle == 0 && adapter.limiter != nil {
+		adapter.releaseUnits()
+	}
+	return handle
 }
 
 // Get returns the resource for a handle, or (nil, false) if invalid.
@@ -89,9 +137,22 @@ func (t *ResourceTable) Clear() {
 	t.table.Clear()
 }
 
+// Subscribe adds an observer for lifecycle events of the table.
+func (t *ResourceTable) Subscribe(o resource.Observer) {
+	t.table.Subscribe(o)
+}
+
+// Unsubscribe removes an observer.
+func (t *ResourceTable) Unsubscribe(o resource.Observer) {
+	t.table.Unsubscribe(o)
+}
+
 // resourceAdapter adapts preview2.Resource to resource.WASIResource
 type resourceAdapter struct {
 	resource Resource
+	limiter  wasmruntime.ResourceLimiter
+	kinds    []wasmruntime.ResourceKind
+	release  sync.Once
 }
 
 func (a *resourceAdapter) WASIResourceType() resource.WASIResourceType {
@@ -103,6 +164,17 @@ func (a *resourceAdapter) Drop() {
 	if a.resource != nil {
 		a.resource.Drop()
 	}
+	if a.limiter != nil {
+		a.releaseUnits()
+	}
+}
+
+func (a *resourceAdapter) releaseUnits() {
+	a.release.Do(func() {
+		for _, kind := range a.kinds {
+			a.limiter.Release(kind)
+		}
+	})
 }
 
 // Pollable is the interface for async-ready resources that can be polled.
@@ -198,7 +270,7 @@ func (s *InputStreamResource) Read(length uint64) ([]byte, error) {
 				}
 				return nil, &StreamError{Closed: true}
 			}
-			return nil, &StreamError{Closed: true}
+			return nil, err
 		}
 		return buf[:n], nil
 	}
@@ -302,10 +374,7 @@ func (s *FileOutputStreamResource) Write(data []byte) error {
 		return &StreamError{Closed: true}
 	}
 	_, err := s.file.Write(data)
-	if err != nil {
-		return &StreamError{LastOpFailed: true}
-	}
-	return nil
+	return err
 }
 
 func (s *FileOutputStreamResource) CheckWrite() (uint64, error) {
@@ -381,7 +450,10 @@ func (e *StreamError) Error() string {
 }
 
 // ErrorResource holds an error message that can be retrieved via ToDebugString.
+// Errors of failed stream operations also keep the Go error, from which
+// wasi:filesystem reads the filesystem error code.
 type ErrorResource struct {
+	err error
 	msg string
 }
 
@@ -389,6 +461,14 @@ func NewErrorResource(msg string) *ErrorResource {
 	return &ErrorResource{msg: msg}
 }
 
+// WrapError returns an error resource for err.
+func WrapError(err error) *ErrorResource {
+	return &ErrorResource{err: err, msg: err.Error()}
+}
+
+// Unwrap returns the Go error the resource was created from, or nil.
+func (e *ErrorResource) Unwrap() error { return e.err }
+
 func (e *ErrorResource) Type() ResourceType    { return ResourceError }
 func (e *ErrorResource) Drop()                 {}
 func (e *ErrorResource) ToDebugString() string { return e.msg }
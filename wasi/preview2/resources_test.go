@@ -10,8 +10,47 @@ import (
 	"strings"
 	"testing"
 	"time"
+
+	wasmruntime "github.com/wippyai/wasm-runtime"
 )
 
+func TestResourceTable_Limiter(t *testing.T) {
+	limiter := wasmruntime.NewLimiter(wasmruntime.Limits{Handles: 3, Files: 1})
+	table := NewResourceTable()
+	table.SetLimiter(limiter)
+
+	file, err := table.AddLimited(NewDescriptorResource("/a", false, true), wasmruntime.ResourceFile)
+	if err != nil {
+		t.Fatalf("first file: %v", err)
+	}
+	if _, err := table.AddLimited(NewDescriptorResource("/b", false, true), wasmruntime.ResourceFile); err == nil {
+		t.Fatal("second file should exceed the file limit")
+	}
+	if n := limiter.Count(wasmruntime.ResourceHandle); n != 1 {
+		t.Errorf("handles after rejected file = %d, want 1", n)
+	}
+
+	table.Add(&PollableResource{})
+	table.Add(&PollableResource{})
+	func() {
+		defer func() {
+			if recover() == nil {
+				t.Error("Add over handle limit did not panic")
+			}
+		}()
+		table.Add(&PollableResource{})
+	}()
+
+	table.Remove(file)
+	if n := limiter.Count(wasmruntime.ResourceFile); n != 0 {
+		t.Errorf("files after remove = %d, want 0", n)
+	}
+	table.Clear()
+	if n := limiter.Count(wasmruntime.ResourceHandle); n != 0 {
+		t.Errorf("handles after clear = %d, want 0", n)
+	}
+}
+
 func TestResourceTable_AddGetRemove(t *testing.T) {
 	table := NewResourceTable()
 
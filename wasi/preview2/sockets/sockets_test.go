@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	wasmruntime "github.com/wippyai/wasm-runtime"
 	"github.com/wippyai/wasm-runtime/wasi/preview2"
 )
 
@@ -61,6 +62,27 @@ func TestTCPCreateSocketHost_CreateTCPSocket(t *testing.T) {
 	}
 }
 
+func TestTCPCreateSocketHost_SocketLimit(t *testing.T) {
+	resources := preview2.NewResourceTable()
+	resources.SetLimiter(wasmruntime.NewLimiter(wasmruntime.Limits{Sockets: 1}))
+	tcp := NewTCPCreateSocketHost(resources)
+	udp := NewUDPCreateSocketHost(resources)
+	ctx := context.Background()
+
+	handle, netErr := tcp.CreateTCPSocket(ctx, AddressFamilyIPv4)
+	if netErr != nil {
+		t.Fatalf("CreateTCPSocket: %v", netErr)
+	}
+	if _, netErr := udp.CreateUDPSocket(ctx, AddressFamilyIPv4); netErr == nil || netErr.Code != NetworkErrorNewSocketLimit {
+		t.Fatalf("CreateUDPSocket over limit: got %v, want new-socket-limit", netErr)
+	}
+
+	resources.Remove(handle)
+	if _, netErr := udp.CreateUDPSocket(ctx, AddressFamilyIPv4); netErr != nil {
+		t.Errorf("CreateUDPSocket after remove: %v", netErr)
+	}
+}
+
 func TestTCPCreateSocketHost_CreateTCPSocket_IPv6(t *testing.T) {
 	resources := preview2.NewResourceTable()
 	host := NewTCPCreateSocketHost(resources)
@@ -7,6 +7,7 @@ import (
 	"strconv"
 	"sync"
 
+	wasmruntime "github.com/wippyai/wasm-runtime"
 	"github.com/wippyai/wasm-runtime/wasi/preview2"
 )
 
@@ -332,7 +333,11 @@ func (h *TCPHost) MethodTCPSocketAccept(_ context.Context, self uint32) (uint32,
 		newSocket.SetRemoteAddr(tcpAddr.IP.String(), uint16(tcpAddr.Port))
 	}
 
-	socketHandle := h.resources.Add(newSocket)
+	socketHandle, limitErr := h.resources.AddLimited(newSocket, wasmruntime.ResourceSocket)
+	if limitErr != nil {
+		_ = conn.Close()
+		return 0, 0, 0, &NetworkError{Code: NetworkErrorNewSocketLimit}
+	}
 
 	// Create streams for the new socket
 	inputStream := preview2.NewTCPInputStreamResource(newSocket)
@@ -3,6 +3,7 @@ package sockets
 import (
 	"context"
 
+	wasmruntime "github.com/wippyai/wasm-runtime"
 	"github.com/wippyai/wasm-runtime/wasi/preview2"
 )
 
@@ -63,6 +64,9 @@ func (h *TCPCreateSocketHost) CreateTCPSocket(_ context.Context, addressFamily u
 	}
 
 	socket := preview2.NewTCPSocketResource(addressFamily)
-	handle := h.resources.Add(socket)
+	handle, err := h.resources.AddLimited(socket, wasmruntime.ResourceSocket)
+	if err != nil {
+		return 0, &NetworkError{Code: NetworkErrorNewSocketLimit}
+	}
 	return handle, nil
 }
@@ -1,5 +1,9 @@
 package preview2
 
+import (
+	wasmruntime "github.com/wippyai/wasm-runtime"
+)
+
 // WASI configures a WASI preview2 environment. Use builder methods to set up.
 type WASI struct {
 	resources *ResourceTable
@@ -57,6 +61,13 @@ func (w *WASI) WithStdin(data []byte) *WASI {
 	return w
 }
 
+// WithResourceLimiter limits handles, open files, sockets and outgoing
+// HTTP requests created through this environment
+func (w *WASI) WithResourceLimiter(limiter wasmruntime.ResourceLimiter) *WASI {
+	w.resources.SetLimiter(limiter)
+	return w
+}
+
 // Stdout returns stdout contents
 func (w *WASI) Stdout() []byte {
 	return w.stdout.Bytes()
@@ -90,8 +90,38 @@
 //   - Type indices are in bounds
 //   - Function signatures match
 //   - Import/export names are valid UTF-8
-//   - Table and memory limits are valid
+//   - Table and memory limits are valid, with at most one memory
+//   - Constant expressions, in global and table initializers, segment
+//     offsets and element items, are constant and of the expected type
 //   - Instructions are well-formed
+//   - Function bodies type-check, through ValidateCode
+//
+// Modules with multiple memories decode, but do not validate: the engine
+// runs a single memory.
+//
+// ValidateCode checks function bodies against the spec's validation
+// algorithm: operand and control stacks, block types, locals, and the
+// reference, SIMD, atomic, GC and exception instructions. It reports the
+// first invalid instruction as a *CodeError with the function index, the
+// instruction's offset in the body, and the expected and actual operands:
+//
+//	var codeErr *wasm.CodeError
+//	if errors.As(module.Validate(), &codeErr) {
+//	    log.Printf("function %d at 0x%x: %s", codeErr.Func, codeErr.Offset, codeErr.Message)
+//	}
+//
+// # Debug Info
+//
+// Custom sections, including DWARF .debug_* sections, are kept in
+// module.CustomSections. Package wasm/dwarf maps code offsets to source
+// locations from them. Module.Names decodes the "name" section, with the
+// label, type, table, memory, global, elem, data, field and tag names of
+// the extended name section; SetNames writes one back:
+//
+//	names, err := module.Names()
+//	if names != nil {
+//	    log.Printf("function 3 is %s", names.Funcs[3])
+//	}
 //
 // # LEB128 Encoding
 //
@@ -0,0 +1,26 @@
+// Package dwarf maps WebAssembly code offsets to source locations using
+// the DWARF debug info that Rust, C and Go toolchains emit as .debug_*
+// custom sections.
+//
+// Offsets are relative to the start of the code section payload, as in
+// DWARF for WebAssembly:
+//
+//	table, err := dwarf.New(module.CustomSections)
+//	for _, loc := range table.Lookup(offset) {
+//	    fmt.Println(loc.Function, loc) // innermost first, inlined calls included
+//	}
+//
+// Function returns the function containing an offset, at the file and
+// line it is declared at.
+//
+// The runtime compiles rewritten modules, with table.grow instrumented or
+// asyncify applied, whose offsets no longer match their DWARF. A Map
+// resolves functions and offsets of the compiled module through the
+// line table of the module as loaded:
+//
+//	m := dwarf.NewMap(original, compiled)
+//	locs, offset := m.Lookup(funcIndex, compiledOffset)
+//
+// New returns a nil table for modules without DWARF; Lookup on a nil
+// table returns nil, so callers fall back to function names.
+package dwarf
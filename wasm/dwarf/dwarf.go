@@ -0,0 +1,376 @@
+package dwarf
+
+import (
+	"debug/dwarf"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/wippyai/wasm-runtime/wasm"
+)
+
+// Location is a source position for a code offset
+type Location struct {
+	File     string
+	Function string // function the position is in, empty if unknown
+	Line     int
+	Column   int
+	Inlined  bool // the function was inlined into the next location
+}
+
+// String formats the location as file:line:column
+func (l Location) String() string {
+	var b strings.Builder
+	b.WriteString(l.File)
+	if l.Line > 0 {
+		b.WriteByte(':')
+		b.WriteString(strconv.Itoa(l.Line))
+		if l.Column > 0 {
+			b.WriteByte(':')
+			b.WriteString(strconv.Itoa(l.Column))
+		}
+	}
+	return b.String()
+}
+
+// LineTable maps code section offsets of one module to source locations.
+// Compilation units are decoded on first lookup. LineTable is safe for
+// concurrent use.
+type LineTable struct {
+	data  *dwarf.Data
+	units []*unit
+	mu    sync.Mutex
+}
+
+// unit is a compilation unit and, once loaded, its rows and scopes
+type unit struct {
+	entry  *dwarf.Entry
+	ranges [][2]uint64
+	files  []*dwarf.LineFile
+	rows   []row   // sorted by address
+	scopes []scope // functions and inlined calls
+	loaded bool
+}
+
+// row is one line table entry
+type row struct {
+	addr   uint64
+	file   string
+	line   int
+	column int
+	end    bool // end of sequence: addr is past the last instruction
+}
+
+// scope is a function or inlined call covering some address ranges
+type scope struct {
+	ranges   [][2]uint64
+	name     string
+	callFile string
+	callLine int
+	callCol  int
+	declFile string
+	declLine int
+	depth    int
+	inlined  bool
+}
+
+// sectionNames lists the custom sections passed to debug/dwarf
+var sectionNames = []string{
+	".debug_abbrev", ".debug_aranges", ".debug_frame", ".debug_info",
+	".debug_line", ".debug_pubnames", ".debug_ranges", ".debug_str",
+}
+
+// dwarf5Sections are added to the data when present
+var dwarf5Sections = []string{
+	".debug_addr", ".debug_line_str", ".debug_str_offsets", ".debug_rnglists",
+}
+
+// New reads the line tables in the .debug_* custom sections. It returns
+// nil and no error when the module carries no DWARF.
+func New(sections []wasm.CustomSection) (*LineTable, error) {
+	byName := make(map[string][]byte, len(sections))
+	for _, s := range sections {
+		if strings.HasPrefix(s.Name, ".debug_") {
+			byName[s.Name] = s.Data
+		}
+	}
+	if byName[".debug_info"] == nil || byName[".debug_line"] == nil {
+		return nil, nil
+	}
+
+	args := make([][]byte, len(sectionNames))
+	for i, name := range sectionNames {
+		args[i] = byName[name]
+	}
+	data, err := dwarf.New(args[0], args[1], args[2], args[3], args[4], args[5], args[6], args[7])
+	if err != nil {
+		return nil, fmt.Errorf("dwarf: %w", err)
+	}
+	for _, name := range dwarf5Sections {
+		if contents := byName[name]; contents != nil {
+			if err := data.AddSection(name, contents); err != nil {
+				return nil, fmt.Errorf("dwarf: %s: %w", name, err)
+			}
+		}
+	}
+
+	t := &LineTable{data: data}
+	r := data.Reader()
+	for {
+		entry, err := r.Next()
+		if err != nil {
+			return nil, fmt.Errorf("dwarf: %w", err)
+		}
+		if entry == nil {
+			break
+		}
+		if entry.Tag == dwarf.TagCompileUnit {
+			ranges, err := data.Ranges(entry)
+			if err == nil {
+				t.units = append(t.units, &unit{entry: entry, ranges: validRanges(ranges)})
+			}
+		}
+		r.SkipChildren()
+	}
+	return t, nil
+}
+
+// Load reads the line tables of a binary module. It returns nil and no
+// error when the module carries no DWARF.
+func Load(module []byte) (*LineTable, error) {
+	m, err := wasm.ParseModule(module)
+	if err != nil {
+		return nil, err
+	}
+	return New(m.CustomSections)
+}
+
+// Lookup returns the source locations of the instruction at offset in the
+// code section, innermost first: the position itself, then the call site
+// of each inlined function it is in. It returns nil when offset has no
+// line information.
+func (t *LineTable) Lookup(offset uint64) []Location {
+	if t == nil {
+		return nil
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, u := range t.units {
+		if !contains(u.ranges, offset) {
+			continue
+		}
+		if !u.loaded {
+			t.load(u)
+		}
+		return u.lookup(offset)
+	}
+	return nil
+}
+
+// Function returns the function whose code contains offset, at the file
+// and line of its declaration. Inlined functions are skipped: the result
+// is the function the code was compiled into.
+func (t *LineTable) Function(offset uint64) (Location, bool) {
+	if t == nil {
+		return Location{}, false
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	for _, u := range t.units {
+		if !contains(u.ranges, offset) {
+			continue
+		}
+		if !u.loaded {
+			t.load(u)
+		}
+		var best *scope
+		for i := range u.scopes {
+			s := &u.scopes[i]
+			if !s.inlined && contains(s.ranges, offset) && (best == nil || s.depth > best.depth) {
+				best = s
+			}
+		}
+		if best == nil {
+			return Location{}, false
+		}
+		return Location{File: best.declFile, Function: best.name, Line: best.declLine}, true
+	}
+	return Location{}, false
+}
+
+func (u *unit) lookup(offset uint64) []Location {
+	i := sort.Search(len(u.rows), func(i int) bool { return u.rows[i].addr > offset }) - 1
+	if i < 0 || u.rows[i].end {
+		return nil
+	}
+	r := u.rows[i]
+
+	var active []scope
+	for _, s := range u.scopes {
+		if contains(s.ranges, offset) {
+			active = append(active, s)
+		}
+	}
+	sort.SliceStable(active, func(a, b int) bool { return active[a].depth > active[b].depth })
+
+	loc := Location{File: r.file, Line: r.line, Column: r.column}
+	if len(active) > 0 {
+		loc.Function = active[0].name
+	}
+	locs := []Location{loc}
+	for i, s := range active {
+		if !s.inlined {
+			break
+		}
+		locs[len(locs)-1].Inlined = true
+		call := Location{File: s.callFile, Line: s.callLine, Column: s.callCol}
+		if i+1 < len(active) {
+			call.Function = active[i+1].name
+		}
+		locs = append(locs, call)
+	}
+	return locs
+}
+
+// load decodes the line table rows and function scopes of u
+func (t *LineTable) load(u *unit) {
+	u.loaded = true
+
+	if lr, err := t.data.LineReader(u.entry); err == nil && lr != nil {
+		u.files = lr.Files()
+		var le dwarf.LineEntry
+		for {
+			if err := lr.Next(&le); err != nil {
+				if err != io.EOF {
+					u.rows = nil
+				}
+				break
+			}
+			if isTombstone(le.Address) {
+				continue
+			}
+			r := row{addr: le.Address, line: le.Line, column: le.Column, end: le.EndSequence}
+			if le.File != nil {
+				r.file = le.File.Name
+			}
+			u.rows = append(u.rows, r)
+		}
+		// Line programs need not be sorted by address across sequences
+		sort.SliceStable(u.rows, func(i, j int) bool { return u.rows[i].addr < u.rows[j].addr })
+	}
+
+	r := t.data.Reader()
+	r.Seek(u.entry.Offset)
+	if _, err := r.Next(); err != nil {
+		return
+	}
+	depth := 1
+	for depth > 0 {
+		entry, err := r.Next()
+		if err != nil || entry == nil {
+			return
+		}
+		if entry.Tag == 0 {
+			depth--
+			continue
+		}
+		if entry.Tag == dwarf.TagSubprogram || entry.Tag == dwarf.TagInlinedSubroutine {
+			t.addScope(u, entry, depth)
+		}
+		if entry.Children {
+			depth++
+		}
+	}
+}
+
+func (t *LineTable) addScope(u *unit, entry *dwarf.Entry, depth int) {
+	ranges, err := t.data.Ranges(entry)
+	if err != nil {
+		return
+	}
+	ranges = validRanges(ranges)
+	if len(ranges) == 0 {
+		return
+	}
+	s := scope{
+		ranges:  ranges,
+		name:    t.name(entry),
+		depth:   depth,
+		inlined: entry.Tag == dwarf.TagInlinedSubroutine,
+	}
+	if idx, ok := entry.Val(dwarf.AttrDeclFile).(int64); ok && idx >= 0 && int(idx) < len(u.files) && u.files[idx] != nil {
+		s.declFile = u.files[idx].Name
+	}
+	if line, ok := entry.Val(dwarf.AttrDeclLine).(int64); ok {
+		s.declLine = int(line)
+	}
+	if s.inlined {
+		if idx, ok := entry.Val(dwarf.AttrCallFile).(int64); ok && idx >= 0 && int(idx) < len(u.files) && u.files[idx] != nil {
+			s.callFile = u.files[idx].Name
+		}
+		if line, ok := entry.Val(dwarf.AttrCallLine).(int64); ok {
+			s.callLine = int(line)
+		}
+		if col, ok := entry.Val(dwarf.AttrCallColumn).(int64); ok {
+			s.callCol = int(col)
+		}
+	}
+	u.scopes = append(u.scopes, s)
+}
+
+// name returns the name of a function entry, following the abstract
+// origin of inlined and out-of-line instances
+func (t *LineTable) name(entry *dwarf.Entry) string {
+	for range 8 {
+		if name, ok := entry.Val(dwarf.AttrName).(string); ok {
+			return name
+		}
+		off, ok := entry.Val(dwarf.AttrAbstractOrigin).(dwarf.Offset)
+		if !ok {
+			off, ok = entry.Val(dwarf.AttrSpecification).(dwarf.Offset)
+		}
+		if !ok {
+			return ""
+		}
+		r := t.data.Reader()
+		r.Seek(off)
+		next, err := r.Next()
+		if err != nil || next == nil {
+			return ""
+		}
+		entry = next
+	}
+	return ""
+}
+
+func contains(ranges [][2]uint64, offset uint64) bool {
+	for _, r := range ranges {
+		if r[0] <= offset && offset < r[1] {
+			return true
+		}
+	}
+	return false
+}
+
+// validRanges drops ranges of code removed by the linker
+func validRanges(ranges [][2]uint64) [][2]uint64 {
+	out := ranges[:0]
+	for _, r := range ranges {
+		if !isTombstone(r[0]) && r[1] > r[0] {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// isTombstone reports addresses the linker invalidated. Tools use -1, -2
+// or 0 for wasm, which is never the offset of an instruction.
+func isTombstone(addr uint64) bool {
+	a := int32(addr)
+	return a == -1 || a == -2 || a == 0
+}
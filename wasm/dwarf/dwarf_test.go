@@ -0,0 +1,169 @@
+package dwarf
+
+import (
+	"encoding/binary"
+	"testing"
+
+	"github.com/wippyai/wasm-runtime/wasm"
+)
+
+// testSections builds DWARF 4 for lib.rs: run covers [0x10, 0x30) and
+// inlines helper at [0x18, 0x20), called from line 20, column 7.
+func testSections() []wasm.CustomSection {
+	abbrev := []byte{
+		// 1: compile unit with children
+		0x01, 0x11, 0x01,
+		0x03, 0x08, // name, string
+		0x10, 0x17, // stmt_list, sec_offset
+		0x11, 0x01, // low_pc, addr
+		0x12, 0x06, // high_pc, data4
+		0x00, 0x00,
+		// 2: subprogram with children
+		0x02, 0x2e, 0x01,
+		0x03, 0x08,
+		0x11, 0x01,
+		0x12, 0x06,
+		0x3a, 0x0b, // decl_file, data1
+		0x3b, 0x0b, // decl_line, data1
+		0x00, 0x00,
+		// 3: inlined subroutine
+		0x03, 0x1d, 0x00,
+		0x31, 0x13, // abstract_origin, ref4
+		0x11, 0x01,
+		0x12, 0x06,
+		0x58, 0x0b, // call_file, data1
+		0x59, 0x0b, // call_line, data1
+		0x57, 0x0b, // call_column, data1
+		0x00, 0x00,
+		// 4: abstract subprogram
+		0x04, 0x2e, 0x00,
+		0x03, 0x08,
+		0x00, 0x00,
+		0x00,
+	}
+
+	u32 := func(v uint32) []byte { return binary.LittleEndian.AppendUint32(nil, v) }
+	cat := func(parts ...[]byte) []byte {
+		var out []byte
+		for _, p := range parts {
+			out = append(out, p...)
+		}
+		return out
+	}
+
+	// Offsets in the unit are relative to its header; helper follows the
+	// 11-byte header and the compile unit entry
+	cuEntry := cat([]byte{0x01}, []byte("lib.rs\x00"), u32(0), u32(0x10), u32(0x20))
+	helperOff := uint32(11 + len(cuEntry))
+	body := cat(
+		cuEntry,
+		[]byte{0x04}, []byte("helper\x00"),
+		[]byte{0x02}, []byte("run\x00"), u32(0x10), u32(0x20), []byte{1, 9},
+		[]byte{0x03}, u32(helperOff), u32(0x18), u32(0x08), []byte{1, 20, 7},
+		[]byte{0x00}, // end of run
+		[]byte{0x00}, // end of unit
+	)
+	info := cat(u32(uint32(7+len(body))), []byte{0x04, 0x00}, u32(0), []byte{0x04}, body)
+
+	header := cat(
+		[]byte{1, 1, 1, 0xfb, 14, 13},              // min_inst, max_ops, default_is_stmt, line_base -5, line_range, opcode_base
+		[]byte{0, 1, 1, 1, 1, 0, 0, 0, 1, 0, 0, 1}, // standard opcode lengths
+		[]byte{0},                             // no include directories
+		[]byte("lib.rs\x00"), []byte{0, 0, 0}, // file 1
+		[]byte{0},
+	)
+	program := cat(
+		[]byte{0x00, 0x05, 0x02}, u32(0x10), // set_address 0x10
+		[]byte{0x03, 0x09, 0x05, 0x03, 0x01},             // line 10, column 3, copy
+		[]byte{0x02, 0x08, 0x03, 0x79, 0x05, 0x09, 0x01}, // 0x18: line 3, column 9
+		[]byte{0x02, 0x08, 0x03, 0x12, 0x05, 0x01, 0x01}, // 0x20: line 21, column 1
+		[]byte{0x02, 0x10, 0x00, 0x01, 0x01},             // 0x30: end_sequence
+	)
+	lineBody := cat([]byte{0x04, 0x00}, u32(uint32(len(header))), header, program)
+	line := cat(u32(uint32(len(lineBody))), lineBody)
+
+	return []wasm.CustomSection{
+		{Name: "name", Data: []byte{0x00}},
+		{Name: ".debug_abbrev", Data: abbrev},
+		{Name: ".debug_info", Data: info},
+		{Name: ".debug_line", Data: line},
+	}
+}
+
+func TestLineTable_Lookup(t *testing.T) {
+	table, err := New(testSections())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if table == nil {
+		t.Fatal("expected a line table")
+	}
+
+	tests := []struct {
+		offset uint64
+		want   []Location
+	}{
+		{0x10, []Location{{File: "lib.rs", Function: "run", Line: 10, Column: 3}}},
+		{0x14, []Location{{File: "lib.rs", Function: "run", Line: 10, Column: 3}}},
+		{0x1a, []Location{
+			{File: "lib.rs", Function: "helper", Line: 3, Column: 9, Inlined: true},
+			{File: "lib.rs", Function: "run", Line: 20, Column: 7},
+		}},
+		{0x2f, []Location{{File: "lib.rs", Function: "run", Line: 21, Column: 1}}},
+		{0x30, nil},
+		{0x08, nil},
+	}
+	for _, tt := range tests {
+		got := table.Lookup(tt.offset)
+		if len(got) != len(tt.want) {
+			t.Errorf("Lookup(%#x) = %+v, want %+v", tt.offset, got, tt.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("Lookup(%#x)[%d] = %+v, want %+v", tt.offset, i, got[i], tt.want[i])
+			}
+		}
+	}
+
+	if s := table.Lookup(0x1a)[0].String(); s != "lib.rs:3:9" {
+		t.Errorf("String() = %q", s)
+	}
+}
+
+func TestLineTable_Function(t *testing.T) {
+	table, err := New(testSections())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Code inlined from helper belongs to run
+	for _, offset := range []uint64{0x10, 0x1a} {
+		loc, ok := table.Function(offset)
+		want := Location{File: "lib.rs", Function: "run", Line: 9}
+		if !ok || loc != want {
+			t.Errorf("Function(%#x) = %+v, %v, want %+v", offset, loc, ok, want)
+		}
+	}
+	if loc, ok := table.Function(0x30); ok {
+		t.Errorf("Function(0x30) = %+v, want none", loc)
+	}
+	if _, ok := (*LineTable)(nil).Function(0x10); ok {
+		t.Error("nil table Function() found a function")
+	}
+}
+
+func TestLineTable_NoDWARF(t *testing.T) {
+	table, err := New([]wasm.CustomSection{{Name: "name", Data: []byte{0x00}}})
+	if err != nil || table != nil {
+		t.Fatalf("New() = %v, %v, want nil table", table, err)
+	}
+	if locs := table.Lookup(0x10); locs != nil {
+		t.Errorf("nil table Lookup() = %v", locs)
+	}
+
+	module := []byte{0x00, 0x61, 0x73, 0x6d, 0x01, 0x00, 0x00, 0x00}
+	if table, err := Load(module); err != nil || table != nil {
+		t.Errorf("Load() = %v, %v, want nil table", table, err)
+	}
+}
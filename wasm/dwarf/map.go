@@ -0,0 +1,248 @@
+package dwarf
+
+import (
+	"bytes"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/wippyai/wasm-runtime/wasm"
+)
+
+// Map resolves the functions and code offsets of a compiled module to
+// source locations through the line table of the module as loaded. The
+// runtime compiles rewritten modules, with table.grow instrumented or
+// asyncify applied, whose function indexes and code offsets no longer
+// match the DWARF they carry.
+//
+// Instructions of bodies the rewrite only inserted instructions into map
+// one to one; instructions inserted before a table.grow map to the
+// table.grow. Offsets in bodies rewritten further, as by asyncify,
+// resolve to the declaration of their function. Both modules are decoded
+// on first use. Map is safe for concurrent use.
+type Map struct {
+	original []byte
+	compiled []byte
+	table    *LineTable
+	orig     *wasm.Module
+	comp     *wasm.Module
+	origBody []span
+	compBody []span
+	once     sync.Once
+	imports  uint32 // imported functions of the compiled module
+	origImps uint32 // imported functions of the module as loaded
+}
+
+// span is the code section range of a function body, after its size
+type span struct {
+	start, end uint64
+}
+
+// NewMap returns the map of compiled, a rewrite of original; compiled
+// may be original itself. It returns nil when original carries no DWARF.
+func NewMap(original, compiled []byte) *Map {
+	if !bytes.Contains(original, []byte(".debug_line")) {
+		return nil
+	}
+	return &Map{original: original, compiled: compiled}
+}
+
+func (m *Map) load() {
+	orig, err := wasm.ParseModule(m.original)
+	if err != nil {
+		return
+	}
+	table, err := New(orig.CustomSections)
+	if err != nil || table == nil {
+		return
+	}
+	comp := orig
+	if !bytes.Equal(m.compiled, m.original) {
+		if comp, err = wasm.ParseModule(m.compiled); err != nil {
+			return
+		}
+	}
+	m.orig, m.comp = orig, comp
+	m.origBody, m.compBody = bodies(m.original), bodies(m.compiled)
+	if len(m.origBody) != len(orig.Code) || len(m.compBody) != len(comp.Code) {
+		return
+	}
+	m.origImps = uint32(orig.NumImportedFuncs())
+	m.imports = uint32(comp.NumImportedFuncs())
+	m.table = table
+}
+
+// defined returns the defined function of the compiled module at index,
+// which the rewrite keeps at the same position among defined functions
+// of the module as loaded. It reports false for imports and functions
+// the rewrite added.
+func (m *Map) defined(index uint32) (int, bool) {
+	if m == nil {
+		return 0, false
+	}
+	m.once.Do(m.load)
+	if m.table == nil || index < m.imports {
+		return 0, false
+	}
+	d := int(index - m.imports)
+	return d, d < len(m.origBody) && d < len(m.compBody)
+}
+
+// Contains reports whether offset is in the body of function index of
+// the compiled module.
+func (m *Map) Contains(index uint32, offset uint64) bool {
+	d, ok := m.defined(index)
+	return ok && offset >= m.compBody[d].start && offset < m.compBody[d].end
+}
+
+// Index returns the index in the module as loaded of function index of
+// the compiled module. It reports false for imports and functions the
+// rewrite added.
+func (m *Map) Index(index uint32) (uint32, bool) {
+	d, ok := m.defined(index)
+	if !ok {
+		return 0, false
+	}
+	return m.origImps + uint32(d), true
+}
+
+// Function returns function index of the compiled module at the file and
+// line it is declared at in the module as loaded.
+func (m *Map) Function(index uint32) (Location, bool) {
+	d, ok := m.defined(index)
+	if !ok {
+		return Location{}, false
+	}
+	return m.table.Function(m.origBody[d].start)
+}
+
+// Lookup returns the source locations of the instruction at offset in
+// function index of the compiled module, innermost first, and the offset
+// of the instruction in the module as loaded. When the instruction has no
+// counterpart there, it returns the function's declaration and offset 0.
+func (m *Map) Lookup(index uint32, offset uint64) ([]Location, uint64) {
+	if !m.Contains(index, offset) {
+		return nil, 0
+	}
+	d, _ := m.defined(index)
+	if orig, ok := m.translate(d, offset); ok {
+		if locs := m.table.Lookup(orig); len(locs) > 0 {
+			return locs, orig
+		}
+	}
+	if loc, ok := m.table.Function(m.origBody[d].start); ok {
+		return []Location{loc}, 0
+	}
+	return nil, 0
+}
+
+// translate maps offset in defined function d of the compiled module to
+// the offset of its instruction in the module as loaded.
+func (m *Map) translate(d int, offset uint64) (uint64, bool) {
+	origCode, compCode := m.orig.Code[d].Code, m.comp.Code[d].Code
+	origStart := m.origBody[d].end - uint64(len(origCode))
+	compStart := m.compBody[d].end - uint64(len(compCode))
+	if offset < compStart {
+		return 0, false // in the locals
+	}
+	rel := int(offset - compStart)
+	if bytes.Equal(origCode, compCode) {
+		return origStart + uint64(rel), true
+	}
+
+	origInstrs, origOffsets, err := wasm.DecodeInstructionsOffsets(origCode)
+	if err != nil {
+		return 0, false
+	}
+	compInstrs, compOffsets, err := wasm.DecodeInstructionsOffsets(compCode)
+	if err != nil {
+		return 0, false
+	}
+	// The compiled instruction containing offset
+	i := sort.Search(len(compOffsets), func(i int) bool { return compOffsets[i] > rel }) - 1
+	if i < 0 {
+		return 0, false
+	}
+	j := 0
+	for k := 0; k <= i; k++ {
+		if j >= len(origInstrs) {
+			return 0, false
+		}
+		switch {
+		case sameOp(compInstrs[k], origInstrs[j]):
+			if k == i {
+				return origStart + uint64(origOffsets[j]), true
+			}
+			j++
+		case isTableGrow(origInstrs[j]):
+			// Inserted before the table.grow it reports
+			if k == i {
+				return origStart + uint64(origOffsets[j]), true
+			}
+		default:
+			return 0, false
+		}
+	}
+	return 0, false
+}
+
+// sameOp reports whether a and b are the same instruction, ignoring
+// immediates other than the sub-opcode of prefixed instructions.
+func sameOp(a, b wasm.Instruction) bool {
+	if a.Opcode != b.Opcode {
+		return false
+	}
+	am, aok := a.Imm.(wasm.MiscImm)
+	bm, bok := b.Imm.(wasm.MiscImm)
+	return aok == bok && am.SubOpcode == bm.SubOpcode
+}
+
+func isTableGrow(in wasm.Instruction) bool {
+	imm, ok := in.Imm.(wasm.MiscImm)
+	return ok && in.Opcode == wasm.OpPrefixMisc && imm.SubOpcode == wasm.MiscTableGrow
+}
+
+// bodies returns the range of each function body relative to the code
+// section payload, the address space of DWARF for WebAssembly.
+func bodies(module []byte) []span {
+	if len(module) < 8 {
+		return nil
+	}
+	r := bytes.NewReader(module[8:])
+	for r.Len() > 0 {
+		id, err := r.ReadByte()
+		if err != nil {
+			return nil
+		}
+		size, err := wasm.ReadLEB128u(r)
+		if err != nil || uint64(size) > uint64(r.Len()) {
+			return nil
+		}
+		if id != wasm.SectionCode {
+			if _, err := r.Seek(int64(size), io.SeekCurrent); err != nil {
+				return nil
+			}
+			continue
+		}
+
+		payload := bytes.NewReader(module[len(module)-r.Len() : len(module)-r.Len()+int(size)])
+		count, err := wasm.ReadLEB128u(payload)
+		if err != nil {
+			return nil
+		}
+		spans := make([]span, 0, count)
+		for range count {
+			bodySize, err := wasm.ReadLEB128u(payload)
+			if err != nil || uint64(bodySize) > uint64(payload.Len()) {
+				return nil
+			}
+			start := uint64(payload.Size()) - uint64(payload.Len())
+			spans = append(spans, span{start: start, end: start + uint64(bodySize)})
+			if _, err := payload.Seek(int64(bodySize), io.SeekCurrent); err != nil {
+				return nil
+			}
+		}
+		return spans
+	}
+	return nil
+}
@@ -0,0 +1,129 @@
+package dwarf
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wippyai/wasm-runtime/wasm"
+)
+
+// mapModule builds a module whose second function is run of
+// testSections, at [0x10, 0x30), with a table.grow at 0x1a. rewrite
+// replaces the instructions before the table.grow; imports prepends a
+// function import, as table.grow instrumentation does.
+func mapModule(imports int, prefix, beforeGrow []byte) []byte {
+	filler := append(bytes.Repeat([]byte{wasm.OpNop}, 11), wasm.OpEnd)
+	run := bytes.Repeat([]byte{wasm.OpNop}, 5)
+	run = append(run, prefix...)
+	run = append(run, 0xd0, 0x70, wasm.OpI32Const, 0x01) // ref.null func, i32.const 1
+	run = append(run, beforeGrow...)
+	run = append(run, wasm.OpPrefixMisc, byte(wasm.MiscTableGrow), 0x00, wasm.OpDrop)
+	run = append(run, bytes.Repeat([]byte{wasm.OpNop}, 17)...)
+	run = append(run, wasm.OpEnd)
+
+	m := &wasm.Module{
+		Types:          []wasm.FuncType{{}},
+		Funcs:          []uint32{0, 0},
+		Tables:         []wasm.TableType{{ElemType: byte(wasm.ValFuncRef)}},
+		Code:           []wasm.FuncBody{{Code: filler}, {Code: run}},
+		CustomSections: testSections(),
+	}
+	for range imports {
+		m.Imports = append(m.Imports, wasm.Import{Module: "hook", Name: "grow", Desc: wasm.ImportDesc{Kind: wasm.KindFunc}})
+	}
+	return m.Encode()
+}
+
+func TestMap_Instrumented(t *testing.T) {
+	original := mapModule(0, nil, nil)
+	// table.size 0 and a call of the hook before the table.grow
+	compiled := mapModule(1, nil, []byte{wasm.OpPrefixMisc, byte(wasm.MiscTableSize), 0x00, wasm.OpCall, 0x00})
+	m := NewMap(original, compiled)
+	if m == nil {
+		t.Fatal("expected a map")
+	}
+
+	if idx, ok := m.Index(2); !ok || idx != 1 {
+		t.Errorf("Index(2) = %d, %v, want 1", idx, ok)
+	}
+	if _, ok := m.Index(0); ok {
+		t.Error("Index(0) mapped the inserted import")
+	}
+	if !m.Contains(2, 0x34) || m.Contains(1, 0x12) || m.Contains(2, 0x35) {
+		t.Error("Contains does not follow the compiled bodies")
+	}
+
+	inlined := []Location{
+		{File: "lib.rs", Function: "helper", Line: 3, Column: 9, Inlined: true},
+		{File: "lib.rs", Function: "run", Line: 20, Column: 7},
+	}
+	tests := []struct {
+		offset uint64
+		orig   uint64
+		want   []Location
+	}{
+		{0x1d, 0x1a, inlined}, // call of the hook, at the table.grow
+		{0x1f, 0x1a, inlined}, // table.grow
+		{0x11, 0x11, []Location{{File: "lib.rs", Function: "run", Line: 10, Column: 3}}},
+		{0x26, 0x21, []Location{{File: "lib.rs", Function: "run", Line: 21, Column: 1}}},
+	}
+	for _, tt := range tests {
+		got, orig := m.Lookup(2, tt.offset)
+		if orig != tt.orig || len(got) != len(tt.want) {
+			t.Errorf("Lookup(2, %#x) = %+v, %#x, want %+v, %#x", tt.offset, got, orig, tt.want, tt.orig)
+			continue
+		}
+		for i := range got {
+			if got[i] != tt.want[i] {
+				t.Errorf("Lookup(2, %#x)[%d] = %+v, want %+v", tt.offset, i, got[i], tt.want[i])
+			}
+		}
+	}
+
+	want := Location{File: "lib.rs", Function: "run", Line: 9}
+	if loc, ok := m.Function(2); !ok || loc != want {
+		t.Errorf("Function(2) = %+v, %v, want %+v", loc, ok, want)
+	}
+}
+
+func TestMap_Rewritten(t *testing.T) {
+	// Instructions inserted other than before table.grow, as asyncify
+	// does, leave the function's declaration only
+	original := mapModule(0, nil, nil)
+	compiled := mapModule(0, []byte{wasm.OpI32Const, 0x00, wasm.OpDrop}, nil)
+	m := NewMap(original, compiled)
+
+	got, orig := m.Lookup(1, 0x1d)
+	want := Location{File: "lib.rs", Function: "run", Line: 9}
+	if orig != 0 || len(got) != 1 || got[0] != want {
+		t.Errorf("Lookup(1, 0x1d) = %+v, %#x, want %+v", got, orig, want)
+	}
+
+	// Unchanged bodies map one to one
+	same := NewMap(original, original)
+	if got, orig := same.Lookup(1, 0x1a); orig != 0x1a || len(got) != 2 {
+		t.Errorf("Lookup(1, 0x1a) = %+v, %#x", got, orig)
+	}
+}
+
+func TestMap_NoDWARF(t *testing.T) {
+	module := (&wasm.Module{Types: []wasm.FuncType{{}}, Funcs: []uint32{0}, Code: []wasm.FuncBody{{Code: []byte{wasm.OpEnd}}}}).Encode()
+	if m := NewMap(module, module); m != nil {
+		t.Fatalf("NewMap() = %v, want nil", m)
+	}
+	var m *Map
+	if locs, _ := m.Lookup(0, 2); locs != nil {
+		t.Errorf("nil map Lookup() = %v", locs)
+	}
+	if _, ok := m.Function(0); ok {
+		t.Error("nil map Function() found a function")
+	}
+}
+
+func TestBodies(t *testing.T) {
+	spans := bodies(mapModule(0, nil, nil))
+	want := []span{{2, 0x0f}, {0x10, 0x30}}
+	if len(spans) != len(want) || spans[0] != want[0] || spans[1] != want[1] {
+		t.Errorf("bodies() = %v, want %v", spans, want)
+	}
+}
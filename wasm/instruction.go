@@ -178,11 +178,29 @@ func (i Instruction) IsIndirectCall() bool {
 
 // DecodeInstructions decodes a sequence of instructions from raw bytes
 func DecodeInstructions(code []byte) ([]Instruction, error) {
+	instrs, _, err := decodeInstructions(code, false)
+	return instrs, err
+}
+
+// DecodeInstructionsOffsets decodes instructions as DecodeInstructions
+// does, also returning the offset of each instruction in code.
+func DecodeInstructionsOffsets(code []byte) ([]Instruction, []int, error) {
+	return decodeInstructions(code, true)
+}
+
+func decodeInstructions(code []byte, withOffsets bool) ([]Instruction, []int, error) {
 	r := bytes.NewReader(code)
 	// Pre-allocate based on estimation: roughly 2 bytes per instruction on average
 	instrs := make([]Instruction, 0, len(code)/2)
+	var offsets []int
+	if withOffsets {
+		offsets = make([]int, 0, len(code)/2)
+	}
 
 	for r.Len() > 0 {
+		if withOffsets {
+			offsets = append(offsets, len(code)-r.Len())
+		}
 		op, err := r.ReadByte()
 		if err != nil {
 			break
@@ -194,56 +212,56 @@ func DecodeInstructions(code []byte) ([]Instruction, error) {
 		case OpBlock, OpLoop, OpIf, OpTry:
 			bt, err := ReadLEB128s(r)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			instr.Imm = BlockImm{Type: bt}
 
 		case OpCatch:
 			tagIdx, err := ReadLEB128u(r)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			instr.Imm = ThrowImm{TagIdx: tagIdx}
 
 		case OpThrow:
 			tagIdx, err := ReadLEB128u(r)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			instr.Imm = ThrowImm{TagIdx: tagIdx}
 
 		case OpRethrow, OpDelegate:
 			labelIdx, err := ReadLEB128u(r)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			instr.Imm = BranchImm{LabelIdx: labelIdx}
 
 		case OpTryTable:
 			bt, err := ReadLEB128s(r)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			catchCount, err := ReadLEB128u(r)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			catches := make([]CatchClause, catchCount)
 			for i := uint32(0); i < catchCount; i++ {
 				kind, err := r.ReadByte()
 				if err != nil {
-					return nil, err
+					return nil, nil, err
 				}
 				var tagIdx uint32
 				if kind == CatchKindCatch || kind == CatchKindCatchRef {
 					tagIdx, err = ReadLEB128u(r)
 					if err != nil {
-						return nil, err
+						return nil, nil, err
 					}
 				}
 				labelIdx, err := ReadLEB128u(r)
 				if err != nil {
-					return nil, err
+					return nil, nil, err
 				}
 				catches[i] = CatchClause{Kind: kind, TagIdx: tagIdx, LabelIdx: labelIdx}
 			}
@@ -252,71 +270,71 @@ func DecodeInstructions(code []byte) ([]Instruction, error) {
 		case OpBr, OpBrIf:
 			idx, err := ReadLEB128u(r)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			instr.Imm = BranchImm{LabelIdx: idx}
 
 		case OpBrTable:
 			count, err := ReadLEB128u(r)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			labels := make([]uint32, count)
 			for i := uint32(0); i < count; i++ {
 				labels[i], err = ReadLEB128u(r)
 				if err != nil {
-					return nil, err
+					return nil, nil, err
 				}
 			}
 			def, err := ReadLEB128u(r)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			instr.Imm = BrTableImm{Labels: labels, Default: def}
 
 		case OpCall, OpReturnCall:
 			idx, err := ReadLEB128u(r)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			instr.Imm = CallImm{FuncIdx: idx}
 
 		case OpCallIndirect, OpReturnCallIndirect:
 			typeIdx, err := ReadLEB128u(r)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			tableIdx, err := ReadLEB128u(r)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			instr.Imm = CallIndirectImm{TypeIdx: typeIdx, TableIdx: tableIdx}
 
 		case OpCallRef, OpReturnCallRef:
 			typeIdx, err := ReadLEB128u(r)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			instr.Imm = CallRefImm{TypeIdx: typeIdx}
 
 		case OpLocalGet, OpLocalSet, OpLocalTee:
 			idx, err := ReadLEB128u(r)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			instr.Imm = LocalImm{LocalIdx: idx}
 
 		case OpGlobalGet, OpGlobalSet:
 			idx, err := ReadLEB128u(r)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			instr.Imm = GlobalImm{GlobalIdx: idx}
 
 		case OpTableGet, OpTableSet:
 			idx, err := ReadLEB128u(r)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			instr.Imm = TableImm{TableIdx: idx}
 
@@ -327,7 +345,7 @@ func DecodeInstructions(code []byte) ([]Instruction, error) {
 			OpI32Store8, OpI32Store16, OpI64Store8, OpI64Store16, OpI64Store32:
 			memImm, err := readMemArg(r)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			instr.Imm = memImm
 
@@ -335,63 +353,63 @@ func DecodeInstructions(code []byte) ([]Instruction, error) {
 			// Memory index (0 for single memory, can be non-zero for multi-memory)
 			memIdx, err := ReadLEB128u(r)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			instr.Imm = MemoryIdxImm{MemIdx: memIdx}
 
 		case OpI32Const:
 			val, err := ReadLEB128s(r)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			instr.Imm = I32Imm{Value: val}
 
 		case OpI64Const:
 			val, err := ReadLEB128s64(r)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			instr.Imm = I64Imm{Value: val}
 
 		case OpF32Const:
 			val, err := ReadFloat32(r)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			instr.Imm = F32Imm{Value: val}
 
 		case OpF64Const:
 			val, err := ReadFloat64(r)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			instr.Imm = F64Imm{Value: val}
 
 		case OpRefNull:
 			heapType, err := ReadLEB128s64(r)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			instr.Imm = RefNullImm{HeapType: heapType}
 
 		case OpRefFunc:
 			funcIdx, err := ReadLEB128u(r)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			instr.Imm = RefFuncImm{FuncIdx: funcIdx}
 
 		case OpBrOnNull, OpBrOnNonNull:
 			labelIdx, err := ReadLEB128u(r)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			instr.Imm = BranchImm{LabelIdx: labelIdx}
 
 		case OpSelectType:
 			count, err := ReadLEB128u(r)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			types := make([]ValType, count)
 			extTypes := make([]ExtValType, count)
@@ -399,13 +417,13 @@ func DecodeInstructions(code []byte) ([]Instruction, error) {
 			for i := uint32(0); i < count; i++ {
 				t, err := r.ReadByte()
 				if err != nil {
-					return nil, err
+					return nil, nil, err
 				}
 				types[i] = ValType(t)
 				if t == byte(ValRefNull) || t == byte(ValRef) {
 					heapType, err := ReadLEB128s64(r)
 					if err != nil {
-						return nil, err
+						return nil, nil, err
 					}
 					extTypes[i] = ExtValType{
 						Kind:    ExtValKindRef,
@@ -454,7 +472,7 @@ func DecodeInstructions(code []byte) ([]Instruction, error) {
 		case OpPrefixMisc:
 			subOp, err := ReadLEB128u(r)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			imm := MiscImm{SubOpcode: subOp}
 			switch subOp {
@@ -466,107 +484,107 @@ func DecodeInstructions(code []byte) ([]Instruction, error) {
 			case MiscMemoryInit:
 				dataidx, err := ReadLEB128u(r)
 				if err != nil {
-					return nil, err
+					return nil, nil, err
 				}
 				memidx, err := ReadLEB128u(r)
 				if err != nil {
-					return nil, err
+					return nil, nil, err
 				}
 				imm.Operands = []uint32{dataidx, memidx}
 			case MiscDataDrop:
 				dataidx, err := ReadLEB128u(r)
 				if err != nil {
-					return nil, err
+					return nil, nil, err
 				}
 				imm.Operands = []uint32{dataidx}
 			case MiscMemoryCopy:
 				dstMem, err := ReadLEB128u(r)
 				if err != nil {
-					return nil, err
+					return nil, nil, err
 				}
 				srcMem, err := ReadLEB128u(r)
 				if err != nil {
-					return nil, err
+					return nil, nil, err
 				}
 				imm.Operands = []uint32{dstMem, srcMem}
 			case MiscMemoryFill:
 				memIdx, err := ReadLEB128u(r)
 				if err != nil {
-					return nil, err
+					return nil, nil, err
 				}
 				imm.Operands = []uint32{memIdx}
 			case MiscTableInit:
 				elemidx, err := ReadLEB128u(r)
 				if err != nil {
-					return nil, err
+					return nil, nil, err
 				}
 				tableidx, err := ReadLEB128u(r)
 				if err != nil {
-					return nil, err
+					return nil, nil, err
 				}
 				imm.Operands = []uint32{elemidx, tableidx}
 			case MiscElemDrop:
 				elemidx, err := ReadLEB128u(r)
 				if err != nil {
-					return nil, err
+					return nil, nil, err
 				}
 				imm.Operands = []uint32{elemidx}
 			case MiscTableCopy:
 				dst, err := ReadLEB128u(r)
 				if err != nil {
-					return nil, err
+					return nil, nil, err
 				}
 				src, err := ReadLEB128u(r)
 				if err != nil {
-					return nil, err
+					return nil, nil, err
 				}
 				imm.Operands = []uint32{dst, src}
 			case MiscTableGrow, MiscTableSize, MiscTableFill:
 				tableidx, err := ReadLEB128u(r)
 				if err != nil {
-					return nil, err
+					return nil, nil, err
 				}
 				imm.Operands = []uint32{tableidx}
 			case MiscMemoryDiscard:
 				memidx, err := ReadLEB128u(r)
 				if err != nil {
-					return nil, err
+					return nil, nil, err
 				}
 				imm.Operands = []uint32{memidx}
 			default:
-				return nil, fmt.Errorf("unknown 0xFC sub-opcode: 0x%02x", subOp)
+				return nil, nil, fmt.Errorf("unknown 0xFC sub-opcode: 0x%02x", subOp)
 			}
 			instr.Imm = imm
 
 		case OpPrefixSIMD:
 			imm, err := decodeSIMDImmediate(r)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			instr.Imm = imm
 
 		case OpPrefixAtomic:
 			imm, err := decodeAtomicImmediate(r)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			instr.Imm = imm
 
 		case OpPrefixGC:
 			imm, err := decodeGCImmediate(r)
 			if err != nil {
-				return nil, err
+				return nil, nil, err
 			}
 			instr.Imm = imm
 
 		default:
-			return nil, fmt.Errorf("unknown opcode: 0x%02x", op)
+			return nil, nil, fmt.Errorf("unknown opcode: 0x%02x", op)
 		}
 
 		instrs = append(instrs, instr)
 	}
 
-	return instrs, nil
+	return instrs, offsets, nil
 }
 
 // EncodeInstructionTo writes a single instruction to the provided buffer.
@@ -0,0 +1,253 @@
+package wasm
+
+import (
+	"bytes"
+	"fmt"
+	"slices"
+
+	"github.com/wippyai/wasm-runtime/wasm/internal/binary"
+)
+
+// Subsection IDs of the name section, including those of the extended
+// name section proposal.
+const (
+	nameModule   byte = 0
+	nameFunction byte = 1
+	nameLocal    byte = 2
+	nameLabel    byte = 3
+	nameType     byte = 4
+	nameTable    byte = 5
+	nameMemory   byte = 6
+	nameGlobal   byte = 7
+	nameElem     byte = 8
+	nameData     byte = 9
+	nameField    byte = 10
+	nameTag      byte = 11
+)
+
+// NameMap maps the indexes of an index space to their names.
+type NameMap map[uint32]string
+
+// IndirectNameMap maps a function or type index to the names of its
+// locals, labels or fields.
+type IndirectNameMap map[uint32]NameMap
+
+// Names is the decoded "name" custom section. Funcs, Globals and the
+// other index spaces include imports; Labels are numbered by the order
+// of the block, loop, if and try_table instructions in a function.
+type Names struct {
+	Funcs    NameMap
+	Locals   IndirectNameMap
+	Labels   IndirectNameMap
+	Types    NameMap
+	Tables   NameMap
+	Memories NameMap
+	Globals  NameMap
+	Elems    NameMap
+	Data     NameMap
+	Fields   IndirectNameMap
+	Tags     NameMap
+	Module   string
+}
+
+// Names decodes the module's "name" custom section, returning nil if it
+// has none. Subsections this package does not know are skipped.
+func (m *Module) Names() (*Names, error) {
+	for _, cs := range m.CustomSections {
+		if cs.Name == "name" {
+			return DecodeNames(cs.Data)
+		}
+	}
+	return nil, nil
+}
+
+// SetNames replaces the module's "name" custom section with n, or
+// removes it if n is nil.
+func (m *Module) SetNames(n *Names) {
+	sections := m.CustomSections[:0]
+	replaced := false
+	for _, cs := range m.CustomSections {
+		if cs.Name != "name" {
+			sections = append(sections, cs)
+			continue
+		}
+		if n != nil && !replaced {
+			sections = append(sections, CustomSection{Name: "name", Data: n.Encode()})
+			replaced = true
+		}
+	}
+	if n != nil && !replaced {
+		sections = append(sections, CustomSection{Name: "name", Data: n.Encode()})
+	}
+	m.CustomSections = sections
+}
+
+// DecodeNames decodes the contents of a "name" custom section.
+func DecodeNames(data []byte) (*Names, error) {
+	n := &Names{}
+	r := binary.NewReader(bytes.NewReader(data))
+	for r.Position() < len(data) {
+		id, err := r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		size, err := r.ReadU32()
+		if err != nil {
+			return nil, fmt.Errorf("name subsection %d: %w", id, err)
+		}
+		if int(size) > len(data)-r.Position() {
+			return nil, fmt.Errorf("name subsection %d: size %d exceeds section", id, size)
+		}
+		sub, err := r.ReadBytes(int(size))
+		if err != nil {
+			return nil, err
+		}
+		if err := n.decodeSubsection(id, sub); err != nil {
+			return nil, fmt.Errorf("name subsection %d: %w", id, err)
+		}
+	}
+	return n, nil
+}
+
+func (n *Names) decodeSubsection(id byte, data []byte) error {
+	r := binary.NewReader(bytes.NewReader(data))
+	var err error
+	switch id {
+	case nameModule:
+		n.Module, err = r.ReadName()
+	case nameFunction:
+		n.Funcs, err = readNameMap(r)
+	case nameLocal:
+		n.Locals, err = readIndirectNameMap(r)
+	case nameLabel:
+		n.Labels, err = readIndirectNameMap(r)
+	case nameType:
+		n.Types, err = readNameMap(r)
+	case nameTable:
+		n.Tables, err = readNameMap(r)
+	case nameMemory:
+		n.Memories, err = readNameMap(r)
+	case nameGlobal:
+		n.Globals, err = readNameMap(r)
+	case nameElem:
+		n.Elems, err = readNameMap(r)
+	case nameData:
+		n.Data, err = readNameMap(r)
+	case nameField:
+		n.Fields, err = readIndirectNameMap(r)
+	case nameTag:
+		n.Tags, err = readNameMap(r)
+	default:
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if r.Position() != len(data) {
+		return fmt.Errorf("%d trailing bytes", len(data)-r.Position())
+	}
+	return nil
+}
+
+func readNameMap(r *binary.Reader) (NameMap, error) {
+	count, err := r.ReadU32()
+	if err != nil {
+		return nil, err
+	}
+	m := make(NameMap, min(count, 1024))
+	for i := uint32(0); i < count; i++ {
+		idx, err := r.ReadU32()
+		if err != nil {
+			return nil, err
+		}
+		name, err := r.ReadName()
+		if err != nil {
+			return nil, err
+		}
+		m[idx] = name
+	}
+	return m, nil
+}
+
+func readIndirectNameMap(r *binary.Reader) (IndirectNameMap, error) {
+	count, err := r.ReadU32()
+	if err != nil {
+		return nil, err
+	}
+	m := make(IndirectNameMap, min(count, 1024))
+	for i := uint32(0); i < count; i++ {
+		idx, err := r.ReadU32()
+		if err != nil {
+			return nil, err
+		}
+		if m[idx], err = readNameMap(r); err != nil {
+			return nil, err
+		}
+	}
+	return m, nil
+}
+
+// Encode encodes n as the contents of a "name" custom section. Empty
+// subsections are left out and names are written in index order.
+func (n *Names) Encode() []byte {
+	w := binary.NewWriter()
+	if n.Module != "" {
+		sub := binary.NewWriter()
+		sub.WriteName(n.Module)
+		writeSection(w, nameModule, sub.Bytes())
+	}
+	writeNameMap(w, nameFunction, n.Funcs)
+	writeIndirectNameMap(w, nameLocal, n.Locals)
+	writeIndirectNameMap(w, nameLabel, n.Labels)
+	writeNameMap(w, nameType, n.Types)
+	writeNameMap(w, nameTable, n.Tables)
+	writeNameMap(w, nameMemory, n.Memories)
+	writeNameMap(w, nameGlobal, n.Globals)
+	writeNameMap(w, nameElem, n.Elems)
+	writeNameMap(w, nameData, n.Data)
+	writeIndirectNameMap(w, nameField, n.Fields)
+	writeNameMap(w, nameTag, n.Tags)
+	return w.Bytes()
+}
+
+func writeNameMap(w *binary.Writer, id byte, m NameMap) {
+	if len(m) == 0 {
+		return
+	}
+	sub := binary.NewWriter()
+	encodeNameMap(sub, m)
+	writeSection(w, id, sub.Bytes())
+}
+
+func writeIndirectNameMap(w *binary.Writer, id byte, m IndirectNameMap) {
+	idxs := make([]uint32, 0, len(m))
+	for idx, names := range m {
+		if len(names) > 0 {
+			idxs = append(idxs, idx)
+		}
+	}
+	if len(idxs) == 0 {
+		return
+	}
+	slices.Sort(idxs)
+	sub := binary.NewWriter()
+	sub.WriteU32(uint32(len(idxs)))
+	for _, idx := range idxs {
+		sub.WriteU32(idx)
+		encodeNameMap(sub, m[idx])
+	}
+	writeSection(w, id, sub.Bytes())
+}
+
+func encodeNameMap(w *binary.Writer, m NameMap) {
+	idxs := make([]uint32, 0, len(m))
+	for idx := range m {
+		idxs = append(idxs, idx)
+	}
+	slices.Sort(idxs)
+	w.WriteU32(uint32(len(idxs)))
+	for _, idx := range idxs {
+		w.WriteU32(idx)
+		w.WriteName(m[idx])
+	}
+}
@@ -0,0 +1,82 @@
+package wasm_test
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/wippyai/wasm-runtime/wasm"
+)
+
+func TestNamesRoundTrip(t *testing.T) {
+	want := &wasm.Names{
+		Module:   "m",
+		Funcs:    wasm.NameMap{0: "main", 3: "helper"},
+		Locals:   wasm.IndirectNameMap{0: {0: "x", 2: "tmp"}},
+		Labels:   wasm.IndirectNameMap{3: {1: "loop"}},
+		Types:    wasm.NameMap{0: "sig"},
+		Tables:   wasm.NameMap{0: "fns"},
+		Memories: wasm.NameMap{0: "heap"},
+		Globals:  wasm.NameMap{1: "sp"},
+		Elems:    wasm.NameMap{0: "init"},
+		Data:     wasm.NameMap{2: "strings"},
+		Fields:   wasm.IndirectNameMap{1: {0: "x", 1: "y"}},
+		Tags:     wasm.NameMap{0: "exn"},
+	}
+
+	m := &wasm.Module{CustomSections: []wasm.CustomSection{{Name: "producers"}}}
+	m.SetNames(want)
+	decoded, err := wasm.ParseModule(m.Encode())
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := decoded.Names()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Names() = %+v, want %+v", got, want)
+	}
+
+	// Setting names again replaces the section
+	decoded.SetNames(&wasm.Names{Module: "renamed"})
+	if len(decoded.CustomSections) != 2 {
+		t.Fatalf("custom sections = %d, want 2", len(decoded.CustomSections))
+	}
+	if got, _ := decoded.Names(); got == nil || got.Module != "renamed" || got.Funcs != nil {
+		t.Errorf("Names() after SetNames = %+v", got)
+	}
+	decoded.SetNames(nil)
+	if got, err := decoded.Names(); got != nil || err != nil {
+		t.Errorf("Names() after removal = %+v, %v", got, err)
+	}
+}
+
+func TestDecodeNamesSkipsUnknownSubsections(t *testing.T) {
+	data := []byte{
+		0x0f, 0x02, 0xaa, 0xbb, // unknown subsection 15
+		0x00, 0x02, 0x01, 'm', // module name
+	}
+	n, err := wasm.DecodeNames(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n.Module != "m" {
+		t.Errorf("module name = %q, want m", n.Module)
+	}
+}
+
+func TestDecodeNamesErrors(t *testing.T) {
+	tests := map[string][]byte{
+		"size past end":  {0x01, 0x10, 0x00},
+		"truncated map":  {0x01, 0x02, 0x02, 0x00},
+		"trailing bytes": {0x00, 0x03, 0x01, 'm', 0x00},
+		"invalid utf-8":  {0x00, 0x02, 0x01, 0xff},
+	}
+	for name, data := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := wasm.DecodeNames(data); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
@@ -0,0 +1,250 @@
+package opt
+
+import "github.com/wippyai/wasm-runtime/wasm"
+
+// RemoveDeadCode removes the defined functions and globals that cannot be
+// reached from the exports, the start function, element segments and the
+// initializers of live globals. Declarative element segments only declare
+// functions for ref.func, so they keep just the live ones.
+func RemoveDeadCode(m *wasm.Module) error {
+	return prune(m, pruneFuncs|pruneGlobals)
+}
+
+// RemoveUnusedSegments removes the passive data and element segments no
+// code refers to, and the declarative element segments left empty. Active
+// segments initialize memories and tables, so they stay.
+func RemoveUnusedSegments(m *wasm.Module) error {
+	return prune(m, pruneSegments)
+}
+
+// Spaces pruned by prune; entries of the other spaces are all live.
+const (
+	pruneFuncs = 1 << iota
+	pruneGlobals
+	pruneSegments
+)
+
+func prune(m *wasm.Module, spaces int) error {
+	mod, err := decode(m)
+	if err != nil {
+		return err
+	}
+	l, err := mod.liveness(spaces)
+	if err != nil {
+		return err
+	}
+
+	var rn renumbering
+	if spaces&pruneFuncs != 0 {
+		rn.funcs = newIndexMap(len(l.funcs), func(i uint32) bool { return l.funcs[i] }, nil)
+	}
+	if spaces&pruneGlobals != 0 {
+		rn.globals = newIndexMap(len(l.globals), func(i uint32) bool { return l.globals[i] }, nil)
+	}
+	if spaces&pruneSegments != 0 {
+		rn.elems = newIndexMap(len(l.elems), func(i uint32) bool {
+			// A declarative segment declares the live functions it lists
+			return l.elems[i] || (declarative(&m.Elements[i]) && l.declares(&m.Elements[i]))
+		}, nil)
+		rn.data = newIndexMap(len(l.data), func(i uint32) bool { return l.data[i] }, nil)
+	}
+	if err := mod.renumber(rn); err != nil {
+		return err
+	}
+	mod.encode()
+	return nil
+}
+
+// liveness records the entries reachable from the module's roots.
+type liveness struct {
+	mod *module
+
+	funcs   []bool
+	globals []bool
+	elems   []bool
+	data    []bool
+
+	// pending holds the live functions whose bodies are not scanned yet
+	pending []uint32
+	err     error
+}
+
+// liveness marks the entries of the pruned spaces that the module uses;
+// every entry of the other spaces is a root.
+func (mod *module) liveness(spaces int) (*liveness, error) {
+	l := &liveness{
+		mod:     mod,
+		funcs:   make([]bool, mod.numFuncs()),
+		globals: make([]bool, mod.numGlobals()),
+		elems:   make([]bool, len(mod.Elements)),
+		data:    make([]bool, len(mod.Data)),
+	}
+
+	// Imports stay, so they are live whatever the spaces
+	for i := 0; i < mod.NumImportedFuncs(); i++ {
+		l.markFunc(uint32(i))
+	}
+	for i := 0; i < mod.NumImportedGlobals(); i++ {
+		l.markGlobal(uint32(i))
+	}
+	if spaces&pruneFuncs == 0 {
+		for i := range l.funcs {
+			l.markFunc(uint32(i))
+		}
+	}
+	if spaces&pruneGlobals == 0 {
+		for i := range l.globals {
+			l.markGlobal(uint32(i))
+		}
+	}
+	for i := range mod.Elements {
+		if spaces&pruneSegments == 0 || active(&mod.Elements[i]) {
+			l.markElem(uint32(i))
+		}
+	}
+	for i := range mod.Data {
+		if spaces&pruneSegments == 0 || mod.Data[i].Flags&1 == 0 {
+			l.markData(uint32(i))
+		}
+	}
+
+	for _, e := range mod.Exports {
+		switch e.Kind {
+		case wasm.KindFunc:
+			l.markFunc(e.Idx)
+		case wasm.KindGlobal:
+			l.markGlobal(e.Idx)
+		}
+	}
+	if mod.Start != nil {
+		l.markFunc(*mod.Start)
+	}
+	for i := range mod.Tables {
+		l.scanExpr(mod.Tables[i].Init)
+	}
+
+	for len(l.pending) > 0 && l.err == nil {
+		idx := l.pending[len(l.pending)-1]
+		l.pending = l.pending[:len(l.pending)-1]
+		l.scan(mod.body(idx))
+	}
+	return l, l.err
+}
+
+func (l *liveness) markFunc(idx uint32) {
+	if int(idx) >= len(l.funcs) || l.funcs[idx] {
+		return
+	}
+	l.funcs[idx] = true
+	if l.mod.body(idx) != nil {
+		l.pending = append(l.pending, idx)
+	}
+}
+
+func (l *liveness) markGlobal(idx uint32) {
+	if int(idx) >= len(l.globals) || l.globals[idx] {
+		return
+	}
+	l.globals[idx] = true
+	if first := uint32(l.mod.NumImportedGlobals()); idx >= first {
+		l.scanExpr(l.mod.Globals[idx-first].Init)
+	}
+}
+
+func (l *liveness) markElem(idx uint32) {
+	if int(idx) >= len(l.elems) || l.elems[idx] {
+		return
+	}
+	l.elems[idx] = true
+	e := &l.mod.Elements[idx]
+	l.scanExpr(e.Offset)
+	if declarative(e) {
+		// Declared functions are live only if something else uses them
+		return
+	}
+	for _, f := range e.FuncIdxs {
+		l.markFunc(f)
+	}
+	for _, expr := range e.Exprs {
+		l.scanExpr(expr)
+	}
+}
+
+func (l *liveness) markData(idx uint32) {
+	if int(idx) >= len(l.data) || l.data[idx] {
+		return
+	}
+	l.data[idx] = true
+	l.scanExpr(l.mod.Data[idx].Offset)
+}
+
+// declares reports whether e lists a live function.
+func (l *liveness) declares(e *wasm.Element) bool {
+	for _, f := range e.FuncIdxs {
+		if int(f) < len(l.funcs) && l.funcs[f] {
+			return true
+		}
+	}
+	for _, expr := range e.Exprs {
+		instrs, err := decodeExpr(expr)
+		if err != nil {
+			// Keep what cannot be analyzed
+			return true
+		}
+		for _, in := range instrs {
+			if imm, ok := in.Imm.(wasm.RefFuncImm); ok && int(imm.FuncIdx) < len(l.funcs) && l.funcs[imm.FuncIdx] {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (l *liveness) scanExpr(code []byte) {
+	if len(code) == 0 || l.err != nil {
+		return
+	}
+	instrs, err := decodeExpr(code)
+	if err != nil {
+		l.err = err
+		return
+	}
+	l.scan(instrs)
+}
+
+func (l *liveness) scan(instrs []wasm.Instruction) {
+	for _, in := range instrs {
+		switch imm := in.Imm.(type) {
+		case wasm.CallImm:
+			l.markFunc(imm.FuncIdx)
+		case wasm.RefFuncImm:
+			l.markFunc(imm.FuncIdx)
+		case wasm.GlobalImm:
+			l.markGlobal(imm.GlobalIdx)
+		case wasm.MiscImm:
+			switch imm.SubOpcode {
+			case wasm.MiscMemoryInit, wasm.MiscDataDrop:
+				l.markData(imm.Operands[0])
+			case wasm.MiscTableInit, wasm.MiscElemDrop:
+				l.markElem(imm.Operands[0])
+			}
+		case wasm.GCImm:
+			switch imm.SubOpcode {
+			case wasm.GCArrayNewData, wasm.GCArrayInitData:
+				l.markData(imm.DataIdx)
+			case wasm.GCArrayNewElem, wasm.GCArrayInitElem:
+				l.markElem(imm.ElemIdx)
+			}
+		}
+	}
+}
+
+// active reports whether e is copied into a table at instantiation.
+func active(e *wasm.Element) bool {
+	return e.Flags&1 == 0
+}
+
+// declarative reports whether e only declares functions for ref.func.
+func declarative(e *wasm.Element) bool {
+	return e.Flags&3 == 3
+}
@@ -0,0 +1,30 @@
+// Package opt shrinks core WebAssembly modules with passes over a decoded
+// wasm.Module. It is meant to run after transforms that inflate code, such
+// as asyncify:
+//
+//	out, err := asyncify.Transform(data, cfg)
+//	m, err := wasm.ParseModule(out)
+//	err = opt.Optimize(m, opt.Options{Strip: opt.DebugSections})
+//	small := m.Encode()
+//
+// Each pass is also available on its own:
+//   - RemoveDeadCode drops defined functions and globals that cannot be
+//     reached from exports, the start function, active element segments
+//     or the initializers of live globals and segments.
+//   - RemoveUnusedSegments drops passive data and element segments that no
+//     code refers to, and empty declarative element segments.
+//   - DedupTypes merges equal function types and drops unused ones.
+//   - MergeFunctions folds defined functions with the same type, locals
+//     and code into one.
+//   - StripCustomSections drops custom sections by name pattern.
+//
+// Imports, tables, memories and tags are never removed, so the module keeps
+// its interface. Every reference to a renumbered function, global, type or
+// segment is updated, including the name section. Code offsets change, so
+// DWARF sections describe the original code; strip them with DebugSections.
+// Relocatable object files are not supported, since their linking and
+// reloc.* sections are not rewritten.
+//
+// Modules with GC types keep their type section as is: DedupTypes leaves
+// them unchanged, while the other passes work as for any module.
+package opt
@@ -0,0 +1,64 @@
+package opt
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wippyai/wasm-runtime/wasm"
+)
+
+// MergeFunctions folds defined functions with the same type index, locals
+// and code into the first of them, and redirects every reference to the
+// folded ones. Merging repeats until no functions are left to fold, so
+// functions that differ only in calls to merged ones are folded too. Run
+// DedupTypes first to compare functions whose types were declared twice.
+func MergeFunctions(m *wasm.Module) error {
+	mod, err := decode(m)
+	if err != nil {
+		return err
+	}
+	first := uint32(m.NumImportedFuncs())
+	for {
+		canonical := make(map[string]uint32)
+		alias := make(map[uint32]uint32)
+		for i, instrs := range mod.bodies {
+			idx := first + uint32(i)
+			key := funcKey(m.Funcs[i], m.Code[i].Locals, instrs)
+			if c, ok := canonical[key]; ok {
+				alias[idx] = c
+			} else {
+				canonical[key] = idx
+			}
+		}
+		if len(alias) == 0 {
+			break
+		}
+		funcs := newIndexMap(mod.numFuncs(), func(i uint32) bool {
+			_, merged := alias[i]
+			return !merged
+		}, func(i uint32) (uint32, bool) {
+			c, ok := alias[i]
+			return c, ok
+		})
+		if err := mod.renumber(renumbering{funcs: funcs}); err != nil {
+			return err
+		}
+	}
+	mod.encode()
+	return nil
+}
+
+// funcKey identifies a function by its type, locals and code.
+func funcKey(typeIdx uint32, locals []wasm.LocalEntry, instrs []wasm.Instruction) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "%d;", typeIdx)
+	for _, le := range locals {
+		fmt.Fprintf(&b, "%d:%d", le.Count, le.ValType)
+		if le.ExtType != nil {
+			fmt.Fprintf(&b, ":%v", *le.ExtType)
+		}
+		b.WriteByte(';')
+	}
+	b.Write(wasm.EncodeInstructions(instrs))
+	return b.String()
+}
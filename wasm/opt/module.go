@@ -0,0 +1,100 @@
+package opt
+
+import (
+	"fmt"
+
+	"github.com/wippyai/wasm-runtime/wasm"
+)
+
+// module is a wasm.Module with its function bodies decoded.
+type module struct {
+	*wasm.Module
+
+	// bodies holds the code of each defined function, closing end included
+	bodies [][]wasm.Instruction
+}
+
+func decode(m *wasm.Module) (*module, error) {
+	mod := &module{Module: m, bodies: make([][]wasm.Instruction, len(m.Code))}
+	first := m.NumImportedFuncs()
+	for i := range m.Code {
+		instrs, err := wasm.DecodeInstructions(m.Code[i].Code)
+		if err != nil {
+			return nil, fmt.Errorf("function %d: %w", first+i, err)
+		}
+		mod.bodies[i] = instrs
+	}
+	return mod, nil
+}
+
+// encode writes the decoded bodies back to the module.
+func (mod *module) encode() {
+	for i, instrs := range mod.bodies {
+		mod.Code[i].Code = wasm.EncodeInstructions(instrs)
+	}
+}
+
+func (mod *module) numFuncs() int {
+	return mod.NumImportedFuncs() + len(mod.Code)
+}
+
+func (mod *module) numGlobals() int {
+	return mod.NumImportedGlobals() + len(mod.Globals)
+}
+
+// body returns the code of function idx, or nil for an import.
+func (mod *module) body(idx uint32) []wasm.Instruction {
+	first := uint32(mod.NumImportedFuncs())
+	if idx < first {
+		return nil
+	}
+	return mod.bodies[idx-first]
+}
+
+// constExpr is a decoded constant expression and where it is stored.
+type constExpr struct {
+	code   *[]byte
+	instrs []wasm.Instruction
+}
+
+// constExprs decodes every constant expression of the module.
+func (mod *module) constExprs() ([]constExpr, error) {
+	var codes []*[]byte
+	for i := range mod.Globals {
+		codes = append(codes, &mod.Globals[i].Init)
+	}
+	for i := range mod.Tables {
+		codes = append(codes, &mod.Tables[i].Init)
+	}
+	for i := range mod.Elements {
+		e := &mod.Elements[i]
+		codes = append(codes, &e.Offset)
+		for j := range e.Exprs {
+			codes = append(codes, &e.Exprs[j])
+		}
+	}
+	for i := range mod.Data {
+		codes = append(codes, &mod.Data[i].Offset)
+	}
+
+	exprs := make([]constExpr, 0, len(codes))
+	for _, code := range codes {
+		if len(*code) == 0 {
+			continue
+		}
+		instrs, err := decodeExpr(*code)
+		if err != nil {
+			return nil, err
+		}
+		exprs = append(exprs, constExpr{code: code, instrs: instrs})
+	}
+	return exprs, nil
+}
+
+func decodeExpr(code []byte) ([]wasm.Instruction, error) {
+	instrs, err := wasm.DecodeInstructions(code)
+	if err != nil {
+		return nil, fmt.Errorf("constant expression: %w", err)
+	}
+	return instrs, nil
+}
@@ -0,0 +1,65 @@
+package opt
+
+import (
+	"path"
+	"slices"
+
+	"github.com/wippyai/wasm-runtime/wasm"
+)
+
+// DebugSections matches the custom sections that only carry debug
+// information: DWARF and references to external debug files or source
+// maps. The name section is not included, as traps and profiles use it.
+var DebugSections = []string{".debug_*", "external_debug_info", "sourceMappingURL"}
+
+// Options configures Optimize.
+type Options struct {
+	// Strip holds path.Match patterns of custom section names to remove
+	Strip []string
+}
+
+// Optimize runs every pass over m: it strips custom sections, merges
+// duplicate types and functions, then removes the functions, globals,
+// segments and types left unused.
+func Optimize(m *wasm.Module, opts Options) error {
+	if err := StripCustomSections(m, opts.Strip...); err != nil {
+		return err
+	}
+	passes := []func(*wasm.Module) error{
+		DedupTypes,
+		MergeFunctions,
+		removeUnused,
+		DedupTypes,
+	}
+	for _, pass := range passes {
+		if err := pass(m); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// removeUnused prunes functions, globals and segments in one analysis, so
+// functions only listed by unused segments go too.
+func removeUnused(m *wasm.Module) error {
+	return prune(m, pruneFuncs|pruneGlobals|pruneSegments)
+}
+
+// StripCustomSections removes the custom sections whose name matches any
+// of patterns, in path.Match syntax.
+func StripCustomSections(m *wasm.Module, patterns ...string) error {
+	for _, p := range patterns {
+		if _, err := path.Match(p, ""); err != nil {
+			return err
+		}
+	}
+	m.CustomSections = slices.DeleteFunc(m.CustomSections, func(cs wasm.CustomSection) bool {
+		for _, p := range patterns {
+			if ok, _ := path.Match(p, cs.Name); ok {
+				return true
+			}
+		}
+		return false
+	})
+	return nil
+}
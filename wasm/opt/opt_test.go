@@ -0,0 +1,298 @@
+package opt_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+
+	"github.com/wippyai/wasm-runtime/asyncify"
+	"github.com/wippyai/wasm-runtime/wasm"
+	"github.com/wippyai/wasm-runtime/wasm/opt"
+	"github.com/wippyai/wasm-runtime/wat"
+)
+
+func parse(t *testing.T, src string) *wasm.Module {
+	t.Helper()
+	bin, err := wat.Compile(src)
+	if err != nil {
+		t.Fatalf("Compile: %v", err)
+	}
+	m, err := wasm.ParseModule(bin)
+	if err != nil {
+		t.Fatalf("ParseModule: %v", err)
+	}
+	return m
+}
+
+// instantiate validates m and instantiates it with an "env" host module
+// providing sleep, which does nothing.
+func instantiate(t *testing.T, m *wasm.Module) api.Module {
+	t.Helper()
+	bin := m.Encode()
+	out, err := wasm.ParseModuleValidate(bin)
+	if err != nil {
+		t.Fatalf("optimized module is invalid: %v", err)
+	}
+	if err := out.ValidateCode(); err != nil {
+		t.Fatalf("optimized code is invalid: %v", err)
+	}
+
+	ctx := context.Background()
+	rt := wazero.NewRuntime(ctx)
+	t.Cleanup(func() { rt.Close(ctx) })
+	_, err = rt.NewHostModuleBuilder("env").
+		NewFunctionBuilder().WithFunc(func(uint32) {}).Export("sleep").
+		Instantiate(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mod, err := rt.Instantiate(ctx, bin)
+	if err != nil {
+		t.Fatalf("instantiate optimized module: %v", err)
+	}
+	return mod
+}
+
+func call(t *testing.T, mod api.Module, name string, args ...uint64) uint64 {
+	t.Helper()
+	res, err := mod.ExportedFunction(name).Call(context.Background(), args...)
+	if err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+	return res[0]
+}
+
+func names(t *testing.T, m *wasm.Module) *wasm.Names {
+	t.Helper()
+	n, err := m.Names()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return n
+}
+
+func TestRemoveDeadCode(t *testing.T) {
+	m := parse(t, `(module
+		(import "env" "sleep" (func $sleep (param i32)))
+		(table 1 funcref)
+		(global $dead_fn funcref (ref.func $dead))
+		(global $base i32 (i32.const 40))
+		(global $unused (mut i32) (i32.const 0))
+		(func $dead (result i32) (call $dead_helper))
+		(func $dead_helper (result i32) (global.get $unused))
+		(func $add (param $x i32) (result i32)
+			(i32.add (local.get $x) (global.get $base)))
+		(func $indirect (result i32) (i32.const 2))
+		(func $run (export "run") (result i32)
+			(drop (ref.func $add))
+			(i32.add
+				(call $add (i32.const 0))
+				(call_indirect (result i32) (i32.const 0))))
+		(elem (i32.const 0) $indirect)
+		(elem declare func $add $dead_helper))`)
+
+	if err := opt.RemoveDeadCode(m); err != nil {
+		t.Fatal(err)
+	}
+	if len(m.Code) != 3 || len(m.Globals) != 1 {
+		t.Fatalf("kept %d functions and %d globals, want 3 and 1", len(m.Code), len(m.Globals))
+	}
+	n := names(t, m)
+	want := map[uint32]string{0: "sleep", 1: "add", 2: "indirect", 3: "run"}
+	for idx, name := range want {
+		if n.Funcs[idx] != name {
+			t.Errorf("function %d = %q, want %q", idx, n.Funcs[idx], name)
+		}
+	}
+	if len(n.Funcs) != len(want) || n.Locals[1][0] != "x" || n.Globals[0] != "base" {
+		t.Errorf("names = %v, locals = %v, globals = %v", n.Funcs, n.Locals, n.Globals)
+	}
+	if declared := m.Elements[1].FuncIdxs; len(declared) != 1 || declared[0] != 1 {
+		t.Errorf("declarative segment = %v, want [1]", declared)
+	}
+
+	mod := instantiate(t, m)
+	if got := call(t, mod, "run"); got != 42 {
+		t.Errorf("run = %d, want 42", got)
+	}
+}
+
+func TestRemoveUnusedSegments(t *testing.T) {
+	m := parse(t, `(module
+		(memory 1)
+		(table 2 funcref)
+		(data $unused "unused")
+		(data $active (i32.const 0) "\2a")
+		(data $init "\07")
+		(elem $dropped func $f)
+		(elem $idle func $f)
+		(elem $fill func $f)
+		(func $f (result i32) (i32.const 1))
+		(func (export "run") (result i32)
+			(memory.init $init (i32.const 1) (i32.const 0) (i32.const 1))
+			(elem.drop $dropped)
+			(table.init $fill (i32.const 1) (i32.const 0) (i32.const 1))
+			(i32.add (i32.load8_u (i32.const 0)) (i32.load8_u (i32.const 1)))))`)
+
+	if err := opt.RemoveUnusedSegments(m); err != nil {
+		t.Fatal(err)
+	}
+	if len(m.Data) != 2 || *m.DataCount != 2 || len(m.Elements) != 2 {
+		t.Fatalf("kept %d data and %d element segments, want 2 and 2", len(m.Data), len(m.Elements))
+	}
+	n := names(t, m)
+	if n.Data[0] != "active" || n.Data[1] != "init" || n.Elems[0] != "dropped" || n.Elems[1] != "fill" {
+		t.Errorf("data names = %v, element names = %v", n.Data, n.Elems)
+	}
+
+	mod := instantiate(t, m)
+	if got := call(t, mod, "run"); got != 49 {
+		t.Errorf("run = %d, want 49", got)
+	}
+}
+
+func TestDedupTypes(t *testing.T) {
+	m := parse(t, `(module
+		(type $unused (func (param f64)))
+		(type $a (func (param i32) (result i32)))
+		(type $b (func (param i32) (result i32)))
+		(type $pair (func (result i32 i32)))
+		(table 1 funcref)
+		(func $double (type $b) (i32.add (local.get 0) (local.get 0)))
+		(func (export "run") (type $a)
+			(block (type $pair) (i32.const 1) (local.get 0))
+			(i32.add)
+			(call_indirect (type $b) (i32.const 0)))
+		(elem (i32.const 0) $double))`)
+
+	if err := opt.DedupTypes(m); err != nil {
+		t.Fatal(err)
+	}
+	if len(m.Types) != 2 {
+		t.Fatalf("types = %v, want the i32 -> i32 and pair types", m.Types)
+	}
+	if n := names(t, m); n.Types[0] != "a" || n.Types[1] != "pair" {
+		t.Errorf("type names = %v", n.Types)
+	}
+	mod := instantiate(t, m)
+	if got := call(t, mod, "run", 20); got != 42 {
+		t.Errorf("run(20) = %d, want 42", got)
+	}
+
+	gc := parse(t, `(module
+		(type $p (struct (field i32)))
+		(type $q (struct (field i32)))
+		(func (export "f") (result i32) (struct.get $q 0 (struct.new $q (i32.const 1)))))`)
+	before := len(gc.TypeDefs)
+	if err := opt.DedupTypes(gc); err != nil {
+		t.Fatal(err)
+	}
+	if len(gc.TypeDefs) != before {
+		t.Errorf("GC types changed from %d to %d", before, len(gc.TypeDefs))
+	}
+}
+
+func TestMergeFunctions(t *testing.T) {
+	m := parse(t, `(module
+		(table 2 funcref)
+		(func $one (result i32) (i32.const 1))
+		(func $also_one (result i32) (i32.const 1))
+		(func $two (export "two") (result i32) (i32.add (call $one) (call $one)))
+		(func $also_two (export "also_two") (result i32) (i32.add (call $also_one) (call $also_one)))
+		(func (export "run") (result i32)
+			(i32.add (call_indirect (result i32) (i32.const 0)) (call $also_two)))
+		(elem (i32.const 0) $also_one $also_two))`)
+
+	if err := opt.MergeFunctions(m); err != nil {
+		t.Fatal(err)
+	}
+	if len(m.Code) != 3 {
+		t.Fatalf("kept %d functions, want 3", len(m.Code))
+	}
+	for _, e := range m.Exports {
+		if e.Name != "run" && e.Idx != 1 {
+			t.Errorf("export %s = function %d, want 1", e.Name, e.Idx)
+		}
+	}
+	if got := m.Elements[0].FuncIdxs; got[0] != 0 || got[1] != 1 {
+		t.Errorf("elements = %v, want [0 1]", got)
+	}
+	if n := names(t, m); n.Funcs[0] != "one" || n.Funcs[1] != "two" {
+		t.Errorf("names = %v", n.Funcs)
+	}
+
+	mod := instantiate(t, m)
+	if got := call(t, mod, "run"); got != 3 {
+		t.Errorf("run = %d, want 3", got)
+	}
+}
+
+func TestStripCustomSections(t *testing.T) {
+	m := &wasm.Module{CustomSections: []wasm.CustomSection{
+		{Name: ".debug_info"},
+		{Name: "name"},
+		{Name: ".debug_line"},
+		{Name: "producers"},
+		{Name: "sourceMappingURL"},
+	}}
+	if err := opt.StripCustomSections(m, opt.DebugSections...); err != nil {
+		t.Fatal(err)
+	}
+	if len(m.CustomSections) != 2 || m.CustomSections[0].Name != "name" || m.CustomSections[1].Name != "producers" {
+		t.Errorf("sections = %v", m.CustomSections)
+	}
+	if err := opt.StripCustomSections(m, "[name"); err == nil {
+		t.Error("bad pattern accepted")
+	}
+}
+
+func TestOptimizeAsyncified(t *testing.T) {
+	src := `(module
+		(import "env" "sleep" (func $sleep (param i32)))
+		(memory (export "memory") 1)
+		(data $unused "never copied")
+		(func $work (param $n i32) (result i32)
+			(call $sleep (local.get $n))
+			(i32.mul (local.get $n) (i32.const 2)))
+		(func $work_copy (param $n i32) (result i32)
+			(call $sleep (local.get $n))
+			(i32.mul (local.get $n) (i32.const 2)))
+		(func $unreachable (param $n i32) (result i32)
+			(call $work (local.get $n)))
+		(func (export "run") (param $n i32) (result i32)
+			(i32.add (call $work (local.get $n)) (call $work_copy (local.get $n)))))`
+	bin, err := wat.Compile(src)
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := asyncify.Transform(bin, asyncify.Config{AsyncImports: []string{"env.sleep"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := wasm.ParseModule(out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exports := len(m.Exports)
+
+	if err := opt.Optimize(m, opt.Options{Strip: opt.DebugSections}); err != nil {
+		t.Fatal(err)
+	}
+	if size := len(m.Encode()); size >= len(out) {
+		t.Errorf("optimized size %d, want less than %d", size, len(out))
+	}
+	if len(m.Exports) != exports || len(m.Data) != 0 {
+		t.Errorf("exports = %d, want %d; data = %d, want 0", len(m.Exports), exports, len(m.Data))
+	}
+	// work_copy merges into work and unreachable goes
+	if n := names(t, m); n.Funcs[1] != "work" || len(n.Funcs) != 2 {
+		t.Errorf("names = %v", n.Funcs)
+	}
+
+	mod := instantiate(t, m)
+	if got := call(t, mod, "run", 5); got != 20 {
+		t.Errorf("run(5) = %d, want 20", got)
+	}
+}
@@ -0,0 +1,348 @@
+package opt
+
+import (
+	"slices"
+
+	"github.com/wippyai/wasm-runtime/wasm"
+)
+
+// removed is the new index of an entry deleted without a replacement.
+const removed = ^uint32(0)
+
+// indexMap renumbers an index space. to[i] is the new index for references
+// to old index i and keep[i] reports whether entry i itself survives; an
+// entry merged into another is dropped but maps to the one it merged into.
+type indexMap struct {
+	to   []uint32
+	keep []bool
+
+	// seen, if set, records the indexes looked up
+	seen []bool
+}
+
+// newIndexMap numbers the kept entries of an n-entry space in order. An
+// entry that is not kept maps to the new index of alias(i) when alias
+// reports one, or to removed.
+func newIndexMap(n int, keep func(uint32) bool, alias func(uint32) (uint32, bool)) *indexMap {
+	im := &indexMap{to: make([]uint32, n), keep: make([]bool, n)}
+	var next uint32
+	for i := range im.to {
+		if im.keep[i] = keep(uint32(i)); im.keep[i] {
+			im.to[i] = next
+			next++
+		}
+	}
+	for i := range im.to {
+		if im.keep[i] {
+			continue
+		}
+		im.to[i] = removed
+		if alias != nil {
+			if target, ok := alias(uint32(i)); ok {
+				im.to[i] = im.to[target]
+			}
+		}
+	}
+	return im
+}
+
+// identity reports whether im changes nothing.
+func (im *indexMap) identity() bool {
+	for i, to := range im.to {
+		if !im.keep[i] || to != uint32(i) {
+			return false
+		}
+	}
+	return true
+}
+
+func (im *indexMap) index(idx uint32) uint32 {
+	if im == nil || int(idx) >= len(im.to) {
+		return idx
+	}
+	if im.seen != nil {
+		im.seen[idx] = true
+	}
+	return im.to[idx]
+}
+
+func (im *indexMap) update(idx *uint32) {
+	*idx = im.index(*idx)
+}
+
+func (im *indexMap) names(names wasm.NameMap) wasm.NameMap {
+	if im == nil || names == nil {
+		return names
+	}
+	out := make(wasm.NameMap, len(names))
+	for idx, name := range names {
+		if int(idx) < len(im.keep) && im.keep[idx] {
+			out[im.to[idx]] = name
+		}
+	}
+	return out
+}
+
+func (im *indexMap) indirectNames(names wasm.IndirectNameMap) wasm.IndirectNameMap {
+	if im == nil || names == nil {
+		return names
+	}
+	out := make(wasm.IndirectNameMap, len(names))
+	for idx, inner := range names {
+		if int(idx) < len(im.keep) && im.keep[idx] {
+			out[im.to[idx]] = inner
+		}
+	}
+	return out
+}
+
+// compact drops the entries of s that im does not keep. s holds the
+// defined entries of the space, which start at index first.
+func compact[T any](s []T, im *indexMap, first int) []T {
+	if im == nil {
+		return s
+	}
+	out := s[:0]
+	for i, v := range s {
+		if im.keep[first+i] {
+			out = append(out, v)
+		}
+	}
+	clear(s[len(out):])
+	return out
+}
+
+// renumbering holds the index maps applied by renumber; a nil map leaves
+// its space unchanged. Only the defined entries of a space may be
+// dropped, and types only in modules without GC types.
+type renumbering struct {
+	funcs   *indexMap
+	globals *indexMap
+	types   *indexMap
+	elems   *indexMap
+	data    *indexMap
+}
+
+// renumber deletes the entries rn drops and rewrites every reference to
+// the entries that move. Nothing live may refer to a removed entry, with
+// one exception: declarative element segments lose their removed
+// functions.
+func (mod *module) renumber(rn renumbering) error {
+	names, err := mod.Names()
+	if err != nil {
+		return err
+	}
+	if rn.funcs != nil {
+		if err := mod.dropRemovedFuncs(rn.funcs); err != nil {
+			return err
+		}
+	}
+	exprs, err := mod.constExprs()
+	if err != nil {
+		return err
+	}
+	for _, e := range exprs {
+		rn.instrs(e.instrs)
+		*e.code = wasm.EncodeInstructions(e.instrs)
+	}
+	for _, body := range mod.bodies {
+		rn.instrs(body)
+	}
+
+	for i := range mod.Types {
+		rn.funcType(&mod.Types[i])
+	}
+	for i := range mod.Imports {
+		d := &mod.Imports[i].Desc
+		switch d.Kind {
+		case wasm.KindFunc:
+			rn.types.update(&d.TypeIdx)
+		case wasm.KindTable:
+			rn.refType(d.Table.RefElemType)
+		case wasm.KindGlobal:
+			rn.valType(d.Global.ExtType)
+		case wasm.KindTag:
+			rn.types.update(&d.Tag.TypeIdx)
+		}
+	}
+	for i := range mod.Funcs {
+		rn.types.update(&mod.Funcs[i])
+	}
+	for i := range mod.Tables {
+		rn.refType(mod.Tables[i].RefElemType)
+	}
+	for i := range mod.Globals {
+		rn.valType(mod.Globals[i].Type.ExtType)
+	}
+	for i := range mod.Tags {
+		rn.types.update(&mod.Tags[i].TypeIdx)
+	}
+	for i := range mod.Exports {
+		e := &mod.Exports[i]
+		switch e.Kind {
+		case wasm.KindFunc:
+			rn.funcs.update(&e.Idx)
+		case wasm.KindGlobal:
+			rn.globals.update(&e.Idx)
+		}
+	}
+	if mod.Start != nil {
+		start := rn.funcs.index(*mod.Start)
+		mod.Start = &start
+	}
+	for i := range mod.Elements {
+		e := &mod.Elements[i]
+		for j := range e.FuncIdxs {
+			rn.funcs.update(&e.FuncIdxs[j])
+		}
+		rn.refType(e.RefType)
+	}
+	for i := range mod.Code {
+		for j := range mod.Code[i].Locals {
+			rn.valType(mod.Code[i].Locals[j].ExtType)
+		}
+	}
+
+	firstFunc := mod.NumImportedFuncs()
+	mod.Funcs = compact(mod.Funcs, rn.funcs, firstFunc)
+	mod.Code = compact(mod.Code, rn.funcs, firstFunc)
+	mod.bodies = compact(mod.bodies, rn.funcs, firstFunc)
+	mod.Globals = compact(mod.Globals, rn.globals, mod.NumImportedGlobals())
+	mod.Types = compact(mod.Types, rn.types, 0)
+	mod.Elements = compact(mod.Elements, rn.elems, 0)
+	mod.Data = compact(mod.Data, rn.data, 0)
+	if mod.DataCount != nil {
+		count := uint32(len(mod.Data))
+		mod.DataCount = &count
+	}
+
+	if names != nil {
+		names.Funcs = rn.funcs.names(names.Funcs)
+		names.Locals = rn.funcs.indirectNames(names.Locals)
+		names.Labels = rn.funcs.indirectNames(names.Labels)
+		names.Globals = rn.globals.names(names.Globals)
+		names.Types = rn.types.names(names.Types)
+		names.Fields = rn.types.indirectNames(names.Fields)
+		names.Elems = rn.elems.names(names.Elems)
+		names.Data = rn.data.names(names.Data)
+		mod.SetNames(names)
+	}
+	return nil
+}
+
+// dropRemovedFuncs takes the functions funcs removes out of element
+// segments, before the segments are renumbered.
+func (mod *module) dropRemovedFuncs(funcs *indexMap) error {
+	isRemoved := func(idx uint32) bool { return funcs.index(idx) == removed }
+	for i := range mod.Elements {
+		e := &mod.Elements[i]
+		e.FuncIdxs = slices.DeleteFunc(e.FuncIdxs, isRemoved)
+		var err error
+		e.Exprs = slices.DeleteFunc(e.Exprs, func(code []byte) bool {
+			if err != nil {
+				return false
+			}
+			var instrs []wasm.Instruction
+			if instrs, err = decodeExpr(code); err != nil {
+				return false
+			}
+			for _, in := range instrs {
+				if imm, ok := in.Imm.(wasm.RefFuncImm); ok && isRemoved(imm.FuncIdx) {
+					return true
+				}
+			}
+			return false
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (rn *renumbering) instrs(instrs []wasm.Instruction) {
+	for i := range instrs {
+		rn.instr(&instrs[i])
+	}
+}
+
+func (rn *renumbering) instr(in *wasm.Instruction) {
+	switch imm := in.Imm.(type) {
+	case wasm.CallImm:
+		rn.funcs.update(&imm.FuncIdx)
+		in.Imm = imm
+	case wasm.RefFuncImm:
+		rn.funcs.update(&imm.FuncIdx)
+		in.Imm = imm
+	case wasm.GlobalImm:
+		rn.globals.update(&imm.GlobalIdx)
+		in.Imm = imm
+	case wasm.BlockImm:
+		rn.blockType(&imm.Type)
+		in.Imm = imm
+	case wasm.TryTableImm:
+		rn.blockType(&imm.BlockType)
+		in.Imm = imm
+	case wasm.CallIndirectImm:
+		rn.types.update(&imm.TypeIdx)
+		in.Imm = imm
+	case wasm.CallRefImm:
+		rn.types.update(&imm.TypeIdx)
+		in.Imm = imm
+	case wasm.RefNullImm:
+		rn.heapType(&imm.HeapType)
+		in.Imm = imm
+	case wasm.SelectTypeImm:
+		for i := range imm.ExtTypes {
+			rn.valType(&imm.ExtTypes[i])
+		}
+	case wasm.MiscImm:
+		switch imm.SubOpcode {
+		case wasm.MiscMemoryInit, wasm.MiscDataDrop:
+			rn.data.update(&imm.Operands[0])
+		case wasm.MiscTableInit, wasm.MiscElemDrop:
+			rn.elems.update(&imm.Operands[0])
+		}
+	case wasm.GCImm:
+		switch imm.SubOpcode {
+		case wasm.GCArrayNewData, wasm.GCArrayInitData:
+			rn.data.update(&imm.DataIdx)
+		case wasm.GCArrayNewElem, wasm.GCArrayInitElem:
+			rn.elems.update(&imm.ElemIdx)
+		}
+		in.Imm = imm
+	}
+}
+
+func (rn *renumbering) blockType(bt *int32) {
+	if *bt >= 0 {
+		*bt = int32(rn.types.index(uint32(*bt)))
+	}
+}
+
+func (rn *renumbering) heapType(ht *int64) {
+	if *ht >= 0 {
+		*ht = int64(rn.types.index(uint32(*ht)))
+	}
+}
+
+func (rn *renumbering) refType(rt *wasm.RefType) {
+	if rt != nil {
+		rn.heapType(&rt.HeapType)
+	}
+}
+
+func (rn *renumbering) valType(vt *wasm.ExtValType) {
+	if vt != nil && vt.Kind == wasm.ExtValKindRef {
+		rn.heapType(&vt.RefType.HeapType)
+	}
+}
+
+func (rn *renumbering) funcType(ft *wasm.FuncType) {
+	for i := range ft.ExtParams {
+		rn.valType(&ft.ExtParams[i])
+	}
+	for i := range ft.ExtResults {
+		rn.valType(&ft.ExtResults[i])
+	}
+}
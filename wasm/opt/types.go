@@ -0,0 +1,77 @@
+package opt
+
+import (
+	"fmt"
+
+	"github.com/wippyai/wasm-runtime/wasm"
+)
+
+// DedupTypes merges equal function types into the first of them and
+// removes the types nothing refers to. Modules with GC types or GC
+// instructions are left unchanged, since their types are not compared
+// structurally.
+func DedupTypes(m *wasm.Module) error {
+	if len(m.TypeDefs) > 0 {
+		return nil
+	}
+	mod, err := decode(m)
+	if err != nil {
+		return err
+	}
+	exprs, err := mod.constExprs()
+	if err != nil {
+		return err
+	}
+	for _, instrs := range mod.bodies {
+		if usesGC(instrs) {
+			return nil
+		}
+	}
+	for _, e := range exprs {
+		if usesGC(e.instrs) {
+			return nil
+		}
+	}
+
+	// Renumbering onto themselves finds the types in use
+	used := newIndexMap(len(m.Types), func(uint32) bool { return true }, nil)
+	used.seen = make([]bool, len(m.Types))
+	if err := mod.renumber(renumbering{types: used}); err != nil {
+		return err
+	}
+
+	canonical := make([]uint32, len(m.Types))
+	first := make(map[string]uint32)
+	for i := range m.Types {
+		key := fmt.Sprint(m.Types[i])
+		c, ok := first[key]
+		if !ok && used.seen[i] {
+			c = uint32(i)
+			first[key] = c
+		}
+		canonical[i] = c
+	}
+	types := newIndexMap(len(m.Types), func(i uint32) bool {
+		return used.seen[i] && canonical[i] == i
+	}, func(i uint32) (uint32, bool) {
+		return canonical[i], used.seen[i]
+	})
+	if types.identity() {
+		mod.encode()
+		return nil
+	}
+	if err := mod.renumber(renumbering{types: types}); err != nil {
+		return err
+	}
+	mod.encode()
+	return nil
+}
+
+func usesGC(instrs []wasm.Instruction) bool {
+	for _, in := range instrs {
+		if _, ok := in.Imm.(wasm.GCImm); ok {
+			return true
+		}
+	}
+	return false
+}
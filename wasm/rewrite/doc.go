@@ -0,0 +1,30 @@
+// Package rewrite edits decoded WebAssembly modules in place, for
+// instrumentation passes such as coverage counters, fuel metering or
+// sanitizers.
+//
+// A Rewriter wraps a wasm.Module. Function bodies are decoded on first
+// use and edited as instruction slices; Module encodes them back:
+//
+//	m, err := wasm.ParseModule(data)
+//	r := rewrite.New(m)
+//	tick, err := r.AddFuncImport("env", "tick", wasm.FuncType{})
+//	err = r.ForEachFunc(func(f *rewrite.Func) error {
+//	    f.Insert(0, wasm.Instruction{Opcode: wasm.OpCall, Imm: wasm.CallImm{FuncIdx: tick}})
+//	    return nil
+//	})
+//	out := r.Module().Encode()
+//
+// Indexes stay consistent as the module grows:
+//   - AddFuncImport and AddGlobalImport insert after the existing imports
+//     of their kind, so every later function or global moves up by one.
+//     Calls, ref.func, global.get and global.set, element segments,
+//     constant expressions, exports, the start function and the name
+//     section are all updated, including in bodies already being edited.
+//   - AddFunc, AddGlobal and AddExport append, and shift nothing.
+//   - AddType and BlockType reuse an equal function type or add one.
+//   - Func.AddLocal appends a local after the existing ones.
+//
+// Branch depths inside edited code are the caller's concern: an inserted
+// block moves the labels of the instructions placed inside it. Code
+// offsets change too, so DWARF sections describe the original code.
+package rewrite
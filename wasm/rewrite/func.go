@@ -0,0 +1,82 @@
+package rewrite
+
+import (
+	"slices"
+
+	"github.com/wippyai/wasm-runtime/wasm"
+)
+
+// Func is a defined function opened for editing.
+type Func struct {
+	r *Rewriter
+
+	// Type is the signature of the function
+	Type *wasm.FuncType
+
+	// Instrs is the body without its closing end. It may be edited
+	// directly or through the methods below.
+	Instrs []wasm.Instruction
+
+	// Index is the function index, kept up to date as imports are added
+	Index uint32
+}
+
+// Insert inserts instrs before the instruction at index at; at may be
+// len(f.Instrs) to append.
+func (f *Func) Insert(at int, instrs ...wasm.Instruction) {
+	f.Instrs = slices.Insert(f.Instrs, at, instrs...)
+}
+
+// Replace replaces the n instructions starting at index at with instrs.
+func (f *Func) Replace(at, n int, instrs ...wasm.Instruction) {
+	f.Instrs = slices.Replace(f.Instrs, at, at+n, instrs...)
+}
+
+// Remove removes the n instructions starting at index at.
+func (f *Func) Remove(at, n int) {
+	f.Instrs = slices.Delete(f.Instrs, at, at+n)
+}
+
+// Map replaces every instruction with what fn returns for it: the
+// instruction itself to keep it, nothing to remove it, or a sequence.
+func (f *Func) Map(fn func(wasm.Instruction) []wasm.Instruction) {
+	out := make([]wasm.Instruction, 0, len(f.Instrs))
+	for _, in := range f.Instrs {
+		out = append(out, fn(in)...)
+	}
+	f.Instrs = out
+}
+
+// NumLocals returns the number of locals, parameters included.
+func (f *Func) NumLocals() uint32 {
+	n := uint32(len(f.Type.Params))
+	if len(f.Type.ExtParams) > 0 {
+		n = uint32(len(f.Type.ExtParams))
+	}
+	for _, le := range f.body().Locals {
+		n += le.Count
+	}
+	return n
+}
+
+// AddLocal adds a local of type t after the existing ones and returns its
+// index.
+func (f *Func) AddLocal(t wasm.ValType) uint32 {
+	idx := f.NumLocals()
+	body := f.body()
+	if n := len(body.Locals); n > 0 && body.Locals[n-1].ExtType == nil && body.Locals[n-1].ValType == t {
+		body.Locals[n-1].Count++
+	} else {
+		body.Locals = append(body.Locals, wasm.LocalEntry{ValType: t, Count: 1})
+	}
+	return idx
+}
+
+func (f *Func) body() *wasm.FuncBody {
+	return &f.r.m.Code[f.Index-uint32(f.r.m.NumImportedFuncs())]
+}
+
+// encode writes Instrs back to the function body.
+func (f *Func) encode() {
+	f.body().Code = encodeExpr(f.Instrs)
+}
@@ -0,0 +1,191 @@
+package rewrite
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/wippyai/wasm-runtime/wasm"
+)
+
+// Rewriter edits a module. It is not safe for concurrent use.
+type Rewriter struct {
+	m *wasm.Module
+
+	// funcs holds the bodies decoded so far, by function index
+	funcs map[uint32]*Func
+}
+
+// New returns a Rewriter editing m in place.
+func New(m *wasm.Module) *Rewriter {
+	return &Rewriter{m: m, funcs: make(map[uint32]*Func)}
+}
+
+// Module encodes the edited function bodies back into the module and
+// returns it. The Rewriter stays usable.
+func (r *Rewriter) Module() *wasm.Module {
+	for _, f := range r.funcs {
+		f.encode()
+	}
+	return r.m
+}
+
+// Func returns the body of the defined function idx for editing. Each
+// call for the same function returns the same Func.
+func (r *Rewriter) Func(idx uint32) (*Func, error) {
+	if f, ok := r.funcs[idx]; ok {
+		return f, nil
+	}
+	numImported := uint32(r.m.NumImportedFuncs())
+	if idx < numImported {
+		return nil, fmt.Errorf("function %d is imported", idx)
+	}
+	local := idx - numImported
+	if int(local) >= len(r.m.Code) {
+		return nil, fmt.Errorf("function %d out of range", idx)
+	}
+	ft := r.m.GetFuncType(idx)
+	if ft == nil {
+		return nil, fmt.Errorf("function %d: type %d is not a function type", idx, r.m.Funcs[local])
+	}
+	instrs, err := wasm.DecodeInstructions(r.m.Code[local].Code)
+	if err != nil {
+		return nil, fmt.Errorf("function %d: %w", idx, err)
+	}
+	// Drop the end that closes the body; encode puts it back
+	if n := len(instrs); n > 0 && instrs[n-1].Opcode == wasm.OpEnd {
+		instrs = instrs[:n-1]
+	}
+	f := &Func{Instrs: instrs, Type: ft, Index: idx, r: r}
+	r.funcs[idx] = f
+	return f, nil
+}
+
+// ForEachFunc calls fn with each defined function, in index order.
+// Functions added by fn are not visited.
+func (r *Rewriter) ForEachFunc(fn func(*Func) error) error {
+	first := uint32(r.m.NumImportedFuncs())
+	n := uint32(len(r.m.Code))
+	for i := uint32(0); i < n; i++ {
+		f, err := r.Func(first + i)
+		if err != nil {
+			return err
+		}
+		if err := fn(f); err != nil {
+			return err
+		}
+		// fn may have imported functions, moving the rest up
+		first = uint32(r.m.NumImportedFuncs())
+	}
+	return nil
+}
+
+// AddType returns the index of a function type equal to ft, adding one
+// if the module has none.
+func (r *Rewriter) AddType(ft wasm.FuncType) uint32 {
+	if len(r.m.TypeDefs) == 0 {
+		return r.m.AddType(ft)
+	}
+	// GC modules index the type definitions, rec groups expanded
+	var idx uint32
+	for _, td := range r.m.TypeDefs {
+		switch td.Kind {
+		case wasm.TypeDefKindFunc:
+			if equalFuncTypes(*td.Func, ft) {
+				return idx
+			}
+			idx++
+		case wasm.TypeDefKindSub:
+			idx++
+		case wasm.TypeDefKindRec:
+			idx += uint32(len(td.Rec.Types))
+		}
+	}
+	r.m.TypeDefs = append(r.m.TypeDefs, wasm.TypeDef{Kind: wasm.TypeDefKindFunc, Func: &ft})
+	r.m.Types = append(r.m.Types, ft)
+	return idx
+}
+
+func equalFuncTypes(a, b wasm.FuncType) bool {
+	return slices.Equal(a.Params, b.Params) && slices.Equal(a.Results, b.Results) &&
+		slices.Equal(a.ExtParams, b.ExtParams) && slices.Equal(a.ExtResults, b.ExtResults)
+}
+
+// BlockType returns the block type immediate for a block taking params
+// and producing results, adding a function type when a single value
+// type cannot express it.
+func (r *Rewriter) BlockType(params, results []wasm.ValType) int32 {
+	switch {
+	case len(params) == 0 && len(results) == 0:
+		return wasm.BlockTypeVoid
+	case len(params) == 0 && len(results) == 1:
+		// Value types are their one-byte negative SLEB128 encoding
+		return int32(results[0]) - 0x80
+	}
+	return int32(r.AddType(wasm.FuncType{Params: params, Results: results}))
+}
+
+// AddFunc appends a function of type ft with the given body, without its
+// closing end, and returns it for further editing.
+func (r *Rewriter) AddFunc(ft wasm.FuncType, body ...wasm.Instruction) *Func {
+	typeIdx := r.AddType(ft)
+	r.m.Funcs = append(r.m.Funcs, typeIdx)
+	r.m.Code = append(r.m.Code, wasm.FuncBody{})
+	idx := uint32(r.m.NumImportedFuncs() + len(r.m.Code) - 1)
+	f := &Func{Instrs: slices.Clone(body), Type: r.m.GetFuncType(idx), Index: idx, r: r}
+	r.funcs[idx] = f
+	return f
+}
+
+// AddFuncImport imports a function of type ft after the existing function
+// imports and returns its index. Defined functions move up by one.
+func (r *Rewriter) AddFuncImport(module, name string, ft wasm.FuncType) (uint32, error) {
+	idx := uint32(r.m.NumImportedFuncs())
+	if err := r.shiftFuncs(idx); err != nil {
+		return 0, err
+	}
+	r.m.Imports = append(r.m.Imports, wasm.Import{
+		Module: module,
+		Name:   name,
+		Desc:   wasm.ImportDesc{Kind: wasm.KindFunc, TypeIdx: r.AddType(ft)},
+	})
+	return idx, nil
+}
+
+// AddGlobalImport imports a global after the existing global imports and
+// returns its index. Defined globals move up by one.
+func (r *Rewriter) AddGlobalImport(module, name string, gt wasm.GlobalType) (uint32, error) {
+	idx := uint32(r.m.NumImportedGlobals())
+	if err := r.shiftGlobals(idx); err != nil {
+		return 0, err
+	}
+	r.m.Imports = append(r.m.Imports, wasm.Import{
+		Module: module,
+		Name:   name,
+		Desc:   wasm.ImportDesc{Kind: wasm.KindGlobal, Global: &gt},
+	})
+	return idx, nil
+}
+
+// AddGlobal appends a global initialized by init, a constant expression
+// without its closing end, and returns its index.
+func (r *Rewriter) AddGlobal(gt wasm.GlobalType, init ...wasm.Instruction) uint32 {
+	r.m.Globals = append(r.m.Globals, wasm.Global{Type: gt, Init: encodeExpr(init)})
+	return uint32(r.m.NumImportedGlobals() + len(r.m.Globals) - 1)
+}
+
+// AddExport exports idx in the index space of kind, one of the wasm.Kind*
+// constants, under name.
+func (r *Rewriter) AddExport(name string, kind byte, idx uint32) error {
+	for _, e := range r.m.Exports {
+		if e.Name == name {
+			return fmt.Errorf("duplicate export %q", name)
+		}
+	}
+	r.m.Exports = append(r.m.Exports, wasm.Export{Name: name, Kind: kind, Idx: idx})
+	return nil
+}
+
+// encodeExpr encodes a constant expression or body, adding the end.
+func encodeExpr(instrs []wasm.Instruction) []byte {
+	return wasm.EncodeInstructions(append(slices.Clip(instrs), wasm.Instruction{Opcode: wasm.OpEnd}))
+}
@@ -0,0 +1,316 @@
+package rewrite_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/tetratelabs/wazero"
+	"github.com/tetratelabs/wazero/api"
+
+	"github.com/wippyai/wasm-runtime/wasm"
+	"github.com/wippyai/wasm-runtime/wasm/rewrite"
+	"github.com/wippyai/wasm-runtime/wat"
+)
+
+func parse(t *testing.T, src string) *wasm.Module {
+	t.Helper()
+	bin, err := wat.Compile(src)
+	if err != nil {
+		t.Fatalf("Compile: %v", err)
+	}
+	m, err := wasm.ParseModule(bin)
+	if err != nil {
+		t.Fatalf("ParseModule: %v", err)
+	}
+	return m
+}
+
+// instantiate runs m with an "env" host module providing log and tick,
+// which counts its calls, and a "globals" module providing base = 100.
+func instantiate(t *testing.T, m *wasm.Module, ticks *int) api.Module {
+	t.Helper()
+	ctx := context.Background()
+	rt := wazero.NewRuntime(ctx)
+	t.Cleanup(func() { rt.Close(ctx) })
+
+	_, err := rt.NewHostModuleBuilder("env").
+		NewFunctionBuilder().WithFunc(func() { *ticks++ }).Export("tick").
+		NewFunctionBuilder().WithFunc(func(uint32) {}).Export("log").
+		Instantiate(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	base, err := wat.Compile(`(module (global (export "base") i32 (i32.const 100)))`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := rt.InstantiateWithConfig(ctx, base, wazero.NewModuleConfig().WithName("globals")); err != nil {
+		t.Fatal(err)
+	}
+	mod, err := rt.Instantiate(ctx, m.Encode())
+	if err != nil {
+		t.Fatalf("instantiate rewritten module: %v", err)
+	}
+	return mod
+}
+
+func call(t *testing.T, mod api.Module, name string, args ...uint64) uint64 {
+	t.Helper()
+	res, err := mod.ExportedFunction(name).Call(context.Background(), args...)
+	if err != nil {
+		t.Fatalf("%s: %v", name, err)
+	}
+	return res[0]
+}
+
+const fibWAT = `(module
+	(import "env" "log" (func $log (param i32)))
+	(type $unary (func (param i32) (result i32)))
+	(table 2 funcref)
+	(global $fn (export "fn") funcref (ref.func $fib))
+	(global $calls (mut i32) (i32.const 0))
+	(func $fib (export "fib") (param $n i32) (result i32)
+		(if (result i32) (i32.lt_u (local.get $n) (i32.const 2))
+			(then (local.get $n))
+			(else (i32.add
+				(call $fib (i32.sub (local.get $n) (i32.const 1)))
+				(call_indirect (type $unary) (i32.sub (local.get $n) (i32.const 2)) (i32.const 0))))))
+	(func $init
+		(global.set $calls (i32.const 1)))
+	(func (export "calls") (result i32) (global.get $calls))
+	(start $init)
+	(elem (i32.const 0) $fib $init))`
+
+func TestAddFuncImport(t *testing.T) {
+	m := parse(t, fibWAT)
+	r := rewrite.New(m)
+
+	fib, err := r.Func(1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tick, err := r.AddFuncImport("env", "tick", wasm.FuncType{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tick != 1 {
+		t.Fatalf("tick = %d, want 1 after the log import", tick)
+	}
+	if fib.Index != 2 {
+		t.Errorf("fib moved to %d, want 2", fib.Index)
+	}
+	err = r.ForEachFunc(func(f *rewrite.Func) error {
+		f.Insert(0, wasm.Instruction{Opcode: wasm.OpCall, Imm: wasm.CallImm{FuncIdx: tick}})
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	m = r.Module()
+
+	if m.Elements[0].FuncIdxs[0] != 2 || m.Elements[0].FuncIdxs[1] != 3 || *m.Start != 3 {
+		t.Errorf("elements = %v, start = %d", m.Elements[0].FuncIdxs, *m.Start)
+	}
+	names, err := m.Names()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if names.Funcs[2] != "fib" || names.Locals[2][0] != "n" || names.Funcs[0] != "log" {
+		t.Errorf("names = %v, locals = %v", names.Funcs, names.Locals)
+	}
+
+	var ticks int
+	mod := instantiate(t, m, &ticks)
+	if ticks != 1 {
+		t.Errorf("start ran %d ticks, want 1", ticks)
+	}
+	if got := call(t, mod, "fib", 10); got != 55 {
+		t.Errorf("fib(10) = %d, want 55", got)
+	}
+	// fib(10) makes 177 calls, through call and call_indirect
+	if ticks != 178 {
+		t.Errorf("ticks = %d, want 178", ticks)
+	}
+	if got := call(t, mod, "calls"); got != 1 {
+		t.Errorf("calls = %d, want 1", got)
+	}
+	fn := mod.ExportedGlobal("fn")
+	if fn == nil {
+		t.Fatal("global fn not exported")
+	}
+}
+
+func TestAddGlobalImport(t *testing.T) {
+	m := parse(t, `(module
+		(import "globals" "base" (global $i i32))
+		(global $g (export "g") (mut i32) (i32.const 7))
+		(global $h i32 (global.get $i))
+		(func (export "sum") (result i32)
+			(global.set $g (i32.add (global.get $g) (global.get $h)))
+			(global.get $g)))`)
+	r := rewrite.New(m)
+	base, err := r.AddGlobalImport("globals", "base", wasm.GlobalType{ValType: wasm.ValI32})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if base != 1 {
+		t.Fatalf("base = %d, want 1", base)
+	}
+	f, err := r.Func(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Add the new import to the result
+	f.Insert(len(f.Instrs),
+		wasm.Instruction{Opcode: wasm.OpGlobalGet, Imm: wasm.GlobalImm{GlobalIdx: base}},
+		wasm.Instruction{Opcode: wasm.OpI32Add})
+
+	m = r.Module()
+	if m.Exports[0].Idx != 2 {
+		t.Errorf("export g = global %d, want 2", m.Exports[0].Idx)
+	}
+	names, _ := m.Names()
+	if names.Globals[2] != "g" || names.Globals[3] != "h" || names.Globals[0] != "i" {
+		t.Errorf("global names = %v", names.Globals)
+	}
+
+	var ticks int
+	mod := instantiate(t, m, &ticks)
+	// g = 7 + h, where h = base = 100, then + base
+	if got := call(t, mod, "sum"); got != 207 {
+		t.Errorf("sum = %d, want 207", got)
+	}
+}
+
+func TestAddFuncGlobalExport(t *testing.T) {
+	m := parse(t, `(module (func $nop))`)
+	r := rewrite.New(m)
+
+	counter := r.AddGlobal(wasm.GlobalType{ValType: wasm.ValI64, Mutable: true},
+		wasm.Instruction{Opcode: wasm.OpI64Const, Imm: wasm.I64Imm{Value: 5}})
+	pair := r.BlockType(nil, []wasm.ValType{wasm.ValI64, wasm.ValI64})
+	if pair < 0 {
+		t.Fatalf("block type for two results = %d, want a type index", pair)
+	}
+	if bt := r.BlockType(nil, []wasm.ValType{wasm.ValI32}); bt != wasm.BlockTypeI32 {
+		t.Errorf("block type for i32 = %d, want %d", bt, wasm.BlockTypeI32)
+	}
+
+	// bump adds its argument to the counter and returns the old and new
+	// values, by way of a scratch local
+	f := r.AddFunc(wasm.FuncType{Params: []wasm.ValType{wasm.ValI64}, Results: []wasm.ValType{wasm.ValI64}})
+	old := f.AddLocal(wasm.ValI64)
+	if old != 1 {
+		t.Fatalf("local = %d, want 1 after the param", old)
+	}
+	f.Insert(0,
+		wasm.Instruction{Opcode: wasm.OpBlock, Imm: wasm.BlockImm{Type: pair}},
+		wasm.Instruction{Opcode: wasm.OpGlobalGet, Imm: wasm.GlobalImm{GlobalIdx: counter}},
+		wasm.Instruction{Opcode: wasm.OpLocalTee, Imm: wasm.LocalImm{LocalIdx: old}},
+		wasm.Instruction{Opcode: wasm.OpLocalGet, Imm: wasm.LocalImm{LocalIdx: old}},
+		wasm.Instruction{Opcode: wasm.OpLocalGet, Imm: wasm.LocalImm{LocalIdx: 0}},
+		wasm.Instruction{Opcode: wasm.OpI64Add},
+		wasm.Instruction{Opcode: wasm.OpEnd},
+		wasm.Instruction{Opcode: wasm.OpGlobalSet, Imm: wasm.GlobalImm{GlobalIdx: counter}},
+		wasm.Instruction{Opcode: wasm.OpGlobalGet, Imm: wasm.GlobalImm{GlobalIdx: counter}},
+		wasm.Instruction{Opcode: wasm.OpI64Sub},
+	)
+	if err := r.AddExport("bump", wasm.KindFunc, f.Index); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.AddExport("counter", wasm.KindGlobal, counter); err != nil {
+		t.Fatal(err)
+	}
+	if err := r.AddExport("bump", wasm.KindFunc, 0); err == nil {
+		t.Error("duplicate export accepted")
+	}
+
+	m = r.Module()
+	if _, err := wasm.ParseModuleValidate(m.Encode()); err != nil {
+		t.Fatalf("rewritten module is invalid: %v", err)
+	}
+	var ticks int
+	mod := instantiate(t, m, &ticks)
+	// old (5) - new (8) wraps to -3
+	if got := int64(call(t, mod, "bump", 3)); got != 5-8 {
+		t.Errorf("bump(3) = %d, want -3", got)
+	}
+	if got := mod.ExportedGlobal("counter").Get(); got != 8 {
+		t.Errorf("counter = %d, want 8", got)
+	}
+}
+
+func TestEditInstructions(t *testing.T) {
+	m := parse(t, `(module
+		(func (export "f") (result i32)
+			nop
+			(i32.const 1)
+			nop
+			(i32.const 2)
+			(i32.add)))`)
+	r := rewrite.New(m)
+	f, err := r.Func(0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.Map(func(in wasm.Instruction) []wasm.Instruction {
+		if in.Opcode == wasm.OpNop {
+			return nil
+		}
+		return []wasm.Instruction{in}
+	})
+	if len(f.Instrs) != 3 {
+		t.Fatalf("instrs after removing nops = %v", f.Instrs)
+	}
+	// 1 + 2 becomes 1 * 40 + 2
+	f.Insert(1,
+		wasm.Instruction{Opcode: wasm.OpI32Const, Imm: wasm.I32Imm{Value: 40}},
+		wasm.Instruction{Opcode: wasm.OpI32Mul})
+	f.Replace(3, 1, wasm.Instruction{Opcode: wasm.OpI32Const, Imm: wasm.I32Imm{Value: 2}})
+	f.Insert(len(f.Instrs), wasm.Instruction{Opcode: wasm.OpNop})
+	f.Remove(len(f.Instrs)-1, 1)
+
+	var ticks int
+	mod := instantiate(t, r.Module(), &ticks)
+	if got := call(t, mod, "f"); got != 42 {
+		t.Errorf("f = %d, want 42", got)
+	}
+}
+
+func TestAddTypeGC(t *testing.T) {
+	m := parse(t, `(module
+		(type $point (struct (field i32) (field i32)))
+		(type $make (func (result (ref $point))))
+		(func (type $make) (struct.new $point (i32.const 1) (i32.const 2))))`)
+	r := rewrite.New(m)
+	idx := r.AddType(wasm.FuncType{Params: []wasm.ValType{wasm.ValI32}})
+	if idx != 2 {
+		t.Errorf("new type = %d, want 2 after the struct and func types", idx)
+	}
+	if again := r.AddType(wasm.FuncType{Params: []wasm.ValType{wasm.ValI32}}); again != idx {
+		t.Errorf("equal type added again as %d", again)
+	}
+	if _, err := wasm.ParseModuleValidate(r.Module().Encode()); err != nil {
+		t.Errorf("module with added type is invalid: %v", err)
+	}
+}
+
+func TestErrors(t *testing.T) {
+	m := parse(t, `(module (import "env" "tick" (func)) (func))`)
+	r := rewrite.New(m)
+	if _, err := r.Func(0); err == nil {
+		t.Error("Func of an import succeeded")
+	}
+	if _, err := r.Func(2); err == nil {
+		t.Error("Func out of range succeeded")
+	}
+
+	// A malformed body fails the shift before anything changes
+	m.Code[0].Code = []byte{wasm.OpCall}
+	if _, err := rewrite.New(m).AddFuncImport("env", "other", wasm.FuncType{}); err == nil {
+		t.Error("AddFuncImport over a malformed body succeeded")
+	}
+	if len(m.Imports) != 1 {
+		t.Errorf("imports = %d after a failed import, want 1", len(m.Imports))
+	}
+}
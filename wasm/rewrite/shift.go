@@ -0,0 +1,188 @@
+package rewrite
+
+import (
+	"fmt"
+
+	"github.com/wippyai/wasm-runtime/wasm"
+)
+
+// shiftFuncs moves every function index from from up by one, making room
+// for an import at from.
+func (r *Rewriter) shiftFuncs(from uint32) error {
+	exprs, err := r.prepareShift()
+	if err != nil {
+		return err
+	}
+	names, err := r.m.Names()
+	if err != nil {
+		return err
+	}
+
+	funcs := make(map[uint32]*Func, len(r.funcs))
+	for idx, f := range r.funcs {
+		if idx >= from {
+			idx++
+			f.Index = idx
+		}
+		funcs[idx] = f
+	}
+	r.funcs = funcs
+
+	r.patch(exprs, func(in *wasm.Instruction) {
+		switch imm := in.Imm.(type) {
+		case wasm.CallImm:
+			shift(&imm.FuncIdx, from)
+			in.Imm = imm
+		case wasm.RefFuncImm:
+			shift(&imm.FuncIdx, from)
+			in.Imm = imm
+		}
+	})
+	for i := range r.m.Elements {
+		for j := range r.m.Elements[i].FuncIdxs {
+			shift(&r.m.Elements[i].FuncIdxs[j], from)
+		}
+	}
+	r.shiftExports(wasm.KindFunc, from)
+	if r.m.Start != nil {
+		start := *r.m.Start
+		shift(&start, from)
+		r.m.Start = &start
+	}
+	if names != nil {
+		names.Funcs = shiftNames(names.Funcs, from)
+		names.Locals = shiftIndirectNames(names.Locals, from)
+		names.Labels = shiftIndirectNames(names.Labels, from)
+		r.m.SetNames(names)
+	}
+	return nil
+}
+
+// shiftGlobals moves every global index from from up by one, making room
+// for an import at from.
+func (r *Rewriter) shiftGlobals(from uint32) error {
+	exprs, err := r.prepareShift()
+	if err != nil {
+		return err
+	}
+	names, err := r.m.Names()
+	if err != nil {
+		return err
+	}
+
+	r.patch(exprs, func(in *wasm.Instruction) {
+		if imm, ok := in.Imm.(wasm.GlobalImm); ok {
+			shift(&imm.GlobalIdx, from)
+			in.Imm = imm
+		}
+	})
+	r.shiftExports(wasm.KindGlobal, from)
+	if names != nil {
+		names.Globals = shiftNames(names.Globals, from)
+		r.m.SetNames(names)
+	}
+	return nil
+}
+
+// constExpr is a decoded constant expression of the module and where it
+// is stored.
+type constExpr struct {
+	code   *[]byte
+	instrs []wasm.Instruction
+}
+
+// prepareShift decodes every function body and constant expression, so
+// that a malformed one fails the shift before anything changes.
+func (r *Rewriter) prepareShift() ([]constExpr, error) {
+	first := uint32(r.m.NumImportedFuncs())
+	for i := range r.m.Code {
+		if _, err := r.Func(first + uint32(i)); err != nil {
+			return nil, err
+		}
+	}
+
+	var codes []*[]byte
+	for i := range r.m.Globals {
+		codes = append(codes, &r.m.Globals[i].Init)
+	}
+	for i := range r.m.Tables {
+		codes = append(codes, &r.m.Tables[i].Init)
+	}
+	for i := range r.m.Elements {
+		e := &r.m.Elements[i]
+		codes = append(codes, &e.Offset)
+		for j := range e.Exprs {
+			codes = append(codes, &e.Exprs[j])
+		}
+	}
+	for i := range r.m.Data {
+		codes = append(codes, &r.m.Data[i].Offset)
+	}
+
+	exprs := make([]constExpr, 0, len(codes))
+	for _, code := range codes {
+		if len(*code) == 0 {
+			continue
+		}
+		instrs, err := wasm.DecodeInstructions(*code)
+		if err != nil {
+			return nil, fmt.Errorf("constant expression: %w", err)
+		}
+		exprs = append(exprs, constExpr{code: code, instrs: instrs})
+	}
+	return exprs, nil
+}
+
+// patch applies fn to every instruction of the function bodies and of
+// exprs, re-encoding the expressions.
+func (r *Rewriter) patch(exprs []constExpr, fn func(*wasm.Instruction)) {
+	for _, f := range r.funcs {
+		for i := range f.Instrs {
+			fn(&f.Instrs[i])
+		}
+	}
+	for _, e := range exprs {
+		for i := range e.instrs {
+			fn(&e.instrs[i])
+		}
+		*e.code = wasm.EncodeInstructions(e.instrs)
+	}
+}
+
+func (r *Rewriter) shiftExports(kind byte, from uint32) {
+	for i := range r.m.Exports {
+		if r.m.Exports[i].Kind == kind {
+			shift(&r.m.Exports[i].Idx, from)
+		}
+	}
+}
+
+func shift(idx *uint32, from uint32) {
+	if *idx >= from {
+		*idx++
+	}
+}
+
+func shiftNames(names wasm.NameMap, from uint32) wasm.NameMap {
+	if names == nil {
+		return nil
+	}
+	shifted := make(wasm.NameMap, len(names))
+	for idx, name := range names {
+		shift(&idx, from)
+		shifted[idx] = name
+	}
+	return shifted
+}
+
+func shiftIndirectNames(names wasm.IndirectNameMap, from uint32) wasm.IndirectNameMap {
+	if names == nil {
+		return nil
+	}
+	shifted := make(wasm.IndirectNameMap, len(names))
+	for idx, inner := range names {
+		shift(&idx, from)
+		shifted[idx] = inner
+	}
+	return shifted
+}
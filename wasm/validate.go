@@ -13,6 +13,9 @@ func (m *Module) Validate() error {
 	if err := m.validateTableIndices(); err != nil {
 		return err
 	}
+	if err := m.validateMemoryCount(); err != nil {
+		return err
+	}
 	if err := m.validateMemoryIndices(); err != nil {
 		return err
 	}
@@ -37,7 +40,10 @@ func (m *Module) Validate() error {
 	if err := m.validateMemoryLimits(); err != nil {
 		return err
 	}
-	return nil
+	if err := m.validateConstExprs(); err != nil {
+		return err
+	}
+	return m.ValidateCode()
 }
 
 // ParseModuleValidate parses a WebAssembly binary and validates it.
@@ -55,12 +61,8 @@ func ParseModuleValidate(data []byte) (*Module, error) {
 
 func (m *Module) validateTypeIndices() error {
 	numTypes := uint32(m.NumTypes())
-	if numTypes == 0 {
-		// No types defined, but check if anything references types
-		if len(m.Funcs) > 0 {
-			return fmt.Errorf("function references type but no types defined")
-		}
-		return nil
+	if numTypes == 0 && len(m.Funcs) > 0 {
+		return fmt.Errorf("function references type but no types defined")
 	}
 
 	// Check function type indices
@@ -143,6 +145,15 @@ func (m *Module) validateTableIndices() error {
 	return nil
 }
 
+// validateMemoryCount rejects modules with more than one memory. Multiple
+// memories decode, but the engine runs a single one.
+func (m *Module) validateMemoryCount() error {
+	if n := m.NumImportedMemories() + len(m.Memories); n > 1 {
+		return fmt.Errorf("multiple memories: %d", n)
+	}
+	return nil
+}
+
 func (m *Module) validateMemoryIndices() error {
 	numMemories := uint32(m.NumImportedMemories() + len(m.Memories))
 
@@ -0,0 +1,1050 @@
+package wasm
+
+import (
+	"fmt"
+	"math"
+	"strings"
+)
+
+// maxLocals bounds the locals of a function body, guarding validation
+// against bodies declaring billions of locals.
+const maxLocals = 1 << 20
+
+// CodeError reports an invalid instruction in a function body.
+type CodeError struct {
+	Message  string
+	Expected []string // expected operand types, top of stack last
+	Actual   []string // operand types found, top of stack last
+	Func     uint32   // function index, imported functions included
+	Offset   int      // offset of the instruction in FuncBody.Code, -1 if unknown
+}
+
+func (e *CodeError) Error() string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "function %d", e.Func)
+	if e.Offset >= 0 {
+		fmt.Fprintf(&b, " at offset 0x%x", e.Offset)
+	}
+	b.WriteString(": ")
+	b.WriteString(e.Message)
+	if e.Expected != nil || e.Actual != nil {
+		fmt.Fprintf(&b, ": expected [%s], got [%s]", strings.Join(e.Expected, " "), strings.Join(e.Actual, " "))
+	}
+	return b.String()
+}
+
+// ValidateCode type-checks the instructions of every function body: the
+// operand and control stacks, block types, locals, and the types of
+// calls, memory, table, reference, SIMD, atomic, GC and exception
+// instructions. It returns a *CodeError for the first invalid instruction.
+func (m *Module) ValidateCode() error {
+	mt := newModuleTypes(m)
+	imported := uint32(m.NumImportedFuncs())
+	for i := range m.Code {
+		if err := mt.validateBody(imported+uint32(i), &m.Code[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ctrlFrame is an entry of the control stack.
+type ctrlFrame struct {
+	params      []operand
+	results     []operand
+	height      int  // operand stack height at entry
+	initHeight  int  // length of codeValidator.initSet at entry
+	op          byte // opcode opening the frame; OpElse, OpCatch or OpCatchAll once reached; 0 for the function
+	unreachable bool
+}
+
+// labelTypes returns the types a branch to the frame carries.
+func (f *ctrlFrame) labelTypes() []operand {
+	if f.op == OpLoop {
+		return f.params
+	}
+	return f.results
+}
+
+type codeValidator struct {
+	mt      *moduleTypes
+	locals  []operand
+	inited  []bool
+	initSet []uint32 // non-defaultable locals set, in order
+	ops     []operand
+	ctrls   []ctrlFrame
+	results []operand
+	fn      uint32
+	offset  int
+}
+
+func (mt *moduleTypes) validateBody(fn uint32, body *FuncBody) error {
+	v := &codeValidator{mt: mt, fn: fn, offset: -1}
+	params, results, err := mt.funcSignature(fn)
+	if err != nil {
+		return v.fail("%v", err)
+	}
+	v.results = results
+
+	total := uint64(len(params))
+	for _, l := range body.Locals {
+		total += uint64(l.Count)
+	}
+	if total > maxLocals {
+		return v.fail("too many locals: %d", total)
+	}
+	v.locals = append(make([]operand, 0, total), params...)
+	for _, l := range body.Locals {
+		var (
+			o  operand
+			ok bool
+		)
+		if l.ExtType != nil {
+			o, ok = extOperand(*l.ExtType)
+		} else {
+			o, ok = valOperand(l.ValType)
+		}
+		if !ok {
+			return v.fail("invalid local type 0x%02x", byte(l.ValType))
+		}
+		for range l.Count {
+			v.locals = append(v.locals, o)
+		}
+	}
+	v.inited = make([]bool, len(v.locals))
+	for i, o := range v.locals {
+		v.inited[i] = i < len(params) || o.defaultable()
+	}
+
+	instrs, offsets, err := DecodeInstructionsOffsets(body.Code)
+	if err != nil {
+		return v.fail("%v", err)
+	}
+	v.ctrls = append(v.ctrls, ctrlFrame{results: results})
+	for i := range instrs {
+		v.offset = offsets[i]
+		if len(v.ctrls) == 0 {
+			return v.fail("instructions after function end")
+		}
+		if err := v.step(&instrs[i]); err != nil {
+			return err
+		}
+	}
+	if len(v.ctrls) != 0 {
+		v.offset = len(body.Code)
+		return v.fail("function body not terminated by end")
+	}
+	return nil
+}
+
+func (v *codeValidator) fail(format string, args ...any) *CodeError {
+	return &CodeError{Func: v.fn, Offset: v.offset, Message: fmt.Sprintf(format, args...)}
+}
+
+// mismatch reports operands not matching want, listing the top of the
+// current frame's stack, or all of it when all is set.
+func (v *codeValidator) mismatch(msg string, want []operand, all bool) *CodeError {
+	stack := v.ops[v.ctrls[len(v.ctrls)-1].height:]
+	if !all && len(stack) > len(want) {
+		stack = stack[len(stack)-len(want):]
+	}
+	e := v.fail("%s", msg)
+	e.Expected = make([]string, len(want))
+	for i, o := range want {
+		e.Expected[i] = o.String()
+	}
+	e.Actual = make([]string, len(stack))
+	for i, o := range stack {
+		e.Actual[i] = o.String()
+	}
+	return e
+}
+
+func (v *codeValidator) push(ops ...operand) {
+	v.ops = append(v.ops, ops...)
+}
+
+// popVals pops operands matching want, top of stack last, returning the
+// operands popped. Unreachable code pops unknown operands past the
+// frame's stack.
+func (v *codeValidator) popVals(want ...operand) ([]operand, error) {
+	f := &v.ctrls[len(v.ctrls)-1]
+	avail := len(v.ops) - f.height
+	got := make([]operand, len(want))
+	for i := len(want) - 1; i >= 0; i-- {
+		depth := len(want) - 1 - i
+		if depth >= avail {
+			if !f.unreachable {
+				return nil, v.mismatch("not enough operands", want, false)
+			}
+			continue
+		}
+		o := v.ops[len(v.ops)-1-depth]
+		if !v.mt.matches(o, want[i]) {
+			return nil, v.mismatch("type mismatch", want, false)
+		}
+		got[i] = o
+	}
+	v.ops = v.ops[:len(v.ops)-min(len(want), avail)]
+	return got, nil
+}
+
+func (v *codeValidator) pop(want ...operand) error {
+	_, err := v.popVals(want...)
+	return err
+}
+
+// popAny pops an operand of any type.
+func (v *codeValidator) popAny() (operand, error) {
+	f := &v.ctrls[len(v.ctrls)-1]
+	if len(v.ops) == f.height {
+		if f.unreachable {
+			return unknown, nil
+		}
+		return unknown, v.mismatch("not enough operands", []operand{unknown}, false)
+	}
+	o := v.ops[len(v.ops)-1]
+	v.ops = v.ops[:len(v.ops)-1]
+	return o, nil
+}
+
+// popRef pops a reference of any type.
+func (v *codeValidator) popRef() (operand, error) {
+	o, err := v.popAny()
+	if err != nil {
+		return o, err
+	}
+	if o != unknown && !o.ref {
+		v.ops = append(v.ops, o)
+		return o, v.mismatch("type mismatch: expected a reference", []operand{refOperand(true, HeapTypeAny)}, false)
+	}
+	return o, nil
+}
+
+func (v *codeValidator) pushCtrl(op byte, params, results []operand) {
+	v.ctrls = append(v.ctrls, ctrlFrame{
+		op:         op,
+		params:     params,
+		results:    results,
+		height:     len(v.ops),
+		initHeight: len(v.initSet),
+	})
+	v.push(params...)
+}
+
+func (v *codeValidator) popCtrl() (ctrlFrame, error) {
+	f := v.ctrls[len(v.ctrls)-1]
+	if len(v.ops)-f.height > len(f.results) {
+		return f, v.mismatch("values remaining on stack at end of block", f.results, true)
+	}
+	if err := v.pop(f.results...); err != nil {
+		return f, err
+	}
+	v.ctrls = v.ctrls[:len(v.ctrls)-1]
+	for _, idx := range v.initSet[f.initHeight:] {
+		v.inited[idx] = false
+	}
+	v.initSet = v.initSet[:f.initHeight]
+	return f, nil
+}
+
+func (v *codeValidator) unreachable() {
+	f := &v.ctrls[len(v.ctrls)-1]
+	v.ops = v.ops[:f.height]
+	f.unreachable = true
+}
+
+func (v *codeValidator) label(idx uint32) (*ctrlFrame, error) {
+	if int(idx) >= len(v.ctrls) {
+		return nil, v.fail("unknown label %d", idx)
+	}
+	return &v.ctrls[len(v.ctrls)-1-int(idx)], nil
+}
+
+func (v *codeValidator) blockType(bt int32) ([]operand, []operand, error) {
+	switch {
+	case bt == BlockTypeVoid:
+		return nil, nil, nil
+	case bt >= 0:
+		ft, err := v.mt.funcType(uint32(bt))
+		if err != nil {
+			return nil, nil, v.fail("block type: %v", err)
+		}
+		params, results, err := signature(ft)
+		if err != nil {
+			return nil, nil, v.fail("block type: %v", err)
+		}
+		return params, results, nil
+	}
+	o, ok := valOperand(ValType(byte(bt) & 0x7F))
+	if !ok {
+		return nil, nil, v.fail("invalid block type %d", bt)
+	}
+	return nil, []operand{o}, nil
+}
+
+func (v *codeValidator) local(idx uint32) (operand, error) {
+	if int(idx) >= len(v.locals) {
+		return unknown, v.fail("unknown local %d", idx)
+	}
+	return v.locals[idx], nil
+}
+
+func (v *codeValidator) setLocal(idx uint32) {
+	if !v.inited[idx] {
+		v.inited[idx] = true
+		v.initSet = append(v.initSet, idx)
+	}
+}
+
+// memArg checks a memory access of naturalAlign and returns the address
+// type of its memory.
+func (v *codeValidator) memArg(imm MemoryImm, naturalAlign uint32) (operand, error) {
+	addr, _, err := v.mt.memory(imm.MemIdx)
+	if err != nil {
+		return unknown, v.fail("%v", err)
+	}
+	if imm.Align > naturalAlign {
+		return unknown, v.fail("alignment 2^%d larger than natural alignment 2^%d", imm.Align, naturalAlign)
+	}
+	if addr == opI32 && imm.Offset > math.MaxUint32 {
+		return unknown, v.fail("offset %d out of range for a 32-bit memory", imm.Offset)
+	}
+	return addr, nil
+}
+
+type memAccess struct {
+	t     operand
+	align uint32
+}
+
+var loads = map[byte]memAccess{
+	OpI32Load: {opI32, 2}, OpI64Load: {opI64, 3}, OpF32Load: {opF32, 2}, OpF64Load: {opF64, 3},
+	OpI32Load8S: {opI32, 0}, OpI32Load8U: {opI32, 0}, OpI32Load16S: {opI32, 1}, OpI32Load16U: {opI32, 1},
+	OpI64Load8S: {opI64, 0}, OpI64Load8U: {opI64, 0}, OpI64Load16S: {opI64, 1}, OpI64Load16U: {opI64, 1},
+	OpI64Load32S: {opI64, 2}, OpI64Load32U: {opI64, 2},
+}
+
+var stores = map[byte]memAccess{
+	OpI32Store: {opI32, 2}, OpI64Store: {opI64, 3}, OpF32Store: {opF32, 2}, OpF64Store: {opF64, 3},
+	OpI32Store8: {opI32, 0}, OpI32Store16: {opI32, 1},
+	OpI64Store8: {opI64, 0}, OpI64Store16: {opI64, 1}, OpI64Store32: {opI64, 2},
+}
+
+// opSig is the type of an instruction without immediates.
+type opSig struct {
+	in  []operand
+	out []operand
+}
+
+// numericOps holds the types of the single-byte numeric instructions.
+var numericOps = func() map[byte]opSig {
+	sigs := make(map[byte]opSig)
+	add := func(from, to byte, in []operand, out ...operand) {
+		for op := from; op <= to; op++ {
+			sigs[op] = opSig{in: in, out: out}
+		}
+	}
+	unary := func(t operand) []operand { return []operand{t} }
+	binary := func(t operand) []operand { return []operand{t, t} }
+
+	add(OpI32Eqz, OpI32Eqz, unary(opI32), opI32)
+	add(OpI32Eq, OpI32GeU, binary(opI32), opI32)
+	add(OpI64Eqz, OpI64Eqz, unary(opI64), opI32)
+	add(OpI64Eq, OpI64GeU, binary(opI64), opI32)
+	add(OpF32Eq, OpF32Ge, binary(opF32), opI32)
+	add(OpF64Eq, OpF64Ge, binary(opF64), opI32)
+	add(OpI32Clz, OpI32Popcnt, unary(opI32), opI32)
+	add(OpI32Add, OpI32Rotr, binary(opI32), opI32)
+	add(OpI64Clz, OpI64Popcnt, unary(opI64), opI64)
+	add(OpI64Add, OpI64Rotr, binary(opI64), opI64)
+	add(OpF32Abs, OpF32Sqrt, unary(opF32), opF32)
+	add(OpF32Add, OpF32Copysign, binary(opF32), opF32)
+	add(OpF64Abs, OpF64Sqrt, unary(opF64), opF64)
+	add(OpF64Add, OpF64Copysign, binary(opF64), opF64)
+
+	add(OpI32WrapI64, OpI32WrapI64, unary(opI64), opI32)
+	add(OpI32TruncF32S, OpI32TruncF32U, unary(opF32), opI32)
+	add(OpI32TruncF64S, OpI32TruncF64U, unary(opF64), opI32)
+	add(OpI64ExtendI32S, OpI64ExtendI32U, unary(opI32), opI64)
+	add(OpI64TruncF32S, OpI64TruncF32U, unary(opF32), opI64)
+	add(OpI64TruncF64S, OpI64TruncF64U, unary(opF64), opI64)
+	add(OpF32ConvertI32S, OpF32ConvertI32U, unary(opI32), opF32)
+	add(OpF32ConvertI64S, OpF32ConvertI64U, unary(opI64), opF32)
+	add(OpF32DemoteF64, OpF32DemoteF64, unary(opF64), opF32)
+	add(OpF64ConvertI32S, OpF64ConvertI32U, unary(opI32), opF64)
+	add(OpF64ConvertI64S, OpF64ConvertI64U, unary(opI64), opF64)
+	add(OpF64PromoteF32, OpF64PromoteF32, unary(opF32), opF64)
+	add(OpI32ReinterpretF32, OpI32ReinterpretF32, unary(opF32), opI32)
+	add(OpI64ReinterpretF64, OpI64ReinterpretF64, unary(opF64), opI64)
+	add(OpF32ReinterpretI32, OpF32ReinterpretI32, unary(opI32), opF32)
+	add(OpF64ReinterpretI64, OpF64ReinterpretI64, unary(opI64), opF64)
+	add(OpI32Extend8S, OpI32Extend16S, unary(opI32), opI32)
+	add(OpI64Extend8S, OpI64Extend32S, unary(opI64), opI64)
+	return sigs
+}()
+
+// apply pops the inputs of sig and pushes its outputs.
+func (v *codeValidator) apply(sig opSig) error {
+	if err := v.pop(sig.in...); err != nil {
+		return err
+	}
+	v.push(sig.out...)
+	return nil
+}
+
+func (v *codeValidator) step(instr *Instruction) error {
+	if sig, ok := numericOps[instr.Opcode]; ok {
+		return v.apply(sig)
+	}
+	if acc, ok := loads[instr.Opcode]; ok {
+		addr, err := v.memArg(instr.Imm.(MemoryImm), acc.align)
+		if err != nil {
+			return err
+		}
+		return v.apply(opSig{in: []operand{addr}, out: []operand{acc.t}})
+	}
+	if acc, ok := stores[instr.Opcode]; ok {
+		addr, err := v.memArg(instr.Imm.(MemoryImm), acc.align)
+		if err != nil {
+			return err
+		}
+		return v.pop(addr, acc.t)
+	}
+
+	switch instr.Opcode {
+	case OpUnreachable:
+		v.unreachable()
+	case OpNop:
+	case OpBlock, OpLoop, OpIf, OpTry:
+		params, results, err := v.blockType(instr.Imm.(BlockImm).Type)
+		if err != nil {
+			return err
+		}
+		if instr.Opcode == OpIf {
+			if err := v.pop(opI32); err != nil {
+				return err
+			}
+		}
+		if err := v.pop(params...); err != nil {
+			return err
+		}
+		v.pushCtrl(instr.Opcode, params, results)
+	case OpElse:
+		if v.ctrls[len(v.ctrls)-1].op != OpIf {
+			return v.fail("else without if")
+		}
+		f, err := v.popCtrl()
+		if err != nil {
+			return err
+		}
+		v.pushCtrl(OpElse, f.params, f.results)
+	case OpEnd:
+		f, err := v.popCtrl()
+		if err != nil {
+			return err
+		}
+		if f.op == OpIf && !v.mt.matchesAll(f.params, f.results) {
+			return v.fail("if without else must leave its parameters as results: params %s, results %s",
+				operandList(f.params), operandList(f.results))
+		}
+		v.push(f.results...)
+	case OpBr:
+		f, err := v.label(instr.Imm.(BranchImm).LabelIdx)
+		if err != nil {
+			return err
+		}
+		if err := v.pop(f.labelTypes()...); err != nil {
+			return err
+		}
+		v.unreachable()
+	case OpBrIf:
+		f, err := v.label(instr.Imm.(BranchImm).LabelIdx)
+		if err != nil {
+			return err
+		}
+		if err := v.pop(opI32); err != nil {
+			return err
+		}
+		types := f.labelTypes()
+		if err := v.pop(types...); err != nil {
+			return err
+		}
+		v.push(types...)
+	case OpBrTable:
+		return v.brTable(instr.Imm.(BrTableImm))
+	case OpReturn:
+		if err := v.pop(v.results...); err != nil {
+			return err
+		}
+		v.unreachable()
+	case OpCall, OpReturnCall:
+		params, results, err := v.mt.funcSignature(instr.Imm.(CallImm).FuncIdx)
+		if err != nil {
+			return v.fail("%v", err)
+		}
+		return v.call(instr.Opcode == OpReturnCall, params, results)
+	case OpCallIndirect, OpReturnCallIndirect:
+		imm := instr.Imm.(CallIndirectImm)
+		elem, addr, err := v.mt.table(imm.TableIdx)
+		if err != nil {
+			return v.fail("%v", err)
+		}
+		if !v.mt.matches(elem, opFuncRef) {
+			return v.fail("call_indirect table %d has element type %s, not funcref", imm.TableIdx, elem)
+		}
+		ft, err := v.mt.funcType(imm.TypeIdx)
+		if err != nil {
+			return v.fail("%v", err)
+		}
+		params, results, err := signature(ft)
+		if err != nil {
+			return v.fail("%v", err)
+		}
+		if err := v.pop(addr); err != nil {
+			return err
+		}
+		return v.call(instr.Opcode == OpReturnCallIndirect, params, results)
+	case OpCallRef, OpReturnCallRef:
+		typeIdx := instr.Imm.(CallRefImm).TypeIdx
+		ft, err := v.mt.funcType(typeIdx)
+		if err != nil {
+			return v.fail("%v", err)
+		}
+		params, results, err := signature(ft)
+		if err != nil {
+			return v.fail("%v", err)
+		}
+		if err := v.pop(refOperand(true, int64(typeIdx))); err != nil {
+			return err
+		}
+		return v.call(instr.Opcode == OpReturnCallRef, params, results)
+	case OpDrop:
+		_, err := v.popAny()
+		return err
+	case OpSelect:
+		return v.selectUntyped()
+	case OpSelectType:
+		imm := instr.Imm.(SelectTypeImm)
+		ops, err := valueOperands(imm.Types, imm.ExtTypes)
+		if err != nil {
+			return v.fail("select: %v", err)
+		}
+		if len(ops) != 1 {
+			return v.fail("select must have exactly one result type, has %d", len(ops))
+		}
+		return v.apply(opSig{in: []operand{ops[0], ops[0], opI32}, out: ops})
+	case OpLocalGet:
+		idx := instr.Imm.(LocalImm).LocalIdx
+		t, err := v.local(idx)
+		if err != nil {
+			return err
+		}
+		if !v.inited[idx] {
+			return v.fail("local %d of type %s read before it is set", idx, t)
+		}
+		v.push(t)
+	case OpLocalSet, OpLocalTee:
+		idx := instr.Imm.(LocalImm).LocalIdx
+		t, err := v.local(idx)
+		if err != nil {
+			return err
+		}
+		if err := v.pop(t); err != nil {
+			return err
+		}
+		v.setLocal(idx)
+		if instr.Opcode == OpLocalTee {
+			v.push(t)
+		}
+	case OpGlobalGet:
+		t, _, err := v.mt.global(instr.Imm.(GlobalImm).GlobalIdx)
+		if err != nil {
+			return v.fail("%v", err)
+		}
+		v.push(t)
+	case OpGlobalSet:
+		idx := instr.Imm.(GlobalImm).GlobalIdx
+		t, mutable, err := v.mt.global(idx)
+		if err != nil {
+			return v.fail("%v", err)
+		}
+		if !mutable {
+			return v.fail("global %d is immutable", idx)
+		}
+		return v.pop(t)
+	case OpTableGet, OpTableSet:
+		elem, addr, err := v.mt.table(instr.Imm.(TableImm).TableIdx)
+		if err != nil {
+			return v.fail("%v", err)
+		}
+		if instr.Opcode == OpTableGet {
+			return v.apply(opSig{in: []operand{addr}, out: []operand{elem}})
+		}
+		return v.pop(addr, elem)
+	case OpMemorySize, OpMemoryGrow:
+		addr, _, err := v.mt.memory(instr.Imm.(MemoryIdxImm).MemIdx)
+		if err != nil {
+			return v.fail("%v", err)
+		}
+		if instr.Opcode == OpMemoryGrow {
+			return v.apply(opSig{in: []operand{addr}, out: []operand{addr}})
+		}
+		v.push(addr)
+	case OpI32Const:
+		v.push(opI32)
+	case OpI64Const:
+		v.push(opI64)
+	case OpF32Const:
+		v.push(opF32)
+	case OpF64Const:
+		v.push(opF64)
+	case OpRefNull, OpRefIsNull, OpRefFunc, OpRefAsNonNull, OpRefEq, OpBrOnNull, OpBrOnNonNull:
+		return v.refOp(instr)
+	case OpCatch, OpCatchAll, OpThrow, OpThrowRef, OpRethrow, OpDelegate, OpTryTable:
+		return v.exceptionOp(instr)
+	case OpPrefixMisc:
+		return v.miscOp(instr.Imm.(MiscImm))
+	case OpPrefixSIMD:
+		return v.simdOp(instr.Imm.(SIMDImm))
+	case OpPrefixAtomic:
+		return v.atomicOp(instr.Imm.(AtomicImm))
+	case OpPrefixGC:
+		return v.gcOp(instr.Imm.(GCImm))
+	default:
+		return v.fail("unknown opcode 0x%02x", instr.Opcode)
+	}
+	return nil
+}
+
+// call pops params and pushes results, or for tail calls checks results
+// against the function's own.
+func (v *codeValidator) call(tail bool, params, results []operand) error {
+	if err := v.pop(params...); err != nil {
+		return err
+	}
+	if !tail {
+		v.push(results...)
+		return nil
+	}
+	if !v.mt.matchesAll(results, v.results) {
+		return v.fail("tail call results %s do not match function results %s", operandList(results), operandList(v.results))
+	}
+	v.unreachable()
+	return nil
+}
+
+func (v *codeValidator) brTable(imm BrTableImm) error {
+	if err := v.pop(opI32); err != nil {
+		return err
+	}
+	def, err := v.label(imm.Default)
+	if err != nil {
+		return err
+	}
+	arity := len(def.labelTypes())
+	for _, l := range imm.Labels {
+		f, err := v.label(l)
+		if err != nil {
+			return err
+		}
+		types := f.labelTypes()
+		if len(types) != arity {
+			return v.fail("br_table label %d carries %d values, default label %d carries %d", l, len(types), imm.Default, arity)
+		}
+		got, err := v.popVals(types...)
+		if err != nil {
+			return err
+		}
+		v.push(got...)
+	}
+	if err := v.pop(def.labelTypes()...); err != nil {
+		return err
+	}
+	v.unreachable()
+	return nil
+}
+
+func (v *codeValidator) selectUntyped() error {
+	if err := v.pop(opI32); err != nil {
+		return err
+	}
+	t1, err := v.popAny()
+	if err != nil {
+		return err
+	}
+	t2, err := v.popAny()
+	if err != nil {
+		return err
+	}
+	if t1.ref || t2.ref {
+		v.push(t2, t1)
+		return v.mismatch("select without type requires number or vector operands", []operand{t2, t1}, false)
+	}
+	if t1 != t2 && t1 != unknown && t2 != unknown {
+		v.push(t2, t1)
+		return v.mismatch("select operands differ in type", []operand{t1, t1}, false)
+	}
+	if t1 == unknown {
+		t1 = t2
+	}
+	v.push(t1)
+	return nil
+}
+
+func (v *codeValidator) refOp(instr *Instruction) error {
+	switch instr.Opcode {
+	case OpRefNull:
+		ht := instr.Imm.(RefNullImm).HeapType
+		if !v.mt.validHeap(ht) {
+			return v.fail("invalid heap type %d", ht)
+		}
+		v.push(refOperand(true, ht))
+	case OpRefIsNull:
+		if _, err := v.popRef(); err != nil {
+			return err
+		}
+		v.push(opI32)
+	case OpRefFunc:
+		idx := instr.Imm.(RefFuncImm).FuncIdx
+		if int(idx) >= len(v.mt.funcs) {
+			return v.fail("unknown function %d", idx)
+		}
+		if !v.mt.refs[idx] {
+			return v.fail("undeclared function reference %d", idx)
+		}
+		v.push(refOperand(false, int64(v.mt.funcs[idx])))
+	case OpRefAsNonNull:
+		r, err := v.popRef()
+		if err != nil {
+			return err
+		}
+		if r != unknown {
+			r.nullable = false
+		}
+		v.push(r)
+	case OpRefEq:
+		return v.apply(opSig{in: []operand{opEqRef, opEqRef}, out: []operand{opI32}})
+	case OpBrOnNull:
+		f, err := v.label(instr.Imm.(BranchImm).LabelIdx)
+		if err != nil {
+			return err
+		}
+		r, err := v.popRef()
+		if err != nil {
+			return err
+		}
+		types := f.labelTypes()
+		if err := v.pop(types...); err != nil {
+			return err
+		}
+		v.push(types...)
+		if r != unknown {
+			r.nullable = false
+		}
+		v.push(r)
+	case OpBrOnNonNull:
+		f, err := v.label(instr.Imm.(BranchImm).LabelIdx)
+		if err != nil {
+			return err
+		}
+		types := f.labelTypes()
+		if len(types) == 0 || !types[len(types)-1].ref {
+			return v.fail("br_on_non_null label must end with a reference type, has %s", operandList(types))
+		}
+		r, err := v.popRef()
+		if err != nil {
+			return err
+		}
+		if r != unknown {
+			r.nullable = false
+		}
+		if !v.mt.matches(r, types[len(types)-1]) {
+			v.push(r)
+			return v.mismatch("type mismatch", types[len(types)-1:], false)
+		}
+		rest := types[:len(types)-1]
+		if err := v.pop(rest...); err != nil {
+			return err
+		}
+		v.push(rest...)
+	}
+	return nil
+}
+
+func (v *codeValidator) exceptionOp(instr *Instruction) error {
+	switch instr.Opcode {
+	case OpThrow:
+		params, err := v.mt.tagParams(instr.Imm.(ThrowImm).TagIdx)
+		if err != nil {
+			return v.fail("%v", err)
+		}
+		if err := v.pop(params...); err != nil {
+			return err
+		}
+		v.unreachable()
+	case OpThrowRef:
+		if err := v.pop(refOperand(true, HeapTypeExn)); err != nil {
+			return err
+		}
+		v.unreachable()
+	case OpCatch, OpCatchAll:
+		if op := v.ctrls[len(v.ctrls)-1].op; op != OpTry && op != OpCatch {
+			return v.fail("catch outside try")
+		}
+		var params []operand
+		if instr.Opcode == OpCatch {
+			var err error
+			params, err = v.mt.tagParams(instr.Imm.(ThrowImm).TagIdx)
+			if err != nil {
+				return v.fail("%v", err)
+			}
+		}
+		f, err := v.popCtrl()
+		if err != nil {
+			return err
+		}
+		v.pushCtrl(instr.Opcode, params, f.results)
+		// The frame's parameters are the caught values, not block inputs
+		v.ctrls[len(v.ctrls)-1].params = nil
+	case OpRethrow:
+		f, err := v.label(instr.Imm.(BranchImm).LabelIdx)
+		if err != nil {
+			return err
+		}
+		if f.op != OpCatch && f.op != OpCatchAll {
+			return v.fail("rethrow label %d is not a catch block", instr.Imm.(BranchImm).LabelIdx)
+		}
+		v.unreachable()
+	case OpDelegate:
+		if v.ctrls[len(v.ctrls)-1].op != OpTry {
+			return v.fail("delegate outside try")
+		}
+		f, err := v.popCtrl()
+		if err != nil {
+			return err
+		}
+		if _, err := v.label(instr.Imm.(BranchImm).LabelIdx); err != nil {
+			return err
+		}
+		v.push(f.results...)
+	case OpTryTable:
+		imm := instr.Imm.(TryTableImm)
+		params, results, err := v.blockType(imm.BlockType)
+		if err != nil {
+			return err
+		}
+		for _, c := range imm.Catches {
+			if err := v.checkCatch(c); err != nil {
+				return err
+			}
+		}
+		if err := v.pop(params...); err != nil {
+			return err
+		}
+		v.pushCtrl(OpTryTable, params, results)
+	}
+	return nil
+}
+
+// checkCatch checks that the values a try_table catch clause branches
+// with match its label.
+func (v *codeValidator) checkCatch(c CatchClause) error {
+	var types []operand
+	if c.Kind == CatchKindCatch || c.Kind == CatchKindCatchRef {
+		params, err := v.mt.tagParams(c.TagIdx)
+		if err != nil {
+			return v.fail("%v", err)
+		}
+		types = append(types, params...)
+	}
+	switch c.Kind {
+	case CatchKindCatchRef, CatchKindCatchAllRef:
+		types = append(types, opExnRef)
+	case CatchKindCatch, CatchKindCatchAll:
+	default:
+		return v.fail("invalid catch kind %d", c.Kind)
+	}
+	f, err := v.label(c.LabelIdx)
+	if err != nil {
+		return err
+	}
+	if !v.mt.matchesAll(types, f.labelTypes()) {
+		return v.fail("catch clause values %s do not match label %d types %s", operandList(types), c.LabelIdx, operandList(f.labelTypes()))
+	}
+	return nil
+}
+
+func (v *codeValidator) miscOp(imm MiscImm) error {
+	switch imm.SubOpcode {
+	case MiscI32TruncSatF32S, MiscI32TruncSatF32U:
+		return v.apply(opSig{in: []operand{opF32}, out: []operand{opI32}})
+	case MiscI32TruncSatF64S, MiscI32TruncSatF64U:
+		return v.apply(opSig{in: []operand{opF64}, out: []operand{opI32}})
+	case MiscI64TruncSatF32S, MiscI64TruncSatF32U:
+		return v.apply(opSig{in: []operand{opF32}, out: []operand{opI64}})
+	case MiscI64TruncSatF64S, MiscI64TruncSatF64U:
+		return v.apply(opSig{in: []operand{opF64}, out: []operand{opI64}})
+	case MiscMemoryInit:
+		if err := v.mt.checkData(imm.Operands[0]); err != nil {
+			return v.fail("memory.init: %v", err)
+		}
+		addr, _, err := v.mt.memory(imm.Operands[1])
+		if err != nil {
+			return v.fail("%v", err)
+		}
+		return v.pop(addr, opI32, opI32)
+	case MiscDataDrop:
+		if err := v.mt.checkData(imm.Operands[0]); err != nil {
+			return v.fail("data.drop: %v", err)
+		}
+	case MiscMemoryCopy:
+		dst, _, err := v.mt.memory(imm.Operands[0])
+		if err != nil {
+			return v.fail("%v", err)
+		}
+		src, _, err := v.mt.memory(imm.Operands[1])
+		if err != nil {
+			return v.fail("%v", err)
+		}
+		return v.pop(dst, src, minAddr(dst, src))
+	case MiscMemoryFill:
+		addr, _, err := v.mt.memory(imm.Operands[0])
+		if err != nil {
+			return v.fail("%v", err)
+		}
+		return v.pop(addr, opI32, addr)
+	case MiscMemoryDiscard:
+		addr, _, err := v.mt.memory(imm.Operands[0])
+		if err != nil {
+			return v.fail("%v", err)
+		}
+		return v.pop(addr, addr)
+	case MiscTableInit:
+		et, err := v.mt.elemType(imm.Operands[0])
+		if err != nil {
+			return v.fail("%v", err)
+		}
+		elem, addr, err := v.mt.table(imm.Operands[1])
+		if err != nil {
+			return v.fail("%v", err)
+		}
+		if !v.mt.matches(et, elem) {
+			return v.fail("table.init: element segment type %s does not match table type %s", et, elem)
+		}
+		return v.pop(addr, opI32, opI32)
+	case MiscElemDrop:
+		if _, err := v.mt.elemType(imm.Operands[0]); err != nil {
+			return v.fail("%v", err)
+		}
+	case MiscTableCopy:
+		dstElem, dst, err := v.mt.table(imm.Operands[0])
+		if err != nil {
+			return v.fail("%v", err)
+		}
+		srcElem, src, err := v.mt.table(imm.Operands[1])
+		if err != nil {
+			return v.fail("%v", err)
+		}
+		if !v.mt.matches(srcElem, dstElem) {
+			return v.fail("table.copy: source type %s does not match destination type %s", srcElem, dstElem)
+		}
+		return v.pop(dst, src, minAddr(dst, src))
+	case MiscTableGrow:
+		elem, addr, err := v.mt.table(imm.Operands[0])
+		if err != nil {
+			return v.fail("%v", err)
+		}
+		return v.apply(opSig{in: []operand{elem, addr}, out: []operand{addr}})
+	case MiscTableSize:
+		_, addr, err := v.mt.table(imm.Operands[0])
+		if err != nil {
+			return v.fail("%v", err)
+		}
+		v.push(addr)
+	case MiscTableFill:
+		elem, addr, err := v.mt.table(imm.Operands[0])
+		if err != nil {
+			return v.fail("%v", err)
+		}
+		return v.pop(addr, elem, addr)
+	default:
+		return v.fail("unknown 0xfc opcode %d", imm.SubOpcode)
+	}
+	return nil
+}
+
+// atomicAccess returns the value type and natural alignment of the
+// atomic load, store and read-modify-write instructions, which come in
+// groups of seven ordered by access size.
+func atomicAccess(op uint32) (operand, uint32) {
+	switch (op - AtomicI32Load) % 7 {
+	case 0:
+		return opI32, 2
+	case 1:
+		return opI64, 3
+	case 2:
+		return opI32, 0
+	case 3:
+		return opI32, 1
+	case 4:
+		return opI64, 0
+	case 5:
+		return opI64, 1
+	default:
+		return opI64, 2
+	}
+}
+
+func (v *codeValidator) atomicOp(imm AtomicImm) error {
+	op := imm.SubOpcode
+	if op == AtomicFence {
+		return nil
+	}
+
+	var (
+		t     operand
+		align uint32
+	)
+	switch {
+	case op == AtomicNotify, op == AtomicWait32:
+		t, align = opI32, 2
+	case op == AtomicWait64:
+		t, align = opI64, 3
+	case op >= AtomicI32Load && op <= AtomicI64Rmw32CmpxchgU:
+		t, align = atomicAccess(op)
+	default:
+		return v.fail("unknown 0xfe opcode %d", op)
+	}
+	// Atomic accesses must be naturally aligned
+	if imm.MemArg.Align != align {
+		return v.fail("atomic alignment 2^%d must equal natural alignment 2^%d", imm.MemArg.Align, align)
+	}
+	addr, err := v.memArg(*imm.MemArg, align)
+	if err != nil {
+		return err
+	}
+
+	switch {
+	case op == AtomicNotify:
+		return v.apply(opSig{in: []operand{addr, opI32}, out: []operand{opI32}})
+	case op == AtomicWait32, op == AtomicWait64:
+		return v.apply(opSig{in: []operand{addr, t, opI64}, out: []operand{opI32}})
+	case op <= AtomicI64Load32U:
+		return v.apply(opSig{in: []operand{addr}, out: []operand{t}})
+	case op <= AtomicI64Store32:
+		return v.pop(addr, t)
+	case op < AtomicI32RmwCmpxchg:
+		return v.apply(opSig{in: []operand{addr, t}, out: []operand{t}})
+	default:
+		return v.apply(opSig{in: []operand{addr, t, t}, out: []operand{t}})
+	}
+}
+
+// minAddr returns the type of a length spanning two address spaces.
+func minAddr(a, b operand) operand {
+	if a == opI32 || b == opI32 {
+		return opI32
+	}
+	return opI64
+}
@@ -0,0 +1,261 @@
+package wasm_test
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/wippyai/wasm-runtime/wasm"
+)
+
+// codeModule returns a module of one function of type (i32 i32) -> i32
+// with the given locals and body.
+func codeModule(locals []wasm.LocalEntry, code ...byte) *wasm.Module {
+	return &wasm.Module{
+		Types: []wasm.FuncType{
+			{Params: []wasm.ValType{wasm.ValI32, wasm.ValI32}, Results: []wasm.ValType{wasm.ValI32}},
+		},
+		Funcs:    []uint32{0},
+		Memories: []wasm.MemoryType{{Limits: wasm.Limits{Min: 1}}},
+		Globals: []wasm.Global{
+			{Type: wasm.GlobalType{ValType: wasm.ValI32}, Init: []byte{wasm.OpI32Const, 0, wasm.OpEnd}},
+		},
+		Code: []wasm.FuncBody{{Locals: locals, Code: code}},
+	}
+}
+
+func TestValidateCode(t *testing.T) {
+	tests := []struct {
+		name   string
+		locals []wasm.LocalEntry
+		code   []byte
+		want   string // error substring, empty when valid
+	}{
+		{
+			name: "add",
+			code: []byte{wasm.OpLocalGet, 0, wasm.OpLocalGet, 1, wasm.OpI32Add, wasm.OpEnd},
+		},
+		{
+			name: "unreachable operands",
+			code: []byte{wasm.OpUnreachable, wasm.OpI32Add, wasm.OpEnd},
+		},
+		{
+			name: "block result",
+			code: []byte{
+				wasm.OpBlock, 0x7F, wasm.OpI32Const, 1, wasm.OpLocalGet, 0, wasm.OpBrIf, 0, wasm.OpEnd, wasm.OpEnd,
+			},
+		},
+		{
+			name: "if else",
+			code: []byte{
+				wasm.OpLocalGet, 0, wasm.OpIf, 0x7F, wasm.OpI32Const, 1, wasm.OpElse, wasm.OpI32Const, 2, wasm.OpEnd,
+				wasm.OpEnd,
+			},
+		},
+		{
+			name: "memory",
+			code: []byte{
+				wasm.OpLocalGet, 0, wasm.OpLocalGet, 1, wasm.OpI32Store, 2, 0,
+				wasm.OpLocalGet, 0, wasm.OpI32Load8U, 0, 4, wasm.OpEnd,
+			},
+		},
+		{
+			name: "select",
+			code: []byte{wasm.OpLocalGet, 0, wasm.OpLocalGet, 1, wasm.OpI32Const, 1, wasm.OpSelect, wasm.OpEnd},
+		},
+		{
+			name:   "locals",
+			locals: []wasm.LocalEntry{{Count: 2, ValType: wasm.ValI64}},
+			code:   []byte{wasm.OpLocalGet, 3, wasm.OpI32WrapI64, wasm.OpEnd},
+		},
+		{
+			name: "type mismatch",
+			code: []byte{wasm.OpI64Const, 1, wasm.OpI32Const, 1, wasm.OpI32Add, wasm.OpEnd},
+			want: "function 0 at offset 0x4: type mismatch: expected [i32 i32], got [i64 i32]",
+		},
+		{
+			name: "not enough operands",
+			code: []byte{wasm.OpI32Const, 1, wasm.OpI32Add, wasm.OpEnd},
+			want: "at offset 0x2: not enough operands: expected [i32 i32], got [i32]",
+		},
+		{
+			name: "values remaining",
+			code: []byte{wasm.OpI32Const, 1, wasm.OpI32Const, 2, wasm.OpEnd},
+			want: "at offset 0x4: values remaining on stack at end of block: expected [i32], got [i32 i32]",
+		},
+		{
+			name: "missing result",
+			code: []byte{wasm.OpBlock, 0x7F, wasm.OpEnd, wasm.OpEnd},
+			want: "at offset 0x2: not enough operands",
+		},
+		{
+			name: "unknown local",
+			code: []byte{wasm.OpLocalGet, 2, wasm.OpEnd},
+			want: "unknown local 2",
+		},
+		{
+			name: "unknown label",
+			code: []byte{wasm.OpI32Const, 0, wasm.OpBr, 1, wasm.OpEnd},
+			want: "unknown label 1",
+		},
+		{
+			name: "missing end",
+			code: []byte{wasm.OpI32Const, 0},
+			want: "at offset 0x2: function body not terminated by end",
+		},
+		{
+			name: "else without if",
+			code: []byte{wasm.OpBlock, 0x40, wasm.OpElse, wasm.OpEnd, wasm.OpI32Const, 0, wasm.OpEnd},
+			want: "else without if",
+		},
+		{
+			name: "select types differ",
+			code: []byte{wasm.OpI32Const, 1, wasm.OpI64Const, 2, wasm.OpI32Const, 1, wasm.OpSelect, wasm.OpEnd},
+			want: "select operands differ in type",
+		},
+		{
+			name: "immutable global",
+			code: []byte{wasm.OpI32Const, 1, wasm.OpGlobalSet, 0, wasm.OpI32Const, 0, wasm.OpEnd},
+			want: "global 0 is immutable",
+		},
+		{
+			name: "alignment",
+			code: []byte{wasm.OpLocalGet, 0, wasm.OpI32Load, 3, 0, wasm.OpEnd},
+			want: "alignment 2^3 larger than natural alignment 2^2",
+		},
+		{
+			name: "undeclared function reference",
+			code: []byte{wasm.OpRefFunc, 0, wasm.OpRefIsNull, wasm.OpEnd},
+			want: "undeclared function reference 0",
+		},
+		{
+			name: "lane index",
+			code: append(append([]byte{wasm.OpPrefixSIMD, 0x0C}, make([]byte, 16)...),
+				wasm.OpPrefixSIMD, 0x1B, 4, wasm.OpEnd),
+			want: "lane index 4 out of range for 4 lanes",
+		},
+		{
+			name: "simd",
+			code: append(append([]byte{wasm.OpPrefixSIMD, 0x0C}, make([]byte, 16)...),
+				wasm.OpLocalGet, 0, wasm.OpPrefixSIMD, 0x11, wasm.OpPrefixSIMD, 0xAE, 0x01,
+				wasm.OpPrefixSIMD, 0x1B, 3, wasm.OpEnd),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := codeModule(tt.locals, tt.code...).ValidateCode()
+			if tt.want == "" {
+				if err != nil {
+					t.Fatalf("ValidateCode() error = %v", err)
+				}
+				return
+			}
+			if err == nil {
+				t.Fatalf("ValidateCode() succeeded, want error %q", tt.want)
+			}
+			if !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("ValidateCode() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateCode_Error(t *testing.T) {
+	m := codeModule(nil, wasm.OpI64Const, 1, wasm.OpF32Const, 0, 0, 0, 0, wasm.OpI32Add, wasm.OpEnd)
+	m.Imports = []wasm.Import{{Module: "env", Name: "f", Desc: wasm.ImportDesc{Kind: wasm.KindFunc, TypeIdx: 0}}}
+
+	err := m.Validate()
+	var codeErr *wasm.CodeError
+	if !errors.As(err, &codeErr) {
+		t.Fatalf("Validate() error = %v, want CodeError", err)
+	}
+	if codeErr.Func != 1 || codeErr.Offset != 7 {
+		t.Errorf("function %d, offset %d, want function 1, offset 7", codeErr.Func, codeErr.Offset)
+	}
+	if want := []string{"i32", "i32"}; !reflect.DeepEqual(codeErr.Expected, want) {
+		t.Errorf("Expected = %v, want %v", codeErr.Expected, want)
+	}
+	if want := []string{"i64", "f32"}; !reflect.DeepEqual(codeErr.Actual, want) {
+		t.Errorf("Actual = %v, want %v", codeErr.Actual, want)
+	}
+}
+
+func TestValidateCode_GC(t *testing.T) {
+	point := wasm.StructType{Fields: []wasm.FieldType{
+		{Type: wasm.StorageType{Kind: wasm.StorageKindVal, ValType: wasm.ValI32}},
+		{Type: wasm.StorageType{Kind: wasm.StorageKindPacked, Packed: wasm.PackedI8}, Mutable: true},
+	}}
+	m := &wasm.Module{
+		TypeDefs: []wasm.TypeDef{
+			{Kind: wasm.TypeDefKindFunc, Func: &wasm.FuncType{Results: []wasm.ValType{wasm.ValI32}}},
+			{Kind: wasm.TypeDefKindSub, Sub: &wasm.SubType{CompType: wasm.CompType{Kind: wasm.CompKindStruct, Struct: &point}}},
+		},
+		Funcs: []uint32{0},
+	}
+
+	tests := []struct {
+		name string
+		code []byte
+		want string
+	}{
+		{
+			name: "struct",
+			code: []byte{
+				wasm.OpI32Const, 1, wasm.OpI32Const, 2, wasm.OpPrefixGC, 0x00, 1, // struct.new 1
+				wasm.OpPrefixGC, 0x03, 1, 1, // struct.get_s 1 1
+				wasm.OpEnd,
+			},
+		},
+		{
+			name: "packed get",
+			code: []byte{
+				wasm.OpPrefixGC, 0x01, 1, // struct.new_default 1
+				wasm.OpPrefixGC, 0x02, 1, 1, // struct.get 1 1
+				wasm.OpEnd,
+			},
+			want: "packed field requires a signed or unsigned get",
+		},
+		{
+			name: "immutable field",
+			code: []byte{
+				wasm.OpPrefixGC, 0x01, 1, wasm.OpI32Const, 0, wasm.OpPrefixGC, 0x05, 1, 0, // struct.set 1 0
+				wasm.OpI32Const, 0, wasm.OpEnd,
+			},
+			want: "struct.set of immutable field 0",
+		},
+		{
+			name: "cast",
+			code: []byte{
+				wasm.OpRefNull, 0x6E, // ref.null any
+				wasm.OpPrefixGC, 0x16, 1, // ref.cast (ref 1)
+				wasm.OpPrefixGC, 0x04, 1, 1, // struct.get_u 1 1
+				wasm.OpEnd,
+			},
+		},
+		{
+			name: "cast hierarchy",
+			code: []byte{
+				wasm.OpRefNull, 0x70, // ref.null func
+				wasm.OpPrefixGC, 0x14, 1, // ref.test (ref 1)
+				wasm.OpEnd,
+			},
+			want: "type mismatch: expected [anyref], got [funcref]",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			m.Code = []wasm.FuncBody{{Code: tt.code}}
+			err := m.ValidateCode()
+			if tt.want == "" {
+				if err != nil {
+					t.Fatalf("ValidateCode() error = %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.want) {
+				t.Errorf("ValidateCode() error = %v, want %q", err, tt.want)
+			}
+		})
+	}
+}
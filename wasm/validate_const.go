@@ -0,0 +1,178 @@
+package wasm
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// constOps are the opcodes without a prefix allowed in constant
+// expressions: constants, references, global.get and the extended-const
+// arithmetic.
+var constOps = map[byte]bool{
+	OpI32Const:  true,
+	OpI64Const:  true,
+	OpF32Const:  true,
+	OpF64Const:  true,
+	OpRefNull:   true,
+	OpRefFunc:   true,
+	OpGlobalGet: true,
+	OpI32Add:    true,
+	OpI32Sub:    true,
+	OpI32Mul:    true,
+	OpI64Add:    true,
+	OpI64Sub:    true,
+	OpI64Mul:    true,
+	OpEnd:       true,
+}
+
+// constGCOps are the GC instructions allowed in constant expressions.
+var constGCOps = map[uint32]bool{
+	GCStructNew:        true,
+	GCStructNewDefault: true,
+	GCArrayNew:         true,
+	GCArrayNewDefault:  true,
+	GCArrayNewFixed:    true,
+	GCRefI31:           true,
+	GCAnyConvertExtern: true,
+	GCExternConvertAny: true,
+}
+
+// validateConstExprs type-checks the constant expressions of the module:
+// global and table initializers, the offsets of active data and element
+// segments, and element items. Active element segments must also match
+// the type of their table.
+func (m *Module) validateConstExprs() error {
+	mt := newModuleTypes(m)
+
+	importedGlobals := m.NumImportedGlobals()
+	for i := range m.Globals {
+		idx := importedGlobals + i
+		want, _, err := mt.global(uint32(idx))
+		if err == nil {
+			err = mt.validateConst(m.Globals[i].Init, want)
+		}
+		if err != nil {
+			return fmt.Errorf("global %d: %w", idx, err)
+		}
+	}
+
+	importedTables := m.NumImportedTables()
+	for i := range m.Tables {
+		if len(m.Tables[i].Init) == 0 {
+			continue
+		}
+		idx := importedTables + i
+		want, _, err := mt.table(uint32(idx))
+		if err == nil {
+			err = mt.validateConst(m.Tables[i].Init, want)
+		}
+		if err != nil {
+			return fmt.Errorf("table %d: %w", idx, err)
+		}
+	}
+
+	for i, d := range m.Data {
+		// Passive segments (flags == 1) have no offset
+		if d.Flags == 1 {
+			continue
+		}
+		addr, _, err := mt.memory(d.MemIdx)
+		if err == nil {
+			err = mt.validateConst(d.Offset, addr)
+		}
+		if err != nil {
+			return fmt.Errorf("data segment %d: %w", i, err)
+		}
+	}
+
+	for i := range m.Elements {
+		e := &m.Elements[i]
+		want, err := mt.elemType(uint32(i))
+		if err != nil {
+			return err
+		}
+		if e.Flags&0x01 == 0 {
+			elem, addr, err := mt.table(e.TableIdx)
+			if err == nil {
+				err = mt.validateConst(e.Offset, addr)
+			}
+			if err == nil && !mt.matches(want, elem) {
+				err = fmt.Errorf("type mismatch: segment of %s in table of %s", want, elem)
+			}
+			if err != nil {
+				return fmt.Errorf("element segment %d: %w", i, err)
+			}
+		}
+		for j, expr := range e.Exprs {
+			if err := mt.validateConst(expr, want); err != nil {
+				return fmt.Errorf("element segment %d, item %d: %w", i, j, err)
+			}
+		}
+	}
+	return nil
+}
+
+// validateConst type-checks expr, a constant expression that must produce
+// one value of type want. global.get may only read immutable imported
+// globals.
+func (mt *moduleTypes) validateConst(expr []byte, want operand) error {
+	instrs, err := DecodeInstructions(expr)
+	if err != nil {
+		return err
+	}
+	imported := uint32(mt.m.NumImportedGlobals())
+	v := &codeValidator{mt: mt, offset: -1}
+	v.ctrls = append(v.ctrls, ctrlFrame{results: []operand{want}})
+	for i := range instrs {
+		instr := &instrs[i]
+		if len(v.ctrls) == 0 {
+			return fmt.Errorf("instructions after end")
+		}
+		if !isConstInstr(instr) {
+			return fmt.Errorf("constant expression required")
+		}
+		if instr.Opcode == OpGlobalGet {
+			idx := instr.Imm.(GlobalImm).GlobalIdx
+			if idx >= imported {
+				return fmt.Errorf("unknown global %d", idx)
+			}
+			if _, mutable, _ := mt.global(idx); mutable {
+				return fmt.Errorf("constant expression required: global %d is mutable", idx)
+			}
+		}
+		if err := v.step(instr); err != nil {
+			return constError(err)
+		}
+	}
+	if len(v.ctrls) != 0 {
+		return fmt.Errorf("constant expression not terminated by end")
+	}
+	return nil
+}
+
+// isConstInstr reports whether instr may appear in a constant expression.
+func isConstInstr(instr *Instruction) bool {
+	switch instr.Opcode {
+	case OpPrefixSIMD:
+		imm, ok := instr.Imm.(SIMDImm)
+		return ok && imm.SubOpcode == SimdV128Const
+	case OpPrefixGC:
+		imm, ok := instr.Imm.(GCImm)
+		return ok && constGCOps[imm.SubOpcode]
+	}
+	return constOps[instr.Opcode]
+}
+
+// constError drops the function and offset a *CodeError reports, which
+// have no meaning outside function bodies.
+func constError(err error) error {
+	var ce *CodeError
+	if !errors.As(err, &ce) {
+		return err
+	}
+	if ce.Expected != nil || ce.Actual != nil {
+		return fmt.Errorf("%s: expected [%s], got [%s]", ce.Message, strings.Join(ce.Expected, " "), strings.Join(ce.Actual, " "))
+	}
+	return errors.New(ce.Message)
+}
@@ -0,0 +1,296 @@
+package wasm
+
+// field returns the unpacked operand type of a struct field or array
+// element, checking that get_s and get_u are used exactly on packed ones.
+func (v *codeValidator) field(ft FieldType, signed bool) (operand, error) {
+	packed := ft.Type.Kind == StorageKindPacked
+	if packed != signed {
+		if packed {
+			return unknown, v.fail("packed field requires a signed or unsigned get")
+		}
+		return unknown, v.fail("signed or unsigned get of a field that is not packed")
+	}
+	t, ok := storageOperand(ft.Type)
+	if !ok {
+		return unknown, v.fail("invalid field type 0x%02x", byte(ft.Type.ValType))
+	}
+	return t, nil
+}
+
+func (v *codeValidator) structField(imm GCImm) (FieldType, error) {
+	st, err := v.mt.structType(imm.TypeIdx)
+	if err != nil {
+		return FieldType{}, v.fail("%v", err)
+	}
+	if int(imm.FieldIdx) >= len(st.Fields) {
+		return FieldType{}, v.fail("unknown field %d of type %d", imm.FieldIdx, imm.TypeIdx)
+	}
+	return st.Fields[imm.FieldIdx], nil
+}
+
+// arrayElem returns the element type of array type typeIdx and its
+// unpacked operand type.
+func (v *codeValidator) arrayElem(typeIdx uint32) (FieldType, operand, error) {
+	at, err := v.mt.arrayType(typeIdx)
+	if err != nil {
+		return FieldType{}, unknown, v.fail("%v", err)
+	}
+	t, ok := storageOperand(at.Element.Type)
+	if !ok {
+		return FieldType{}, unknown, v.fail("invalid element type of array type %d", typeIdx)
+	}
+	return at.Element, t, nil
+}
+
+// castType checks a cast target heap type, returning the reference type
+// the cast produces.
+func (v *codeValidator) castType(heap int64, nullable bool) (operand, error) {
+	if !v.mt.validHeap(heap) {
+		return unknown, v.fail("invalid heap type %d", heap)
+	}
+	return refOperand(nullable, heap), nil
+}
+
+func (v *codeValidator) gcOp(imm GCImm) error {
+	ref := refOperand(true, int64(imm.TypeIdx))
+	switch imm.SubOpcode {
+	case GCStructNew, GCStructNewDefault:
+		st, err := v.mt.structType(imm.TypeIdx)
+		if err != nil {
+			return v.fail("%v", err)
+		}
+		fields := make([]operand, len(st.Fields))
+		for i, f := range st.Fields {
+			t, ok := storageOperand(f.Type)
+			if !ok {
+				return v.fail("invalid type of field %d of type %d", i, imm.TypeIdx)
+			}
+			if imm.SubOpcode == GCStructNewDefault && !t.defaultable() {
+				return v.fail("struct.new_default: field %d of type %d has no default value", i, imm.TypeIdx)
+			}
+			fields[i] = t
+		}
+		if imm.SubOpcode == GCStructNewDefault {
+			fields = nil
+		}
+		return v.apply(opSig{in: fields, out: []operand{refOperand(false, int64(imm.TypeIdx))}})
+	case GCStructGet, GCStructGetS, GCStructGetU:
+		f, err := v.structField(imm)
+		if err != nil {
+			return err
+		}
+		t, err := v.field(f, imm.SubOpcode != GCStructGet)
+		if err != nil {
+			return err
+		}
+		return v.apply(opSig{in: []operand{ref}, out: []operand{t}})
+	case GCStructSet:
+		f, err := v.structField(imm)
+		if err != nil {
+			return err
+		}
+		if !f.Mutable {
+			return v.fail("struct.set of immutable field %d of type %d", imm.FieldIdx, imm.TypeIdx)
+		}
+		t, _ := storageOperand(f.Type)
+		return v.pop(ref, t)
+
+	case GCArrayNew, GCArrayNewDefault, GCArrayNewFixed, GCArrayNewData, GCArrayNewElem:
+		return v.arrayNew(imm)
+	case GCArrayGet, GCArrayGetS, GCArrayGetU:
+		f, _, err := v.arrayElem(imm.TypeIdx)
+		if err != nil {
+			return err
+		}
+		t, err := v.field(f, imm.SubOpcode != GCArrayGet)
+		if err != nil {
+			return err
+		}
+		return v.apply(opSig{in: []operand{ref, opI32}, out: []operand{t}})
+	case GCArraySet, GCArrayFill:
+		f, t, err := v.arrayElem(imm.TypeIdx)
+		if err != nil {
+			return err
+		}
+		if !f.Mutable {
+			return v.fail("array type %d is immutable", imm.TypeIdx)
+		}
+		if imm.SubOpcode == GCArrayFill {
+			return v.pop(ref, opI32, t, opI32)
+		}
+		return v.pop(ref, opI32, t)
+	case GCArrayLen:
+		return v.apply(opSig{in: []operand{refOperand(true, HeapTypeArray)}, out: []operand{opI32}})
+	case GCArrayCopy:
+		dst, _, err := v.arrayElem(imm.TypeIdx)
+		if err != nil {
+			return err
+		}
+		src, _, err := v.arrayElem(imm.TypeIdx2)
+		if err != nil {
+			return err
+		}
+		if !dst.Mutable {
+			return v.fail("array type %d is immutable", imm.TypeIdx)
+		}
+		if !v.storageMatches(src.Type, dst.Type) {
+			return v.fail("array.copy: element type of array type %d does not match array type %d", imm.TypeIdx2, imm.TypeIdx)
+		}
+		return v.pop(ref, opI32, refOperand(true, int64(imm.TypeIdx2)), opI32, opI32)
+	case GCArrayInitData, GCArrayInitElem:
+		f, t, err := v.arrayElem(imm.TypeIdx)
+		if err != nil {
+			return err
+		}
+		if !f.Mutable {
+			return v.fail("array type %d is immutable", imm.TypeIdx)
+		}
+		if err := v.segment(imm, t); err != nil {
+			return err
+		}
+		return v.pop(ref, opI32, opI32, opI32)
+
+	case GCRefTest, GCRefTestNull, GCRefCast, GCRefCastNull:
+		nullable := imm.SubOpcode == GCRefTestNull || imm.SubOpcode == GCRefCastNull
+		target, err := v.castType(imm.HeapType, nullable)
+		if err != nil {
+			return err
+		}
+		if err := v.pop(refOperand(true, v.mt.topHeap(imm.HeapType))); err != nil {
+			return err
+		}
+		if imm.SubOpcode == GCRefCast || imm.SubOpcode == GCRefCastNull {
+			v.push(target)
+		} else {
+			v.push(opI32)
+		}
+	case GCBrOnCast, GCBrOnCastFail:
+		return v.brOnCast(imm)
+
+	case GCAnyConvertExtern, GCExternConvertAny:
+		from, to := int64(HeapTypeExtern), int64(HeapTypeAny)
+		if imm.SubOpcode == GCExternConvertAny {
+			from, to = to, from
+		}
+		got, err := v.popVals(refOperand(true, from))
+		if err != nil {
+			return err
+		}
+		// Conversion preserves nullability; unknown operands may be null
+		v.push(refOperand(got[0] == unknown || got[0].nullable, to))
+	case GCRefI31:
+		return v.apply(opSig{in: []operand{opI32}, out: []operand{refOperand(false, HeapTypeI31)}})
+	case GCI31GetS, GCI31GetU:
+		return v.apply(opSig{in: []operand{refOperand(true, HeapTypeI31)}, out: []operand{opI32}})
+	default:
+		return v.fail("unknown 0xfb opcode %d", imm.SubOpcode)
+	}
+	return nil
+}
+
+func (v *codeValidator) arrayNew(imm GCImm) error {
+	_, t, err := v.arrayElem(imm.TypeIdx)
+	if err != nil {
+		return err
+	}
+	out := []operand{refOperand(false, int64(imm.TypeIdx))}
+	switch imm.SubOpcode {
+	case GCArrayNew:
+		return v.apply(opSig{in: []operand{t, opI32}, out: out})
+	case GCArrayNewDefault:
+		if !t.defaultable() {
+			return v.fail("array.new_default: element type of array type %d has no default value", imm.TypeIdx)
+		}
+		return v.apply(opSig{in: []operand{opI32}, out: out})
+	case GCArrayNewFixed:
+		if imm.Size > maxLocals {
+			return v.fail("array.new_fixed: too many operands: %d", imm.Size)
+		}
+		in := make([]operand, imm.Size)
+		for i := range in {
+			in[i] = t
+		}
+		return v.apply(opSig{in: in, out: out})
+	}
+	if err := v.segment(imm, t); err != nil {
+		return err
+	}
+	return v.apply(opSig{in: []operand{opI32, opI32}, out: out})
+}
+
+// segment checks the data or element segment an array is created or
+// initialized from against the array's element type t.
+func (v *codeValidator) segment(imm GCImm, t operand) error {
+	if imm.SubOpcode == GCArrayNewData || imm.SubOpcode == GCArrayInitData {
+		if t.ref {
+			return v.fail("array type %d of reference elements initialized from data segment", imm.TypeIdx)
+		}
+		if err := v.mt.checkData(imm.DataIdx); err != nil {
+			return v.fail("%v", err)
+		}
+		return nil
+	}
+	et, err := v.mt.elemType(imm.ElemIdx)
+	if err != nil {
+		return v.fail("%v", err)
+	}
+	if !v.mt.matches(et, t) {
+		return v.fail("element segment %d of type %s does not match array type %d element type %s", imm.ElemIdx, et, imm.TypeIdx, t)
+	}
+	return nil
+}
+
+// storageMatches reports whether storage type a is a subtype of b.
+func (v *codeValidator) storageMatches(a, b StorageType) bool {
+	if a.Kind == StorageKindPacked || b.Kind == StorageKindPacked {
+		return a.Kind == b.Kind && a.Packed == b.Packed
+	}
+	ta, okA := storageOperand(a)
+	tb, okB := storageOperand(b)
+	return okA && okB && v.mt.matches(ta, tb)
+}
+
+// brOnCast checks br_on_cast and br_on_cast_fail, which branch with the
+// operand cast to the target type, or on failure with the operand
+// itself, leaving the other on the stack.
+func (v *codeValidator) brOnCast(imm GCImm) error {
+	f, err := v.label(imm.LabelIdx)
+	if err != nil {
+		return err
+	}
+	src, err := v.castType(imm.HeapType, imm.CastFlags&0x01 != 0)
+	if err != nil {
+		return err
+	}
+	dst, err := v.castType(imm.HeapType2, imm.CastFlags&0x02 != 0)
+	if err != nil {
+		return err
+	}
+	if !v.mt.matches(dst, src) {
+		return v.fail("cast target %s is not a subtype of %s", dst, src)
+	}
+	// The operand minus the target type: non-null when a null would cast
+	diff := src
+	if dst.nullable {
+		diff.nullable = false
+	}
+	branch, fallthru := dst, diff
+	if imm.SubOpcode == GCBrOnCastFail {
+		branch, fallthru = diff, dst
+	}
+
+	types := f.labelTypes()
+	if len(types) == 0 || !v.mt.matches(branch, types[len(types)-1]) {
+		return v.fail("branch type %s does not match label %d types %s", branch, imm.LabelIdx, operandList(types))
+	}
+	if err := v.pop(src); err != nil {
+		return err
+	}
+	rest := types[:len(types)-1]
+	if err := v.pop(rest...); err != nil {
+		return err
+	}
+	v.push(rest...)
+	v.push(fallthru)
+	return nil
+}
@@ -0,0 +1,162 @@
+package wasm
+
+// simdLoads maps the 0xfd memory instructions without lane immediates to
+// the natural alignment of their access.
+var simdLoads = map[uint32]uint32{
+	0x00: 4,                            // v128.load
+	0x01: 3, 0x02: 3, 0x03: 3, 0x04: 3, // v128.load8x8_s ... load16x4_u
+	0x05: 3, 0x06: 3, // v128.load32x2_s, load32x2_u
+	0x07: 0, 0x08: 1, 0x09: 2, 0x0A: 3, // v128.load8_splat ... load64_splat
+	0x5C: 2, 0x5D: 3, // v128.load32_zero, load64_zero
+}
+
+// simdLane describes a lane instruction: the scalar type of its lane and
+// the number of lanes.
+type simdLane struct {
+	t     operand
+	lanes byte
+}
+
+// simdLanes holds the extract_lane and replace_lane instructions.
+var simdLanes = map[uint32]simdLane{
+	0x15: {opI32, 16}, 0x16: {opI32, 16}, 0x17: {opI32, 16},
+	0x18: {opI32, 8}, 0x19: {opI32, 8}, 0x1A: {opI32, 8},
+	0x1B: {opI32, 4}, 0x1C: {opI32, 4},
+	0x1D: {opI64, 2}, 0x1E: {opI64, 2},
+	0x1F: {opF32, 4}, 0x20: {opF32, 4},
+	0x21: {opF64, 2}, 0x22: {opF64, 2},
+}
+
+// simdMemLanes holds the load_lane and store_lane instructions, whose
+// natural alignment is the lane size.
+var simdMemLanes = map[uint32]struct {
+	align uint32
+	lanes byte
+}{
+	0x54: {0, 16}, 0x55: {1, 8}, 0x56: {2, 4}, 0x57: {3, 2},
+	0x58: {0, 16}, 0x59: {1, 8}, 0x5A: {2, 4}, 0x5B: {3, 2},
+}
+
+// simdOps holds the types of the 0xfd instructions without immediates,
+// numbered as in the SIMD and relaxed SIMD specifications.
+var simdOps = func() map[uint32]opSig {
+	sigs := make(map[uint32]opSig)
+	add := func(sig opSig, ops ...uint32) {
+		for _, op := range ops {
+			sigs[op] = sig
+		}
+	}
+	span := func(from, to uint32) []uint32 {
+		ops := make([]uint32, 0, to-from+1)
+		for op := from; op <= to; op++ {
+			ops = append(ops, op)
+		}
+		return ops
+	}
+	v := []operand{opV128}
+	unary := opSig{in: v, out: v}
+	binary := opSig{in: []operand{opV128, opV128}, out: v}
+	ternary := opSig{in: []operand{opV128, opV128, opV128}, out: v}
+	test := opSig{in: v, out: []operand{opI32}}
+	shift := opSig{in: []operand{opV128, opI32}, out: v}
+
+	add(binary, 0x0E) // i8x16.swizzle
+	add(opSig{in: []operand{opI32}, out: v}, 0x0F, 0x10, 0x11)
+	add(opSig{in: []operand{opI64}, out: v}, 0x12)
+	add(opSig{in: []operand{opF32}, out: v}, 0x13)
+	add(opSig{in: []operand{opF64}, out: v}, 0x14)
+	add(binary, span(0x23, 0x4C)...) // comparisons
+	add(unary, 0x4D)                 // v128.not
+	add(binary, span(0x4E, 0x51)...)
+	add(ternary, 0x52) // v128.bitselect
+	add(test, 0x53)    // v128.any_true
+	add(unary, 0x5E, 0x5F)
+
+	// Lane-wise arithmetic, grouped by the shape it operates on
+	add(unary, 0x60, 0x61, 0x62, 0x67, 0x68, 0x69, 0x6A, 0x74, 0x75, 0x7A)
+	add(binary, 0x65, 0x66, 0x6E, 0x6F, 0x70, 0x71, 0x72, 0x73, 0x76, 0x77, 0x78, 0x79, 0x7B)
+	add(unary, span(0x7C, 0x7F)...)
+	add(unary, 0x80, 0x81, 0x87, 0x88, 0x89, 0x8A, 0x94)
+	add(binary, 0x82, 0x85, 0x86)
+	add(binary, span(0x8E, 0x93)...)
+	add(binary, span(0x95, 0x99)...)
+	add(binary, span(0x9B, 0x9F)...)
+	add(unary, 0xA0, 0xA1, 0xA7, 0xA8, 0xA9, 0xAA)
+	add(binary, 0xAE, 0xB1, 0xB5, 0xB6, 0xB7, 0xB8, 0xB9, 0xBA, 0xBC, 0xBD, 0xBE, 0xBF)
+	add(unary, 0xC0, 0xC1, 0xC7, 0xC8, 0xC9, 0xCA)
+	add(binary, 0xCE, 0xD1, 0xD5)
+	add(binary, span(0xD6, 0xDF)...)
+	add(unary, 0xE0, 0xE1, 0xE3)
+	add(binary, span(0xE4, 0xEB)...)
+	add(unary, 0xEC, 0xED, 0xEF)
+	add(binary, span(0xF0, 0xF7)...)
+	add(unary, span(0xF8, 0xFF)...) // conversions
+	add(test, 0x63, 0x64, 0x83, 0x84, 0xA3, 0xA4, 0xC3, 0xC4)
+	add(shift, 0x6B, 0x6C, 0x6D, 0x8B, 0x8C, 0x8D, 0xAB, 0xAC, 0xAD, 0xCB, 0xCC, 0xCD)
+
+	// Relaxed SIMD
+	add(binary, 0x100)
+	add(unary, span(0x101, 0x104)...)
+	add(ternary, span(0x105, 0x10C)...)
+	add(binary, span(0x10D, 0x112)...)
+	add(ternary, 0x113)
+	return sigs
+}()
+
+func (v *codeValidator) simdOp(imm SIMDImm) error {
+	op := imm.SubOpcode
+	if sig, ok := simdOps[op]; ok {
+		return v.apply(sig)
+	}
+	if align, ok := simdLoads[op]; ok {
+		addr, err := v.memArg(*imm.MemArg, align)
+		if err != nil {
+			return err
+		}
+		return v.apply(opSig{in: []operand{addr}, out: []operand{opV128}})
+	}
+	if l, ok := simdLanes[op]; ok {
+		if *imm.LaneIdx >= l.lanes {
+			return v.fail("lane index %d out of range for %d lanes", *imm.LaneIdx, l.lanes)
+		}
+		// Replace instructions are the last of each shape's group
+		if op == 0x17 || op == 0x1A || (op > 0x1A && op%2 == 0) {
+			return v.apply(opSig{in: []operand{opV128, l.t}, out: []operand{opV128}})
+		}
+		return v.apply(opSig{in: []operand{opV128}, out: []operand{l.t}})
+	}
+	if l, ok := simdMemLanes[op]; ok {
+		if *imm.LaneIdx >= l.lanes {
+			return v.fail("lane index %d out of range for %d lanes", *imm.LaneIdx, l.lanes)
+		}
+		addr, err := v.memArg(*imm.MemArg, l.align)
+		if err != nil {
+			return err
+		}
+		if op >= SimdV128Store8Lane {
+			return v.pop(addr, opV128)
+		}
+		return v.apply(opSig{in: []operand{addr, opV128}, out: []operand{opV128}})
+	}
+
+	switch op {
+	case SimdV128Store:
+		addr, err := v.memArg(*imm.MemArg, 4)
+		if err != nil {
+			return err
+		}
+		return v.pop(addr, opV128)
+	case SimdV128Const:
+		v.push(opV128)
+	case SimdI8x16Shuffle:
+		for _, lane := range imm.V128Bytes {
+			if lane >= 32 {
+				return v.fail("shuffle lane index %d out of range", lane)
+			}
+		}
+		return v.apply(opSig{in: []operand{opV128, opV128}, out: []operand{opV128}})
+	default:
+		return v.fail("unknown 0xfd opcode %d", op)
+	}
+	return nil
+}
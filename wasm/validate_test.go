@@ -422,3 +422,117 @@ func TestValidate_InvalidElementFuncIndex(t *testing.T) {
 		t.Errorf("unexpected error: %v", err)
 	}
 }
+
+func TestValidate_ConstExprs(t *testing.T) {
+	i32Const := []byte{wasm.OpI32Const, 0x00, wasm.OpEnd}
+	i64Const := []byte{wasm.OpI64Const, 0x00, wasm.OpEnd}
+	f32Const := []byte{wasm.OpF32Const, 0, 0, 0, 0, wasm.OpEnd}
+	globalGet0 := []byte{wasm.OpGlobalGet, 0x00, wasm.OpEnd}
+	importGlobal := func(mutable bool) wasm.Import {
+		return wasm.Import{Module: "env", Name: "g", Desc: wasm.ImportDesc{
+			Kind:   wasm.KindGlobal,
+			Global: &wasm.GlobalType{ValType: wasm.ValI32, Mutable: mutable},
+		}}
+	}
+	i32Global := func(init []byte) wasm.Global {
+		return wasm.Global{Type: wasm.GlobalType{ValType: wasm.ValI32}, Init: init}
+	}
+
+	tests := []struct {
+		name string
+		m    *wasm.Module
+		err  string // empty for a valid module
+	}{
+		{
+			name: "imported global",
+			m:    &wasm.Module{Imports: []wasm.Import{importGlobal(false)}, Globals: []wasm.Global{i32Global(globalGet0)}},
+		},
+		{
+			name: "extended const",
+			m: &wasm.Module{Globals: []wasm.Global{i32Global([]byte{
+				wasm.OpI32Const, 0x01, wasm.OpI32Const, 0x02, wasm.OpI32Add, wasm.OpEnd,
+			})}},
+		},
+		{
+			name: "non-constant instruction",
+			m:    &wasm.Module{Globals: []wasm.Global{i32Global([]byte{wasm.OpI32Const, 0x00, wasm.OpI32Ctz, wasm.OpEnd})}},
+			err:  "global 0: constant expression required",
+		},
+		{
+			name: "wrong type",
+			m:    &wasm.Module{Globals: []wasm.Global{i32Global(f32Const)}},
+			err:  "global 0: type mismatch",
+		},
+		{
+			name: "empty",
+			m:    &wasm.Module{Globals: []wasm.Global{i32Global([]byte{wasm.OpEnd})}},
+			err:  "global 0: not enough operands",
+		},
+		{
+			name: "defined global",
+			m:    &wasm.Module{Globals: []wasm.Global{i32Global(i32Const), i32Global(globalGet0)}},
+			err:  "global 1: unknown global 0",
+		},
+		{
+			name: "mutable global",
+			m:    &wasm.Module{Imports: []wasm.Import{importGlobal(true)}, Globals: []wasm.Global{i32Global(globalGet0)}},
+			err:  "global 1: constant expression required",
+		},
+		{
+			name: "data offset type",
+			m: &wasm.Module{
+				Memories: []wasm.MemoryType{{Limits: wasm.Limits{Min: 1}}},
+				Data:     []wasm.DataSegment{{Offset: i64Const}},
+			},
+			err: "data segment 0: type mismatch",
+		},
+		{
+			name: "element segment table type",
+			m: &wasm.Module{
+				Tables:   []wasm.TableType{{ElemType: byte(wasm.ValExtern), Limits: wasm.Limits{Min: 1}}},
+				Elements: []wasm.Element{{Offset: i32Const}},
+			},
+			err: "element segment 0: type mismatch",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.m.Validate()
+			if tt.err == "" {
+				if err != nil {
+					t.Errorf("valid module failed validation: %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.err) {
+				t.Errorf("Validate() = %v, want %q", err, tt.err)
+			}
+		})
+	}
+}
+
+func TestValidate_MultipleMemories(t *testing.T) {
+	m := &wasm.Module{
+		Imports: []wasm.Import{{Module: "env", Name: "mem", Desc: wasm.ImportDesc{
+			Kind:   wasm.KindMemory,
+			Memory: &wasm.MemoryType{Limits: wasm.Limits{Min: 1}},
+		}}},
+		Memories: []wasm.MemoryType{{Limits: wasm.Limits{Min: 1}}},
+	}
+
+	err := m.Validate()
+	if err == nil || !strings.Contains(err.Error(), "multiple memories") {
+		t.Errorf("Validate() = %v, want multiple memories", err)
+	}
+}
+
+func TestValidate_ImportTypeIndexWithoutTypes(t *testing.T) {
+	m := &wasm.Module{
+		Imports: []wasm.Import{{Module: "env", Name: "f", Desc: wasm.ImportDesc{Kind: wasm.KindFunc, TypeIdx: 43}}},
+	}
+
+	err := m.Validate()
+	if err == nil || !strings.Contains(err.Error(), "invalid type index 43") {
+		t.Errorf("Validate() = %v, want invalid type index", err)
+	}
+}
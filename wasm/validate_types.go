@@ -0,0 +1,599 @@
+package wasm
+
+import (
+	"fmt"
+	"strings"
+)
+
+// operand is the type of a value on the validation operand stack: a number
+// or vector type, or a reference with a heap type. The zero operand is the
+// unknown type popped from the stack of unreachable code.
+type operand struct {
+	num      ValType // number or vector type, 0 for references
+	heap     int64   // heap type of references: abstract (negative) or type index
+	ref      bool
+	nullable bool
+}
+
+var (
+	unknown   = operand{}
+	opI32     = operand{num: ValI32}
+	opI64     = operand{num: ValI64}
+	opF32     = operand{num: ValF32}
+	opF64     = operand{num: ValF64}
+	opV128    = operand{num: ValV128}
+	opFuncRef = refOperand(true, HeapTypeFunc)
+	opExnRef  = refOperand(false, HeapTypeExn)
+	opEqRef   = refOperand(true, HeapTypeEq)
+)
+
+// Shorthand reference types not covered by the ValType constants.
+const (
+	valExnRef     ValType = 0x69
+	valNullExnRef ValType = 0x74
+)
+
+func refOperand(nullable bool, heap int64) operand {
+	return operand{ref: true, nullable: nullable, heap: heap}
+}
+
+// valOperand converts a value type. Shorthand reference types such as
+// funcref are nullable references to their abstract heap type.
+func valOperand(t ValType) (operand, bool) {
+	switch t {
+	case ValI32, ValI64, ValF32, ValF64, ValV128:
+		return operand{num: t}, true
+	case ValFuncRef, ValExtern, ValAnyRef, ValEqRef, ValI31Ref, ValStructRef, ValArrayRef,
+		ValNullRef, ValNullExternRef, ValNullFuncRef, valExnRef, valNullExnRef:
+		// Abstract heap types are the negative s33 values of their type bytes
+		return refOperand(true, int64(t)-0x80), true
+	}
+	return unknown, false
+}
+
+func extOperand(t ExtValType) (operand, bool) {
+	if t.Kind == ExtValKindRef {
+		return refOperand(t.RefType.Nullable, t.RefType.HeapType), true
+	}
+	return valOperand(t.ValType)
+}
+
+func (o operand) isNum() bool { return !o.ref && o.num != 0 && o.num != ValV128 }
+
+func (o operand) String() string {
+	switch {
+	case !o.ref && o.num == 0:
+		return "unknown"
+	case !o.ref:
+		return o.num.String()
+	}
+	name := heapName(o.heap)
+	if o.nullable && o.heap < 0 {
+		switch o.heap {
+		case HeapTypeExtern:
+			return "externref"
+		case HeapTypeNone:
+			return "nullref"
+		case HeapTypeNoExtern:
+			return "nullexternref"
+		case HeapTypeNoFunc:
+			return "nullfuncref"
+		case HeapTypeNoExn:
+			return "nullexnref"
+		}
+		return name + "ref"
+	}
+	if o.nullable {
+		return "(ref null " + name + ")"
+	}
+	return "(ref " + name + ")"
+}
+
+func heapName(h int64) string {
+	switch h {
+	case HeapTypeFunc:
+		return "func"
+	case HeapTypeExtern:
+		return "extern"
+	case HeapTypeAny:
+		return "any"
+	case HeapTypeEq:
+		return "eq"
+	case HeapTypeI31:
+		return "i31"
+	case HeapTypeStruct:
+		return "struct"
+	case HeapTypeArray:
+		return "array"
+	case HeapTypeExn:
+		return "exn"
+	case HeapTypeNone:
+		return "none"
+	case HeapTypeNoExtern:
+		return "noextern"
+	case HeapTypeNoFunc:
+		return "nofunc"
+	case HeapTypeNoExn:
+		return "noexn"
+	}
+	if h >= 0 {
+		return fmt.Sprint(h)
+	}
+	return fmt.Sprintf("heap type %d", h)
+}
+
+func operandList(ops []operand) string {
+	parts := make([]string, len(ops))
+	for i, o := range ops {
+		parts[i] = o.String()
+	}
+	return "[" + strings.Join(parts, " ") + "]"
+}
+
+// defType is a type of the flat type index space.
+type defType struct {
+	comp    CompType
+	parents []uint32
+}
+
+// moduleTypes indexes the definitions validation looks up.
+type moduleTypes struct {
+	m        *Module
+	types    []defType
+	funcs    []uint32 // type index of each function, imports first
+	tables   []TableType
+	memories []MemoryType
+	globals  []GlobalType
+	tags     []uint32 // type index of each tag, imports first
+	refs     map[uint32]bool
+}
+
+func newModuleTypes(m *Module) *moduleTypes {
+	mt := &moduleTypes{m: m}
+	if len(m.TypeDefs) > 0 {
+		for i := range m.TypeDefs {
+			td := &m.TypeDefs[i]
+			switch td.Kind {
+			case TypeDefKindFunc:
+				mt.types = append(mt.types, defType{comp: CompType{Kind: CompKindFunc, Func: td.Func}})
+			case TypeDefKindSub:
+				mt.types = append(mt.types, defType{comp: td.Sub.CompType, parents: td.Sub.Parents})
+			case TypeDefKindRec:
+				for _, st := range td.Rec.Types {
+					mt.types = append(mt.types, defType{comp: st.CompType, parents: st.Parents})
+				}
+			}
+		}
+	} else {
+		for i := range m.Types {
+			mt.types = append(mt.types, defType{comp: CompType{Kind: CompKindFunc, Func: &m.Types[i]}})
+		}
+	}
+
+	for _, imp := range m.Imports {
+		switch imp.Desc.Kind {
+		case KindFunc:
+			mt.funcs = append(mt.funcs, imp.Desc.TypeIdx)
+		case KindTable:
+			if imp.Desc.Table != nil {
+				mt.tables = append(mt.tables, *imp.Desc.Table)
+			}
+		case KindMemory:
+			if imp.Desc.Memory != nil {
+				mt.memories = append(mt.memories, *imp.Desc.Memory)
+			}
+		case KindGlobal:
+			if imp.Desc.Global != nil {
+				mt.globals = append(mt.globals, *imp.Desc.Global)
+			}
+		case KindTag:
+			if imp.Desc.Tag != nil {
+				mt.tags = append(mt.tags, imp.Desc.Tag.TypeIdx)
+			}
+		}
+	}
+	mt.funcs = append(mt.funcs, m.Funcs...)
+	mt.tables = append(mt.tables, m.Tables...)
+	mt.memories = append(mt.memories, m.Memories...)
+	for _, g := range m.Globals {
+		mt.globals = append(mt.globals, g.Type)
+	}
+	for _, tag := range m.Tags {
+		mt.tags = append(mt.tags, tag.TypeIdx)
+	}
+	mt.refs = declaredRefs(m)
+	return mt
+}
+
+// declaredRefs returns the functions ref.func may reference in function
+// bodies: those in element segments, exports and global initializers.
+func declaredRefs(m *Module) map[uint32]bool {
+	refs := make(map[uint32]bool)
+	addExpr := func(expr []byte) {
+		instrs, err := DecodeInstructions(expr)
+		if err != nil {
+			return
+		}
+		for _, instr := range instrs {
+			if imm, ok := instr.Imm.(RefFuncImm); ok {
+				refs[imm.FuncIdx] = true
+			}
+		}
+	}
+	for _, elem := range m.Elements {
+		for _, idx := range elem.FuncIdxs {
+			refs[idx] = true
+		}
+		for _, expr := range elem.Exprs {
+			addExpr(expr)
+		}
+	}
+	for _, exp := range m.Exports {
+		if exp.Kind == KindFunc {
+			refs[exp.Idx] = true
+		}
+	}
+	for _, g := range m.Globals {
+		addExpr(g.Init)
+	}
+	return refs
+}
+
+func (mt *moduleTypes) funcType(typeIdx uint32) (*FuncType, error) {
+	if int(typeIdx) >= len(mt.types) {
+		return nil, fmt.Errorf("unknown type %d", typeIdx)
+	}
+	ct := &mt.types[typeIdx].comp
+	if ct.Kind != CompKindFunc || ct.Func == nil {
+		return nil, fmt.Errorf("type %d is not a function type", typeIdx)
+	}
+	return ct.Func, nil
+}
+
+func (mt *moduleTypes) structType(typeIdx uint32) (*StructType, error) {
+	if int(typeIdx) >= len(mt.types) {
+		return nil, fmt.Errorf("unknown type %d", typeIdx)
+	}
+	ct := &mt.types[typeIdx].comp
+	if ct.Kind != CompKindStruct || ct.Struct == nil {
+		return nil, fmt.Errorf("type %d is not a struct type", typeIdx)
+	}
+	return ct.Struct, nil
+}
+
+func (mt *moduleTypes) arrayType(typeIdx uint32) (*ArrayType, error) {
+	if int(typeIdx) >= len(mt.types) {
+		return nil, fmt.Errorf("unknown type %d", typeIdx)
+	}
+	ct := &mt.types[typeIdx].comp
+	if ct.Kind != CompKindArray || ct.Array == nil {
+		return nil, fmt.Errorf("type %d is not an array type", typeIdx)
+	}
+	return ct.Array, nil
+}
+
+// signature returns the parameter and result operands of a function type.
+func signature(ft *FuncType) (params, results []operand, err error) {
+	params, err = valueOperands(ft.Params, ft.ExtParams)
+	if err != nil {
+		return nil, nil, err
+	}
+	results, err = valueOperands(ft.Results, ft.ExtResults)
+	return params, results, err
+}
+
+func valueOperands(simple []ValType, ext []ExtValType) ([]operand, error) {
+	out := make([]operand, 0, max(len(simple), len(ext)))
+	if len(ext) > 0 {
+		for _, t := range ext {
+			o, ok := extOperand(t)
+			if !ok {
+				return nil, fmt.Errorf("invalid value type 0x%02x", byte(t.ValType))
+			}
+			out = append(out, o)
+		}
+		return out, nil
+	}
+	for _, t := range simple {
+		o, ok := valOperand(t)
+		if !ok {
+			return nil, fmt.Errorf("invalid value type 0x%02x", byte(t))
+		}
+		out = append(out, o)
+	}
+	return out, nil
+}
+
+func (mt *moduleTypes) funcSignature(funcIdx uint32) ([]operand, []operand, error) {
+	if int(funcIdx) >= len(mt.funcs) {
+		return nil, nil, fmt.Errorf("unknown function %d", funcIdx)
+	}
+	ft, err := mt.funcType(mt.funcs[funcIdx])
+	if err != nil {
+		return nil, nil, err
+	}
+	return signature(ft)
+}
+
+func (mt *moduleTypes) tagParams(tagIdx uint32) ([]operand, error) {
+	if int(tagIdx) >= len(mt.tags) {
+		return nil, fmt.Errorf("unknown tag %d", tagIdx)
+	}
+	ft, err := mt.funcType(mt.tags[tagIdx])
+	if err != nil {
+		return nil, err
+	}
+	params, results, err := signature(ft)
+	if err != nil {
+		return nil, err
+	}
+	if len(results) != 0 {
+		return nil, fmt.Errorf("tag %d type has results", tagIdx)
+	}
+	return params, nil
+}
+
+func (mt *moduleTypes) table(idx uint32) (elem, addr operand, err error) {
+	if int(idx) >= len(mt.tables) {
+		return unknown, unknown, fmt.Errorf("unknown table %d", idx)
+	}
+	t := &mt.tables[idx]
+	addr = opI32
+	if t.Limits.Memory64 {
+		addr = opI64
+	}
+	if t.RefElemType != nil {
+		return refOperand(t.RefElemType.Nullable, t.RefElemType.HeapType), addr, nil
+	}
+	elem, ok := valOperand(ValType(t.ElemType))
+	if !ok || !elem.ref {
+		return unknown, unknown, fmt.Errorf("table %d has invalid element type 0x%02x", idx, t.ElemType)
+	}
+	return elem, addr, nil
+}
+
+func (mt *moduleTypes) memory(idx uint32) (addr operand, mem *MemoryType, err error) {
+	if int(idx) >= len(mt.memories) {
+		return unknown, nil, fmt.Errorf("unknown memory %d", idx)
+	}
+	mem = &mt.memories[idx]
+	if mem.Limits.Memory64 {
+		return opI64, mem, nil
+	}
+	return opI32, mem, nil
+}
+
+func (mt *moduleTypes) global(idx uint32) (operand, bool, error) {
+	if int(idx) >= len(mt.globals) {
+		return unknown, false, fmt.Errorf("unknown global %d", idx)
+	}
+	g := &mt.globals[idx]
+	var (
+		o  operand
+		ok bool
+	)
+	if g.ExtType != nil {
+		o, ok = extOperand(*g.ExtType)
+	} else {
+		o, ok = valOperand(g.ValType)
+	}
+	if !ok {
+		return unknown, false, fmt.Errorf("global %d has invalid type", idx)
+	}
+	return o, g.Mutable, nil
+}
+
+func (mt *moduleTypes) elemType(idx uint32) (operand, error) {
+	if int(idx) >= len(mt.m.Elements) {
+		return unknown, fmt.Errorf("unknown element segment %d", idx)
+	}
+	e := &mt.m.Elements[idx]
+	if e.Flags&0x04 == 0 || e.Flags&0x03 == 0 {
+		return opFuncRef, nil
+	}
+	if e.RefType != nil {
+		return refOperand(e.RefType.Nullable, e.RefType.HeapType), nil
+	}
+	o, ok := valOperand(e.Type)
+	if !ok || !o.ref {
+		return unknown, fmt.Errorf("element segment %d has invalid type", idx)
+	}
+	return o, nil
+}
+
+func (mt *moduleTypes) checkData(idx uint32) error {
+	if mt.m.DataCount == nil {
+		return fmt.Errorf("data count section required")
+	}
+	if idx >= *mt.m.DataCount {
+		return fmt.Errorf("unknown data segment %d", idx)
+	}
+	return nil
+}
+
+// storageOperand returns the operand type of a field, unpacking i8 and
+// i16 to i32.
+func storageOperand(st StorageType) (operand, bool) {
+	switch st.Kind {
+	case StorageKindPacked:
+		return opI32, true
+	case StorageKindRef:
+		return refOperand(st.RefType.Nullable, st.RefType.HeapType), true
+	}
+	return valOperand(st.ValType)
+}
+
+func (o operand) defaultable() bool {
+	return !o.ref || o.nullable
+}
+
+// matches reports whether a is a subtype of b.
+func (mt *moduleTypes) matches(a, b operand) bool {
+	if a == unknown || b == unknown {
+		return true
+	}
+	if a.ref != b.ref {
+		return false
+	}
+	if !a.ref {
+		return a.num == b.num
+	}
+	if a.nullable && !b.nullable {
+		return false
+	}
+	return mt.heapMatches(a.heap, b.heap)
+}
+
+func (mt *moduleTypes) matchesAll(a, b []operand) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !mt.matches(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// heapMatches reports whether heap type a is a subtype of b.
+func (mt *moduleTypes) heapMatches(a, b int64) bool {
+	if a == b {
+		return true
+	}
+	if a >= 0 && b >= 0 {
+		return mt.typeMatches(uint32(a), uint32(b))
+	}
+	if a >= 0 {
+		switch mt.kind(a) {
+		case CompKindFunc:
+			return b == HeapTypeFunc
+		case CompKindStruct:
+			return b == HeapTypeStruct || b == HeapTypeEq || b == HeapTypeAny
+		case CompKindArray:
+			return b == HeapTypeArray || b == HeapTypeEq || b == HeapTypeAny
+		}
+		return false
+	}
+	if b >= 0 {
+		switch a {
+		case HeapTypeNone:
+			k := mt.kind(b)
+			return k == CompKindStruct || k == CompKindArray
+		case HeapTypeNoFunc:
+			return mt.kind(b) == CompKindFunc
+		}
+		return false
+	}
+	switch a {
+	case HeapTypeI31, HeapTypeStruct, HeapTypeArray:
+		return b == HeapTypeEq || b == HeapTypeAny
+	case HeapTypeEq:
+		return b == HeapTypeAny
+	case HeapTypeNone:
+		return b == HeapTypeAny || b == HeapTypeEq || b == HeapTypeI31 ||
+			b == HeapTypeStruct || b == HeapTypeArray
+	case HeapTypeNoFunc:
+		return b == HeapTypeFunc
+	case HeapTypeNoExtern:
+		return b == HeapTypeExtern
+	case HeapTypeNoExn:
+		return b == HeapTypeExn
+	}
+	return false
+}
+
+func (mt *moduleTypes) kind(typeIdx int64) byte {
+	if typeIdx < 0 || int(typeIdx) >= len(mt.types) {
+		return 0
+	}
+	return mt.types[typeIdx].comp.Kind
+}
+
+// typeMatches reports whether type a is b, equivalent to b, or declares b
+// as a supertype.
+func (mt *moduleTypes) typeMatches(a, b uint32) bool {
+	seen := make(map[uint32]bool)
+	for !seen[a] {
+		if mt.typeEqual(a, b) {
+			return true
+		}
+		seen[a] = true
+		if int(a) >= len(mt.types) || len(mt.types[a].parents) == 0 {
+			return false
+		}
+		a = mt.types[a].parents[0]
+	}
+	return false
+}
+
+// typeEqual compares two types structurally. Type indices within them are
+// compared by index, which equates the duplicate function types of MVP
+// modules without resolving recursive groups.
+func (mt *moduleTypes) typeEqual(a, b uint32) bool {
+	if a == b {
+		return true
+	}
+	if int(a) >= len(mt.types) || int(b) >= len(mt.types) {
+		return false
+	}
+	ta, tb := &mt.types[a], &mt.types[b]
+	if ta.comp.Kind != tb.comp.Kind || len(ta.parents) != len(tb.parents) {
+		return false
+	}
+	switch ta.comp.Kind {
+	case CompKindFunc:
+		pa, ra, errA := signature(ta.comp.Func)
+		pb, rb, errB := signature(tb.comp.Func)
+		return errA == nil && errB == nil && operandsEqual(pa, pb) && operandsEqual(ra, rb)
+	case CompKindStruct:
+		fa, fb := ta.comp.Struct.Fields, tb.comp.Struct.Fields
+		if len(fa) != len(fb) {
+			return false
+		}
+		for i := range fa {
+			if fa[i] != fb[i] {
+				return false
+			}
+		}
+		return true
+	case CompKindArray:
+		return ta.comp.Array.Element == tb.comp.Array.Element
+	}
+	return false
+}
+
+func operandsEqual(a, b []operand) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// topHeap returns the top of the hierarchy heap type h belongs to.
+func (mt *moduleTypes) topHeap(h int64) int64 {
+	switch h {
+	case HeapTypeFunc, HeapTypeNoFunc:
+		return HeapTypeFunc
+	case HeapTypeExtern, HeapTypeNoExtern:
+		return HeapTypeExtern
+	case HeapTypeExn, HeapTypeNoExn:
+		return HeapTypeExn
+	}
+	if h >= 0 && mt.kind(h) == CompKindFunc {
+		return HeapTypeFunc
+	}
+	return HeapTypeAny
+}
+
+func (mt *moduleTypes) validHeap(h int64) bool {
+	if h >= 0 {
+		return int(h) < len(mt.types)
+	}
+	return h >= HeapTypeExn && h <= HeapTypeNoExn
+}
@@ -0,0 +1,192 @@
+package wat
+
+import (
+	"testing"
+
+	"github.com/wippyai/wasm-runtime/component"
+)
+
+var componentFixtures = map[string]string{
+	"lift": `(component $c
+		(core module $m
+			(memory (export "mem") 1)
+			(global $top (mut i32) (i32.const 1024))
+			(func (export "realloc") (param i32 i32 i32 i32) (result i32)
+				(global.get $top)
+				(global.set $top (i32.add (global.get $top) (local.get 3))))
+			(func (export "add") (param i32 i32) (result i32)
+				(i32.add (local.get 0) (local.get 1)))
+			(func (export "len") (param i32 i32) (result i32)
+				(local.get 1)))
+		(core instance $i (instantiate $m))
+		(alias core export $i "mem" (core memory $mem))
+		(core func $realloc (alias core export $i "realloc"))
+		(type $point (record (field "x" s32) (field "y" s32)))
+		(type $shape (variant (case "dot") (case "circle" u32)))
+		(type (export "color") (enum "red" "green" "blue"))
+		(type $add (func (param "a" u32) (param "b" u32) (result u32)))
+		(func $add (type $add) (canon lift (core func $i "add")))
+		(func (export "len") (param "s" string) (result u32)
+			(canon lift (core func $i "len") (memory $mem) (realloc $realloc) string-encoding=utf8))
+		(canon lift (core func $i "len") (memory $mem) (realloc (func $realloc))
+			(func $count (param "items" (list (tuple u8 (option string)))) (result u32)))
+		(func $flags (param "f" (flags "a" "b")) (param "r" (result u32 (error string)))
+			(canon lift (core func $i "add") (memory $mem)))
+		(export "add" (func $add))
+		(export "count" (func $count))
+		(export $point2 "point" (type $point))
+		(export "shape" (type $shape))
+		(export "module" (core module $m)))`,
+
+	"lower": `(component
+		(import "host" (instance $host
+			(export "log" (func (param "msg" string)))
+			(export "now" (func (result u64)))))
+		(import "tick" (func $tick))
+		(alias export $host "log" (func $log))
+		(core module $libc
+			(memory (export "memory") 1)
+			(func (export "realloc") (param i32 i32 i32 i32) (result i32) (i32.const 0)))
+		(core instance $libc (instantiate $libc))
+		(core func $log (canon lower (func $log) (memory $libc "memory") (realloc (func $libc "realloc"))))
+		(canon lower (func $host "now") (core func $now))
+		(core func $tick (canon lower (func $tick)))
+		(core module $main
+			(import "host" "log" (func (param i32 i32)))
+			(import "host" "now" (func (result i64)))
+			(import "host" "tick" (func))
+			(func (export "run") (call 2)))
+		(core instance $main (instantiate $main
+			(with "host" (instance
+				(export "log" (func $log))
+				(export "now" (func $now))
+				(export "tick" (func $tick))))))
+		(func (export "run") (canon lift (core func $main "run"))))`,
+
+	"resources": `(component
+		(core module $impl
+			(global $n (mut i32) (i32.const 0))
+			(func (export "dtor") (param i32))
+			(func (export "get") (param i32) (result i32) (local.get 0))
+			(func (export "next") (result i32)
+				(global.set $n (i32.add (global.get $n) (i32.const 1)))
+				(global.get $n)))
+		(core instance $impl (instantiate $impl))
+		(type $counter (resource (rep i32) (dtor (func $impl "dtor"))))
+		(core func $new (canon resource.new $counter))
+		(core func $rep (canon resource.rep $counter))
+		(canon resource.drop $counter (core func $drop))
+		(core module $glue
+			(import "" "new" (func $new (param i32) (result i32)))
+			(func (export "ctor") (param i32) (result i32) (call $new (local.get 0))))
+		(core instance $glue (instantiate $glue (with "" (instance (export "new" (func $new))))))
+		(export $c "counter" (type $counter))
+		(func (export "[constructor]counter") (param "n" u32) (result (own $c))
+			(canon lift (core func $glue "ctor")))
+		(func (export "[method]counter.get") (param "self" (borrow $c)) (result u32)
+			(canon lift (core func $impl "get"))))`,
+
+	"nested": `(component $outer
+		(type $greeting (func (param "name" string) (result string)))
+		(import "greet" (func $greet (type $greeting)))
+		(component $inner
+			(alias outer $outer $greeting (type $g))
+			(import "greet" (func $f (type $g)))
+			(export "greet" (func $f)))
+		(instance $a (instantiate $inner (with "greet" (func $greet))))
+		(instance $b (export "greet" (func $greet)))
+		(alias export $a "greet" (func $again))
+		(core module $m)
+		(export "inner" (component $inner))
+		(export "again" (func $again))
+		(export "b" (instance $b)))`,
+}
+
+func TestCompileComponent(t *testing.T) {
+	for name, src := range componentFixtures {
+		t.Run(name, func(t *testing.T) {
+			bin, err := Compile(src)
+			if err != nil {
+				t.Fatalf("Compile failed: %v", err)
+			}
+			if !component.IsComponent(bin) {
+				t.Fatalf("not a component binary: % x", bin[:8])
+			}
+			if _, err := component.DecodeAndValidate(bin); err != nil {
+				t.Fatalf("DecodeAndValidate: %v", err)
+			}
+		})
+	}
+}
+
+func TestCompileComponentIndices(t *testing.T) {
+	bin, err := Compile(componentFixtures["lift"])
+	if err != nil {
+		t.Fatalf("Compile failed: %v", err)
+	}
+	v, err := component.DecodeAndValidate(bin)
+	if err != nil {
+		t.Fatalf("DecodeAndValidate: %v", err)
+	}
+	c := v.Raw
+
+	var exports []string
+	for _, e := range c.Exports {
+		exports = append(exports, e.Name)
+	}
+	want := []string{"color", "len", "add", "count", "point", "shape", "module"}
+	if len(exports) != len(want) {
+		t.Fatalf("exports = %q, want %q", exports, want)
+	}
+	for i := range want {
+		if exports[i] != want[i] {
+			t.Fatalf("exports = %q, want %q", exports, want)
+		}
+	}
+
+	// Inline types such as (list (tuple u8 (option string))) are hoisted
+	// into definitions of their own, and exports add indices
+	if n := v.TypeCount(); n != 15 {
+		t.Errorf("got %d types, want 15", n)
+	}
+	if n := v.FuncCount(); n != 7 {
+		t.Errorf("got %d funcs, want 7", n)
+	}
+
+	var lifts int
+	for _, canon := range c.Canons {
+		if canon.Parsed.Kind != component.CanonLift {
+			continue
+		}
+		lifts++
+		if lifts == 2 {
+			if len(canon.Parsed.Options) != 3 || canon.Parsed.GetStringEncoding() != 0 ||
+				canon.Parsed.GetReallocIndex() != 0 {
+				t.Errorf("unexpected options on lift of len: %+v", canon.Parsed.Options)
+			}
+		}
+	}
+	if lifts != 4 {
+		t.Errorf("got %d lifts, want 4", lifts)
+	}
+}
+
+func TestCompileComponentErrors(t *testing.T) {
+	tests := map[string]string{
+		"unknown func":   `(component (export "f" (func $missing)))`,
+		"lower in func":  `(component (func (canon lower (func 0))))`,
+		"lift wrong ref": `(component (core module) (func (canon lift (func 0))))`,
+		"bad encoding":   `(component (core module) (func (canon lift (core func 0) string-encoding=ascii)))`,
+		"unknown type":   `(component (type (list widget)))`,
+		"named result":   `(component (type (func (result "r" u32))))`,
+		"bad core":       `(component (core module (func (foo))))`,
+		"unknown outer":  `(component (component (alias outer $nope 0 (type))))`,
+	}
+	for name, src := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := Compile(src); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
@@ -1,7 +1,9 @@
-// Package wat provides WebAssembly Text format parsing.
+// Package wat provides WebAssembly Text format parsing and printing.
 //
 // This package compiles WAT (WebAssembly Text) format into binary WASM,
 // enabling human-readable module definitions for testing and examples.
+// Print and Disassemble go the other way, rendering a module as text that
+// Compile accepts, in flat or folded style.
 //
 // Basic usage:
 //
@@ -25,8 +27,57 @@
 //   - Saturating truncations: i32/i64.trunc_sat_f32/f64_s/u
 //   - Sign extension: i32.extend8_s, i32.extend16_s, i64.extend*_s
 //   - Select with type annotation
+//   - SIMD: v128 values, all fixed-width and relaxed SIMD instructions,
+//     v128.const in every lane shape, lane indices and lane memargs
+//   - GC: rec groups, sub/final types, struct and array types with named
+//     fields, struct.*, array.*, i31, ref.test/ref.cast, br_on_cast
+//   - Typed function references: (ref null? heaptype), call_ref,
+//     return_call_ref, ref.as_non_null, br_on_null, br_on_non_null
+//   - Exception handling: tags, throw, throw_ref, try_table with catch clauses
+//   - Threads: shared memories, atomic loads, stores and read-modify-write
+//     ops, memory.atomic.wait32/wait64/notify, atomic.fence
 //   - Data and elem sections (active, passive, declarative)
 //   - Comments: line (;;) and block (; ;)
 //
-// Not supported: SIMD (v128), threads/atomics, exception handling, GC types.
+// Every $identifier ends up in the "name" custom section: module, function,
+// local, label, type, field, table, memory, global, tag, elem and data
+// names. Traps and profiles then show the names of the source, and Print
+// uses them all.
+//
+// Components:
+//
+// Compile also accepts (component ...) text and emits a component binary,
+// so component fixtures need no external toolchain:
+//
+//	bin, err := wat.Compile(`(component
+//		(core module $m
+//			(func (export "add") (param i32 i32) (result i32)
+//				(i32.add (local.get 0) (local.get 1))))
+//		(core instance $i (instantiate $m))
+//		(func (export "add") (param "a" u32) (param "b" u32) (result u32)
+//			(canon lift (core func $i "add"))))`)
+//
+// Supported component fields:
+//   - Core modules, written as (core module ...) with the module syntax above
+//   - Core instances: instantiate with (with "name" (instance ...)) arguments,
+//     or bundles of core exports
+//   - Types: primitive, record, variant, list, tuple, flags, enum, option,
+//     result, own, borrow, func, instance, component and resource types;
+//     inline compound types are hoisted into definitions of their own
+//   - Core types: function and module types
+//   - Imports and exports of funcs, types, instances, components and core
+//     modules, with (eq idx) and (sub resource) type bounds
+//   - Aliases of instance exports, core instance exports and outer
+//     definitions, including the (func $inst "name") shorthand
+//   - canon lift and lower with memory, realloc, post-return and
+//     string-encoding options; resource.new, resource.drop, resource.rep
+//   - Component and instance definitions, nested components, and inline
+//     (export "name") on definitions
+//
+// Disassembly:
+//
+//	text, err := wat.Disassemble(wasm, wat.PrintOptions{Folded: true})
+//
+// Not supported by Compile: the legacy try/catch instructions, which Print
+// renders, and component values and start functions.
 package wat
@@ -0,0 +1,138 @@
+package wat
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/wippyai/wasm-runtime/wasm"
+)
+
+var gcModules = map[string]string{
+	"gc": `(module
+		(rec
+			(type $node (sub (struct (field $val i32) (field $next (mut (ref null $node))))))
+			(type $leaf (sub final $node (struct (field i32) (field (mut (ref null $node))) (field i8)))))
+		(type $bytes (array (mut i8)))
+		(type $pair (struct (field $a i64) (field $b f64)))
+		(global $empty (ref null $node) (ref.null $node))
+		(data $d "abcd")
+		(func $list (param $n i32) (result (ref $node))
+			(struct.new $node (local.get $n) (global.get $empty)))
+		(func (param $r (ref null $node)) (result i32)
+			(struct.set $node $next (ref.as_non_null (local.get $r)) (ref.null none))
+			(struct.get $node $val (local.get $r)))
+		(func (result i32)
+			(local $b (ref $bytes))
+			(local.set $b (array.new_data $bytes $d (i32.const 0) (i32.const 4)))
+			(array.set $bytes (local.get $b) (i32.const 1) (i32.const 0x7a))
+			(drop (array.new_fixed $bytes 2 (i32.const 1) (i32.const 2)))
+			(drop (struct.new_default $pair))
+			(i32.add
+				(array.len (local.get $b))
+				(array.get_u $bytes (local.get $b) (i32.const 1))))
+		(func (param anyref) (result i32)
+			(block $l (result (ref $leaf))
+				(br_on_cast $l anyref (ref $leaf) (local.get 0))
+				(drop)
+				(return (ref.test (ref null $node) (ref.cast nullref (ref.null any)))))
+			(struct.get_s $leaf 2))
+		(func (result i32)
+			(i31.get_u (ref.i31 (i32.const -1))))
+		(func (param externref) (result externref)
+			(extern.convert_any (any.convert_extern (local.get 0)))))`,
+	"exceptions": `(module
+		(import "env" "t" (tag $imported (param i64)))
+		(tag $e (export "e") (param i32))
+		(func $throws (param i32)
+			(throw $e (local.get 0)))
+		(func (export "catch") (param i32) (result i32)
+			(block $h (result i32)
+				(try_table (catch $e $h)
+					(call $throws (local.get 0)))
+				(i32.const 0)))
+		(func (result exnref)
+			(block $h (result exnref)
+				(try_table (result exnref) (catch_all_ref $h)
+					(throw $imported (i64.const 1)))))
+		(func (param exnref)
+			block $outer
+				try_table (catch_all $outer)
+					local.get 0
+					throw_ref
+				end
+			end))`,
+	"funcrefs": `(module
+		(type $binop (func (param i32 i32) (result i32)))
+		(table $t 1 (ref null $binop))
+		(table 2 (ref $binop) (ref.func $add))
+		(elem declare func $add)
+		(func $add (type $binop) (i32.add (local.get 0) (local.get 1)))
+		(func (param $f (ref null $binop)) (result i32)
+			(block $null
+				(return (call_ref $binop (i32.const 1) (i32.const 2)
+					(br_on_null $null (local.get $f)))))
+			(i32.const -1))
+		(func (result i32)
+			(return_call_ref $binop (i32.const 3) (i32.const 4) (ref.func $add)))
+		(func (result i32)
+			(ref.eq (ref.null eq) (ref.i31 (i32.const 0)))))`,
+}
+
+func TestCompileGC(t *testing.T) {
+	for name, src := range gcModules {
+		t.Run(name, func(t *testing.T) {
+			bin, err := Compile(src)
+			if err != nil {
+				t.Fatalf("Compile failed: %v", err)
+			}
+			if _, err := wasm.ParseModuleValidate(bin); err != nil {
+				t.Fatalf("validate: %v", err)
+			}
+		})
+	}
+}
+
+func TestGCRoundTrip(t *testing.T) {
+	for name, src := range gcModules {
+		for _, folded := range []bool{false, true} {
+			t.Run(name, func(t *testing.T) {
+				bin, err := Compile(src)
+				if err != nil {
+					t.Fatalf("Compile failed: %v", err)
+				}
+				text, err := Disassemble(bin, PrintOptions{Folded: folded})
+				if err != nil {
+					t.Fatalf("Disassemble failed: %v", err)
+				}
+				again, err := Compile(text)
+				if err != nil {
+					t.Fatalf("Compile of printed text failed: %v\n%s", err, text)
+				}
+				if !bytes.Equal(bin, again) {
+					t.Errorf("round trip changed the binary\n%s", text)
+				}
+			})
+		}
+	}
+}
+
+func TestCompileRecTypeIndices(t *testing.T) {
+	bin, err := Compile(`(module
+		(func (param i32))
+		(rec (type $a (struct (field (ref null $b)))) (type $b (struct (field (ref null $a)))))
+		(type $f (func (param (ref $a)))))`)
+	if err != nil {
+		t.Fatalf("Compile failed: %v", err)
+	}
+	m, err := wasm.ParseModuleValidate(bin)
+	if err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	// Explicit types come first; the implicit (func (param i32)) follows
+	if n := m.NumTypes(); n != 4 {
+		t.Fatalf("got %d types, want 4", n)
+	}
+	if len(m.TypeDefs) != 3 || m.TypeDefs[0].Kind != wasm.TypeDefKindRec {
+		t.Fatalf("unexpected type section layout: %+v", m.TypeDefs)
+	}
+}
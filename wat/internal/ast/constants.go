@@ -1,16 +1,85 @@
 package ast
 
-type ValType byte
+// ValType is a value type. Numeric and vector types and the abbreviated
+// reference types are their one-byte binary codes; reference types built
+// with RefType also carry a heap type above the low byte.
+type ValType uint64
 
 const (
-	ValTypeI32       ValType = 0x7F
-	ValTypeI64       ValType = 0x7E
-	ValTypeF32       ValType = 0x7D
-	ValTypeF64       ValType = 0x7C
-	ValTypeFuncref   ValType = 0x70
-	ValTypeExternref ValType = 0x6F
+	ValTypeI32           ValType = 0x7F
+	ValTypeI64           ValType = 0x7E
+	ValTypeF32           ValType = 0x7D
+	ValTypeF64           ValType = 0x7C
+	ValTypeV128          ValType = 0x7B
+	ValTypeI8            ValType = 0x78 // packed, struct and array fields only
+	ValTypeI16           ValType = 0x77 // packed, struct and array fields only
+	ValTypeNullexnref    ValType = 0x74
+	ValTypeNullfuncref   ValType = 0x73
+	ValTypeNullexternref ValType = 0x72
+	ValTypeNullref       ValType = 0x71
+	ValTypeFuncref       ValType = 0x70
+	ValTypeExternref     ValType = 0x6F
+	ValTypeAnyref        ValType = 0x6E
+	ValTypeEqref         ValType = 0x6D
+	ValTypeI31ref        ValType = 0x6C
+	ValTypeStructref     ValType = 0x6B
+	ValTypeArrayref      ValType = 0x6A
+	ValTypeExnref        ValType = 0x69
+	ValTypeRef           ValType = 0x64
+	ValTypeRefNull       ValType = 0x63
 )
 
+// Abstract heap types, as the s33 values of their one-byte codes.
+// Concrete heap types are non-negative type indices.
+const (
+	HeapTypeNoExn    int64 = -0x0C
+	HeapTypeNoFunc   int64 = -0x0D
+	HeapTypeNoExtern int64 = -0x0E
+	HeapTypeNone     int64 = -0x0F
+	HeapTypeFunc     int64 = -0x10
+	HeapTypeExtern   int64 = -0x11
+	HeapTypeAny      int64 = -0x12
+	HeapTypeEq       int64 = -0x13
+	HeapTypeI31      int64 = -0x14
+	HeapTypeStruct   int64 = -0x15
+	HeapTypeArray    int64 = -0x16
+	HeapTypeExn      int64 = -0x17
+)
+
+// heapTypeBias keeps the heap type stored in a ValType positive.
+const heapTypeBias = 0x80
+
+// RefType returns the reference type to heapType. Nullable references to
+// abstract heap types use their abbreviated one-byte form.
+func RefType(nullable bool, heapType int64) ValType {
+	if nullable && heapType < 0 {
+		return ValType(byte(heapType) & 0x7F)
+	}
+	code := ValTypeRef
+	if nullable {
+		code = ValTypeRefNull
+	}
+	return code | ValType(heapType+heapTypeBias)<<8
+}
+
+// Code returns the binary type code, which is followed by HeapType for
+// ValTypeRef and ValTypeRefNull.
+func (vt ValType) Code() byte {
+	return byte(vt)
+}
+
+// HeapType returns the heap type of a reference type written with RefType
+// or in abbreviated form.
+func (vt ValType) HeapType() (heapType int64, nullable bool, ok bool) {
+	switch code := ValType(vt.Code()); {
+	case code == ValTypeRef || code == ValTypeRefNull:
+		return int64(vt>>8) - heapTypeBias, code == ValTypeRefNull, true
+	case code >= ValTypeExnref && code <= ValTypeNullexnref:
+		return int64(int8(byte(code) | 0x80)), true, true
+	}
+	return 0, false, false
+}
+
 const BlockTypeEmpty byte = 0x40
 
 const (
@@ -18,6 +87,7 @@ const (
 	KindTable  byte = 1
 	KindMemory byte = 2
 	KindGlobal byte = 3
+	KindTag    byte = 4
 )
 
 const (
@@ -46,12 +116,19 @@ const (
 	SectionCode      byte = 10
 	SectionData      byte = 11
 	SectionDataCount byte = 12
+	SectionTag       byte = 13
 )
 
 const (
-	FuncTypeMarker byte = 0x60
-	LimitsNoMax    byte = 0x00
-	LimitsHasMax   byte = 0x01
+	FuncTypeMarker   byte = 0x60
+	StructTypeMarker byte = 0x5F
+	ArrayTypeMarker  byte = 0x5E
+	SubTypeMarker    byte = 0x50
+	SubFinalMarker   byte = 0x4F
+	RecTypeMarker    byte = 0x4E
+	LimitsNoMax      byte = 0x00
+	LimitsHasMax     byte = 0x01
+	LimitsShared     byte = 0x02
 )
 
 const (
@@ -73,6 +150,14 @@ const (
 
 const ElemKindFuncref byte = 0x00
 
+// Catch clause kinds of try_table.
+const (
+	CatchKindCatch       byte = 0x00
+	CatchKindCatchRef    byte = 0x01
+	CatchKindCatchAll    byte = 0x02
+	CatchKindCatchAllRef byte = 0x03
+)
+
 const (
 	OpUnreachable        byte = 0x00
 	OpNop                byte = 0x01
@@ -80,6 +165,8 @@ const (
 	OpLoop               byte = 0x03
 	OpIf                 byte = 0x04
 	OpElse               byte = 0x05
+	OpThrow              byte = 0x08
+	OpThrowRef           byte = 0x0A
 	OpEnd                byte = 0x0B
 	OpBr                 byte = 0x0C
 	OpBrIf               byte = 0x0D
@@ -89,9 +176,12 @@ const (
 	OpCallIndirect       byte = 0x11
 	OpReturnCall         byte = 0x12
 	OpReturnCallIndirect byte = 0x13
+	OpCallRef            byte = 0x14
+	OpReturnCallRef      byte = 0x15
 	OpDrop               byte = 0x1A
 	OpSelect             byte = 0x1B
 	OpSelectTyped        byte = 0x1C
+	OpTryTable           byte = 0x1F
 	OpLocalGet           byte = 0x20
 	OpLocalSet           byte = 0x21
 	OpLocalTee           byte = 0x22
@@ -108,7 +198,14 @@ const (
 	OpRefNull            byte = 0xD0
 	OpRefIsNull          byte = 0xD1
 	OpRefFunc            byte = 0xD2
+	OpRefAsNonNull       byte = 0xD3
+	OpRefEq              byte = 0xD4
+	OpBrOnNull           byte = 0xD5
+	OpBrOnNonNull        byte = 0xD6
+	OpPrefixGC           byte = 0xFB
 	OpPrefixMisc         byte = 0xFC
+	OpPrefixSIMD         byte = 0xFD
+	OpPrefixAtomic       byte = 0xFE
 )
 
 const (
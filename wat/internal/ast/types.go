@@ -1,17 +1,26 @@
 package ast
 
+import "github.com/wippyai/wasm-runtime/wasm"
+
 type Module struct {
-	Types    []FuncType
+	Types []FuncType
+	// TypeDefs parallels Types once the module declares struct, array or
+	// sub types or rec groups; it is nil for plain function types.
+	TypeDefs []TypeDef
 	Imports  []Import
 	Funcs    []FuncEntry
 	Tables   []Table
 	Memories []Memory
+	Tags     []Tag
 	Globals  []Global
 	Exports  []Export
 	Start    *uint32
 	Elems    []Elem
 	Code     []FuncBody
 	Data     []DataSegment
+	// Names holds the identifiers of the module, written to the name
+	// section
+	Names *wasm.Names
 }
 
 type FuncType struct {
@@ -36,6 +45,33 @@ func (ft FuncType) Equal(other FuncType) bool {
 	return true
 }
 
+// TypeDef is the GC form of a type section entry. Types holds the
+// signature of func types; struct and array types leave it empty.
+type TypeDef struct {
+	Fields  []FieldType // struct fields, or the single array element
+	Parents []uint32
+	Kind    byte // FuncTypeMarker, StructTypeMarker or ArrayTypeMarker
+	Final   bool
+	Sub     bool   // written as (sub ...)
+	RecLen  uint32 // set on the first type of a rec group to its size
+	InRec   bool
+}
+
+// Plain reports whether d is an ordinary function type: final, without
+// supertypes and outside any rec group.
+func (d TypeDef) Plain() bool {
+	return d.Kind == FuncTypeMarker && d.Final && !d.Sub && !d.InRec && len(d.Parents) == 0
+}
+
+type FieldType struct {
+	Type    ValType // a value type or ValTypeI8/ValTypeI16
+	Mutable bool
+}
+
+type Tag struct {
+	TypeIdx uint32
+}
+
 type Import struct {
 	Desc   ImportDesc
 	Module string
@@ -56,8 +92,12 @@ type FuncEntry struct {
 }
 
 type Table struct {
+	Init     []Instr // initializer expression, if any
 	Limits   Limits
 	ElemType byte
+	// ElemRefType is set instead of ElemType for reference types that
+	// need a heap type, such as (ref null $t).
+	ElemRefType ValType
 }
 
 type Memory struct {
@@ -65,8 +105,9 @@ type Memory struct {
 }
 
 type Limits struct {
-	Max *uint32
-	Min uint32
+	Max    *uint32
+	Min    uint32
+	Shared bool // shared memory, threads proposal
 }
 
 type Global struct {
@@ -117,6 +158,43 @@ type Memarg struct {
 	MemIdx uint32 // Memory index for multi-memory
 }
 
+// SIMDImm holds a 0xFD-prefixed instruction: the sub-opcode and whichever
+// of the memarg, lane index and 16 immediate bytes it takes.
+type SIMDImm struct {
+	Memarg *Memarg
+	Lane   *byte
+	Bytes  []byte // v128.const value or i8x16.shuffle lanes
+	Subop  uint32
+}
+
+// GCImm holds a 0xFB-prefixed instruction: the sub-opcode, then the
+// br_on_cast flags if any, the index immediates and the heap types of
+// casts, in binary order.
+type GCImm struct {
+	CastFlags *byte
+	Idx       []uint32
+	HeapTypes []int64
+	Subop     uint32
+}
+
+type TryTable struct {
+	Catches   []Catch
+	BlockType BlockType
+}
+
+type Catch struct {
+	TagIdx   uint32
+	LabelIdx uint32
+	Kind     byte
+}
+
+// AtomicImm holds a 0xFE-prefixed instruction. Memarg is nil only for
+// atomic.fence.
+type AtomicImm struct {
+	Memarg *Memarg
+	Subop  uint32
+}
+
 type BlockType struct {
 	Params  []ValType
 	Results []ValType
@@ -0,0 +1,237 @@
+package component
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wippyai/wasm-runtime/wat/internal/encoder"
+	"github.com/wippyai/wasm-runtime/wat/internal/token"
+)
+
+// Canonical built-in codes.
+const (
+	canonLift         = 0x00
+	canonLower        = 0x01
+	canonResourceNew  = 0x02
+	canonResourceDrop = 0x03
+	canonResourceRep  = 0x04
+)
+
+// Canonical option codes.
+const (
+	optUTF8         = 0x00
+	optUTF16        = 0x01
+	optCompactUTF16 = 0x02
+	optMemory       = 0x03
+	optRealloc      = 0x04
+	optPostReturn   = 0x05
+	optAsync        = 0x06
+	optCallback     = 0x07
+)
+
+var stringEncodings = map[string]byte{
+	"utf8":         optUTF8,
+	"utf16":        optUTF16,
+	"latin1+utf16": optCompactUTF16,
+}
+
+var resourceBuiltins = map[string]byte{
+	"resource.new":  canonResourceNew,
+	"resource.drop": canonResourceDrop,
+	"resource.rep":  canonResourceRep,
+}
+
+// liftTarget describes the component function defined by a canon lift
+// written inside a (func ...) field.
+type liftTarget struct {
+	id      string
+	exports []string
+	typeIdx uint32
+}
+
+// parseCanon parses a canon form after its keyword, through its closing
+// paren. Inside a (func ...) field lift describes the lifted function, and
+// inside a (core func ...) field coreID names the defined core function;
+// otherwise the defined function is described at the end of the form.
+func (p *Parser) parseCanon(inCore bool, coreID string, lift *liftTarget) error {
+	kw, err := p.expect(token.Ident)
+	if err != nil {
+		return err
+	}
+	if (lift != nil && kw.Value != "lift") || (inCore && kw.Value == "lift") {
+		return fmt.Errorf("line %d: canon %s defines the wrong kind of function", kw.Line, kw.Value)
+	}
+
+	var b encoder.Buffer
+	switch kw.Value {
+	case "lift":
+		coreFunc, err := p.parseSortIdxOf("core func")
+		if err != nil {
+			return err
+		}
+		opts, err := p.parseCanonOpts()
+		if err != nil {
+			return err
+		}
+		if lift == nil {
+			if err := p.expectGroup("func"); err != nil {
+				return err
+			}
+			lift = &liftTarget{id: p.optionalID(), exports: p.parseInlineExports()}
+			if lift.typeIdx, err = p.parseFuncTypeUse(); err != nil {
+				return err
+			}
+			if err := p.closeGroups(1); err != nil {
+				return err
+			}
+		}
+		if err := p.closeGroups(1); err != nil {
+			return err
+		}
+		b.WriteBytes([]byte{canonLift, 0x00})
+		b.WriteU32(coreFunc)
+		b.WriteBytes(opts)
+		b.WriteU32(lift.typeIdx)
+		p.emitSection(sectionCanon, b.Bytes)
+		idx := p.sc.define("func", lift.id)
+		for _, name := range lift.exports {
+			p.emitExport(name, "func", idx, "")
+		}
+		return nil
+
+	case "lower":
+		fn, err := p.parseSortIdxOf("func")
+		if err != nil {
+			return err
+		}
+		opts, err := p.parseCanonOpts()
+		if err != nil {
+			return err
+		}
+		b.WriteBytes([]byte{canonLower, 0x00})
+		b.WriteU32(fn)
+		b.WriteBytes(opts)
+
+	default:
+		code, ok := resourceBuiltins[kw.Value]
+		if !ok {
+			return fmt.Errorf("line %d: unsupported canon built-in %q", kw.Line, kw.Value)
+		}
+		res, err := p.parseIdx("type")
+		if err != nil {
+			return err
+		}
+		b.AppendByte(code)
+		b.WriteU32(res)
+	}
+
+	if !inCore && p.isGroup("core") {
+		p.pos += 2
+		if err := p.expectKeyword("func"); err != nil {
+			return err
+		}
+		coreID = p.optionalID()
+		if err := p.closeGroups(1); err != nil {
+			return err
+		}
+	}
+	if err := p.closeGroups(1); err != nil {
+		return err
+	}
+	p.emitSection(sectionCanon, b.Bytes)
+	p.sc.define("core func", coreID)
+	return nil
+}
+
+// parseSortIdxOf parses a reference that must be of the given sort.
+func (p *Parser) parseSortIdxOf(want string) (uint32, error) {
+	sort, idx, err := p.parseSortIdx()
+	if err != nil {
+		return 0, err
+	}
+	if sort != want {
+		return 0, fmt.Errorf("expected a %s reference, got a %s", want, sort)
+	}
+	return idx, nil
+}
+
+// parseCanonOpts parses the options of canon lift or lower into their
+// vector encoding.
+func (p *Parser) parseCanonOpts() ([]byte, error) {
+	var opts encoder.Buffer
+	var n uint32
+	for {
+		t := p.peek()
+		if t == nil {
+			break
+		}
+		if t.Type == token.Ident && strings.HasPrefix(t.Value, "string-encoding=") {
+			code, ok := stringEncodings[strings.TrimPrefix(t.Value, "string-encoding=")]
+			if !ok {
+				return nil, fmt.Errorf("line %d: unknown string encoding %q", t.Line, t.Value)
+			}
+			p.next()
+			opts.AppendByte(code)
+			n++
+			continue
+		}
+		if t.Type == token.Ident && t.Value == "async" {
+			p.next()
+			opts.AppendByte(optAsync)
+			n++
+			continue
+		}
+
+		var code byte
+		sort := "core func"
+		switch {
+		case p.isGroup("memory"):
+			code, sort = optMemory, "core memory"
+		case p.isGroup("realloc"):
+			code = optRealloc
+		case p.isGroup("post-return"):
+			code = optPostReturn
+		case p.isGroup("callback"):
+			code = optCallback
+		}
+		if code == 0 {
+			break
+		}
+		p.pos += 2
+		idx, err := p.parseCoreRef(sort)
+		if err != nil {
+			return nil, err
+		}
+		if err := p.closeGroups(1); err != nil {
+			return nil, err
+		}
+		opts.AppendByte(code)
+		opts.WriteU32(idx)
+		n++
+	}
+
+	var b encoder.Buffer
+	b.WriteU32(n)
+	b.WriteBytes(opts.Bytes)
+	return b.Bytes, nil
+}
+
+// parseCoreRef parses the core item of a canonical option, either as a
+// bare index or wrapped as in (func $f) or (core func $f).
+func (p *Parser) parseCoreRef(sort string) (uint32, error) {
+	if t := p.peek(); t == nil || t.Type != token.LParen {
+		return p.parseItemRef(sort)
+	}
+	p.next()
+	if t := p.peek(); t != nil && t.Type == token.Ident && t.Value == "core" {
+		p.next()
+	}
+	if err := p.expectKeyword(strings.TrimPrefix(sort, "core ")); err != nil {
+		return 0, err
+	}
+	idx, err := p.parseItemRef(sort)
+	if err != nil {
+		return 0, err
+	}
+	return idx, p.closeGroups(1)
+}
@@ -0,0 +1,924 @@
+// Package component compiles the text format of a WebAssembly component.
+//
+// Components are ordered: every index refers to a definition that precedes
+// it. The parser therefore emits each definition as a section of its own as
+// soon as it is parsed, and types written inline, such as the (list u8) of a
+// parameter, are hoisted into type definitions ahead of their use.
+package component
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wippyai/wasm-runtime/wat/internal/encoder"
+	"github.com/wippyai/wasm-runtime/wat/internal/parser"
+	"github.com/wippyai/wasm-runtime/wat/internal/token"
+)
+
+// Component section IDs.
+const (
+	sectionCoreModule   = 1
+	sectionCoreInstance = 2
+	sectionCoreType     = 3
+	sectionComponent    = 4
+	sectionInstance     = 5
+	sectionAlias        = 6
+	sectionType         = 7
+	sectionCanon        = 8
+	sectionImport       = 10
+	sectionExport       = 11
+)
+
+// Declaration kinds of instance and component types.
+const (
+	declCoreType = 0x00
+	declType     = 0x01
+	declAlias    = 0x02
+	declImport   = 0x03
+	declExport   = 0x04
+)
+
+// header is the preamble of a component binary: magic, version 0x0d and
+// layer 1.
+var header = []byte{0x00, 0x61, 0x73, 0x6D, 0x0D, 0x00, 0x01, 0x00}
+
+// sortBytes holds the binary encoding of each sort, by text name. Core sorts
+// carry the 0x00 prefix.
+var sortBytes = map[string][]byte{
+	"core func":     {0x00, 0x00},
+	"core table":    {0x00, 0x01},
+	"core memory":   {0x00, 0x02},
+	"core global":   {0x00, 0x03},
+	"core type":     {0x00, 0x10},
+	"core module":   {0x00, 0x11},
+	"core instance": {0x00, 0x12},
+	"func":          {0x01},
+	"value":         {0x02},
+	"type":          {0x03},
+	"component":     {0x04},
+	"instance":      {0x05},
+}
+
+// scope holds the index spaces of a component, or of an instance or
+// component type, together with the binary it has produced so far.
+type scope struct {
+	parent *scope
+	names  map[string]map[string]uint32
+	counts map[string]uint32
+	id     string
+	// body holds sections for a component, declarations for a type
+	body  encoder.Buffer
+	decls uint32
+	// isType is set for the declarations of an instance or component type
+	isType bool
+}
+
+func newScope(parent *scope, id string, isType bool) *scope {
+	return &scope{
+		parent: parent,
+		id:     id,
+		isType: isType,
+		names:  make(map[string]map[string]uint32),
+		counts: make(map[string]uint32),
+	}
+}
+
+// define adds an entry to the index space of sort and returns its index.
+func (s *scope) define(sort, id string) uint32 {
+	idx := s.counts[sort]
+	s.counts[sort]++
+	if id != "" {
+		if s.names[sort] == nil {
+			s.names[sort] = make(map[string]uint32)
+		}
+		s.names[sort][id] = idx
+	}
+	return idx
+}
+
+type Parser struct {
+	sc     *scope
+	tokens []token.Token
+	pos    int
+}
+
+func New(tokens []token.Token) *Parser {
+	return &Parser{tokens: tokens}
+}
+
+// Parse compiles a (component ...) form into a component binary.
+func (p *Parser) Parse() ([]byte, error) {
+	if err := p.expectGroup("component"); err != nil {
+		return nil, err
+	}
+	bin, _, exports, err := p.parseComponent()
+	if err != nil {
+		return nil, err
+	}
+	if len(exports) > 0 {
+		return nil, fmt.Errorf("inline exports on the outermost component")
+	}
+	return bin, nil
+}
+
+// IsComponent reports whether tokens hold a (component ...) form.
+func IsComponent(tokens []token.Token) bool {
+	return len(tokens) > 1 && tokens[0].Type == token.LParen &&
+		tokens[1].Type == token.Ident && tokens[1].Value == "component"
+}
+
+// parseComponent parses the body of a (component ...) form after its
+// keyword, in a scope of its own. It returns the component binary along
+// with the identifier and inline export names of the form.
+func (p *Parser) parseComponent() ([]byte, string, []string, error) {
+	id := p.optionalID()
+	exports := p.parseInlineExports()
+
+	outer := p.sc
+	p.sc = newScope(outer, id, false)
+	p.sc.body.WriteBytes(header)
+	defer func() { p.sc = outer }()
+
+	for {
+		t := p.peek()
+		if t == nil {
+			return nil, "", nil, fmt.Errorf("unexpected end of component")
+		}
+		if t.Type == token.RParen {
+			p.next()
+			break
+		}
+		if err := p.parseDefinition(); err != nil {
+			return nil, "", nil, err
+		}
+	}
+	return p.sc.body.Bytes, id, exports, nil
+}
+
+// parseDefinition parses one field of a component.
+func (p *Parser) parseDefinition() error {
+	if _, err := p.expect(token.LParen); err != nil {
+		return err
+	}
+	kw, err := p.expect(token.Ident)
+	if err != nil {
+		return err
+	}
+
+	switch kw.Value {
+	case "core":
+		core, err := p.expect(token.Ident)
+		if err != nil {
+			return err
+		}
+		switch core.Value {
+		case "module":
+			return p.parseCoreModule()
+		case "instance":
+			return p.parseCoreInstance()
+		case "type":
+			return p.parseCoreTypeDef()
+		case "func", "table", "memory", "global":
+			return p.parseCoreItem("core " + core.Value)
+		}
+		return fmt.Errorf("line %d: unknown core definition %q", core.Line, core.Value)
+	case "type":
+		return p.parseTypeDef()
+	case "alias":
+		return p.parseAlias()
+	case "func":
+		return p.parseFunc()
+	case "canon":
+		return p.parseCanon(false, "", nil)
+	case "instance":
+		return p.parseInstance()
+	case "component":
+		bin, id, exports, err := p.parseComponent()
+		if err != nil {
+			return fmt.Errorf("component %s: %w", id, err)
+		}
+		p.emitSection(sectionComponent, bin)
+		idx := p.sc.define("component", id)
+		for _, name := range exports {
+			p.emitExport(name, "component", idx, "")
+		}
+		return nil
+	case "import":
+		return p.parseImport()
+	case "export":
+		return p.parseExport()
+	}
+	return fmt.Errorf("line %d: unsupported component field %q", kw.Line, kw.Value)
+}
+
+// parseCoreModule compiles a (core module ...) field with the module
+// parser and emits its binary.
+func (p *Parser) parseCoreModule() error {
+	start := p.pos - 3
+	end := p.groupEnd(start)
+	id := p.optionalID()
+
+	// Drop the 'core' keyword so the module parser sees (module ...)
+	tokens := append([]token.Token{p.tokens[start]}, p.tokens[start+2:end]...)
+	mod, err := parser.New(tokens).Parse()
+	if err != nil {
+		return fmt.Errorf("core module %s: %w", id, err)
+	}
+	p.pos = end
+	p.emitSection(sectionCoreModule, encoder.Encode(mod))
+	p.sc.define("core module", id)
+	return nil
+}
+
+// parseCoreInstance parses a core instance made by instantiating a module
+// or by bundling core items.
+func (p *Parser) parseCoreInstance() error {
+	id := p.optionalID()
+	var b encoder.Buffer
+	if p.isGroup("instantiate") {
+		p.pos += 2
+		mod, err := p.parseItemRef("core module")
+		if err != nil {
+			return err
+		}
+		b.AppendByte(0x00)
+		b.WriteU32(mod)
+
+		var args encoder.Buffer
+		var n uint32
+		for p.isGroup("with") {
+			p.pos += 2
+			name, err := p.parseName()
+			if err != nil {
+				return err
+			}
+			if err := p.expectGroup("instance"); err != nil {
+				return err
+			}
+			var inst uint32
+			if t := p.peek(); t != nil && t.Type == token.LParen {
+				// Inline instance of core exports
+				if inst, err = p.parseCoreExports(""); err != nil {
+					return err
+				}
+			} else if inst, err = p.parseItemRef("core instance"); err != nil {
+				return err
+			}
+			if err := p.closeGroups(2); err != nil {
+				return err
+			}
+			args.WriteString(name)
+			args.AppendByte(0x12)
+			args.WriteU32(inst)
+			n++
+		}
+		b.WriteU32(n)
+		b.WriteBytes(args.Bytes)
+		if err := p.closeGroups(2); err != nil {
+			return err
+		}
+		p.emitSection(sectionCoreInstance, b.Bytes)
+		p.sc.define("core instance", id)
+		return nil
+	}
+
+	if _, err := p.parseCoreExports(id); err != nil {
+		return err
+	}
+	return p.closeGroups(1)
+}
+
+// parseCoreExports parses (export "name" (kind idx)) entries into a core
+// instance, up to the closing paren of the enclosing form.
+func (p *Parser) parseCoreExports(id string) (uint32, error) {
+	var exports encoder.Buffer
+	var n uint32
+	for p.isGroup("export") {
+		p.pos += 2
+		name, err := p.parseName()
+		if err != nil {
+			return 0, err
+		}
+		if _, err := p.expect(token.LParen); err != nil {
+			return 0, err
+		}
+		kind, err := p.expect(token.Ident)
+		if err != nil {
+			return 0, err
+		}
+		sort := "core " + kind.Value
+		code, ok := sortBytes[sort]
+		if !ok {
+			return 0, fmt.Errorf("line %d: unknown core sort %q", kind.Line, kind.Value)
+		}
+		idx, err := p.parseItemRef(sort)
+		if err != nil {
+			return 0, err
+		}
+		if err := p.closeGroups(2); err != nil {
+			return 0, err
+		}
+		exports.WriteString(name)
+		exports.AppendByte(code[1])
+		exports.WriteU32(idx)
+		n++
+	}
+	var b encoder.Buffer
+	b.AppendByte(0x01)
+	b.WriteU32(n)
+	b.WriteBytes(exports.Bytes)
+	p.emitSection(sectionCoreInstance, b.Bytes)
+	return p.sc.define("core instance", id), nil
+}
+
+// parseCoreItem parses a core func, table, memory or global defined by an
+// alias, or a core func defined by a canon built-in.
+func (p *Parser) parseCoreItem(sort string) error {
+	id := p.optionalID()
+	switch {
+	case p.isGroup("alias"):
+		p.pos += 2
+		if err := p.expectKeyword("core"); err != nil {
+			return err
+		}
+		if err := p.expectKeyword("export"); err != nil {
+			return err
+		}
+		inst, err := p.parseIdx("core instance")
+		if err != nil {
+			return err
+		}
+		name, err := p.parseName()
+		if err != nil {
+			return err
+		}
+		p.emitAlias(sort, id, aliasExport(sort, inst, name))
+		return p.closeGroups(2)
+	case sort == "core func" && p.isGroup("canon"):
+		p.pos += 2
+		if err := p.parseCanon(true, id, nil); err != nil {
+			return err
+		}
+		return p.closeGroups(1)
+	}
+	return fmt.Errorf("%s %s: expected alias or canon definition", sort, id)
+}
+
+// parseAlias parses an alias field: an export of a component or core
+// instance, or a definition of an enclosing component.
+func (p *Parser) parseAlias() error {
+	kw, err := p.expect(token.Ident)
+	if err != nil {
+		return err
+	}
+	switch kw.Value {
+	case "export", "core":
+		instSort := "instance"
+		if kw.Value == "core" {
+			if err := p.expectKeyword("export"); err != nil {
+				return err
+			}
+			instSort = "core instance"
+		}
+		inst, err := p.parseIdx(instSort)
+		if err != nil {
+			return err
+		}
+		name, err := p.parseName()
+		if err != nil {
+			return err
+		}
+		sort, id, err := p.parseSortDecl()
+		if err != nil {
+			return err
+		}
+		if strings.HasPrefix(sort, "core ") != (kw.Value == "core") {
+			return fmt.Errorf("line %d: alias of %s from %s", kw.Line, sort, instSort)
+		}
+		p.emitAlias(sort, id, aliasExport(sort, inst, name))
+		return p.closeGroups(1)
+
+	case "outer":
+		outerTok := p.next()
+		idxTok := p.next()
+		if outerTok == nil || idxTok == nil {
+			return fmt.Errorf("unexpected end of alias")
+		}
+		sort, id, err := p.parseSortDecl()
+		if err != nil {
+			return err
+		}
+		count, outer, err := p.resolveOuter(outerTok)
+		if err != nil {
+			return err
+		}
+		idx, err := resolveIn(outer, sort, idxTok)
+		if err != nil {
+			return err
+		}
+		var b encoder.Buffer
+		b.WriteBytes(sortBytes[sort])
+		b.AppendByte(0x02)
+		b.WriteU32(count)
+		b.WriteU32(idx)
+		p.emitAlias(sort, id, b.Bytes)
+		return p.closeGroups(1)
+	}
+	return fmt.Errorf("line %d: unknown alias target %q", kw.Line, kw.Value)
+}
+
+// aliasExport encodes an alias of the named export of an instance.
+func aliasExport(sort string, inst uint32, name string) []byte {
+	var b encoder.Buffer
+	b.WriteBytes(sortBytes[sort])
+	if strings.HasPrefix(sort, "core ") {
+		b.AppendByte(0x01)
+	} else {
+		b.AppendByte(0x00)
+	}
+	b.WriteU32(inst)
+	b.WriteString(name)
+	return b.Bytes
+}
+
+// resolveOuter resolves the enclosing component named by t, returning how
+// many scopes out it is.
+func (p *Parser) resolveOuter(t *token.Token) (uint32, *scope, error) {
+	if t.Type == token.Number {
+		var count uint32
+		if _, err := fmt.Sscan(t.Value, &count); err != nil {
+			return 0, nil, fmt.Errorf("line %d: invalid outer count %q", t.Line, t.Value)
+		}
+		s := p.sc
+		for i := uint32(0); i < count && s != nil; i++ {
+			s = s.parent
+		}
+		if s == nil {
+			return 0, nil, fmt.Errorf("line %d: outer count %d out of range", t.Line, count)
+		}
+		return count, s, nil
+	}
+	var count uint32
+	for s := p.sc; s != nil; s = s.parent {
+		if s.id == t.Value {
+			return count, s, nil
+		}
+		count++
+	}
+	return 0, nil, fmt.Errorf("line %d: unknown enclosing component %s", t.Line, t.Value)
+}
+
+// parseFunc parses a component function, lifted from a core function or
+// aliased from an instance export.
+func (p *Parser) parseFunc() error {
+	id := p.optionalID()
+	exports := p.parseInlineExports()
+	if p.isGroup("alias") {
+		p.pos += 2
+		if err := p.expectKeyword("export"); err != nil {
+			return err
+		}
+		inst, err := p.parseIdx("instance")
+		if err != nil {
+			return err
+		}
+		name, err := p.parseName()
+		if err != nil {
+			return err
+		}
+		idx := p.emitAlias("func", id, aliasExport("func", inst, name))
+		for _, name := range exports {
+			p.emitExport(name, "func", idx, "")
+		}
+		return p.closeGroups(2)
+	}
+
+	typeIdx, err := p.parseFuncTypeUse()
+	if err != nil {
+		return err
+	}
+	if !p.isGroup("canon") {
+		return fmt.Errorf("func %s: expected canon lift or alias", id)
+	}
+	p.pos += 2
+	lift := &liftTarget{id: id, typeIdx: typeIdx, exports: exports}
+	if err := p.parseCanon(false, "", lift); err != nil {
+		return err
+	}
+	return p.closeGroups(1)
+}
+
+// parseInstance parses a component instance made by instantiating a
+// component or by bundling exports.
+func (p *Parser) parseInstance() error {
+	id := p.optionalID()
+	exports := p.parseInlineExports()
+	var b encoder.Buffer
+	if p.isGroup("instantiate") {
+		p.pos += 2
+		comp, err := p.parseItemRef("component")
+		if err != nil {
+			return err
+		}
+		b.AppendByte(0x00)
+		b.WriteU32(comp)
+
+		var args encoder.Buffer
+		var n uint32
+		for p.isGroup("with") {
+			p.pos += 2
+			name, err := p.parseName()
+			if err != nil {
+				return err
+			}
+			sort, idx, err := p.parseSortIdx()
+			if err != nil {
+				return err
+			}
+			args.WriteString(name)
+			args.WriteBytes(sortBytes[sort])
+			args.WriteU32(idx)
+			n++
+			if err := p.closeGroups(1); err != nil {
+				return err
+			}
+		}
+		b.WriteU32(n)
+		b.WriteBytes(args.Bytes)
+		if err := p.closeGroups(1); err != nil {
+			return err
+		}
+	} else {
+		var entries encoder.Buffer
+		var n uint32
+		for p.isGroup("export") {
+			p.pos += 2
+			name, err := p.parseName()
+			if err != nil {
+				return err
+			}
+			sort, idx, err := p.parseSortIdx()
+			if err != nil {
+				return err
+			}
+			entries.AppendByte(0x00)
+			entries.WriteString(name)
+			entries.WriteBytes(sortBytes[sort])
+			entries.WriteU32(idx)
+			n++
+			if err := p.closeGroups(1); err != nil {
+				return err
+			}
+		}
+		b.AppendByte(0x01)
+		b.WriteU32(n)
+		b.WriteBytes(entries.Bytes)
+	}
+	if err := p.closeGroups(1); err != nil {
+		return err
+	}
+
+	p.emitSection(sectionInstance, b.Bytes)
+	idx := p.sc.define("instance", id)
+	for _, name := range exports {
+		p.emitExport(name, "instance", idx, "")
+	}
+	return nil
+}
+
+// parseImport parses (import "name" externdesc).
+func (p *Parser) parseImport() error {
+	name, err := p.parseName()
+	if err != nil {
+		return err
+	}
+	desc, sort, id, err := p.parseExternDesc()
+	if err != nil {
+		return fmt.Errorf("import %q: %w", name, err)
+	}
+	if err := p.closeGroups(1); err != nil {
+		return err
+	}
+
+	var b encoder.Buffer
+	b.AppendByte(0x00)
+	b.WriteString(name)
+	b.WriteBytes(desc)
+	p.emitDecl(sectionImport, declImport, b.Bytes)
+	p.sc.define(sort, id)
+	return nil
+}
+
+// parseExport parses an export field. In a component it exports an item,
+// optionally ascribing it a type; in an instance or component type it
+// declares an export of the given externdesc.
+func (p *Parser) parseExport() error {
+	if p.sc.isType {
+		name, err := p.parseName()
+		if err != nil {
+			return err
+		}
+		desc, sort, id, err := p.parseExternDesc()
+		if err != nil {
+			return fmt.Errorf("export %q: %w", name, err)
+		}
+		var b encoder.Buffer
+		b.AppendByte(0x00)
+		b.WriteString(name)
+		b.WriteBytes(desc)
+		p.emitDecl(0, declExport, b.Bytes)
+		p.sc.define(sort, id)
+		return p.closeGroups(1)
+	}
+
+	id := p.optionalID()
+	name, err := p.parseName()
+	if err != nil {
+		return err
+	}
+	sort, idx, err := p.parseSortIdx()
+	if err != nil {
+		return err
+	}
+	var ascribed []byte
+	if t := p.peek(); t != nil && t.Type == token.LParen {
+		if ascribed, _, _, err = p.parseExternDesc(); err != nil {
+			return fmt.Errorf("export %q: %w", name, err)
+		}
+	}
+	if err := p.closeGroups(1); err != nil {
+		return err
+	}
+	p.emitExport(name, sort, idx, id, ascribed...)
+	return nil
+}
+
+// emitExport emits an export of idx in sort. The export adds an index of
+// its own to the sort.
+func (p *Parser) emitExport(name, sort string, idx uint32, id string, ascribed ...byte) {
+	var b encoder.Buffer
+	b.AppendByte(0x00)
+	b.WriteString(name)
+	b.WriteBytes(sortBytes[sort])
+	b.WriteU32(idx)
+	if len(ascribed) > 0 {
+		b.AppendByte(0x01)
+		b.WriteBytes(ascribed)
+	} else {
+		b.AppendByte(0x00)
+	}
+	p.emitSection(sectionExport, b.Bytes)
+	if !strings.HasPrefix(sort, "core ") {
+		p.sc.define(sort, id)
+	}
+}
+
+// emitSection appends a section holding a single item to the current
+// component. Core modules and nested components are embedded whole.
+func (p *Parser) emitSection(id byte, item []byte) {
+	body := &p.sc.body
+	body.AppendByte(id)
+	if id == sectionCoreModule || id == sectionComponent {
+		body.WriteU32(uint32(len(item)))
+		body.WriteBytes(item)
+		return
+	}
+	body.WriteU32(uint32(len(item) + 1))
+	body.AppendByte(0x01)
+	body.WriteBytes(item)
+}
+
+// emitDecl emits an item as a section of a component, or as a declaration
+// of the given kind when inside an instance or component type.
+func (p *Parser) emitDecl(section, decl byte, item []byte) {
+	if !p.sc.isType {
+		p.emitSection(section, item)
+		return
+	}
+	p.sc.body.AppendByte(decl)
+	p.sc.body.WriteBytes(item)
+	p.sc.decls++
+}
+
+// emitAlias emits an encoded alias and defines its index in sort.
+func (p *Parser) emitAlias(sort, id string, alias []byte) uint32 {
+	p.emitDecl(sectionAlias, declAlias, alias)
+	return p.sc.define(sort, id)
+}
+
+// parseSortDecl parses the (sort id?) that closes an alias.
+func (p *Parser) parseSortDecl() (string, string, error) {
+	if _, err := p.expect(token.LParen); err != nil {
+		return "", "", err
+	}
+	sort, err := p.parseSortName()
+	if err != nil {
+		return "", "", err
+	}
+	id := p.optionalID()
+	if _, err := p.expect(token.RParen); err != nil {
+		return "", "", err
+	}
+	return sort, id, nil
+}
+
+// parseSortName parses a sort keyword, with its core prefix if any.
+func (p *Parser) parseSortName() (string, error) {
+	kw, err := p.expect(token.Ident)
+	if err != nil {
+		return "", err
+	}
+	sort := kw.Value
+	if sort == "core" {
+		next, err := p.expect(token.Ident)
+		if err != nil {
+			return "", err
+		}
+		sort = "core " + next.Value
+	}
+	if _, ok := sortBytes[sort]; !ok {
+		return "", fmt.Errorf("line %d: unknown sort %q", kw.Line, sort)
+	}
+	return sort, nil
+}
+
+// parseSortIdx parses a reference such as (func $f) or (core module $m).
+func (p *Parser) parseSortIdx() (string, uint32, error) {
+	if _, err := p.expect(token.LParen); err != nil {
+		return "", 0, err
+	}
+	sort, err := p.parseSortName()
+	if err != nil {
+		return "", 0, err
+	}
+	idx, err := p.parseItemRef(sort)
+	if err != nil {
+		return "", 0, err
+	}
+	if _, err := p.expect(token.RParen); err != nil {
+		return "", 0, err
+	}
+	return sort, idx, nil
+}
+
+// parseItemRef parses the index of an item of sort. An instance index
+// followed by an export name refers to that export, which is aliased first.
+func (p *Parser) parseItemRef(sort string) (uint32, error) {
+	if p.pos+1 < len(p.tokens) && p.tokens[p.pos+1].Type == token.String {
+		instSort := "instance"
+		if strings.HasPrefix(sort, "core ") {
+			instSort = "core instance"
+		}
+		inst, err := p.parseIdx(instSort)
+		if err != nil {
+			return 0, err
+		}
+		name, err := p.parseName()
+		if err != nil {
+			return 0, err
+		}
+		return p.emitAlias(sort, "", aliasExport(sort, inst, name)), nil
+	}
+	return p.parseIdx(sort)
+}
+
+// parseIdx parses a numeric index or identifier in the index space of sort.
+func (p *Parser) parseIdx(sort string) (uint32, error) {
+	return p.parseIdxIn(p.sc, sort)
+}
+
+// parseIdxIn parses an index in the index space of sort of scope s.
+func (p *Parser) parseIdxIn(s *scope, sort string) (uint32, error) {
+	t := p.next()
+	if t == nil {
+		return 0, fmt.Errorf("unexpected end of input, expected %s index", sort)
+	}
+	return resolveIn(s, sort, t)
+}
+
+func resolveIn(s *scope, sort string, t *token.Token) (uint32, error) {
+	switch {
+	case t.Type == token.Number:
+		var idx uint32
+		if _, err := fmt.Sscan(t.Value, &idx); err != nil {
+			return 0, fmt.Errorf("line %d: invalid %s index %q", t.Line, sort, t.Value)
+		}
+		return idx, nil
+	case t.Type == token.Ident && strings.HasPrefix(t.Value, "$"):
+		if idx, ok := s.names[sort][t.Value]; ok {
+			return idx, nil
+		}
+		return 0, fmt.Errorf("line %d: unknown %s %s", t.Line, sort, t.Value)
+	}
+	return 0, fmt.Errorf("line %d: expected %s index, got %q", t.Line, sort, t.Value)
+}
+
+// parseInlineExports parses the (export "name") abbreviations that follow
+// the identifier of a definition.
+func (p *Parser) parseInlineExports() []string {
+	var names []string
+	for p.pos+3 < len(p.tokens) && p.isGroup("export") &&
+		p.tokens[p.pos+2].Type == token.String && p.tokens[p.pos+3].Type == token.RParen {
+		names = append(names, string(parser.DecodeStringLiteral(p.tokens[p.pos+2].Value)))
+		p.pos += 4
+	}
+	return names
+}
+
+func (p *Parser) peek() *token.Token {
+	if p.pos >= len(p.tokens) {
+		return nil
+	}
+	return &p.tokens[p.pos]
+}
+
+func (p *Parser) next() *token.Token {
+	if p.pos >= len(p.tokens) {
+		return nil
+	}
+	t := &p.tokens[p.pos]
+	p.pos++
+	return t
+}
+
+func (p *Parser) expect(typ token.Type) (*token.Token, error) {
+	t := p.next()
+	if t == nil {
+		return nil, fmt.Errorf("unexpected end of input")
+	}
+	if t.Type != typ {
+		return nil, fmt.Errorf("line %d: expected %v, got %q", t.Line, typ, t.Value)
+	}
+	return t, nil
+}
+
+func (p *Parser) expectKeyword(kw string) error {
+	t := p.next()
+	if t == nil {
+		return fmt.Errorf("unexpected end of input, expected %q", kw)
+	}
+	if t.Type != token.Ident || t.Value != kw {
+		return fmt.Errorf("expected %q", kw)
+	}
+	return nil
+}
+
+// expectGroup consumes the opening of a (kw ...) group.
+func (p *Parser) expectGroup(kw string) error {
+	if !p.isGroup(kw) {
+		if t := p.peek(); t != nil {
+			return fmt.Errorf("line %d: expected (%s ...)", t.Line, kw)
+		}
+		return fmt.Errorf("unexpected end of input, expected (%s ...)", kw)
+	}
+	p.pos += 2
+	return nil
+}
+
+// isGroup reports whether a (kw ...) group starts at the current token.
+func (p *Parser) isGroup(kw string) bool {
+	return p.pos+1 < len(p.tokens) && p.tokens[p.pos].Type == token.LParen &&
+		p.tokens[p.pos+1].Type == token.Ident && p.tokens[p.pos+1].Value == kw
+}
+
+func (p *Parser) closeGroups(n int) error {
+	for i := 0; i < n; i++ {
+		if _, err := p.expect(token.RParen); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *Parser) optionalID() string {
+	if t := p.peek(); t != nil && t.Type == token.Ident && strings.HasPrefix(t.Value, "$") {
+		p.next()
+		return t.Value
+	}
+	return ""
+}
+
+func (p *Parser) parseName() (string, error) {
+	t, err := p.expect(token.String)
+	if err != nil {
+		return "", err
+	}
+	return string(parser.DecodeStringLiteral(t.Value)), nil
+}
+
+// groupEnd returns the position just past the group opening at start.
+func (p *Parser) groupEnd(start int) int {
+	depth := 0
+	for i := start; i < len(p.tokens); i++ {
+		switch p.tokens[i].Type {
+		case token.LParen:
+			depth++
+		case token.RParen:
+			depth--
+			if depth == 0 {
+				return i + 1
+			}
+		}
+	}
+	return len(p.tokens)
+}
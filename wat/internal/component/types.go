@@ -0,0 +1,777 @@
+package component
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wippyai/wasm-runtime/wat/internal/encoder"
+	"github.com/wippyai/wasm-runtime/wat/internal/token"
+)
+
+// primValTypes holds the codes of the primitive value types.
+var primValTypes = map[string]byte{
+	"bool":          0x7F,
+	"s8":            0x7E,
+	"u8":            0x7D,
+	"s16":           0x7C,
+	"u16":           0x7B,
+	"s32":           0x7A,
+	"u32":           0x79,
+	"s64":           0x78,
+	"u64":           0x77,
+	"f32":           0x76,
+	"float32":       0x76,
+	"f64":           0x75,
+	"float64":       0x75,
+	"char":          0x74,
+	"string":        0x73,
+	"error-context": 0x64,
+}
+
+// coreValTypes holds the codes of the core value types usable in core
+// function and module types.
+var coreValTypes = map[string]byte{
+	"i32":       0x7F,
+	"i64":       0x7E,
+	"f32":       0x7D,
+	"f64":       0x7C,
+	"v128":      0x7B,
+	"funcref":   0x70,
+	"externref": 0x6F,
+}
+
+// Type encodings.
+const (
+	typeFunc      = 0x40
+	typeComponent = 0x41
+	typeInstance  = 0x42
+	typeResource  = 0x3F
+	coreTypeFunc  = 0x60
+	coreTypeMod   = 0x50
+)
+
+// parseTypeDef parses a type field after its keyword.
+func (p *Parser) parseTypeDef() error {
+	id := p.optionalID()
+	exports := p.parseInlineExports()
+	if len(exports) > 0 && p.sc.isType {
+		return fmt.Errorf("type %s: inline export in a type declaration", id)
+	}
+	def, err := p.parseDefType()
+	if err != nil {
+		return fmt.Errorf("type %s: %w", id, err)
+	}
+	if err := p.closeGroups(1); err != nil {
+		return err
+	}
+	p.emitDecl(sectionType, declType, def)
+	idx := p.sc.define("type", id)
+	for _, name := range exports {
+		p.emitExport(name, "type", idx, "")
+	}
+	return nil
+}
+
+// hoistType defines an anonymous type written inline and returns its index.
+func (p *Parser) hoistType(def []byte) uint32 {
+	p.emitDecl(sectionType, declType, def)
+	return p.sc.define("type", "")
+}
+
+// parseDefType parses a value, function, instance, component or resource
+// type.
+func (p *Parser) parseDefType() ([]byte, error) {
+	t := p.peek()
+	if t == nil {
+		return nil, fmt.Errorf("unexpected end of input, expected a type")
+	}
+	if t.Type == token.Ident {
+		code, ok := primValTypes[t.Value]
+		if !ok {
+			return nil, fmt.Errorf("line %d: unknown type %q", t.Line, t.Value)
+		}
+		p.next()
+		return []byte{code}, nil
+	}
+	if t.Type != token.LParen || p.pos+1 >= len(p.tokens) {
+		return nil, fmt.Errorf("line %d: expected a type, got %q", t.Line, t.Value)
+	}
+
+	var def []byte
+	var err error
+	switch p.tokens[p.pos+1].Value {
+	case "func":
+		p.pos += 2
+		def, err = p.parseFuncTypeBody()
+	case "instance":
+		p.pos += 2
+		def, err = p.parseTypeDecls(typeInstance)
+	case "component":
+		p.pos += 2
+		def, err = p.parseTypeDecls(typeComponent)
+	case "resource":
+		p.pos += 2
+		def, err = p.parseResourceType()
+	default:
+		return p.parseDefValType()
+	}
+	if err != nil {
+		return nil, err
+	}
+	return def, p.closeGroups(1)
+}
+
+// parseDefValType parses a compound value type such as (list u8), through
+// its closing paren.
+func (p *Parser) parseDefValType() ([]byte, error) {
+	if _, err := p.expect(token.LParen); err != nil {
+		return nil, err
+	}
+	kw, err := p.expect(token.Ident)
+	if err != nil {
+		return nil, err
+	}
+
+	var b encoder.Buffer
+	switch kw.Value {
+	case "record":
+		var fields encoder.Buffer
+		var n uint32
+		for p.isGroup("field") {
+			p.pos += 2
+			name, err := p.parseName()
+			if err != nil {
+				return nil, err
+			}
+			fields.WriteString(name)
+			if err := p.parseValType(&fields); err != nil {
+				return nil, fmt.Errorf("field %q: %w", name, err)
+			}
+			if err := p.closeGroups(1); err != nil {
+				return nil, err
+			}
+			n++
+		}
+		b.AppendByte(0x72)
+		b.WriteU32(n)
+		b.WriteBytes(fields.Bytes)
+
+	case "variant":
+		var cases encoder.Buffer
+		var n uint32
+		for p.isGroup("case") {
+			p.pos += 2
+			p.optionalID()
+			name, err := p.parseName()
+			if err != nil {
+				return nil, err
+			}
+			cases.WriteString(name)
+			if t := p.peek(); t != nil && t.Type != token.RParen && !p.isGroup("refines") {
+				cases.AppendByte(0x01)
+				if err := p.parseValType(&cases); err != nil {
+					return nil, fmt.Errorf("case %q: %w", name, err)
+				}
+			} else {
+				cases.AppendByte(0x00)
+			}
+			if p.isGroup("refines") {
+				return nil, fmt.Errorf("case %q: refines is not supported", name)
+			}
+			cases.AppendByte(0x00)
+			if err := p.closeGroups(1); err != nil {
+				return nil, err
+			}
+			n++
+		}
+		b.AppendByte(0x71)
+		b.WriteU32(n)
+		b.WriteBytes(cases.Bytes)
+
+	case "list", "option":
+		if kw.Value == "list" {
+			b.AppendByte(0x70)
+		} else {
+			b.AppendByte(0x6B)
+		}
+		if err := p.parseValType(&b); err != nil {
+			return nil, err
+		}
+
+	case "tuple":
+		var elems encoder.Buffer
+		var n uint32
+		for t := p.peek(); t != nil && t.Type != token.RParen; t = p.peek() {
+			if err := p.parseValType(&elems); err != nil {
+				return nil, err
+			}
+			n++
+		}
+		b.AppendByte(0x6F)
+		b.WriteU32(n)
+		b.WriteBytes(elems.Bytes)
+
+	case "flags", "enum":
+		var labels encoder.Buffer
+		var n uint32
+		for t := p.peek(); t != nil && t.Type == token.String; t = p.peek() {
+			name, _ := p.parseName()
+			labels.WriteString(name)
+			n++
+		}
+		if kw.Value == "flags" {
+			b.AppendByte(0x6E)
+		} else {
+			b.AppendByte(0x6D)
+		}
+		b.WriteU32(n)
+		b.WriteBytes(labels.Bytes)
+
+	case "result":
+		b.AppendByte(0x6A)
+		if t := p.peek(); t != nil && t.Type != token.RParen && !p.isGroup("error") {
+			b.AppendByte(0x01)
+			if err := p.parseValType(&b); err != nil {
+				return nil, err
+			}
+		} else {
+			b.AppendByte(0x00)
+		}
+		if p.isGroup("error") {
+			p.pos += 2
+			b.AppendByte(0x01)
+			if err := p.parseValType(&b); err != nil {
+				return nil, err
+			}
+			if err := p.closeGroups(1); err != nil {
+				return nil, err
+			}
+		} else {
+			b.AppendByte(0x00)
+		}
+
+	case "own", "borrow":
+		if kw.Value == "own" {
+			b.AppendByte(0x69)
+		} else {
+			b.AppendByte(0x68)
+		}
+		idx, err := p.parseIdx("type")
+		if err != nil {
+			return nil, err
+		}
+		b.WriteU32(idx)
+
+	default:
+		return nil, fmt.Errorf("line %d: unknown value type %q", kw.Line, kw.Value)
+	}
+	return b.Bytes, p.closeGroups(1)
+}
+
+// parseValType writes a value type: a primitive, a type index, or an
+// inline compound type, which is hoisted into a definition of its own.
+func (p *Parser) parseValType(b *encoder.Buffer) error {
+	t := p.peek()
+	if t == nil {
+		return fmt.Errorf("unexpected end of input, expected a value type")
+	}
+	var idx uint32
+	switch {
+	case t.Type == token.Ident && !strings.HasPrefix(t.Value, "$"):
+		code, ok := primValTypes[t.Value]
+		if !ok {
+			return fmt.Errorf("line %d: unknown value type %q", t.Line, t.Value)
+		}
+		p.next()
+		b.AppendByte(code)
+		return nil
+	case t.Type == token.LParen:
+		def, err := p.parseDefValType()
+		if err != nil {
+			return err
+		}
+		idx = p.hoistType(def)
+	default:
+		var err error
+		if idx, err = p.parseIdx("type"); err != nil {
+			return err
+		}
+	}
+	// Type indices are s33 so they never collide with primitive codes
+	b.WriteI64(int64(idx))
+	return nil
+}
+
+// parseFuncTypeBody parses the (param "name" type) and (result type) of a
+// function type.
+func (p *Parser) parseFuncTypeBody() ([]byte, error) {
+	var params encoder.Buffer
+	var n uint32
+	for p.isGroup("param") {
+		p.pos += 2
+		name, err := p.parseName()
+		if err != nil {
+			return nil, err
+		}
+		params.WriteString(name)
+		if err := p.parseValType(&params); err != nil {
+			return nil, fmt.Errorf("param %q: %w", name, err)
+		}
+		if err := p.closeGroups(1); err != nil {
+			return nil, err
+		}
+		n++
+	}
+
+	var b encoder.Buffer
+	b.AppendByte(typeFunc)
+	b.WriteU32(n)
+	b.WriteBytes(params.Bytes)
+	if p.isGroup("result") {
+		p.pos += 2
+		if t := p.peek(); t != nil && t.Type == token.String {
+			return nil, fmt.Errorf("line %d: named results are not supported", t.Line)
+		}
+		b.AppendByte(0x00)
+		if err := p.parseValType(&b); err != nil {
+			return nil, fmt.Errorf("result: %w", err)
+		}
+		if err := p.closeGroups(1); err != nil {
+			return nil, err
+		}
+	} else {
+		b.WriteBytes([]byte{0x01, 0x00})
+	}
+	return b.Bytes, nil
+}
+
+// parseFuncTypeUse parses (type idx) or an inline function type, which is
+// hoisted, returning the type index.
+func (p *Parser) parseFuncTypeUse() (uint32, error) {
+	if p.isGroup("type") {
+		p.pos += 2
+		idx, err := p.parseIdx("type")
+		if err != nil {
+			return 0, err
+		}
+		return idx, p.closeGroups(1)
+	}
+	def, err := p.parseFuncTypeBody()
+	if err != nil {
+		return 0, err
+	}
+	return p.hoistType(def), nil
+}
+
+// parseTypeDecls parses the declarations of an instance or component type
+// in a scope of their own.
+func (p *Parser) parseTypeDecls(kind byte) ([]byte, error) {
+	outer := p.sc
+	p.sc = newScope(outer, "", true)
+	defer func() { p.sc = outer }()
+
+	for {
+		t := p.peek()
+		if t == nil {
+			return nil, fmt.Errorf("unexpected end of type declarations")
+		}
+		if t.Type == token.RParen {
+			break
+		}
+		if _, err := p.expect(token.LParen); err != nil {
+			return nil, err
+		}
+		kw, err := p.expect(token.Ident)
+		if err != nil {
+			return nil, err
+		}
+		switch {
+		case kw.Value == "core":
+			if err = p.expectKeyword("type"); err == nil {
+				err = p.parseCoreTypeDef()
+			}
+		case kw.Value == "type":
+			err = p.parseTypeDef()
+		case kw.Value == "alias":
+			err = p.parseAlias()
+		case kw.Value == "export":
+			err = p.parseExport()
+		case kw.Value == "import" && kind == typeComponent:
+			err = p.parseImport()
+		default:
+			err = fmt.Errorf("line %d: unexpected %q in type declarations", kw.Line, kw.Value)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var b encoder.Buffer
+	b.AppendByte(kind)
+	b.WriteU32(p.sc.decls)
+	b.WriteBytes(p.sc.body.Bytes)
+	return b.Bytes, nil
+}
+
+// parseResourceType parses (rep i32) and an optional (dtor (func idx))
+// naming a core function.
+func (p *Parser) parseResourceType() ([]byte, error) {
+	if err := p.expectGroup("rep"); err != nil {
+		return nil, err
+	}
+	if err := p.expectKeyword("i32"); err != nil {
+		return nil, fmt.Errorf("resource rep: %w", err)
+	}
+	if err := p.closeGroups(1); err != nil {
+		return nil, err
+	}
+
+	b := []byte{typeResource, 0x7F}
+	if !p.isGroup("dtor") {
+		return append(b, 0x00), nil
+	}
+	p.pos += 2
+	if err := p.expectGroup("func"); err != nil {
+		return nil, err
+	}
+	dtor, err := p.parseItemRef("core func")
+	if err != nil {
+		return nil, err
+	}
+	if err := p.closeGroups(2); err != nil {
+		return nil, err
+	}
+	var buf encoder.Buffer
+	buf.WriteBytes(append(b, 0x01))
+	buf.WriteU32(dtor)
+	return buf.Bytes, nil
+}
+
+// parseExternDesc parses the (sort id? ...) describing an import or export,
+// returning its encoding, sort and identifier.
+func (p *Parser) parseExternDesc() ([]byte, string, string, error) {
+	if _, err := p.expect(token.LParen); err != nil {
+		return nil, "", "", err
+	}
+	sort, err := p.parseSortName()
+	if err != nil {
+		return nil, "", "", err
+	}
+	id := p.optionalID()
+
+	var b encoder.Buffer
+	switch sort {
+	case "core module":
+		var idx uint32
+		if p.isGroup("type") {
+			p.pos += 2
+			if idx, err = p.parseIdx("core type"); err == nil {
+				err = p.closeGroups(1)
+			}
+		} else {
+			var def []byte
+			if def, err = p.parseModuleType(); err == nil {
+				p.emitDecl(sectionCoreType, declCoreType, def)
+				idx = p.sc.define("core type", "")
+			}
+		}
+		b.WriteBytes([]byte{0x00, 0x11})
+		b.WriteU32(idx)
+
+	case "func":
+		var idx uint32
+		idx, err = p.parseFuncTypeUse()
+		b.AppendByte(0x01)
+		b.WriteU32(idx)
+
+	case "type":
+		b.AppendByte(0x03)
+		switch {
+		case p.isGroup("eq"):
+			p.pos += 2
+			var idx uint32
+			if idx, err = p.parseIdx("type"); err == nil {
+				err = p.closeGroups(1)
+			}
+			b.AppendByte(0x00)
+			b.WriteU32(idx)
+		case p.isGroup("sub"):
+			p.pos += 2
+			if err = p.expectKeyword("resource"); err == nil {
+				err = p.closeGroups(1)
+			}
+			b.AppendByte(0x01)
+		default:
+			err = fmt.Errorf("type %s: expected (eq idx) or (sub resource) bound", id)
+		}
+
+	case "instance", "component":
+		var idx uint32
+		if p.isGroup("type") {
+			p.pos += 2
+			if idx, err = p.parseIdx("type"); err == nil {
+				err = p.closeGroups(1)
+			}
+		} else {
+			kind := byte(typeInstance)
+			if sort == "component" {
+				kind = typeComponent
+			}
+			var def []byte
+			if def, err = p.parseTypeDecls(kind); err == nil {
+				idx = p.hoistType(def)
+			}
+		}
+		b.WriteBytes(sortBytes[sort])
+		b.WriteU32(idx)
+
+	default:
+		err = fmt.Errorf("%s imports and exports are not supported", sort)
+	}
+	if err != nil {
+		return nil, "", "", err
+	}
+	if err := p.closeGroups(1); err != nil {
+		return nil, "", "", err
+	}
+	return b.Bytes, sort, id, nil
+}
+
+// parseCoreTypeDef parses a (core type id? coretype) field after its
+// keywords: a core function type or a module type.
+func (p *Parser) parseCoreTypeDef() error {
+	id := p.optionalID()
+	var def []byte
+	var err error
+	switch {
+	case p.isGroup("func"):
+		p.pos += 2
+		def, err = p.parseCoreFuncType()
+	case p.isGroup("module"):
+		p.pos += 2
+		def, err = p.parseModuleType()
+	default:
+		err = fmt.Errorf("expected func or module type")
+	}
+	if err != nil {
+		return fmt.Errorf("core type %s: %w", id, err)
+	}
+	if err := p.closeGroups(2); err != nil {
+		return err
+	}
+	p.emitDecl(sectionCoreType, declCoreType, def)
+	p.sc.define("core type", id)
+	return nil
+}
+
+// parseCoreFuncType parses the params and results of a core function type.
+func (p *Parser) parseCoreFuncType() ([]byte, error) {
+	var params, results []byte
+	for _, kw := range []string{"param", "result"} {
+		for p.isGroup(kw) {
+			p.pos += 2
+			p.optionalID()
+			for t := p.peek(); t != nil && t.Type != token.RParen; t = p.peek() {
+				code, ok := coreValTypes[t.Value]
+				if !ok {
+					return nil, fmt.Errorf("line %d: unknown core value type %q", t.Line, t.Value)
+				}
+				p.next()
+				if kw == "param" {
+					params = append(params, code)
+				} else {
+					results = append(results, code)
+				}
+			}
+			if err := p.closeGroups(1); err != nil {
+				return nil, err
+			}
+		}
+	}
+	var b encoder.Buffer
+	b.AppendByte(coreTypeFunc)
+	b.WriteU32(uint32(len(params)))
+	b.WriteBytes(params)
+	b.WriteU32(uint32(len(results)))
+	b.WriteBytes(results)
+	return b.Bytes, nil
+}
+
+// parseModuleType parses the imports, exports and types declared by a
+// core module type.
+func (p *Parser) parseModuleType() ([]byte, error) {
+	var decls encoder.Buffer
+	var n uint32
+	types := newScope(nil, "", true)
+
+	for {
+		t := p.peek()
+		if t == nil {
+			return nil, fmt.Errorf("unexpected end of module type")
+		}
+		if t.Type == token.RParen {
+			break
+		}
+		if _, err := p.expect(token.LParen); err != nil {
+			return nil, err
+		}
+		kw, err := p.expect(token.Ident)
+		if err != nil {
+			return nil, err
+		}
+		switch kw.Value {
+		case "type":
+			id := p.optionalID()
+			if err := p.expectGroup("func"); err != nil {
+				return nil, err
+			}
+			def, err := p.parseCoreFuncType()
+			if err != nil {
+				return nil, err
+			}
+			decls.AppendByte(0x01)
+			decls.WriteBytes(def)
+			types.define("type", id)
+		case "import", "export":
+			var names []string
+			for t := p.peek(); t != nil && t.Type == token.String; t = p.peek() {
+				name, _ := p.parseName()
+				names = append(names, name)
+			}
+			if (kw.Value == "import") != (len(names) == 2) || len(names) == 0 || len(names) > 2 {
+				return nil, fmt.Errorf("line %d: malformed %s in module type", kw.Line, kw.Value)
+			}
+			desc, err := p.parseCoreImportDesc(types, &decls, &n)
+			if err != nil {
+				return nil, fmt.Errorf("%s %q: %w", kw.Value, names[len(names)-1], err)
+			}
+			if kw.Value == "import" {
+				decls.AppendByte(0x00)
+			} else {
+				decls.AppendByte(0x03)
+			}
+			for _, name := range names {
+				decls.WriteString(name)
+			}
+			decls.WriteBytes(desc)
+		default:
+			return nil, fmt.Errorf("line %d: unexpected %q in module type", kw.Line, kw.Value)
+		}
+		if err := p.closeGroups(1); err != nil {
+			return nil, err
+		}
+		n++
+	}
+
+	var b encoder.Buffer
+	b.AppendByte(coreTypeMod)
+	b.WriteU32(n)
+	b.WriteBytes(decls.Bytes)
+	return b.Bytes, nil
+}
+
+// parseCoreImportDesc parses the (func ...), (memory ...), (table ...) or
+// (global ...) of a module type import or export. An inline function type
+// is declared in the module type first.
+func (p *Parser) parseCoreImportDesc(types *scope, decls *encoder.Buffer, n *uint32) ([]byte, error) {
+	if _, err := p.expect(token.LParen); err != nil {
+		return nil, err
+	}
+	kw, err := p.expect(token.Ident)
+	if err != nil {
+		return nil, err
+	}
+	p.optionalID()
+
+	var b encoder.Buffer
+	switch kw.Value {
+	case "func":
+		var idx uint32
+		if p.isGroup("type") {
+			p.pos += 2
+			if idx, err = p.parseIdxIn(types, "type"); err == nil {
+				err = p.closeGroups(1)
+			}
+		} else {
+			var def []byte
+			if def, err = p.parseCoreFuncType(); err == nil {
+				decls.AppendByte(0x01)
+				decls.WriteBytes(def)
+				*n++
+				idx = types.define("type", "")
+			}
+		}
+		b.AppendByte(0x00)
+		b.WriteU32(idx)
+	case "memory":
+		b.AppendByte(0x02)
+		err = p.parseCoreLimits(&b)
+	case "table":
+		b.AppendByte(0x01)
+		var limits encoder.Buffer
+		if err = p.parseCoreLimits(&limits); err == nil {
+			t, terr := p.expect(token.Ident)
+			if terr != nil {
+				return nil, terr
+			}
+			code, ok := coreValTypes[t.Value]
+			if !ok || code < 0x6F || code > 0x70 {
+				return nil, fmt.Errorf("line %d: invalid table element type %q", t.Line, t.Value)
+			}
+			b.AppendByte(code)
+			b.WriteBytes(limits.Bytes)
+		}
+	case "global":
+		mutable := p.isGroup("mut")
+		if mutable {
+			p.pos += 2
+		}
+		t, terr := p.expect(token.Ident)
+		if terr != nil {
+			return nil, terr
+		}
+		code, ok := coreValTypes[t.Value]
+		if !ok {
+			return nil, fmt.Errorf("line %d: unknown core value type %q", t.Line, t.Value)
+		}
+		b.AppendByte(0x03)
+		b.AppendByte(code)
+		if mutable {
+			b.AppendByte(0x01)
+			err = p.closeGroups(1)
+		} else {
+			b.AppendByte(0x00)
+		}
+	default:
+		err = fmt.Errorf("line %d: unknown core extern kind %q", kw.Line, kw.Value)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return b.Bytes, p.closeGroups(1)
+}
+
+// parseCoreLimits parses the min and optional max of a memory or table.
+func (p *Parser) parseCoreLimits(b *encoder.Buffer) error {
+	var bounds []uint32
+	for t := p.peek(); t != nil && t.Type == token.Number; t = p.peek() {
+		var v uint32
+		if _, err := fmt.Sscan(t.Value, &v); err != nil {
+			return fmt.Errorf("line %d: invalid limit %q", t.Line, t.Value)
+		}
+		p.next()
+		bounds = append(bounds, v)
+	}
+	switch len(bounds) {
+	case 1:
+		b.AppendByte(0x00)
+		b.WriteU32(bounds[0])
+	case 2:
+		b.AppendByte(0x01)
+		b.WriteU32(bounds[0])
+		b.WriteU32(bounds[1])
+	default:
+		return fmt.Errorf("expected min and optional max limits")
+	}
+	return nil
+}
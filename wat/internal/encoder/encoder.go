@@ -24,6 +24,9 @@ func Encode(m *ast.Module) []byte {
 	if len(m.Memories) > 0 {
 		encodeMemorySection(buf, m)
 	}
+	if len(m.Tags) > 0 {
+		encodeTagSection(buf, m)
+	}
 	if len(m.Globals) > 0 {
 		encodeGlobalSection(buf, m)
 	}
@@ -36,8 +39,9 @@ func Encode(m *ast.Module) []byte {
 	if len(m.Elems) > 0 {
 		encodeElemSection(buf, m)
 	}
-	// DataCount must precede Code when passive data segments exist
-	if hasPassiveData(m) && len(m.Code) > 0 {
+	// DataCount must precede Code when passive data segments exist or code
+	// refers to segments by index
+	if (hasPassiveData(m) || usesDataIndex(m)) && len(m.Code) > 0 {
 		encodeDataCountSection(buf, m)
 	}
 	if len(m.Code) > 0 {
@@ -46,6 +50,9 @@ func Encode(m *ast.Module) []byte {
 	if len(m.Data) > 0 {
 		encodeDataSection(buf, m)
 	}
+	if m.Names != nil {
+		encodeNameSection(buf, m)
+	}
 
 	return buf.Bytes
 }
@@ -58,3 +65,20 @@ func hasPassiveData(m *ast.Module) bool {
 	}
 	return false
 }
+
+// usesDataIndex reports whether any function body contains memory.init or
+// data.drop, which require a DataCount section to validate.
+func usesDataIndex(m *ast.Module) bool {
+	for _, body := range m.Code {
+		for _, ins := range body.Code {
+			if ins.Opcode != ast.OpPrefixMisc {
+				continue
+			}
+			if imm, ok := ins.Imm.([]uint32); ok && len(imm) > 0 &&
+				(imm[0] == ast.MiscOpMemoryInit || imm[0] == ast.MiscOpDataDrop) {
+				return true
+			}
+		}
+	}
+	return false
+}
@@ -11,7 +11,8 @@ func EncodeInstr(buf *Buffer, ins ast.Instr) {
 	// Instructions with u32 immediate (br, br_if, call, local.*, global.*)
 	case ast.OpBr, ast.OpBrIf, ast.OpCall, ast.OpReturnCall,
 		ast.OpLocalGet, ast.OpLocalSet, ast.OpLocalTee,
-		ast.OpGlobalGet, ast.OpGlobalSet:
+		ast.OpGlobalGet, ast.OpGlobalSet,
+		ast.OpCallRef, ast.OpReturnCallRef, ast.OpBrOnNull, ast.OpBrOnNonNull, ast.OpThrow:
 		buf.WriteU32(ins.Imm.(uint32))
 
 	case ast.OpI32Const:
@@ -28,11 +29,18 @@ func EncodeInstr(buf *Buffer, ins ast.Instr) {
 
 	// Block types
 	case ast.OpBlock, ast.OpLoop, ast.OpIf:
-		bt := ins.Imm.(ast.BlockType)
-		if bt.TypeIdx >= 0 {
-			buf.WriteI33(int64(bt.TypeIdx))
-		} else {
-			buf.AppendByte(bt.Simple)
+		encodeBlockType(buf, ins.Imm.(ast.BlockType))
+
+	case ast.OpTryTable:
+		tt := ins.Imm.(ast.TryTable)
+		encodeBlockType(buf, tt.BlockType)
+		buf.WriteU32(uint32(len(tt.Catches)))
+		for _, c := range tt.Catches {
+			buf.AppendByte(c.Kind)
+			if c.Kind == ast.CatchKindCatch || c.Kind == ast.CatchKindCatchRef {
+				buf.WriteU32(c.TagIdx)
+			}
+			buf.WriteU32(c.LabelIdx)
 		}
 
 	// Memory operations
@@ -43,14 +51,7 @@ func EncodeInstr(buf *Buffer, ins ast.Instr) {
 		ast.OpI32Store, ast.OpI64Store, ast.OpF32Store, ast.OpF64Store,
 		ast.OpI32Store8, ast.OpI32Store16,
 		ast.OpI64Store8, ast.OpI64Store16, ast.OpI64Store32:
-		ma := ins.Imm.(ast.Memarg)
-		if ma.MemIdx > 0 {
-			buf.WriteU32(ma.Align | 0x40)
-			buf.WriteU32(ma.MemIdx)
-		} else {
-			buf.WriteU32(ma.Align)
-		}
-		buf.WriteU32(ma.Offset)
+		encodeMemarg(buf, ins.Imm.(ast.Memarg))
 
 	case ast.OpMemorySize, ast.OpMemoryGrow:
 		if ins.Imm == nil {
@@ -75,7 +76,13 @@ func EncodeInstr(buf *Buffer, ins ast.Instr) {
 		buf.WriteU32(ins.Imm.(uint32))
 
 	case ast.OpRefNull:
-		buf.AppendByte(ins.Imm.(byte))
+		// Abstract heap types are single bytes; concrete ones type indices
+		switch ht := ins.Imm.(type) {
+		case byte:
+			buf.AppendByte(ht)
+		case int64:
+			buf.WriteI33(ht)
+		}
 
 	case ast.OpRefFunc:
 		buf.WriteU32(ins.Imm.(uint32))
@@ -84,11 +91,70 @@ func EncodeInstr(buf *Buffer, ins ast.Instr) {
 		types := ins.Imm.([]ast.ValType)
 		buf.WriteU32(uint32(len(types)))
 		for _, t := range types {
-			buf.AppendByte(byte(t))
+			encodeValType(buf, t)
 		}
 
 	case ast.OpPrefixMisc:
 		encodeMiscOp(buf, ins.Imm)
+
+	case ast.OpPrefixSIMD:
+		encodeSIMDOp(buf, ins.Imm.(ast.SIMDImm))
+
+	case ast.OpPrefixAtomic:
+		imm := ins.Imm.(ast.AtomicImm)
+		buf.WriteU32(imm.Subop)
+		if imm.Memarg != nil {
+			encodeMemarg(buf, *imm.Memarg)
+		} else {
+			buf.AppendByte(0x00) // atomic.fence ordering
+		}
+
+	case ast.OpPrefixGC:
+		encodeGCOp(buf, ins.Imm.(ast.GCImm))
+	}
+}
+
+func encodeBlockType(buf *Buffer, bt ast.BlockType) {
+	if bt.TypeIdx >= 0 {
+		buf.WriteI33(int64(bt.TypeIdx))
+	} else {
+		buf.AppendByte(bt.Simple)
+	}
+}
+
+func encodeGCOp(buf *Buffer, imm ast.GCImm) {
+	buf.WriteU32(imm.Subop)
+	if imm.CastFlags != nil {
+		buf.AppendByte(*imm.CastFlags)
+	}
+	for _, idx := range imm.Idx {
+		buf.WriteU32(idx)
+	}
+	for _, ht := range imm.HeapTypes {
+		buf.WriteI33(ht)
+	}
+}
+
+// encodeMemarg writes alignment and offset, setting bit 6 of the alignment
+// when an explicit memory index follows.
+func encodeMemarg(buf *Buffer, ma ast.Memarg) {
+	if ma.MemIdx > 0 {
+		buf.WriteU32(ma.Align | 0x40)
+		buf.WriteU32(ma.MemIdx)
+	} else {
+		buf.WriteU32(ma.Align)
+	}
+	buf.WriteU32(ma.Offset)
+}
+
+func encodeSIMDOp(buf *Buffer, imm ast.SIMDImm) {
+	buf.WriteU32(imm.Subop)
+	if imm.Memarg != nil {
+		encodeMemarg(buf, *imm.Memarg)
+	}
+	buf.WriteBytes(imm.Bytes)
+	if imm.Lane != nil {
+		buf.AppendByte(*imm.Lane)
 	}
 }
 
@@ -12,21 +12,102 @@ func writeSection(buf *Buffer, id byte, content *Buffer) {
 
 func encodeTypeSection(buf *Buffer, m *ast.Module) {
 	sec := &Buffer{}
-	sec.WriteU32(uint32(len(m.Types)))
-	for _, ft := range m.Types {
-		sec.AppendByte(ast.FuncTypeMarker)
-		sec.WriteU32(uint32(len(ft.Params)))
-		for _, p := range ft.Params {
-			sec.AppendByte(byte(p))
+	if len(m.TypeDefs) == 0 {
+		sec.WriteU32(uint32(len(m.Types)))
+		for _, ft := range m.Types {
+			encodeFuncType(sec, ft)
 		}
-		sec.WriteU32(uint32(len(ft.Results)))
-		for _, r := range ft.Results {
-			sec.AppendByte(byte(r))
+		writeSection(buf, ast.SectionType, sec)
+		return
+	}
+
+	// The section counts rec groups; a type outside one is its own group
+	groups := &Buffer{}
+	var count uint32
+	for i := 0; i < len(m.TypeDefs); i++ {
+		count++
+		if n := int(m.TypeDefs[i].RecLen); n > 0 {
+			groups.AppendByte(ast.RecTypeMarker)
+			groups.WriteU32(uint32(n))
+			for j := i; j < i+n; j++ {
+				encodeSubType(groups, m.TypeDefs[j], m.Types[j])
+			}
+			i += n - 1
+			continue
 		}
+		encodeSubType(groups, m.TypeDefs[i], m.Types[i])
 	}
+	sec.WriteU32(count)
+	sec.WriteBytes(groups.Bytes)
 	writeSection(buf, ast.SectionType, sec)
 }
 
+func encodeSubType(buf *Buffer, td ast.TypeDef, ft ast.FuncType) {
+	if td.Sub {
+		if td.Final {
+			buf.AppendByte(ast.SubFinalMarker)
+		} else {
+			buf.AppendByte(ast.SubTypeMarker)
+		}
+		buf.WriteU32(uint32(len(td.Parents)))
+		for _, parent := range td.Parents {
+			buf.WriteU32(parent)
+		}
+	}
+	switch td.Kind {
+	case ast.StructTypeMarker:
+		buf.AppendByte(ast.StructTypeMarker)
+		buf.WriteU32(uint32(len(td.Fields)))
+		for _, f := range td.Fields {
+			encodeFieldType(buf, f)
+		}
+	case ast.ArrayTypeMarker:
+		buf.AppendByte(ast.ArrayTypeMarker)
+		encodeFieldType(buf, td.Fields[0])
+	default:
+		encodeFuncType(buf, ft)
+	}
+}
+
+func encodeFuncType(buf *Buffer, ft ast.FuncType) {
+	buf.AppendByte(ast.FuncTypeMarker)
+	buf.WriteU32(uint32(len(ft.Params)))
+	for _, p := range ft.Params {
+		encodeValType(buf, p)
+	}
+	buf.WriteU32(uint32(len(ft.Results)))
+	for _, r := range ft.Results {
+		encodeValType(buf, r)
+	}
+}
+
+func encodeFieldType(buf *Buffer, f ast.FieldType) {
+	encodeValType(buf, f.Type)
+	if f.Mutable {
+		buf.AppendByte(0x01)
+	} else {
+		buf.AppendByte(0x00)
+	}
+}
+
+// encodeValType writes the type code and, for (ref ...) types, the heap type.
+func encodeValType(buf *Buffer, vt ast.ValType) {
+	buf.AppendByte(vt.Code())
+	if code := ast.ValType(vt.Code()); code == ast.ValTypeRef || code == ast.ValTypeRefNull {
+		heapType, _, _ := vt.HeapType()
+		buf.WriteI33(heapType)
+	}
+}
+
+func encodeTableType(buf *Buffer, t ast.Table) {
+	if t.ElemRefType != 0 {
+		encodeValType(buf, t.ElemRefType)
+	} else {
+		buf.AppendByte(t.ElemType)
+	}
+	buf.WriteLimits(t.Limits.Min, t.Limits.Max)
+}
+
 func encodeImportSection(buf *Buffer, m *ast.Module) {
 	sec := &Buffer{}
 	sec.WriteU32(uint32(len(m.Imports)))
@@ -38,20 +119,20 @@ func encodeImportSection(buf *Buffer, m *ast.Module) {
 		case ast.KindFunc:
 			sec.WriteU32(imp.Desc.TypeIdx)
 		case ast.KindTable:
-			tt := imp.Desc.TableTyp
-			sec.AppendByte(tt.ElemType)
-			sec.WriteLimits(tt.Limits.Min, tt.Limits.Max)
+			encodeTableType(sec, *imp.Desc.TableTyp)
 		case ast.KindMemory:
-			lim := imp.Desc.MemLimits
-			sec.WriteLimits(lim.Min, lim.Max)
+			encodeMemLimits(sec, *imp.Desc.MemLimits)
 		case ast.KindGlobal:
 			gt := imp.Desc.GlobalTyp
-			sec.AppendByte(byte(gt.ValType))
+			encodeValType(sec, gt.ValType)
 			if gt.Mutable {
 				sec.AppendByte(0x01)
 			} else {
 				sec.AppendByte(0x00)
 			}
+		case ast.KindTag:
+			sec.AppendByte(0x00) // exception attribute
+			sec.WriteU32(imp.Desc.TypeIdx)
 		}
 	}
 	writeSection(buf, ast.SectionImport, sec)
@@ -70,8 +151,14 @@ func encodeTableSection(buf *Buffer, m *ast.Module) {
 	sec := &Buffer{}
 	sec.WriteU32(uint32(len(m.Tables)))
 	for _, t := range m.Tables {
-		sec.AppendByte(t.ElemType)
-		sec.WriteLimits(t.Limits.Min, t.Limits.Max)
+		if len(t.Init) > 0 {
+			sec.AppendByte(0x40)
+			sec.AppendByte(0x00)
+			encodeTableType(sec, t)
+			encodeExpr(sec, t.Init)
+			continue
+		}
+		encodeTableType(sec, t)
 	}
 	writeSection(buf, ast.SectionTable, sec)
 }
@@ -80,16 +167,43 @@ func encodeMemorySection(buf *Buffer, m *ast.Module) {
 	sec := &Buffer{}
 	sec.WriteU32(uint32(len(m.Memories)))
 	for _, mem := range m.Memories {
-		sec.WriteLimits(mem.Limits.Min, mem.Limits.Max)
+		encodeMemLimits(sec, mem.Limits)
 	}
 	writeSection(buf, ast.SectionMemory, sec)
 }
 
+// encodeMemLimits writes memory limits, whose flags also mark shared memory.
+func encodeMemLimits(buf *Buffer, lim ast.Limits) {
+	if !lim.Shared {
+		buf.WriteLimits(lim.Min, lim.Max)
+		return
+	}
+	flags := ast.LimitsShared
+	if lim.Max != nil {
+		flags |= ast.LimitsHasMax
+	}
+	buf.AppendByte(flags)
+	buf.WriteU32(lim.Min)
+	if lim.Max != nil {
+		buf.WriteU32(*lim.Max)
+	}
+}
+
+func encodeTagSection(buf *Buffer, m *ast.Module) {
+	sec := &Buffer{}
+	sec.WriteU32(uint32(len(m.Tags)))
+	for _, t := range m.Tags {
+		sec.AppendByte(0x00) // exception attribute
+		sec.WriteU32(t.TypeIdx)
+	}
+	writeSection(buf, ast.SectionTag, sec)
+}
+
 func encodeGlobalSection(buf *Buffer, m *ast.Module) {
 	sec := &Buffer{}
 	sec.WriteU32(uint32(len(m.Globals)))
 	for _, g := range m.Globals {
-		sec.AppendByte(byte(g.Type.ValType))
+		encodeValType(sec, g.Type.ValType)
 		if g.Type.Mutable {
 			sec.AppendByte(0x01)
 		} else {
@@ -123,19 +237,34 @@ func encodeElemSection(buf *Buffer, m *ast.Module) {
 	sec := &Buffer{}
 	sec.WriteU32(uint32(len(m.Elems)))
 	for _, e := range m.Elems {
-		hasExprs := len(e.Exprs) > 0
 		refTypeByte := e.RefType
 		if refTypeByte == 0 {
-			refTypeByte = ast.ElemKindFuncref
+			refTypeByte = ast.RefTypeFuncref
+		}
+		// The function index forms only carry funcref, so other reference
+		// types need the expression forms, even for an empty segment
+		hasExprs := len(e.Exprs) > 0 || refTypeByte != ast.RefTypeFuncref
+		exprs := e.Exprs
+		if hasExprs && len(exprs) == 0 {
+			for _, idx := range e.Init {
+				exprs = append(exprs, []ast.Instr{{Opcode: ast.OpRefFunc, Imm: idx}, {Opcode: ast.OpEnd}})
+			}
 		}
 
-		switch e.Mode {
+		// Flags 0 and 4 imply table 0 and funcref; other active segments
+		// name their table
+		mode := e.Mode
+		if mode == ast.ElemModeActive && (e.TableIdx != 0 || refTypeByte != ast.RefTypeFuncref) {
+			mode = ast.ElemModeActiveTable
+		}
+
+		switch mode {
 		case ast.ElemModeActive:
 			if hasExprs {
 				sec.AppendByte(ast.ElemFlagActiveExpr)
 				encodeExpr(sec, e.Offset)
-				sec.WriteU32(uint32(len(e.Exprs)))
-				for _, expr := range e.Exprs {
+				sec.WriteU32(uint32(len(exprs)))
+				for _, expr := range exprs {
 					encodeExpr(sec, expr)
 				}
 			} else {
@@ -150,8 +279,8 @@ func encodeElemSection(buf *Buffer, m *ast.Module) {
 			if hasExprs {
 				sec.AppendByte(ast.ElemFlagPassiveExpr)
 				sec.AppendByte(refTypeByte)
-				sec.WriteU32(uint32(len(e.Exprs)))
-				for _, expr := range e.Exprs {
+				sec.WriteU32(uint32(len(exprs)))
+				for _, expr := range exprs {
 					encodeExpr(sec, expr)
 				}
 			} else {
@@ -168,8 +297,8 @@ func encodeElemSection(buf *Buffer, m *ast.Module) {
 				sec.WriteU32(e.TableIdx)
 				encodeExpr(sec, e.Offset)
 				sec.AppendByte(refTypeByte)
-				sec.WriteU32(uint32(len(e.Exprs)))
-				for _, expr := range e.Exprs {
+				sec.WriteU32(uint32(len(exprs)))
+				for _, expr := range exprs {
 					encodeExpr(sec, expr)
 				}
 			} else {
@@ -186,8 +315,8 @@ func encodeElemSection(buf *Buffer, m *ast.Module) {
 			if hasExprs {
 				sec.AppendByte(ast.ElemFlagDeclarativeExpr)
 				sec.AppendByte(refTypeByte)
-				sec.WriteU32(uint32(len(e.Exprs)))
-				for _, expr := range e.Exprs {
+				sec.WriteU32(uint32(len(exprs)))
+				for _, expr := range exprs {
 					encodeExpr(sec, expr)
 				}
 			} else {
@@ -234,7 +363,7 @@ func encodeCodeSection(buf *Buffer, m *ast.Module) {
 		code.WriteU32(uint32(len(groups)))
 		for _, g := range groups {
 			code.WriteU32(g.count)
-			code.AppendByte(byte(g.vt))
+			encodeValType(code, g.vt)
 		}
 
 		for _, instr := range c.Code {
@@ -272,3 +401,16 @@ func encodeDataSection(buf *Buffer, m *ast.Module) {
 	}
 	writeSection(buf, ast.SectionData, sec)
 }
+
+// encodeNameSection writes the identifiers of the module as the "name"
+// custom section, after all other sections.
+func encodeNameSection(buf *Buffer, m *ast.Module) {
+	data := m.Names.Encode()
+	if len(data) == 0 {
+		return
+	}
+	sec := &Buffer{}
+	sec.WriteString("name")
+	sec.WriteBytes(data)
+	writeSection(buf, ast.SectionCustom, sec)
+}
@@ -0,0 +1,39 @@
+package opcode
+
+import "strings"
+
+// AtomicOp describes a 0xFE-prefixed instruction. All but atomic.fence
+// take a memarg.
+type AtomicOp struct {
+	Subop        uint32
+	Operands     int
+	NaturalAlign uint32
+}
+
+func LookupAtomic(name string) (AtomicOp, bool) {
+	op, ok := atomicOps[name]
+	return op, ok
+}
+
+// atomicOps is derived from atomicNames; operand counts follow from the
+// operation part of the name.
+var atomicOps = func() map[string]AtomicOp {
+	m := make(map[string]AtomicOp, len(atomicNames))
+	for subop, name := range atomicNames {
+		m[name] = AtomicOp{Subop: subop, Operands: atomicOperands(name), NaturalAlign: AtomicNaturalAlign(subop)}
+	}
+	return m
+}()
+
+func atomicOperands(name string) int {
+	switch {
+	case name == "atomic.fence":
+		return 0
+	case strings.HasPrefix(name, "memory.atomic.wait"), strings.Contains(name, ".cmpxchg"):
+		return 3
+	case strings.Contains(name, ".load"):
+		return 1
+	}
+	// notify, stores and read-modify-write ops take an address and a value
+	return 2
+}
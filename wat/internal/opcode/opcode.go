@@ -232,14 +232,44 @@ var table = map[string]Info{
 	// Tail call
 	"return_call": {0x12, -1, ImmU32},
 
+	// Typed function references
+	"call_ref":        {0x14, -1, ImmU32},
+	"return_call_ref": {0x15, -1, ImmU32},
+	"ref.as_non_null": {0xD3, 1, ImmNone},
+	"br_on_null":      {0xD5, -1, ImmU32},
+	"br_on_non_null":  {0xD6, -1, ImmU32},
+
+	// Exception handling
+	"throw":     {0x08, -1, ImmU32},
+	"throw_ref": {0x0A, 1, ImmNone},
+
 	// Memory
 	"memory.size": {0x3F, 0, ImmMemIdx},
 	"memory.grow": {0x40, 1, ImmMemIdx},
 
 	// Reference types
 	"ref.is_null": {0xD1, 1, ImmNone},
+	"ref.eq":      {0xD4, 2, ImmNone},
 }
 
+// LookupGC returns the sub-opcode of a 0xFB-prefixed instruction. For
+// ref.test and ref.cast it is that of the non-nullable form; the nullable
+// form follows it.
+func LookupGC(name string) (uint32, bool) {
+	subop, ok := gcOps[name]
+	return subop, ok
+}
+
+var gcOps = func() map[string]uint32 {
+	m := make(map[string]uint32, len(gcNames))
+	for subop, name := range gcNames {
+		if prev, ok := m[name]; !ok || subop < prev {
+			m[name] = subop
+		}
+	}
+	return m
+}()
+
 var prefixedOps = map[string]PrefixedOp{
 	// Saturating truncation
 	"i32.trunc_sat_f32_s": {0, 1},
@@ -0,0 +1,91 @@
+package opcode
+
+import "strings"
+
+// SIMDImmKind is the immediate shape of a 0xFD-prefixed instruction.
+type SIMDImmKind int
+
+const (
+	SIMDImmNone       SIMDImmKind = iota
+	SIMDImmMemarg                 // v128.load, v128.store, splat and zero loads
+	SIMDImmMemargLane             // v128.load8_lane etc.: memarg then lane index
+	SIMDImmLane                   // extract_lane / replace_lane
+	SIMDImmConst                  // v128.const: shape and lane values
+	SIMDImmShuffle                // i8x16.shuffle: 16 lane indices
+)
+
+type SIMDOp struct {
+	Subop        uint32
+	Operands     int
+	NaturalAlign uint32
+	Imm          SIMDImmKind
+}
+
+func LookupSIMD(name string) (SIMDOp, bool) {
+	op, ok := simdOps[name]
+	return op, ok
+}
+
+// simdOps is derived from simdNames: operand counts follow from the
+// operation part of the name, immediates from the sub-opcode ranges.
+var simdOps = func() map[string]SIMDOp {
+	m := make(map[string]SIMDOp, len(simdNames))
+	for subop, name := range simdNames {
+		op := SIMDOp{Subop: subop, Operands: simdOperands(name), Imm: simdImm(subop)}
+		if op.Imm == SIMDImmMemarg || op.Imm == SIMDImmMemargLane {
+			op.NaturalAlign = SIMDNaturalAlign(subop)
+		}
+		m[name] = op
+	}
+	return m
+}()
+
+func simdImm(subop uint32) SIMDImmKind {
+	switch {
+	case subop <= 0x0B, subop == 0x5C, subop == 0x5D:
+		return SIMDImmMemarg
+	case subop == 0x0C:
+		return SIMDImmConst
+	case subop == 0x0D:
+		return SIMDImmShuffle
+	case subop >= 0x15 && subop <= 0x22:
+		return SIMDImmLane
+	case subop >= 0x54 && subop <= 0x5B:
+		return SIMDImmMemargLane
+	}
+	return SIMDImmNone
+}
+
+func simdOperands(name string) int {
+	_, op, _ := strings.Cut(name, ".")
+	switch {
+	case op == "const":
+		return 0
+	case op == "store", strings.HasSuffix(op, "_lane"):
+		// Stores take an address and a vector; lane loads, lane stores and
+		// replace_lane take a vector and one more operand
+		if strings.HasPrefix(op, "extract_lane") {
+			return 1
+		}
+		return 2
+	case strings.HasPrefix(op, "load"),
+		strings.HasPrefix(op, "extract_lane"),
+		strings.HasPrefix(op, "extend_"),
+		strings.HasPrefix(op, "extadd_pairwise"),
+		strings.HasPrefix(op, "convert"),
+		strings.HasPrefix(op, "trunc_sat"),
+		strings.HasPrefix(op, "relaxed_trunc"),
+		strings.HasPrefix(op, "demote"),
+		strings.HasPrefix(op, "promote"):
+		return 1
+	}
+	switch op {
+	case "splat", "not", "any_true", "all_true", "bitmask", "abs", "neg", "popcnt",
+		"sqrt", "ceil", "floor", "trunc", "nearest":
+		return 1
+	case "bitselect", "relaxed_madd", "relaxed_nmadd", "relaxed_laneselect",
+		"relaxed_dot_i8x16_i7x16_add_s":
+		return 3
+	}
+	return 2
+}
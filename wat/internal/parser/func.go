@@ -12,10 +12,20 @@ func (p *Parser) parseFunc(funcIdx *uint32) error {
 	var name string
 	var exports []string
 	localMap := make(map[string]uint32)
-	var localIdx uint32
 
+	// An explicit (type N) fixes the signature; params and results written
+	// alongside it only name the params and must agree with it.
 	ft := ast.FuncType{}
+	var typeUse *ast.FuncType
+	typeIdx := uint32(0)
+	hasType := false
 	body := ast.FuncBody{}
+	numParams := func() uint32 {
+		if typeUse != nil {
+			return uint32(len(typeUse.Params))
+		}
+		return uint32(len(ft.Params))
+	}
 
 	for {
 		t := p.peek()
@@ -50,17 +60,19 @@ func (p *Parser) parseFunc(funcIdx *uint32) error {
 
 		switch t.Value {
 		case "import":
-			modName, err := p.expect(token.String)
+			modName, err := p.expectName()
 			if err != nil {
 				return err
 			}
-			importName, err := p.expect(token.String)
+			importName, err := p.expectName()
 			if err != nil {
 				return err
 			}
 			if _, err := p.expect(token.RParen); err != nil {
 				return err
 			}
+			var typeIdx uint32
+			var hasType bool
 			for {
 				t := p.peek()
 				if t == nil || t.Type == token.RParen {
@@ -118,9 +130,7 @@ func (p *Parser) parseFunc(funcIdx *uint32) error {
 					if err != nil {
 						return err
 					}
-					if idx < uint32(len(p.mod.Types)) {
-						ft = p.mod.Types[idx]
-					}
+					typeIdx, hasType = idx, true
 					if _, err := p.expect(token.RParen); err != nil {
 						return err
 					}
@@ -128,10 +138,15 @@ func (p *Parser) parseFunc(funcIdx *uint32) error {
 					return fmt.Errorf("unexpected clause in import func: %s", clause.Value)
 				}
 			}
-			typeIdx := p.findOrAddType(ft)
+			// Declarations after a type index restate its signature
+			if hasType && typeIdx < uint32(len(p.mod.Types)) {
+				ft = p.mod.Types[typeIdx]
+			} else if !hasType {
+				typeIdx = p.findOrAddType(ft)
+			}
 			imp := ast.Import{
-				Module: modName.Value,
-				Name:   importName.Value,
+				Module: modName,
+				Name:   importName,
 				Desc: ast.ImportDesc{
 					Kind:    ast.KindFunc,
 					TypeIdx: typeIdx,
@@ -146,11 +161,11 @@ func (p *Parser) parseFunc(funcIdx *uint32) error {
 			return nil
 
 		case "export":
-			exp, err := p.expect(token.String)
+			exp, err := p.expectName()
 			if err != nil {
 				return err
 			}
-			exports = append(exports, exp.Value)
+			exports = append(exports, exp)
 			if _, err := p.expect(token.RParen); err != nil {
 				return err
 			}
@@ -160,10 +175,13 @@ func (p *Parser) parseFunc(funcIdx *uint32) error {
 			if err != nil {
 				return err
 			}
+			// Unknown types are left for validation to reject
 			if idx < uint32(len(p.mod.Types)) {
-				ft = p.mod.Types[idx]
-				localIdx = uint32(len(ft.Params))
+				tu := p.mod.Types[idx]
+				typeUse = &tu
 			}
+			typeIdx = idx
+			hasType = true
 			if _, err := p.expect(token.RParen); err != nil {
 				return err
 			}
@@ -182,8 +200,7 @@ func (p *Parser) parseFunc(funcIdx *uint32) error {
 					if err != nil {
 						return err
 					}
-					localMap[pname] = localIdx
-					localIdx++
+					localMap[pname] = uint32(len(ft.Params))
 					ft.Params = append(ft.Params, vt)
 					continue
 				}
@@ -191,7 +208,6 @@ func (p *Parser) parseFunc(funcIdx *uint32) error {
 				if err != nil {
 					return err
 				}
-				localIdx++
 				ft.Params = append(ft.Params, vt)
 			}
 
@@ -223,8 +239,7 @@ func (p *Parser) parseFunc(funcIdx *uint32) error {
 					if err != nil {
 						return err
 					}
-					localMap[lname] = localIdx
-					localIdx++
+					localMap[lname] = numParams() + uint32(len(body.Locals))
 					body.Locals = append(body.Locals, vt)
 					continue
 				}
@@ -232,7 +247,6 @@ func (p *Parser) parseFunc(funcIdx *uint32) error {
 				if err != nil {
 					return err
 				}
-				localIdx++
 				body.Locals = append(body.Locals, vt)
 			}
 
@@ -255,7 +269,13 @@ func (p *Parser) parseFunc(funcIdx *uint32) error {
 		p.funcMap[name] = *funcIdx
 	}
 
-	typeIdx := p.findOrAddType(ft)
+	if typeUse != nil {
+		if (len(ft.Params) > 0 || len(ft.Results) > 0) && !typeUse.Equal(ft) {
+			return fmt.Errorf("inline signature of function %d does not match type %d", *funcIdx, typeIdx)
+		}
+	} else if !hasType {
+		typeIdx = p.findOrAddType(ft)
+	}
 
 	p.mod.Funcs = append(p.mod.Funcs, ast.FuncEntry{TypeIdx: typeIdx})
 	p.mod.Code = append(p.mod.Code, body)
@@ -264,6 +284,7 @@ func (p *Parser) parseFunc(funcIdx *uint32) error {
 		p.mod.Exports = append(p.mod.Exports, ast.Export{Name: exp, Kind: ast.KindFunc, Idx: *funcIdx})
 	}
 
+	p.endFunc(*funcIdx, localMap)
 	*funcIdx++
 	return nil
 }
@@ -292,25 +313,25 @@ parseTableClauses:
 		}
 		switch clause.Value {
 		case "export":
-			exp, err := p.expect(token.String)
+			exp, err := p.expectName()
 			if err != nil {
 				return err
 			}
-			exportName = exp.Value
+			exportName = exp
 			if _, err := p.expect(token.RParen); err != nil {
 				return err
 			}
 		case "import":
-			modTok, err := p.expect(token.String)
+			modTok, err := p.expectName()
 			if err != nil {
 				return err
 			}
-			nameTok, err := p.expect(token.String)
+			nameTok, err := p.expectName()
 			if err != nil {
 				return err
 			}
-			importMod = modTok.Value
-			importName = nameTok.Value
+			importMod = modTok
+			importName = nameTok
 			if _, err := p.expect(token.RParen); err != nil {
 				return err
 			}
@@ -399,29 +420,18 @@ parseTableClauses:
 		maxVal = &m
 	}
 
-	elemTok, err := p.expect(token.Ident)
-	if err != nil {
-		return err
-	}
-	var elemType byte
-	switch elemTok.Value {
-	case "funcref", "anyfunc":
-		elemType = ast.RefTypeFuncref
-	case "externref":
-		elemType = ast.RefTypeExternref
-	default:
-		return fmt.Errorf("expected funcref or externref, got %s", elemTok.Value)
-	}
-
-	if _, err := p.expect(token.RParen); err != nil {
+	tbl := ast.Table{Limits: ast.Limits{Min: minVal, Max: maxVal}}
+	if err := p.parseTableElemType(&tbl); err != nil {
 		return err
 	}
 
 	if importMod != "" {
+		if _, err := p.expect(token.RParen); err != nil {
+			return err
+		}
 		if name != "" {
 			p.tableMap[name] = uint32(len(p.mod.Tables))
 		}
-		tbl := ast.Table{ElemType: elemType, Limits: ast.Limits{Min: minVal, Max: maxVal}}
 		p.mod.Imports = append(p.mod.Imports, ast.Import{
 			Module: importMod,
 			Name:   importName,
@@ -433,10 +443,22 @@ parseTableClauses:
 		return nil
 	}
 
+	// An initializer expression gives the value of every element
+	if t := p.peek(); t != nil && t.Type == token.LParen {
+		init, err := p.parseInstrs(nil)
+		if err != nil {
+			return err
+		}
+		tbl.Init = append(init, ast.Instr{Opcode: ast.OpEnd})
+	}
+	if _, err := p.expect(token.RParen); err != nil {
+		return err
+	}
+
 	if name != "" {
 		p.tableMap[name] = uint32(len(p.mod.Tables))
 	}
-	p.mod.Tables = append(p.mod.Tables, ast.Table{ElemType: elemType, Limits: ast.Limits{Min: minVal, Max: maxVal}})
+	p.mod.Tables = append(p.mod.Tables, tbl)
 
 	if exportName != "" {
 		p.mod.Exports = append(p.mod.Exports, ast.Export{
@@ -449,6 +471,29 @@ parseTableClauses:
 	return nil
 }
 
+// parseTableElemType parses the reference type of a table. Types with a
+// one-byte code go in ElemType, others in ElemRefType.
+func (p *Parser) parseTableElemType(tbl *ast.Table) error {
+	if t := p.peek(); t != nil && t.Type == token.Ident && t.Value == "anyfunc" {
+		p.next()
+		tbl.ElemType = ast.RefTypeFuncref
+		return nil
+	}
+	vt, err := p.parseValType()
+	if err != nil {
+		return err
+	}
+	if _, _, ok := vt.HeapType(); !ok {
+		return fmt.Errorf("expected reference type for table element")
+	}
+	if vt == ast.ValType(vt.Code()) {
+		tbl.ElemType = vt.Code()
+	} else {
+		tbl.ElemRefType = vt
+	}
+	return nil
+}
+
 func (p *Parser) parseMemory() error {
 	var name string
 	if t := p.peek(); t != nil && t.Type == token.Ident && strings.HasPrefix(t.Value, "$") {
@@ -473,25 +518,25 @@ parseMemClauses:
 		}
 		switch clause.Value {
 		case "export":
-			exp, err := p.expect(token.String)
+			exp, err := p.expectName()
 			if err != nil {
 				return err
 			}
-			exportName = exp.Value
+			exportName = exp
 			if _, err := p.expect(token.RParen); err != nil {
 				return err
 			}
 		case "import":
-			modTok, err := p.expect(token.String)
+			modTok, err := p.expectName()
 			if err != nil {
 				return err
 			}
-			nameTok, err := p.expect(token.String)
+			nameTok, err := p.expectName()
 			if err != nil {
 				return err
 			}
-			importMod = modTok.Value
-			importName = nameTok.Value
+			importMod = modTok
+			importName = nameTok
 			if _, err := p.expect(token.RParen); err != nil {
 				return err
 			}
@@ -519,15 +564,13 @@ parseMemClauses:
 				return err
 			}
 
+			// Inline data sizes the memory exactly, to the page
 			pages := (uint32(len(dataBytes)) + 65535) / 65536
-			if pages == 0 {
-				pages = 1
-			}
 
 			if name != "" {
 				p.memMap[name] = uint32(len(p.mod.Memories))
 			}
-			p.mod.Memories = append(p.mod.Memories, ast.Memory{Limits: ast.Limits{Min: pages, Max: nil}})
+			p.mod.Memories = append(p.mod.Memories, ast.Memory{Limits: ast.Limits{Min: pages, Max: &pages}})
 
 			if exportName != "" {
 				p.mod.Exports = append(p.mod.Exports, ast.Export{
@@ -565,16 +608,27 @@ parseMemClauses:
 		}
 		maxVal = &m
 	}
+	shared := p.parseShared()
 
 	if _, err = p.expect(token.RParen); err != nil {
 		return err
 	}
 
+	// Imported memories come first in the index space
+	idx := p.countImports(ast.KindMemory) + uint32(len(p.mod.Memories))
+	if name != "" {
+		p.memMap[name] = idx
+	}
+	if exportName != "" {
+		p.mod.Exports = append(p.mod.Exports, ast.Export{
+			Name: exportName,
+			Kind: ast.KindMemory,
+			Idx:  idx,
+		})
+	}
+
 	if importMod != "" {
-		if name != "" {
-			p.memMap[name] = uint32(len(p.mod.Memories))
-		}
-		lim := ast.Limits{Min: minVal, Max: maxVal}
+		lim := ast.Limits{Min: minVal, Max: maxVal, Shared: shared}
 		p.mod.Imports = append(p.mod.Imports, ast.Import{
 			Module: importMod,
 			Name:   importName,
@@ -586,23 +640,138 @@ parseMemClauses:
 		return nil
 	}
 
-	if name != "" {
-		p.memMap[name] = uint32(len(p.mod.Memories))
+	p.mod.Memories = append(p.mod.Memories, ast.Memory{Limits: ast.Limits{Min: minVal, Max: maxVal, Shared: shared}})
+
+	return nil
+}
+
+func (p *Parser) parseTag() error {
+	if t := p.peek(); t != nil && t.Type == token.Ident && strings.HasPrefix(t.Value, "$") {
+		p.next()
+	}
+
+	var exportNames []string
+	var importMod, importName string
+parseTagClauses:
+	for {
+		t := p.peek()
+		if t == nil || t.Type != token.LParen {
+			break
+		}
+		saved := p.pos
+		p.next()
+		clause, err := p.expect(token.Ident)
+		if err != nil {
+			p.pos = saved
+			break
+		}
+		switch clause.Value {
+		case "export":
+			exp, err := p.expectName()
+			if err != nil {
+				return err
+			}
+			exportNames = append(exportNames, exp)
+			if _, err := p.expect(token.RParen); err != nil {
+				return err
+			}
+		case "import":
+			modTok, err := p.expectName()
+			if err != nil {
+				return err
+			}
+			nameTok, err := p.expectName()
+			if err != nil {
+				return err
+			}
+			importMod = modTok
+			importName = nameTok
+			if _, err := p.expect(token.RParen); err != nil {
+				return err
+			}
+		default:
+			p.pos = saved
+			break parseTagClauses
+		}
 	}
 
-	p.mod.Memories = append(p.mod.Memories, ast.Memory{Limits: ast.Limits{Min: minVal, Max: maxVal}})
+	typeIdx, err := p.parseTagType()
+	if err != nil {
+		return err
+	}
+	if _, err := p.expect(token.RParen); err != nil {
+		return err
+	}
 
-	if exportName != "" {
-		p.mod.Exports = append(p.mod.Exports, ast.Export{
-			Name: exportName,
-			Kind: ast.KindMemory,
-			Idx:  uint32(len(p.mod.Memories) - 1),
+	if importMod != "" {
+		p.mod.Imports = append(p.mod.Imports, ast.Import{
+			Module: importMod,
+			Name:   importName,
+			Desc:   ast.ImportDesc{Kind: ast.KindTag, TypeIdx: typeIdx},
 		})
+		return nil
 	}
 
+	// Tag imports precede the module's own tags in the index space
+	idx := uint32(len(p.mod.Tags))
+	for _, imp := range p.mod.Imports {
+		if imp.Desc.Kind == ast.KindTag {
+			idx++
+		}
+	}
+	p.mod.Tags = append(p.mod.Tags, ast.Tag{TypeIdx: typeIdx})
+	for _, exportName := range exportNames {
+		p.mod.Exports = append(p.mod.Exports, ast.Export{Name: exportName, Kind: ast.KindTag, Idx: idx})
+	}
 	return nil
 }
 
+// parseTagType parses the type use of a tag: a (type N) reference, an
+// inline signature, or both.
+func (p *Parser) parseTagType() (uint32, error) {
+	var ft ast.FuncType
+	if p.pos+1 < len(p.tokens) && p.tokens[p.pos].Type == token.LParen && p.tokens[p.pos+1].Value == "type" {
+		p.pos += 2
+		idx, err := p.parseIdx(p.typeMap)
+		if err != nil {
+			return 0, err
+		}
+		if _, err := p.expect(token.RParen); err != nil {
+			return 0, err
+		}
+		// An inline signature alongside only restates the type
+		if err := p.parseFuncSig(&ft); err != nil {
+			return 0, err
+		}
+		return idx, nil
+	}
+	if err := p.parseFuncSig(&ft); err != nil {
+		return 0, err
+	}
+	return p.findOrAddType(ft), nil
+}
+
+// parseShared consumes the shared keyword that ends the limits of a
+// shared memory.
+func (p *Parser) parseShared() bool {
+	if t := p.peek(); t != nil && t.Type == token.Ident && t.Value == "shared" {
+		p.next()
+		return true
+	}
+	return false
+}
+
+// countImports returns the number of imports of the given kind seen so far.
+func (p *Parser) countImports(kind byte) uint32 {
+	var n uint32
+	for _, imp := range p.mod.Imports {
+		if imp.Desc.Kind == kind {
+			n++
+		}
+	}
+	return n
+}
+
 func (p *Parser) parseGlobal(globalIdx *uint32) error {
 	var name string
 	if t := p.peek(); t != nil && t.Type == token.Ident && strings.HasPrefix(t.Value, "$") {
@@ -627,25 +796,25 @@ parseGlobalClauses:
 		}
 		switch clause.Value {
 		case "export":
-			exp, err := p.expect(token.String)
+			exp, err := p.expectName()
 			if err != nil {
 				return err
 			}
-			exportName = exp.Value
+			exportName = exp
 			if _, err := p.expect(token.RParen); err != nil {
 				return err
 			}
 		case "import":
-			modTok, err := p.expect(token.String)
+			modTok, err := p.expectName()
 			if err != nil {
 				return err
 			}
-			nameTok, err := p.expect(token.String)
+			nameTok, err := p.expectName()
 			if err != nil {
 				return err
 			}
-			importMod = modTok.Value
-			importName = nameTok.Value
+			importMod = modTok
+			importName = nameTok
 			if _, err := p.expect(token.RParen); err != nil {
 				return err
 			}
@@ -0,0 +1,166 @@
+package parser
+
+import (
+	"fmt"
+
+	"github.com/wippyai/wasm-runtime/wat/internal/ast"
+	"github.com/wippyai/wasm-runtime/wat/internal/token"
+)
+
+// parseGCInstr parses a 0xFB-prefixed instruction after its name. Type,
+// field, data and element immediates may be given by name.
+func (p *Parser) parseGCInstr(name string, subop uint32, localMap map[string]uint32) ([]ast.Instr, error) {
+	imm := ast.GCImm{Subop: subop}
+	idx := func(nameMap map[string]uint32) error {
+		v, err := p.parseIdx(nameMap)
+		if err != nil {
+			return err
+		}
+		imm.Idx = append(imm.Idx, v)
+		return nil
+	}
+
+	var err error
+	switch name {
+	case "struct.new", "struct.new_default", "array.new", "array.new_default",
+		"array.get", "array.get_s", "array.get_u", "array.set", "array.fill":
+		err = idx(p.typeMap)
+
+	case "struct.get", "struct.get_s", "struct.get_u", "struct.set":
+		if err = idx(p.typeMap); err == nil {
+			err = idx(p.fieldMap[imm.Idx[0]])
+		}
+
+	case "array.new_fixed":
+		if err = idx(p.typeMap); err == nil {
+			err = idx(nil)
+		}
+
+	case "array.new_data", "array.init_data":
+		if err = idx(p.typeMap); err == nil {
+			err = idx(p.dataMap)
+		}
+
+	case "array.new_elem", "array.init_elem":
+		if err = idx(p.typeMap); err == nil {
+			err = idx(p.elemMap)
+		}
+
+	case "array.copy":
+		if err = idx(p.typeMap); err == nil {
+			err = idx(p.typeMap)
+		}
+
+	case "ref.test", "ref.cast":
+		// The nullable form is the sub-opcode after the non-nullable one
+		nullable, heapType, perr := p.parseRefTypeParts()
+		if perr != nil {
+			return nil, perr
+		}
+		if nullable {
+			imm.Subop++
+		}
+		imm.HeapTypes = []int64{heapType}
+
+	case "br_on_cast", "br_on_cast_fail":
+		label, perr := p.parseLabelIdx()
+		if perr != nil {
+			return nil, perr
+		}
+		imm.Idx = []uint32{label}
+		var flags byte
+		for bit := byte(1); bit <= 2; bit <<= 1 {
+			nullable, heapType, perr := p.parseRefTypeParts()
+			if perr != nil {
+				return nil, perr
+			}
+			if nullable {
+				flags |= bit
+			}
+			imm.HeapTypes = append(imm.HeapTypes, heapType)
+		}
+		imm.CastFlags = &flags
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	ops, err := p.parseFoldedOperands(localMap)
+	if err != nil {
+		return nil, err
+	}
+	return append(ops, ast.Instr{Opcode: ast.OpPrefixGC, Imm: imm}), nil
+}
+
+// parseTryTable parses try_table after its keyword, through the end of its
+// body. Catch labels are resolved outside the try_table's own label.
+func (p *Parser) parseTryTable(localMap map[string]uint32) ([]ast.Instr, error) {
+	label := p.parseLabel()
+	bt, err := p.parseBlockType()
+	if err != nil {
+		return nil, err
+	}
+
+	tt := ast.TryTable{BlockType: bt}
+	for {
+		if p.pos+1 >= len(p.tokens) || p.tokens[p.pos].Type != token.LParen {
+			break
+		}
+		kind, ok := catchKinds[p.tokens[p.pos+1].Value]
+		if !ok {
+			break
+		}
+		p.pos += 2
+
+		c := ast.Catch{Kind: kind}
+		if kind == ast.CatchKindCatch || kind == ast.CatchKindCatchRef {
+			if c.TagIdx, err = p.parseIdx(p.tagMap); err != nil {
+				return nil, err
+			}
+		}
+		if c.LabelIdx, err = p.parseLabelIdx(); err != nil {
+			return nil, err
+		}
+		if _, err := p.expect(token.RParen); err != nil {
+			return nil, err
+		}
+		tt.Catches = append(tt.Catches, c)
+	}
+
+	instrs := []ast.Instr{{Opcode: ast.OpTryTable, Imm: tt}}
+	p.pushLabel(label)
+	body, err := p.parseInstrs(localMap)
+	p.popLabel()
+	if err != nil {
+		return nil, err
+	}
+	instrs = append(instrs, body...)
+	return append(instrs, ast.Instr{Opcode: ast.OpEnd}), nil
+}
+
+var catchKinds = map[string]byte{
+	"catch":         ast.CatchKindCatch,
+	"catch_ref":     ast.CatchKindCatchRef,
+	"catch_all":     ast.CatchKindCatchAll,
+	"catch_all_ref": ast.CatchKindCatchAllRef,
+}
+
+// parseFoldedOperands parses every folded operand that follows an
+// instruction taking a variable number of them.
+func (p *Parser) parseFoldedOperands(localMap map[string]uint32) ([]ast.Instr, error) {
+	var result []ast.Instr
+	for {
+		if t := p.peek(); t == nil || t.Type != token.LParen {
+			return result, nil
+		}
+		p.next()
+		ops, err := p.parseInstrs(localMap)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, ops...)
+		if _, err := p.expect(token.RParen); err != nil {
+			return nil, err
+		}
+	}
+}
@@ -14,14 +14,61 @@ func (p *Parser) parseU32() (uint32, error) {
 	if err != nil {
 		return 0, err
 	}
-	s := strings.ReplaceAll(t.Value, "_", "")
-	val, err := strconv.ParseUint(s, 0, 32)
+	val, err := parseUint(t.Value, 32)
 	if err != nil {
 		return 0, fmt.Errorf("invalid number: %s", t.Value)
 	}
 	return uint32(val), nil
 }
 
+// parseInt parses an integer literal of bitSize bits: decimal or 0x
+// hexadecimal, with an optional sign and _ between digits. Leading zeros
+// do not make a literal octal. Both the signed and unsigned ranges are
+// accepted; the result is the two's complement bit pattern.
+func parseInt(s string, bitSize int) (uint64, error) {
+	digits := s
+	neg := false
+	if digits != "" && (digits[0] == '-' || digits[0] == '+') {
+		neg = digits[0] == '-'
+		digits = digits[1:]
+	}
+	val, err := parseUint(digits, bitSize)
+	if err != nil || neg && val > 1<<(bitSize-1) {
+		return 0, fmt.Errorf("invalid i%d: %s", bitSize, s)
+	}
+	if neg {
+		val = -val & (math.MaxUint64 >> (64 - bitSize))
+	}
+	return val, nil
+}
+
+// parseUint parses an unsigned integer literal of bitSize bits, as
+// parseInt does without a sign.
+func parseUint(s string, bitSize int) (uint64, error) {
+	digits, base := s, 10
+	if hex, ok := strings.CutPrefix(s, "0x"); ok {
+		digits, base = hex, 16
+	}
+	if !separated(digits, base == 16) {
+		return 0, fmt.Errorf("invalid number: %s", s)
+	}
+	return strconv.ParseUint(strings.ReplaceAll(digits, "_", ""), base, bitSize)
+}
+
+// separated reports whether every _ in the numeral s is between two of
+// its digits.
+func separated(s string, hex bool) bool {
+	digit := func(c byte) bool {
+		return c >= '0' && c <= '9' || hex && (c >= 'a' && c <= 'f' || c >= 'A' && c <= 'F')
+	}
+	for i := 0; i < len(s); i++ {
+		if s[i] == '_' && (i == 0 || i == len(s)-1 || !digit(s[i-1]) || !digit(s[i+1])) {
+			return false
+		}
+	}
+	return true
+}
+
 func (p *Parser) parseF32() (float32, error) {
 	t := p.next()
 	if t == nil {
@@ -38,23 +85,68 @@ func (p *Parser) parseF32() (float32, error) {
 		case "-nan":
 			return float32(math.Float32frombits(0xFFC00000)), nil
 		}
-		if strings.HasPrefix(t.Value, "nan:") || strings.HasPrefix(t.Value, "+nan:") {
-			return float32(math.NaN()), nil
-		}
-		if strings.HasPrefix(t.Value, "-nan:") {
-			return float32(math.Float32frombits(0xFFC00000)), nil
+		if payload, neg, ok := nanPayload(t.Value); ok {
+			if payload == 0 || payload > 0x7FFFFF {
+				return 0, fmt.Errorf("invalid f32 NaN payload: %s", t.Value)
+			}
+			bits := 0x7F800000 | uint32(payload)
+			if neg {
+				bits |= 0x80000000
+			}
+			return math.Float32frombits(bits), nil
 		}
 	}
 	if t.Type != token.Number {
 		return 0, fmt.Errorf("expected float, got %q", t.Value)
 	}
-	val, err := strconv.ParseFloat(t.Value, 32)
+	lit, ok := floatLiteral(t.Value)
+	if !ok {
+		return 0, fmt.Errorf("invalid f32: %s", t.Value)
+	}
+	val, err := strconv.ParseFloat(lit, 32)
 	if err != nil {
 		return 0, fmt.Errorf("invalid f32: %s", t.Value)
 	}
 	return float32(val), nil
 }
 
+// floatLiteral rewrites a float literal in the form strconv.ParseFloat
+// reads: without the _ between digits, and with an exponent on hexadecimal
+// literals, which WAT lets them omit. It reports false if an _ is not
+// between two digits.
+func floatLiteral(s string) (string, bool) {
+	body := strings.TrimLeft(s, "+-")
+	hex := strings.HasPrefix(body, "0x")
+	if !separated(strings.TrimPrefix(body, "0x"), hex) {
+		return "", false
+	}
+	s = strings.ReplaceAll(s, "_", "")
+	if hex && !strings.ContainsAny(s, "pP") {
+		s += "p0"
+	}
+	return s, true
+}
+
+// nanPayload splits a nan:0x... literal into its payload and sign.
+func nanPayload(s string) (payload uint64, neg, ok bool) {
+	switch {
+	case strings.HasPrefix(s, "-"):
+		neg = true
+		s = s[1:]
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	}
+	rest, found := strings.CutPrefix(s, "nan:0x")
+	if !found {
+		return 0, false, false
+	}
+	payload, err := strconv.ParseUint(strings.ReplaceAll(rest, "_", ""), 16, 64)
+	if err != nil {
+		return 0, false, false
+	}
+	return payload, neg, true
+}
+
 func (p *Parser) parseF64() (float64, error) {
 	t := p.next()
 	if t == nil {
@@ -63,7 +155,7 @@ func (p *Parser) parseF64() (float64, error) {
 	if t.Type == token.Ident {
 		switch t.Value {
 		case "nan", "+nan":
-			return math.NaN(), nil
+			return math.Float64frombits(0x7FF8000000000000), nil
 		case "inf", "+inf":
 			return math.Inf(1), nil
 		case "-inf":
@@ -71,17 +163,25 @@ func (p *Parser) parseF64() (float64, error) {
 		case "-nan":
 			return math.Float64frombits(0xFFF8000000000000), nil
 		}
-		if strings.HasPrefix(t.Value, "nan:") || strings.HasPrefix(t.Value, "+nan:") {
-			return math.NaN(), nil
-		}
-		if strings.HasPrefix(t.Value, "-nan:") {
-			return math.Float64frombits(0xFFF8000000000000), nil
+		if payload, neg, ok := nanPayload(t.Value); ok {
+			if payload == 0 || payload > 0xFFFFFFFFFFFFF {
+				return 0, fmt.Errorf("invalid f64 NaN payload: %s", t.Value)
+			}
+			bits := 0x7FF0000000000000 | payload
+			if neg {
+				bits |= 0x8000000000000000
+			}
+			return math.Float64frombits(bits), nil
 		}
 	}
 	if t.Type != token.Number {
 		return 0, fmt.Errorf("expected float, got %q", t.Value)
 	}
-	val, err := strconv.ParseFloat(t.Value, 64)
+	lit, ok := floatLiteral(t.Value)
+	if !ok {
+		return 0, fmt.Errorf("invalid f64: %s", t.Value)
+	}
+	val, err := strconv.ParseFloat(lit, 64)
 	if err != nil {
 		return 0, fmt.Errorf("invalid f64: %s", t.Value)
 	}
@@ -2,7 +2,7 @@ package parser
 
 import (
 	"fmt"
-	"strconv"
+	"math/bits"
 	"strings"
 
 	"github.com/wippyai/wasm-runtime/wat/internal/ast"
@@ -128,6 +128,33 @@ func (p *Parser) parseInstrs(localMap map[string]uint32) ([]ast.Instr, error) {
 			continue
 		}
 
+		if simdOp, ok := opcode.LookupSIMD(name); ok {
+			result, err := p.parseSIMDInstr(simdOp, localMap)
+			if err != nil {
+				return nil, err
+			}
+			instrs = append(instrs, result...)
+			continue
+		}
+
+		if atomicOp, ok := opcode.LookupAtomic(name); ok {
+			result, err := p.parseAtomicInstr(atomicOp, localMap)
+			if err != nil {
+				return nil, err
+			}
+			instrs = append(instrs, result...)
+			continue
+		}
+
+		if subop, ok := opcode.LookupGC(name); ok {
+			result, err := p.parseGCInstr(name, subop, localMap)
+			if err != nil {
+				return nil, err
+			}
+			instrs = append(instrs, result...)
+			continue
+		}
+
 		switch name {
 		case "block", "loop":
 			label := p.parseLabel()
@@ -156,21 +183,25 @@ func (p *Parser) parseInstrs(localMap map[string]uint32) ([]ast.Instr, error) {
 				return nil, err
 			}
 
-			// Check for folded condition: (if (condition) ...)
-			if t := p.peek(); t != nil && t.Type == token.LParen {
+			// Folded condition: (if (condition)* (then ...) ...)
+			for {
+				t := p.peek()
+				if t == nil || t.Type != token.LParen {
+					break
+				}
 				saved := p.pos
 				p.next()
 				if nt := p.peek(); nt != nil && nt.Type == token.Ident && (nt.Value == "then" || nt.Value == "result" || nt.Value == "param") {
 					p.pos = saved
-				} else {
-					cond, err := p.parseInstrs(localMap)
-					if err != nil {
-						return nil, err
-					}
-					instrs = append(instrs, cond...)
-					if _, err := p.expect(token.RParen); err != nil {
-						return nil, err
-					}
+					break
+				}
+				cond, err := p.parseInstrs(localMap)
+				if err != nil {
+					return nil, err
+				}
+				instrs = append(instrs, cond...)
+				if _, err := p.expect(token.RParen); err != nil {
+					return nil, err
 				}
 			}
 
@@ -248,6 +279,9 @@ func (p *Parser) parseInstrs(localMap map[string]uint32) ([]ast.Instr, error) {
 		case "end":
 			// In flat form, end terminates the enclosing block/loop/if/func.
 			// Return immediately - the caller adds OpEnd.
+			if err := p.parseEndLabel(); err != nil {
+				return nil, err
+			}
 			return instrs, nil
 
 		case "call_indirect", "return_call_indirect":
@@ -335,18 +369,7 @@ func (p *Parser) parseInstrs(localMap map[string]uint32) ([]ast.Instr, error) {
 				}
 			}
 			if !hasType && (len(inlineParams) > 0 || len(inlineResults) > 0) {
-				ft := ast.FuncType{Params: inlineParams, Results: inlineResults}
-				for i, t := range p.mod.Types {
-					if t.Equal(ft) {
-						typeIdx = uint32(i)
-						hasType = true
-						break
-					}
-				}
-				if !hasType {
-					typeIdx = uint32(len(p.mod.Types))
-					p.mod.Types = append(p.mod.Types, ft)
-				}
+				typeIdx = p.findOrAddType(ast.FuncType{Params: inlineParams, Results: inlineResults})
 			}
 
 			for {
@@ -450,20 +473,18 @@ func (p *Parser) parseInstrs(localMap map[string]uint32) ([]ast.Instr, error) {
 			instrs = append(instrs, ast.Instr{Opcode: ast.OpTableSet, Imm: idx})
 
 		case "ref.null":
-			t, err := p.expect(token.Ident)
+			ins, err := p.parseRefNull()
 			if err != nil {
 				return nil, err
 			}
-			var heapType byte
-			switch t.Value {
-			case "func", "funcref":
-				heapType = ast.RefTypeFuncref
-			case "extern", "externref":
-				heapType = ast.RefTypeExternref
-			default:
-				return nil, fmt.Errorf("unknown heap type: %s", t.Value)
+			instrs = append(instrs, ins)
+
+		case "try_table":
+			result, err := p.parseTryTable(localMap)
+			if err != nil {
+				return nil, err
 			}
-			instrs = append(instrs, ast.Instr{Opcode: ast.OpRefNull, Imm: heapType})
+			instrs = append(instrs, result...)
 
 		case "ref.func":
 			idx, err := p.parseIdx(p.funcMap)
@@ -524,24 +545,14 @@ func (p *Parser) parseSimpleInstr(name string, info opcode.Info, localMap map[st
 	var imm interface{}
 	switch info.ImmType {
 	case opcode.ImmU32:
-		if name == "br" || name == "br_if" {
-			t := p.peek()
-			if t != nil && t.Type == token.Ident && strings.HasPrefix(t.Value, "$") {
-				labelName := t.Value
-				p.next()
-				if depth, ok := p.resolveLabel(labelName); ok {
-					imm = depth
-				} else {
-					return nil, fmt.Errorf("unknown label: %s", labelName)
-				}
-			} else {
-				idx, err := p.parseU32()
-				if err != nil {
-					return nil, err
-				}
-				imm = idx
+		switch name {
+		case "br", "br_if", "br_on_null", "br_on_non_null":
+			depth, err := p.parseLabelIdx()
+			if err != nil {
+				return nil, err
 			}
-		} else {
+			imm = depth
+		default:
 			var nameMap map[string]uint32
 			switch name {
 			case "local.get", "local.set", "local.tee":
@@ -550,6 +561,10 @@ func (p *Parser) parseSimpleInstr(name string, info opcode.Info, localMap map[st
 				nameMap = p.globalMap
 			case "call", "return_call":
 				nameMap = p.funcMap
+			case "call_ref", "return_call_ref":
+				nameMap = p.typeMap
+			case "throw":
+				nameMap = p.tagMap
 			}
 			idx, err := p.parseIdx(nameMap)
 			if err != nil {
@@ -563,34 +578,22 @@ func (p *Parser) parseSimpleInstr(name string, info opcode.Info, localMap map[st
 		if err != nil {
 			return nil, err
 		}
-		s := strings.ReplaceAll(t.Value, "_", "")
-		val, err := strconv.ParseInt(s, 0, 32)
+		val, err := parseInt(t.Value, 32)
 		if err != nil {
-			uval, err2 := strconv.ParseUint(s, 0, 32)
-			if err2 != nil {
-				return nil, fmt.Errorf("invalid i32: %s", t.Value)
-			}
-			imm = int32(uval)
-		} else {
-			imm = int32(val)
+			return nil, err
 		}
+		imm = int32(val)
 
 	case opcode.ImmI64:
 		t, err := p.expect(token.Number)
 		if err != nil {
 			return nil, err
 		}
-		s := strings.ReplaceAll(t.Value, "_", "")
-		val, err := strconv.ParseInt(s, 0, 64)
+		val, err := parseInt(t.Value, 64)
 		if err != nil {
-			uval, err2 := strconv.ParseUint(s, 0, 64)
-			if err2 != nil {
-				return nil, fmt.Errorf("invalid i64: %s", t.Value)
-			}
-			imm = int64(uval)
-		} else {
-			imm = val
+			return nil, err
 		}
+		imm = int64(val)
 
 	case opcode.ImmF32:
 		val, err := p.parseF32()
@@ -623,7 +626,7 @@ func (p *Parser) parseSimpleInstr(name string, info opcode.Info, localMap map[st
 			}
 		} else if t != nil && t.Type == token.Number {
 			p.next()
-			val, err := strconv.ParseUint(t.Value, 0, 32)
+			val, err := parseUint(t.Value, 32)
 			if err != nil {
 				return nil, fmt.Errorf("invalid memory index: %s", t.Value)
 			}
@@ -666,7 +669,25 @@ func (p *Parser) parseSimpleInstr(name string, info opcode.Info, localMap map[st
 func (p *Parser) parseMemoryInstr(memOp opcode.MemoryOp, localMap map[string]uint32) ([]ast.Instr, error) {
 	var result []ast.Instr
 
-	ma := ast.Memarg{Align: memOp.NaturalAlign, Offset: 0}
+	ma, err := p.parseMemarg(memOp.NaturalAlign)
+	if err != nil {
+		return nil, err
+	}
+
+	ops, err := p.parseOperands(localMap, memOp.Operands)
+	if err != nil {
+		return nil, err
+	}
+	result = append(result, ops...)
+
+	result = append(result, ast.Instr{Opcode: memOp.Opcode, Imm: ma})
+	return result, nil
+}
+
+// parseMemarg parses an optional memory index followed by optional
+// offset= and align= fields.
+func (p *Parser) parseMemarg(naturalAlign uint32) (ast.Memarg, error) {
+	ma := ast.Memarg{Align: naturalAlign, Offset: 0}
 
 	// Check for optional memory index (multi-memory)
 	if t := p.peek(); t != nil {
@@ -679,9 +700,9 @@ func (p *Parser) parseMemoryInstr(memOp opcode.MemoryOp, localMap map[string]uin
 			isMemIdx := next == nil || next.Type == token.LParen || next.Type == token.RParen ||
 				(next.Type == token.Ident && !strings.HasPrefix(next.Value, "$"))
 			if isMemIdx {
-				idx, err := strconv.ParseUint(t.Value, 0, 32)
+				idx, err := parseUint(t.Value, 32)
 				if err != nil {
-					return nil, fmt.Errorf("invalid memory index: %s", t.Value)
+					return ma, fmt.Errorf("invalid memory index: %s", t.Value)
 				}
 				ma.MemIdx = uint32(idx)
 			} else {
@@ -696,6 +717,14 @@ func (p *Parser) parseMemoryInstr(memOp opcode.MemoryOp, localMap map[string]uin
 		}
 	}
 
+	if err := p.parseMemargFields(&ma); err != nil {
+		return ma, err
+	}
+	return ma, nil
+}
+
+// parseMemargFields parses the offset= and align= fields of a memarg.
+func (p *Parser) parseMemargFields(ma *ast.Memarg) error {
 	for {
 		t := p.peek()
 		if t == nil || t.Type != token.Ident {
@@ -703,42 +732,41 @@ func (p *Parser) parseMemoryInstr(memOp opcode.MemoryOp, localMap map[string]uin
 		}
 		if strings.HasPrefix(t.Value, "offset=") {
 			p.next()
-			offset, err := strconv.ParseUint(t.Value[7:], 0, 32)
+			offset, err := parseUint(t.Value[7:], 32)
 			if err != nil {
-				return nil, fmt.Errorf("invalid offset: %s", t.Value)
+				return fmt.Errorf("invalid offset: %s", t.Value)
 			}
 			ma.Offset = uint32(offset)
 		} else if strings.HasPrefix(t.Value, "align=") {
 			p.next()
-			align, err := strconv.ParseUint(t.Value[6:], 0, 32)
-			if err != nil {
-				return nil, fmt.Errorf("invalid align: %s", t.Value)
-			}
-			switch align {
-			case 1:
-				ma.Align = 0
-			case 2:
-				ma.Align = 1
-			case 4:
-				ma.Align = 2
-			case 8:
-				ma.Align = 3
-			default:
-				ma.Align = uint32(align)
+			align, err := parseUint(t.Value[6:], 32)
+			if err != nil || align == 0 || align&(align-1) != 0 {
+				return fmt.Errorf("invalid align: %s", t.Value)
 			}
+			ma.Align = uint32(bits.TrailingZeros64(align))
 		} else {
 			break
 		}
 	}
+	return nil
+}
 
-	ops, err := p.parseOperands(localMap, memOp.Operands)
+// parseAtomicInstr parses a 0xFE-prefixed instruction: a memarg for all
+// but atomic.fence, then its folded operands.
+func (p *Parser) parseAtomicInstr(op opcode.AtomicOp, localMap map[string]uint32) ([]ast.Instr, error) {
+	imm := ast.AtomicImm{Subop: op.Subop}
+	if op.Operands > 0 {
+		ma, err := p.parseMemarg(op.NaturalAlign)
+		if err != nil {
+			return nil, err
+		}
+		imm.Memarg = &ma
+	}
+	ops, err := p.parseOperands(localMap, op.Operands)
 	if err != nil {
 		return nil, err
 	}
-	result = append(result, ops...)
-
-	result = append(result, ast.Instr{Opcode: memOp.Opcode, Imm: ma})
-	return result, nil
+	return append(ops, ast.Instr{Opcode: ast.OpPrefixAtomic, Imm: imm}), nil
 }
 
 func (p *Parser) parseOperands(localMap map[string]uint32, count int) ([]ast.Instr, error) {
@@ -761,6 +789,7 @@ func (p *Parser) parseOperands(localMap map[string]uint32, count int) ([]ast.Ins
 
 func (p *Parser) parseBlockType() (ast.BlockType, error) {
 	bt := ast.BlockType{Simple: ast.BlockTypeEmpty, TypeIdx: -1}
+	hasType := false
 
 parseBlockLoop:
 	for {
@@ -786,12 +815,7 @@ parseBlockLoop:
 				return bt, err
 			}
 			bt.TypeIdx = int32(idx)
-			if int(idx) < len(p.mod.Types) {
-				ft := p.mod.Types[idx]
-				bt.Params = ft.Params
-				bt.Results = ft.Results
-			}
-			return bt, nil
+			hasType = true
 		case "param":
 			for {
 				if pt := p.peek(); pt == nil || pt.Type == token.RParen {
@@ -826,20 +850,24 @@ parseBlockLoop:
 		}
 	}
 
+	// Declarations after a type index restate its signature
+	if hasType {
+		if int(bt.TypeIdx) < len(p.mod.Types) {
+			ft := p.mod.Types[bt.TypeIdx]
+			bt.Params = ft.Params
+			bt.Results = ft.Results
+		}
+		return bt, nil
+	}
+
+	// A single result is written as its type code unless it needs a heap
+	// type, which only a type index can express
 	if len(bt.Params) == 0 && len(bt.Results) == 0 {
 		bt.Simple = ast.BlockTypeEmpty
-	} else if len(bt.Params) == 0 && len(bt.Results) == 1 {
-		bt.Simple = byte(bt.Results[0])
+	} else if len(bt.Params) == 0 && len(bt.Results) == 1 && bt.Results[0] == ast.ValType(bt.Results[0].Code()) {
+		bt.Simple = bt.Results[0].Code()
 	} else {
-		ft := ast.FuncType{Params: bt.Params, Results: bt.Results}
-		for i, t := range p.mod.Types {
-			if t.Equal(ft) {
-				bt.TypeIdx = int32(i)
-				return bt, nil
-			}
-		}
-		bt.TypeIdx = int32(len(p.mod.Types))
-		p.mod.Types = append(p.mod.Types, ft)
+		bt.TypeIdx = int32(p.findOrAddType(ast.FuncType{Params: bt.Params, Results: bt.Results}))
 	}
 
 	return bt, nil
@@ -857,11 +885,17 @@ func (p *Parser) parseIfBody(localMap map[string]uint32) ([]ast.Instr, error) {
 		if t.Type == token.Ident {
 			if t.Value == "else" {
 				p.next()
+				if err := p.parseEndLabel(); err != nil {
+					return nil, err
+				}
 				instrs = append(instrs, ast.Instr{Opcode: ast.OpElse})
 				continue
 			}
 			if t.Value == "end" {
 				p.next()
+				if err := p.parseEndLabel(); err != nil {
+					return nil, err
+				}
 				return instrs, nil
 			}
 		}
@@ -901,6 +935,9 @@ func (p *Parser) parseFlatInstr(name string, localMap map[string]uint32) ([]ast.
 	case "block", "loop", "if":
 		return p.parseFlatBlock(name, localMap)
 
+	case "try_table":
+		return p.parseTryTable(localMap)
+
 	case "ref.null":
 		ins, err := p.parseRefNull()
 		if err != nil {
@@ -970,6 +1007,18 @@ func (p *Parser) parseFlatInstr(name string, localMap map[string]uint32) ([]ast.
 		return p.parsePrefixedInstr(name, prefOp, localMap)
 	}
 
+	if simdOp, ok := opcode.LookupSIMD(name); ok {
+		return p.parseSIMDInstr(simdOp, localMap)
+	}
+
+	if atomicOp, ok := opcode.LookupAtomic(name); ok {
+		return p.parseAtomicInstr(atomicOp, localMap)
+	}
+
+	if subop, ok := opcode.LookupGC(name); ok {
+		return p.parseGCInstr(name, subop, localMap)
+	}
+
 	return nil, fmt.Errorf("unknown instruction: %s", name)
 }
 
@@ -1110,7 +1159,7 @@ func (p *Parser) parsePrefixedInstr(name string, prefOp opcode.PrefixedOp, local
 			saved := p.pos
 			p.next()
 			if next := p.peek(); next != nil && next.Type == token.LParen {
-				val, err := strconv.ParseUint(t.Value, 0, 32)
+				val, err := parseUint(t.Value, 32)
 				if err != nil {
 					return nil, fmt.Errorf("invalid memory index: %s", t.Value)
 				}
@@ -9,18 +9,19 @@ import (
 )
 
 func (p *Parser) parseRefNull() (ast.Instr, error) {
-	t, err := p.expect(token.Ident)
+	// The reference type names are accepted for the abstract heap types
+	if t := p.peek(); t != nil && t.Type == token.Ident {
+		if vt, ok := refTypeAbbrevs[t.Value]; ok {
+			p.next()
+			return ast.Instr{Opcode: ast.OpRefNull, Imm: vt.Code()}, nil
+		}
+	}
+	heapType, err := p.parseHeapType()
 	if err != nil {
 		return ast.Instr{}, err
 	}
-	var heapType byte
-	switch t.Value {
-	case "func", "funcref":
-		heapType = ast.RefTypeFuncref
-	case "extern", "externref":
-		heapType = ast.RefTypeExternref
-	default:
-		return ast.Instr{}, fmt.Errorf("unknown heap type: %s", t.Value)
+	if heapType < 0 {
+		return ast.Instr{Opcode: ast.OpRefNull, Imm: ast.RefType(true, heapType).Code()}, nil
 	}
 	return ast.Instr{Opcode: ast.OpRefNull, Imm: heapType}, nil
 }
@@ -193,22 +194,39 @@ parseLoop:
 		}
 	}
 	if !hasType && (len(inlineParams) > 0 || len(inlineResults) > 0) {
-		ft := ast.FuncType{Params: inlineParams, Results: inlineResults}
-		for i, t := range p.mod.Types {
-			if t.Equal(ft) {
-				typeIdx = uint32(i)
-				hasType = true
-				break
-			}
-		}
-		if !hasType {
-			typeIdx = uint32(len(p.mod.Types))
-			p.mod.Types = append(p.mod.Types, ft)
-		}
+		typeIdx = p.findOrAddType(ast.FuncType{Params: inlineParams, Results: inlineResults})
 	}
 	return tableIdx, typeIdx, nil
 }
 
+// parseEndLabel parses the label that may follow else and end, which must
+// repeat the label of the block they close.
+func (p *Parser) parseEndLabel() error {
+	t := p.peek()
+	if t == nil || t.Type != token.Ident || !strings.HasPrefix(t.Value, "$") {
+		return nil
+	}
+	p.next()
+	if len(p.labels) == 0 || p.labels[len(p.labels)-1] != t.Value {
+		return fmt.Errorf("mismatching label %s", t.Value)
+	}
+	return nil
+}
+
+// parseLabelIdx parses a branch target given by label name or depth.
+func (p *Parser) parseLabelIdx() (uint32, error) {
+	t := p.peek()
+	if t != nil && t.Type == token.Ident && strings.HasPrefix(t.Value, "$") {
+		p.next()
+		depth, ok := p.resolveLabel(t.Value)
+		if !ok {
+			return 0, fmt.Errorf("unknown label: %s", t.Value)
+		}
+		return depth, nil
+	}
+	return p.parseU32()
+}
+
 func (p *Parser) parseLabel() string {
 	t := p.peek()
 	if t != nil && t.Type == token.Ident && strings.HasPrefix(t.Value, "$") {
@@ -2,67 +2,18 @@ package parser
 
 import (
 	"fmt"
-	"strconv"
 	"strings"
 
 	"github.com/wippyai/wasm-runtime/wat/internal/ast"
 	"github.com/wippyai/wasm-runtime/wat/internal/token"
 )
 
-func (p *Parser) prescanTypes() {
-	saved := p.pos
-	depth := 0
-
-	for p.pos < len(p.tokens) {
-		t := &p.tokens[p.pos]
-		if t.Type == token.LParen {
-			depth++
-			p.pos++
-			if p.pos < len(p.tokens) && p.tokens[p.pos].Type == token.Ident && p.tokens[p.pos].Value == "type" {
-				p.pos++
-				if p.pos < len(p.tokens) && p.tokens[p.pos].Type == token.Ident && strings.HasPrefix(p.tokens[p.pos].Value, "$") {
-					name := p.tokens[p.pos].Value
-					p.pos++
-					if p.pos < len(p.tokens) && p.tokens[p.pos].Type == token.LParen {
-						p.pos++
-						if p.pos < len(p.tokens) && p.tokens[p.pos].Type == token.Ident && p.tokens[p.pos].Value == "func" {
-							p.pos++
-							ft := ast.FuncType{}
-							_ = p.parseFuncSig(&ft) // Error handled by later parsing
-							p.typeMap[name] = uint32(len(p.mod.Types))
-							p.mod.Types = append(p.mod.Types, ft)
-						}
-					}
-				}
-				for p.pos < len(p.tokens) && depth > 0 {
-					switch p.tokens[p.pos].Type {
-					case token.LParen:
-						depth++
-					case token.RParen:
-						depth--
-					}
-					p.pos++
-				}
-				continue
-			}
-		} else if t.Type == token.RParen {
-			depth--
-			if depth <= 0 {
-				break
-			}
-		}
-		p.pos++
-	}
-
-	p.pos = saved
-}
-
 // prescanNames collects all module-level names before the main parsing pass
 // to support forward references in WAT
 func (p *Parser) prescanNames() {
 	saved := p.pos
 	depth := 0
-	var funcIdx, globalIdx, tableIdx, memIdx, dataIdx, elemIdx uint32
+	var funcIdx, globalIdx, tableIdx, memIdx, dataIdx, elemIdx, tagIdx uint32
 
 	for p.pos < len(p.tokens) {
 		t := &p.tokens[p.pos]
@@ -99,6 +50,8 @@ func (p *Parser) prescanNames() {
 									p.tableMap[name] = tableIdx
 								case "memory":
 									p.memMap[name] = memIdx
+								case "tag":
+									p.tagMap[name] = tagIdx
 								}
 							}
 							switch kind {
@@ -110,6 +63,8 @@ func (p *Parser) prescanNames() {
 								tableIdx++
 							case "memory":
 								memIdx++
+							case "tag":
+								tagIdx++
 							}
 							break
 						}
@@ -200,6 +155,23 @@ func (p *Parser) prescanNames() {
 				}
 				continue
 
+			case "tag":
+				if p.pos < len(p.tokens) && p.tokens[p.pos].Type == token.Ident && strings.HasPrefix(p.tokens[p.pos].Value, "$") {
+					p.tagMap[p.tokens[p.pos].Value] = tagIdx
+				}
+				tagIdx++
+				localDepth := 1
+				for p.pos < len(p.tokens) && localDepth > 0 {
+					switch p.tokens[p.pos].Type {
+					case token.LParen:
+						localDepth++
+					case token.RParen:
+						localDepth--
+					}
+					p.pos++
+				}
+				continue
+
 			case "data":
 				if p.pos < len(p.tokens) && p.tokens[p.pos].Type == token.Ident && strings.HasPrefix(p.tokens[p.pos].Value, "$") {
 					p.dataMap[p.tokens[p.pos].Value] = dataIdx
@@ -275,13 +247,16 @@ func (p *Parser) parseModule() (*ast.Module, error) {
 		return nil, fmt.Errorf("expected 'module', got %q", t.Value)
 	}
 
+	var id string
 	if t := p.peek(); t != nil && t.Type == token.Ident && strings.HasPrefix(t.Value, "$") {
-		p.next()
+		id = p.next().Value
 	}
 
 	p.mod = &ast.Module{}
 	p.prescanNames()
-	p.prescanTypes()
+	if err := p.prescanTypes(); err != nil {
+		return nil, err
+	}
 
 	var funcIdx uint32
 	var globalIdx uint32
@@ -298,6 +273,13 @@ func (p *Parser) parseModule() (*ast.Module, error) {
 			break
 		}
 
+		// Type definitions were parsed by prescanTypes
+		if t.Type == token.LParen && p.pos+1 < len(p.tokens) &&
+			(p.tokens[p.pos+1].Value == "type" || p.tokens[p.pos+1].Value == "rec") {
+			p.skipGroup()
+			continue
+		}
+
 		if _, err = p.expect(token.LParen); err != nil {
 			return nil, err
 		}
@@ -307,10 +289,6 @@ func (p *Parser) parseModule() (*ast.Module, error) {
 		}
 
 		switch t.Value {
-		case "type":
-			if err := p.parseType(); err != nil {
-				return nil, err
-			}
 		case "import":
 			if err := p.parseImport(&funcIdx, &globalIdx, &tableIdx, &memIdx); err != nil {
 				return nil, err
@@ -327,6 +305,10 @@ func (p *Parser) parseModule() (*ast.Module, error) {
 			if err := p.parseMemory(); err != nil {
 				return nil, err
 			}
+		case "tag":
+			if err := p.parseTag(); err != nil {
+				return nil, err
+			}
 		case "global":
 			if err := p.parseGlobal(&globalIdx); err != nil {
 				return nil, err
@@ -352,49 +334,10 @@ func (p *Parser) parseModule() (*ast.Module, error) {
 		}
 	}
 
+	p.mod.Names = p.collectNames(id)
 	return p.mod, nil
 }
 
-func (p *Parser) parseType() error {
-	var name string
-	if t := p.peek(); t != nil && t.Type == token.Ident && strings.HasPrefix(t.Value, "$") {
-		name = t.Value
-		p.next()
-	}
-
-	if _, err := p.expect(token.LParen); err != nil {
-		return err
-	}
-	t, err := p.expect(token.Ident)
-	if err != nil {
-		return err
-	}
-	if t.Value != "func" {
-		return fmt.Errorf("expected 'func' in type definition")
-	}
-
-	ft := ast.FuncType{}
-	if err := p.parseFuncSig(&ft); err != nil {
-		return err
-	}
-
-	if _, err := p.expect(token.RParen); err != nil {
-		return err
-	}
-	if _, err := p.expect(token.RParen); err != nil {
-		return err
-	}
-
-	if name != "" {
-		if _, exists := p.typeMap[name]; exists {
-			return nil
-		}
-		p.typeMap[name] = uint32(len(p.mod.Types))
-	}
-	p.mod.Types = append(p.mod.Types, ft)
-	return nil
-}
-
 func (p *Parser) parseFuncSig(ft *ast.FuncType) error {
 	for {
 		t := p.peek()
@@ -462,11 +405,11 @@ func (p *Parser) parseResults(ft *ast.FuncType) error {
 }
 
 func (p *Parser) parseImport(funcIdx, globalIdx, tableIdx, memIdx *uint32) error {
-	modName, err := p.expect(token.String)
+	modName, err := p.expectName()
 	if err != nil {
 		return err
 	}
-	name, err := p.expect(token.String)
+	name, err := p.expectName()
 	if err != nil {
 		return err
 	}
@@ -479,7 +422,7 @@ func (p *Parser) parseImport(funcIdx, globalIdx, tableIdx, memIdx *uint32) error
 		return err
 	}
 
-	imp := ast.Import{Module: modName.Value, Name: name.Value}
+	imp := ast.Import{Module: modName, Name: name}
 
 	switch t.Value {
 	case "func":
@@ -505,25 +448,25 @@ func (p *Parser) parseImport(funcIdx, globalIdx, tableIdx, memIdx *uint32) error
 				if _, err := p.expect(token.RParen); err != nil {
 					return err
 				}
-				if int(typeIdx) >= len(p.mod.Types) {
-					return fmt.Errorf("type index %d out of range", typeIdx)
+				// Unknown types are left for validation to reject
+				if int(typeIdx) < len(p.mod.Types) {
+					ft = p.mod.Types[typeIdx]
 				}
-				ft = p.mod.Types[typeIdx]
 				imp.Desc.TypeIdx = typeIdx
 			} else {
 				p.pos = saved
 				if err := p.parseFuncSig(&ft); err != nil {
 					return err
 				}
-				p.mod.Types = append(p.mod.Types, ft)
-				imp.Desc.TypeIdx = uint32(len(p.mod.Types) - 1)
+				imp.Desc.TypeIdx = uint32(len(p.mod.Types))
+				p.addType(ft, plainFuncType)
 			}
 		} else {
 			if err := p.parseFuncSig(&ft); err != nil {
 				return err
 			}
-			p.mod.Types = append(p.mod.Types, ft)
-			imp.Desc.TypeIdx = uint32(len(p.mod.Types) - 1)
+			imp.Desc.TypeIdx = uint32(len(p.mod.Types))
+			p.addType(ft, plainFuncType)
 		}
 
 		if _, err := p.expect(token.RParen); err != nil {
@@ -594,7 +537,7 @@ func (p *Parser) parseImport(funcIdx, globalIdx, tableIdx, memIdx *uint32) error
 		if err != nil {
 			return err
 		}
-		minVal, err := strconv.ParseUint(minTok.Value, 0, 32)
+		minVal, err := parseUint(minTok.Value, 32)
 		if err != nil {
 			return fmt.Errorf("invalid memory min: %s", minTok.Value)
 		}
@@ -602,13 +545,14 @@ func (p *Parser) parseImport(funcIdx, globalIdx, tableIdx, memIdx *uint32) error
 
 		if tok := p.peek(); tok != nil && tok.Type == token.Number {
 			maxTok := p.next()
-			maxVal, err := strconv.ParseUint(maxTok.Value, 0, 32)
+			maxVal, err := parseUint(maxTok.Value, 32)
 			if err != nil {
 				return fmt.Errorf("invalid memory max: %s", maxTok.Value)
 			}
 			maxU32 := uint32(maxVal)
 			lim.Max = &maxU32
 		}
+		lim.Shared = p.parseShared()
 
 		if _, err := p.expect(token.RParen); err != nil {
 			return err
@@ -633,7 +577,7 @@ func (p *Parser) parseImport(funcIdx, globalIdx, tableIdx, memIdx *uint32) error
 		if err != nil {
 			return err
 		}
-		minVal, err := strconv.ParseUint(minTok.Value, 0, 32)
+		minVal, err := parseUint(minTok.Value, 32)
 		if err != nil {
 			return fmt.Errorf("invalid table min: %s", minTok.Value)
 		}
@@ -641,7 +585,7 @@ func (p *Parser) parseImport(funcIdx, globalIdx, tableIdx, memIdx *uint32) error
 
 		if tok := p.peek(); tok != nil && tok.Type == token.Number {
 			maxTok := p.next()
-			maxVal, err := strconv.ParseUint(maxTok.Value, 0, 32)
+			maxVal, err := parseUint(maxTok.Value, 32)
 			if err != nil {
 				return fmt.Errorf("invalid table max: %s", maxTok.Value)
 			}
@@ -649,31 +593,36 @@ func (p *Parser) parseImport(funcIdx, globalIdx, tableIdx, memIdx *uint32) error
 			lim.Max = &maxU32
 		}
 
-		elemTyp, err := p.expect(token.Ident)
-		if err != nil {
+		tbl := ast.Table{Limits: lim}
+		if err := p.parseTableElemType(&tbl); err != nil {
 			return err
 		}
-		var elemByte byte
-		switch elemTyp.Value {
-		case "funcref":
-			elemByte = ast.RefTypeFuncref
-		case "externref":
-			elemByte = ast.RefTypeExternref
-		default:
-			return fmt.Errorf("expected funcref or externref, got %s", elemTyp.Value)
-		}
 
 		if _, err := p.expect(token.RParen); err != nil {
 			return err
 		}
 
 		imp.Desc.Kind = ast.KindTable
-		imp.Desc.TableTyp = &ast.Table{ElemType: elemByte, Limits: lim}
+		imp.Desc.TableTyp = &tbl
 		if tname != "" {
 			p.tableMap[tname] = *tableIdx
 		}
 		*tableIdx++
 
+	case "tag":
+		if tok := p.peek(); tok != nil && tok.Type == token.Ident && strings.HasPrefix(tok.Value, "$") {
+			p.next()
+		}
+		typeIdx, err := p.parseTagType()
+		if err != nil {
+			return err
+		}
+		if _, err := p.expect(token.RParen); err != nil {
+			return err
+		}
+		imp.Desc.Kind = ast.KindTag
+		imp.Desc.TypeIdx = typeIdx
+
 	default:
 		return fmt.Errorf("unsupported import kind: %s", t.Value)
 	}
@@ -700,7 +649,7 @@ func (p *Parser) parseStart() error {
 }
 
 func (p *Parser) parseExport() error {
-	name, err := p.expect(token.String)
+	name, err := p.expectName()
 	if err != nil {
 		return err
 	}
@@ -729,6 +678,9 @@ func (p *Parser) parseExport() error {
 	case "global":
 		kindByte = ast.KindGlobal
 		nameMap = p.globalMap
+	case "tag":
+		kindByte = ast.KindTag
+		nameMap = p.tagMap
 	default:
 		return fmt.Errorf("unknown export kind: %s", kind.Value)
 	}
@@ -745,6 +697,6 @@ func (p *Parser) parseExport() error {
 		return err
 	}
 
-	p.mod.Exports = append(p.mod.Exports, ast.Export{Name: name.Value, Kind: kindByte, Idx: idx})
+	p.mod.Exports = append(p.mod.Exports, ast.Export{Name: name, Kind: kindByte, Idx: idx})
 	return nil
 }
@@ -0,0 +1,59 @@
+package parser
+
+import (
+	"strings"
+
+	"github.com/wippyai/wasm-runtime/wasm"
+)
+
+// collectNames gathers the identifiers resolved while parsing into the
+// contents of the name section. id is the module's own $id, if any.
+func (p *Parser) collectNames(id string) *wasm.Names {
+	n := &wasm.Names{
+		Module:   identifierName(id),
+		Funcs:    idNames(p.funcMap),
+		Locals:   p.localNames,
+		Labels:   p.labelNames,
+		Types:    idNames(p.typeMap),
+		Tables:   idNames(p.tableMap),
+		Memories: idNames(p.memMap),
+		Globals:  idNames(p.globalMap),
+		Elems:    idNames(p.elemMap),
+		Data:     idNames(p.dataMap),
+		Tags:     idNames(p.tagMap),
+		Fields:   make(wasm.IndirectNameMap),
+	}
+	for typeIdx, fields := range p.fieldMap {
+		if names := idNames(fields); len(names) > 0 {
+			n.Fields[typeIdx] = names
+		}
+	}
+	return n
+}
+
+// endFunc records the local and label names of function funcIdx and
+// resets the label count for the next one.
+func (p *Parser) endFunc(funcIdx uint32, localMap map[string]uint32) {
+	if names := idNames(localMap); len(names) > 0 {
+		p.localNames[funcIdx] = names
+	}
+	if len(p.funcLabels) > 0 {
+		p.labelNames[funcIdx] = p.funcLabels
+	}
+	p.funcLabels = nil
+	p.blocks = 0
+}
+
+// idNames inverts a map of $identifiers to indexes.
+func idNames(ids map[string]uint32) wasm.NameMap {
+	names := make(wasm.NameMap, len(ids))
+	for id, idx := range ids {
+		names[idx] = identifierName(id)
+	}
+	return names
+}
+
+// identifierName is the name an $identifier gives in the name section.
+func identifierName(id string) string {
+	return strings.TrimPrefix(id, "$")
+}
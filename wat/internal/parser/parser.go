@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/wippyai/wasm-runtime/wasm"
 	"github.com/wippyai/wasm-runtime/wat/internal/ast"
 	"github.com/wippyai/wasm-runtime/wat/internal/token"
 )
@@ -17,9 +18,20 @@ type Parser struct {
 	tableMap  map[string]uint32
 	elemMap   map[string]uint32
 	dataMap   map[string]uint32
-	tokens    []token.Token
-	labels    []string
-	pos       int
+	tagMap    map[string]uint32
+	// fieldMap holds the field names of each struct type
+	fieldMap map[uint32]map[string]uint32
+	// localNames and labelNames hold the identifiers of the locals and
+	// labels of each function, for the name section
+	localNames wasm.IndirectNameMap
+	labelNames wasm.IndirectNameMap
+	tokens     []token.Token
+	labels     []string
+	// funcLabels names the labels of the function being parsed by their
+	// index, which blocks counts
+	funcLabels wasm.NameMap
+	blocks     uint32
+	pos        int
 }
 
 func New(tokens []token.Token) *Parser {
@@ -32,6 +44,11 @@ func New(tokens []token.Token) *Parser {
 		tableMap:  make(map[string]uint32),
 		elemMap:   make(map[string]uint32),
 		dataMap:   make(map[string]uint32),
+		tagMap:    make(map[string]uint32),
+		fieldMap:  make(map[uint32]map[string]uint32),
+
+		localNames: make(wasm.IndirectNameMap),
+		labelNames: make(wasm.IndirectNameMap),
 	}
 }
 
@@ -66,8 +83,27 @@ func (p *Parser) expect(typ token.Type) (*token.Token, error) {
 	return t, nil
 }
 
+// expectName parses a string naming an import or export, with its escapes
+// decoded.
+func (p *Parser) expectName() (string, error) {
+	t, err := p.expect(token.String)
+	if err != nil {
+		return "", err
+	}
+	return string(DecodeStringLiteral(t.Value)), nil
+}
+
+// pushLabel enters a block. Every block of a function takes the next
+// label index of the name section, so unnamed ones are counted too.
 func (p *Parser) pushLabel(name string) {
 	p.labels = append(p.labels, name)
+	if name != "" {
+		if p.funcLabels == nil {
+			p.funcLabels = make(wasm.NameMap)
+		}
+		p.funcLabels[p.blocks] = identifierName(name)
+	}
+	p.blocks++
 }
 
 func (p *Parser) popLabel() {
@@ -86,6 +122,9 @@ func (p *Parser) resolveLabel(name string) (uint32, bool) {
 }
 
 func (p *Parser) parseValType() (ast.ValType, error) {
+	if t := p.peek(); t != nil && t.Type == token.LParen {
+		return p.parseRefType()
+	}
 	t, err := p.expect(token.Ident)
 	if err != nil {
 		return 0, err
@@ -99,15 +138,102 @@ func (p *Parser) parseValType() (ast.ValType, error) {
 		return ast.ValTypeF32, nil
 	case "f64":
 		return ast.ValTypeF64, nil
-	case "funcref":
-		return ast.ValTypeFuncref, nil
-	case "externref":
-		return ast.ValTypeExternref, nil
+	case "v128":
+		return ast.ValTypeV128, nil
 	default:
+		if vt, ok := refTypeAbbrevs[t.Value]; ok {
+			return vt, nil
+		}
 		return 0, fmt.Errorf("unknown value type: %s", t.Value)
 	}
 }
 
+var refTypeAbbrevs = map[string]ast.ValType{
+	"funcref":       ast.ValTypeFuncref,
+	"externref":     ast.ValTypeExternref,
+	"anyref":        ast.ValTypeAnyref,
+	"eqref":         ast.ValTypeEqref,
+	"i31ref":        ast.ValTypeI31ref,
+	"structref":     ast.ValTypeStructref,
+	"arrayref":      ast.ValTypeArrayref,
+	"exnref":        ast.ValTypeExnref,
+	"nullref":       ast.ValTypeNullref,
+	"nullfuncref":   ast.ValTypeNullfuncref,
+	"nullexternref": ast.ValTypeNullexternref,
+	"nullexnref":    ast.ValTypeNullexnref,
+}
+
+var abstractHeapTypes = map[string]int64{
+	"func":     ast.HeapTypeFunc,
+	"extern":   ast.HeapTypeExtern,
+	"any":      ast.HeapTypeAny,
+	"eq":       ast.HeapTypeEq,
+	"i31":      ast.HeapTypeI31,
+	"struct":   ast.HeapTypeStruct,
+	"array":    ast.HeapTypeArray,
+	"exn":      ast.HeapTypeExn,
+	"none":     ast.HeapTypeNone,
+	"nofunc":   ast.HeapTypeNoFunc,
+	"noextern": ast.HeapTypeNoExtern,
+	"noexn":    ast.HeapTypeNoExn,
+}
+
+// parseRefType parses (ref null? heaptype).
+func (p *Parser) parseRefType() (ast.ValType, error) {
+	if _, err := p.expect(token.LParen); err != nil {
+		return 0, err
+	}
+	t, err := p.expect(token.Ident)
+	if err != nil {
+		return 0, err
+	}
+	if t.Value != "ref" {
+		return 0, fmt.Errorf("line %d: expected value type, got %q", t.Line, t.Value)
+	}
+	nullable := false
+	if t := p.peek(); t != nil && t.Type == token.Ident && t.Value == "null" {
+		p.next()
+		nullable = true
+	}
+	heapType, err := p.parseHeapType()
+	if err != nil {
+		return 0, err
+	}
+	if _, err := p.expect(token.RParen); err != nil {
+		return 0, err
+	}
+	return ast.RefType(nullable, heapType), nil
+}
+
+// parseRefTypeParts parses a reference type in full or abbreviated form
+// and splits it into nullability and heap type.
+func (p *Parser) parseRefTypeParts() (nullable bool, heapType int64, err error) {
+	vt, err := p.parseValType()
+	if err != nil {
+		return false, 0, err
+	}
+	heapType, nullable, ok := vt.HeapType()
+	if !ok {
+		return false, 0, fmt.Errorf("expected reference type")
+	}
+	return nullable, heapType, nil
+}
+
+// parseHeapType parses an abstract heap type or a type index.
+func (p *Parser) parseHeapType() (int64, error) {
+	if t := p.peek(); t != nil && t.Type == token.Ident {
+		if ht, ok := abstractHeapTypes[t.Value]; ok {
+			p.next()
+			return ht, nil
+		}
+	}
+	idx, err := p.parseIdx(p.typeMap)
+	if err != nil {
+		return 0, fmt.Errorf("expected heap type: %w", err)
+	}
+	return int64(idx), nil
+}
+
 func (p *Parser) parseIdx(nameMap map[string]uint32) (uint32, error) {
 	t := p.peek()
 	if t == nil {
@@ -128,13 +254,31 @@ func (p *Parser) parseIdx(nameMap map[string]uint32) (uint32, error) {
 	return p.parseU32()
 }
 
+// findOrAddType returns the index of the first plain function type equal
+// to ft, appending one if there is none.
 func (p *Parser) findOrAddType(ft ast.FuncType) uint32 {
 	for i, t := range p.mod.Types {
-		if t.Equal(ft) {
+		if t.Equal(ft) && (p.mod.TypeDefs == nil || p.mod.TypeDefs[i].Plain()) {
 			return uint32(i)
 		}
 	}
 	idx := uint32(len(p.mod.Types))
-	p.mod.Types = append(p.mod.Types, ft)
+	p.addType(ft, plainFuncType)
 	return idx
 }
+
+var plainFuncType = ast.TypeDef{Kind: ast.FuncTypeMarker, Final: true}
+
+// addType appends a type, keeping Module.TypeDefs in step with Types once
+// the module declares a type that is not a plain function type.
+func (p *Parser) addType(ft ast.FuncType, def ast.TypeDef) {
+	if p.mod.TypeDefs == nil && def.Plain() {
+		p.mod.Types = append(p.mod.Types, ft)
+		return
+	}
+	for len(p.mod.TypeDefs) < len(p.mod.Types) {
+		p.mod.TypeDefs = append(p.mod.TypeDefs, plainFuncType)
+	}
+	p.mod.Types = append(p.mod.Types, ft)
+	p.mod.TypeDefs = append(p.mod.TypeDefs, def)
+}
@@ -0,0 +1,163 @@
+package parser
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strings"
+
+	"github.com/wippyai/wasm-runtime/wat/internal/ast"
+	"github.com/wippyai/wasm-runtime/wat/internal/opcode"
+	"github.com/wippyai/wasm-runtime/wat/internal/token"
+)
+
+func (p *Parser) parseSIMDInstr(op opcode.SIMDOp, localMap map[string]uint32) ([]ast.Instr, error) {
+	imm := ast.SIMDImm{Subop: op.Subop}
+
+	switch op.Imm {
+	case opcode.SIMDImmMemarg:
+		ma, err := p.parseMemarg(op.NaturalAlign)
+		if err != nil {
+			return nil, err
+		}
+		imm.Memarg = &ma
+
+	case opcode.SIMDImmMemargLane:
+		ma, err := p.parseLaneMemarg(op.NaturalAlign)
+		if err != nil {
+			return nil, err
+		}
+		imm.Memarg = &ma
+		lane, err := p.parseLaneIdx()
+		if err != nil {
+			return nil, err
+		}
+		imm.Lane = &lane
+
+	case opcode.SIMDImmLane:
+		lane, err := p.parseLaneIdx()
+		if err != nil {
+			return nil, err
+		}
+		imm.Lane = &lane
+
+	case opcode.SIMDImmConst:
+		v, err := p.parseV128Const()
+		if err != nil {
+			return nil, err
+		}
+		imm.Bytes = v
+
+	case opcode.SIMDImmShuffle:
+		imm.Bytes = make([]byte, 16)
+		for i := range imm.Bytes {
+			lane, err := p.parseLaneIdx()
+			if err != nil {
+				return nil, err
+			}
+			imm.Bytes[i] = lane
+		}
+	}
+
+	ops, err := p.parseOperands(localMap, op.Operands)
+	if err != nil {
+		return nil, err
+	}
+	return append(ops, ast.Instr{Opcode: ast.OpPrefixSIMD, Imm: imm}), nil
+}
+
+// parseLaneMemarg parses the memarg of a lane load or store. A lane index
+// always follows, so a leading number is a memory index only when another
+// number or a memarg field comes after it.
+func (p *Parser) parseLaneMemarg(naturalAlign uint32) (ast.Memarg, error) {
+	ma := ast.Memarg{Align: naturalAlign}
+	if t := p.peek(); t != nil {
+		switch {
+		case t.Type == token.Ident && strings.HasPrefix(t.Value, "$"):
+			idx, ok := p.memMap[t.Value]
+			if !ok {
+				return ma, fmt.Errorf("unknown memory: %s", t.Value)
+			}
+			p.next()
+			ma.MemIdx = idx
+		case t.Type == token.Number && p.pos+1 < len(p.tokens):
+			next := p.tokens[p.pos+1]
+			if next.Type == token.Number ||
+				(next.Type == token.Ident && (strings.HasPrefix(next.Value, "offset=") || strings.HasPrefix(next.Value, "align="))) {
+				idx, err := p.parseU32()
+				if err != nil {
+					return ma, err
+				}
+				ma.MemIdx = idx
+			}
+		}
+	}
+	if err := p.parseMemargFields(&ma); err != nil {
+		return ma, err
+	}
+	return ma, nil
+}
+
+func (p *Parser) parseLaneIdx() (byte, error) {
+	idx, err := p.parseU32()
+	if err != nil {
+		return 0, fmt.Errorf("expected lane index: %w", err)
+	}
+	if idx > math.MaxUint8 {
+		return 0, fmt.Errorf("lane index out of range: %d", idx)
+	}
+	return byte(idx), nil
+}
+
+// parseV128Const parses the shape and lane values of v128.const into the
+// 16 little-endian bytes of the constant.
+func (p *Parser) parseV128Const() ([]byte, error) {
+	shape, err := p.expect(token.Ident)
+	if err != nil {
+		return nil, err
+	}
+	v := make([]byte, 16)
+	switch shape.Value {
+	case "i8x16", "i16x8", "i32x4", "i64x2":
+		laneBits := map[string]int{"i8x16": 8, "i16x8": 16, "i32x4": 32, "i64x2": 64}[shape.Value]
+		laneBytes := laneBits / 8
+		for i := 0; i < 16; i += laneBytes {
+			lane, err := p.parseIntBits(laneBits)
+			if err != nil {
+				return nil, err
+			}
+			var buf [8]byte
+			binary.LittleEndian.PutUint64(buf[:], lane)
+			copy(v[i:i+laneBytes], buf[:laneBytes])
+		}
+	case "f32x4":
+		for i := 0; i < 16; i += 4 {
+			lane, err := p.parseF32()
+			if err != nil {
+				return nil, err
+			}
+			binary.LittleEndian.PutUint32(v[i:], math.Float32bits(lane))
+		}
+	case "f64x2":
+		for i := 0; i < 16; i += 8 {
+			lane, err := p.parseF64()
+			if err != nil {
+				return nil, err
+			}
+			binary.LittleEndian.PutUint64(v[i:], math.Float64bits(lane))
+		}
+	default:
+		return nil, fmt.Errorf("unknown v128 shape: %s", shape.Value)
+	}
+	return v, nil
+}
+
+// parseIntBits parses a signed or unsigned integer that fits in the given
+// number of bits, returning its two's complement bit pattern.
+func (p *Parser) parseIntBits(bitSize int) (uint64, error) {
+	t, err := p.expect(token.Number)
+	if err != nil {
+		return 0, err
+	}
+	return parseInt(t.Value, bitSize)
+}
@@ -0,0 +1,261 @@
+package parser
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wippyai/wasm-runtime/wat/internal/ast"
+	"github.com/wippyai/wasm-runtime/wat/internal/token"
+)
+
+// prescanTypes parses the type and rec fields of the module ahead of the
+// other fields. Types are numbered in declaration order ahead of any added
+// implicitly by type uses, and every field may refer to any of them.
+func (p *Parser) prescanTypes() error {
+	saved := p.pos
+	defer func() { p.pos = saved }()
+
+	// Name all types first: a type may refer to any other, not only to
+	// those declared before it
+	var starts []int
+	var idx uint32
+	for {
+		t := p.peek()
+		if t == nil || t.Type != token.LParen || p.pos+1 >= len(p.tokens) {
+			break
+		}
+		start := p.pos
+		switch p.tokens[p.pos+1].Value {
+		case "type":
+			p.nameType(start+2, idx)
+			idx++
+			starts = append(starts, start)
+		case "rec":
+			p.pos += 2
+			for t := p.peek(); t != nil && t.Type == token.LParen; t = p.peek() {
+				p.nameType(p.pos+2, idx)
+				idx++
+				p.skipGroup()
+			}
+			p.pos = start
+			starts = append(starts, start)
+		}
+		p.skipGroup()
+	}
+
+	for _, start := range starts {
+		p.pos = start + 2
+		var err error
+		if p.tokens[start+1].Value == "rec" {
+			err = p.parseRec()
+		} else {
+			err = p.parseTypeDef(false)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// nameType records the identifier of the type definition at pos, if any.
+func (p *Parser) nameType(pos int, idx uint32) {
+	if pos < len(p.tokens) && p.tokens[pos].Type == token.Ident && strings.HasPrefix(p.tokens[pos].Value, "$") {
+		if _, exists := p.typeMap[p.tokens[pos].Value]; !exists {
+			p.typeMap[p.tokens[pos].Value] = idx
+		}
+	}
+}
+
+// skipGroup moves past the parenthesized group starting at the current
+// token.
+func (p *Parser) skipGroup() {
+	depth := 0
+	for p.pos < len(p.tokens) {
+		switch p.tokens[p.pos].Type {
+		case token.LParen:
+			depth++
+		case token.RParen:
+			depth--
+		}
+		p.pos++
+		if depth == 0 {
+			return
+		}
+	}
+}
+
+// parseRec parses the type definitions of a rec group.
+func (p *Parser) parseRec() error {
+	first := len(p.mod.Types)
+	for {
+		t := p.peek()
+		if t == nil {
+			return fmt.Errorf("unexpected end in rec")
+		}
+		if t.Type == token.RParen {
+			p.next()
+			break
+		}
+		if _, err := p.expect(token.LParen); err != nil {
+			return err
+		}
+		kw, err := p.expect(token.Ident)
+		if err != nil {
+			return err
+		}
+		if kw.Value != "type" {
+			return fmt.Errorf("expected 'type' in rec group, got %q", kw.Value)
+		}
+		if err := p.parseTypeDef(true); err != nil {
+			return err
+		}
+	}
+	if n := len(p.mod.Types) - first; n > 0 {
+		p.mod.TypeDefs[first].RecLen = uint32(n)
+	}
+	return nil
+}
+
+// parseTypeDef parses a type definition after its 'type' keyword.
+func (p *Parser) parseTypeDef(inRec bool) error {
+	if t := p.peek(); t != nil && t.Type == token.Ident && strings.HasPrefix(t.Value, "$") {
+		p.next()
+	}
+
+	def := ast.TypeDef{Final: true, InRec: inRec}
+	if _, err := p.expect(token.LParen); err != nil {
+		return err
+	}
+	kw, err := p.expect(token.Ident)
+	if err != nil {
+		return err
+	}
+	if kw.Value == "sub" {
+		def.Sub = true
+		def.Final = false
+		if t := p.peek(); t != nil && t.Type == token.Ident && t.Value == "final" {
+			p.next()
+			def.Final = true
+		}
+		for t := p.peek(); t != nil && t.Type != token.LParen && t.Type != token.RParen; t = p.peek() {
+			parent, err := p.parseIdx(p.typeMap)
+			if err != nil {
+				return err
+			}
+			def.Parents = append(def.Parents, parent)
+		}
+		if _, err := p.expect(token.LParen); err != nil {
+			return err
+		}
+		if kw, err = p.expect(token.Ident); err != nil {
+			return err
+		}
+	}
+
+	ft := ast.FuncType{}
+	switch kw.Value {
+	case "func":
+		def.Kind = ast.FuncTypeMarker
+		if err := p.parseFuncSig(&ft); err != nil {
+			return err
+		}
+	case "struct":
+		def.Kind = ast.StructTypeMarker
+		if err := p.parseStructFields(&def); err != nil {
+			return err
+		}
+	case "array":
+		def.Kind = ast.ArrayTypeMarker
+		f, err := p.parseFieldType()
+		if err != nil {
+			return err
+		}
+		def.Fields = []ast.FieldType{f}
+	default:
+		return fmt.Errorf("expected func, struct or array in type definition, got %q", kw.Value)
+	}
+
+	closing := 2
+	if def.Sub {
+		closing = 3
+	}
+	for i := 0; i < closing; i++ {
+		if _, err := p.expect(token.RParen); err != nil {
+			return err
+		}
+	}
+
+	p.addType(ft, def)
+	return nil
+}
+
+// parseStructFields parses (field $name? fieldtype) and (field fieldtype*)
+// clauses, recording field names for struct.get and struct.set.
+func (p *Parser) parseStructFields(def *ast.TypeDef) error {
+	typeIdx := uint32(len(p.mod.Types))
+	for {
+		t := p.peek()
+		if t == nil || t.Type != token.LParen {
+			return nil
+		}
+		p.next()
+		kw, err := p.expect(token.Ident)
+		if err != nil {
+			return err
+		}
+		if kw.Value != "field" {
+			return fmt.Errorf("expected 'field' in struct type, got %q", kw.Value)
+		}
+		if t := p.peek(); t != nil && t.Type == token.Ident && strings.HasPrefix(t.Value, "$") {
+			p.next()
+			if p.fieldMap[typeIdx] == nil {
+				p.fieldMap[typeIdx] = make(map[string]uint32)
+			}
+			p.fieldMap[typeIdx][t.Value] = uint32(len(def.Fields))
+		}
+		for t := p.peek(); t != nil && t.Type != token.RParen; t = p.peek() {
+			f, err := p.parseFieldType()
+			if err != nil {
+				return err
+			}
+			def.Fields = append(def.Fields, f)
+		}
+		if _, err := p.expect(token.RParen); err != nil {
+			return err
+		}
+	}
+}
+
+// parseFieldType parses a storage type, optionally wrapped in (mut ...).
+func (p *Parser) parseFieldType() (ast.FieldType, error) {
+	var f ast.FieldType
+	mutable := p.pos+1 < len(p.tokens) && p.tokens[p.pos].Type == token.LParen &&
+		p.tokens[p.pos+1].Type == token.Ident && p.tokens[p.pos+1].Value == "mut"
+	if mutable {
+		p.pos += 2
+		f.Mutable = true
+	}
+
+	switch t := p.peek(); {
+	case t != nil && t.Type == token.Ident && t.Value == "i8":
+		p.next()
+		f.Type = ast.ValTypeI8
+	case t != nil && t.Type == token.Ident && t.Value == "i16":
+		p.next()
+		f.Type = ast.ValTypeI16
+	default:
+		vt, err := p.parseValType()
+		if err != nil {
+			return f, err
+		}
+		f.Type = vt
+	}
+
+	if mutable {
+		if _, err := p.expect(token.RParen); err != nil {
+			return f, err
+		}
+	}
+	return f, nil
+}
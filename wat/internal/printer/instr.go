@@ -0,0 +1,684 @@
+package printer
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+
+	"github.com/wippyai/wasm-runtime/wasm"
+	"github.com/wippyai/wasm-runtime/wat/internal/opcode"
+)
+
+// node is one folded instruction. Plain instructions carry the operands
+// folded into them as children; structured instructions carry their
+// bodies, one per clause.
+type node struct {
+	text     string
+	children []*node
+	clauses  []clause
+	results  int // values left on the stack, -1 when unknown
+}
+
+// clause is a labelled body of a structured instruction, such as the then
+// arm of an if or a catch handler of a try.
+type clause struct {
+	head string
+	body []*node
+}
+
+// body prints a function body at the given depth.
+func (p *printer) body(instrs []wasm.Instruction, depth int) error {
+	if !p.opts.Folded {
+		return p.flat(instrs, depth)
+	}
+	pos := 0
+	seq, term, err := p.fold(instrs, &pos)
+	if err != nil {
+		return err
+	}
+	if term != nil {
+		return fmt.Errorf("unexpected %s", p.mnemonic(term))
+	}
+	for _, n := range seq {
+		p.node(n, depth)
+	}
+	return p.err
+}
+
+// flat prints instrs one per line, indenting block bodies.
+func (p *printer) flat(instrs []wasm.Instruction, depth int) error {
+	for i := range instrs {
+		in := &instrs[i]
+		switch in.Opcode {
+		case wasm.OpBlock, wasm.OpLoop, wasm.OpIf, wasm.OpTry, wasm.OpTryTable:
+			p.enterBlock(0)
+			p.line(depth, p.instrText(in))
+			depth++
+		case wasm.OpElse, wasm.OpCatch, wasm.OpCatchAll:
+			p.line(depth-1, p.instrText(in))
+		case wasm.OpEnd, wasm.OpDelegate:
+			depth--
+			if depth < 2 {
+				return fmt.Errorf("unexpected %s", p.mnemonic(in))
+			}
+			p.labels = p.labels[:len(p.labels)-1]
+			p.line(depth, p.instrText(in))
+		default:
+			p.line(depth, p.instrText(in))
+		}
+	}
+	return p.err
+}
+
+// fold reads instructions from *pos up to the end, else, catch or delegate
+// that closes the current block, folding operands into the instructions
+// that consume them. Folding only regroups adjacent instructions, so the
+// printed text always encodes to the original sequence.
+func (p *printer) fold(instrs []wasm.Instruction, pos *int) ([]*node, *wasm.Instruction, error) {
+	var seq []*node
+	for *pos < len(instrs) {
+		in := &instrs[*pos]
+		*pos++
+
+		switch in.Opcode {
+		case wasm.OpEnd, wasm.OpElse, wasm.OpCatch, wasm.OpCatchAll, wasm.OpDelegate:
+			return seq, in, nil
+		case wasm.OpBlock, wasm.OpLoop, wasm.OpIf, wasm.OpTry, wasm.OpTryTable:
+			n, err := p.foldBlock(in, instrs, pos, &seq)
+			if err != nil {
+				return nil, nil, err
+			}
+			seq = append(seq, n)
+			continue
+		}
+
+		n := &node{text: p.instrText(in), results: -1}
+		if params, results, ok := p.effect(in); ok {
+			n.results = results
+			n.children, seq = takeOperands(seq, params)
+		}
+		seq = append(seq, n)
+	}
+	return seq, nil, nil
+}
+
+// takeOperands moves the last count nodes of seq into a parent when each
+// of them produces exactly one value.
+func takeOperands(seq []*node, count int) ([]*node, []*node) {
+	if count == 0 || count > len(seq) {
+		return nil, seq
+	}
+	operands := seq[len(seq)-count:]
+	for _, n := range operands {
+		if n.results != 1 {
+			return nil, seq
+		}
+	}
+	return append([]*node(nil), operands...), seq[:len(seq)-count]
+}
+
+func (p *printer) foldBlock(in *wasm.Instruction, instrs []wasm.Instruction, pos *int, seq *[]*node) (*node, error) {
+	bt := blockTypeOf(in)
+	params, results := p.blockArity(bt)
+	arity := results
+	if in.Opcode == wasm.OpLoop {
+		arity = params
+	}
+	p.enterBlock(arity)
+	defer func() { p.labels = p.labels[:len(p.labels)-1] }()
+	n := &node{text: p.instrText(in), results: results}
+
+	if in.Opcode == wasm.OpIf && params == 0 {
+		n.children, *seq = takeOperands(*seq, 1)
+	}
+
+	body, term, err := p.fold(instrs, pos)
+	if err != nil {
+		return nil, err
+	}
+	switch in.Opcode {
+	case wasm.OpIf:
+		n.clauses = append(n.clauses, clause{head: "then", body: body})
+		if term != nil && term.Opcode == wasm.OpElse {
+			body, term, err = p.fold(instrs, pos)
+			if err != nil {
+				return nil, err
+			}
+			n.clauses = append(n.clauses, clause{head: "else", body: body})
+		}
+	case wasm.OpTry:
+		n.clauses = append(n.clauses, clause{head: "do", body: body})
+		for term != nil && (term.Opcode == wasm.OpCatch || term.Opcode == wasm.OpCatchAll) {
+			head := p.instrText(term)
+			body, term, err = p.fold(instrs, pos)
+			if err != nil {
+				return nil, err
+			}
+			n.clauses = append(n.clauses, clause{head: head, body: body})
+		}
+		if term != nil && term.Opcode == wasm.OpDelegate {
+			n.clauses = append(n.clauses, clause{head: p.instrText(term)})
+			return n, nil
+		}
+	default:
+		n.clauses = append(n.clauses, clause{body: body})
+	}
+	if term == nil || term.Opcode != wasm.OpEnd {
+		return nil, fmt.Errorf("%s is not closed by end", p.mnemonic(in))
+	}
+	return n, nil
+}
+
+// enterBlock pushes the label of the next block of the function. Blocks
+// are numbered in order for the label names of the name section.
+func (p *printer) enterBlock(arity int) {
+	p.labels = append(p.labels, label{id: p.names.labels[p.fn][p.blocks], arity: arity})
+	p.blocks++
+}
+
+// labelRef prints a branch target, by identifier when the label has one.
+func (p *printer) labelRef(depth uint32) string {
+	if int(depth) < len(p.labels) {
+		if id := p.labels[len(p.labels)-1-int(depth)].id; id != "" {
+			return id
+		}
+	}
+	return u32(depth)
+}
+
+// node prints n as an S-expression starting on a new line.
+func (p *printer) node(n *node, depth int) {
+	if len(n.clauses) == 0 {
+		if inline, ok := inlineNode(n); ok {
+			p.line(depth, inline)
+			return
+		}
+		p.line(depth, "("+n.text)
+		for _, c := range n.children {
+			p.node(c, depth+1)
+		}
+		p.b.WriteString(")")
+		return
+	}
+
+	p.line(depth, "("+n.text)
+	for _, c := range n.children {
+		p.node(c, depth+1)
+	}
+	for _, cl := range n.clauses {
+		if cl.head == "" {
+			// Plain block body
+			for _, c := range cl.body {
+				p.node(c, depth+1)
+			}
+			continue
+		}
+		p.line(depth+1, "("+cl.head)
+		for _, c := range cl.body {
+			p.node(c, depth+2)
+		}
+		p.b.WriteString(")")
+	}
+	p.b.WriteString(")")
+}
+
+// inlineNode renders n on one line when its operands are short leaves.
+func inlineNode(n *node) (string, bool) {
+	const maxInline = 80
+	var b strings.Builder
+	b.WriteString("(" + n.text)
+	for _, c := range n.children {
+		if len(c.children) > 0 || len(c.clauses) > 0 {
+			return "", false
+		}
+		b.WriteString(" (" + c.text + ")")
+	}
+	b.WriteString(")")
+	if len(n.children) > 0 && b.Len() > maxInline {
+		return "", false
+	}
+	return b.String(), true
+}
+
+func blockTypeOf(in *wasm.Instruction) int32 {
+	switch imm := in.Imm.(type) {
+	case wasm.BlockImm:
+		return imm.Type
+	case wasm.TryTableImm:
+		return imm.BlockType
+	}
+	return wasm.BlockTypeVoid
+}
+
+// blockArity returns the number of params and results of a block type.
+func (p *printer) blockArity(bt int32) (int, int) {
+	switch {
+	case bt == wasm.BlockTypeVoid:
+		return 0, 0
+	case bt < 0:
+		return 0, 1
+	}
+	ft := p.funcType(uint32(bt))
+	if ft == nil {
+		return 0, 0
+	}
+	return len(params(ft)), len(results(ft))
+}
+
+// effect returns how many operands an instruction pops and how many values
+// it pushes, when that is known from the instruction alone.
+func (p *printer) effect(in *wasm.Instruction) (int, int, bool) {
+	switch imm := in.Imm.(type) {
+	case wasm.CallImm:
+		ft := p.calleeType(imm.FuncIdx)
+		if ft == nil {
+			return 0, 0, false
+		}
+		if in.Opcode == wasm.OpReturnCall {
+			return len(params(ft)), 0, true
+		}
+		return len(params(ft)), len(results(ft)), true
+	case wasm.CallIndirectImm:
+		ft := p.funcType(imm.TypeIdx)
+		if ft == nil {
+			return 0, 0, false
+		}
+		if in.Opcode == wasm.OpReturnCallIndirect {
+			return len(params(ft)) + 1, 0, true
+		}
+		return len(params(ft)) + 1, len(results(ft)), true
+	case wasm.CallRefImm:
+		ft := p.funcType(imm.TypeIdx)
+		if ft == nil {
+			return 0, 0, false
+		}
+		if in.Opcode == wasm.OpReturnCallRef {
+			return len(params(ft)) + 1, 0, true
+		}
+		return len(params(ft)) + 1, len(results(ft)), true
+	case wasm.BranchImm:
+		if int(imm.LabelIdx) >= len(p.labels) {
+			return 0, 0, false
+		}
+		arity := p.labels[len(p.labels)-1-int(imm.LabelIdx)].arity
+		switch in.Opcode {
+		case wasm.OpBr:
+			return arity, 0, true
+		case wasm.OpBrIf:
+			return arity + 1, arity, true
+		}
+		return 0, 0, false
+	case wasm.BrTableImm:
+		if int(imm.Default) >= len(p.labels) {
+			return 0, 0, false
+		}
+		return p.labels[len(p.labels)-1-int(imm.Default)].arity + 1, 0, true
+	case wasm.SelectTypeImm:
+		return 3, max(len(imm.Types), len(imm.ExtTypes)), true
+	case wasm.MiscImm:
+		name, _ := opcode.MiscName(imm.SubOpcode)
+		op, ok := opcode.LookupPrefixed(name)
+		if !ok {
+			return 0, 0, false
+		}
+		return op.Operands, resultCount(name), true
+	case wasm.SIMDImm:
+		name, _ := opcode.SIMDName(imm.SubOpcode)
+		op, ok := opcode.LookupSIMD(name)
+		if !ok {
+			return 0, 0, false
+		}
+		return op.Operands, resultCount(name), true
+	case wasm.AtomicImm:
+		name, _ := opcode.AtomicName(imm.SubOpcode)
+		op, ok := opcode.LookupAtomic(name)
+		if !ok {
+			return 0, 0, false
+		}
+		return op.Operands, resultCount(name), true
+	case wasm.GCImm:
+		return 0, 0, false
+	}
+
+	switch in.Opcode {
+	case wasm.OpReturn:
+		return p.labels[0].arity, 0, true
+	case wasm.OpSelect:
+		return 3, 1, true
+	case wasm.OpRefNull, wasm.OpRefFunc:
+		return 0, 1, true
+	case wasm.OpRefIsNull, wasm.OpRefAsNonNull, wasm.OpTableGet:
+		return 1, 1, true
+	case wasm.OpRefEq:
+		return 2, 1, true
+	case wasm.OpTableSet:
+		return 2, 0, true
+	}
+
+	name, _ := opcode.Name(in.Opcode)
+	if info, ok := opcode.Lookup(name); ok && info.Operands >= 0 {
+		return info.Operands, resultCount(name), true
+	}
+	if op, ok := opcode.LookupMemory(name); ok {
+		return op.Operands, resultCount(name), true
+	}
+	return 0, 0, false
+}
+
+// resultCount returns the number of values pushed by a fixed-arity
+// instruction: none for stores and instructions run for their effect, one
+// otherwise.
+func resultCount(name string) int {
+	switch name {
+	case "unreachable", "nop", "drop", "local.set", "global.set", "throw_ref", "atomic.fence",
+		"memory.init", "data.drop", "memory.copy", "memory.fill", "memory.discard",
+		"table.init", "elem.drop", "table.copy", "table.fill":
+		return 0
+	}
+	if strings.Contains(name, ".store") {
+		return 0
+	}
+	return 1
+}
+
+// calleeType returns the type of the function at funcIdx, counting
+// imported functions first.
+func (p *printer) calleeType(funcIdx uint32) *wasm.FuncType {
+	var n uint32
+	for _, imp := range p.m.Imports {
+		if imp.Desc.Kind != wasm.KindFunc {
+			continue
+		}
+		if n == funcIdx {
+			return p.funcType(imp.Desc.TypeIdx)
+		}
+		n++
+	}
+	local := funcIdx - n
+	if int(local) >= len(p.m.Funcs) {
+		return nil
+	}
+	return p.funcType(p.m.Funcs[local])
+}
+
+// mnemonic returns the text name of an instruction.
+func (p *printer) mnemonic(in *wasm.Instruction) string {
+	var name string
+	var ok bool
+	switch imm := in.Imm.(type) {
+	case wasm.MiscImm:
+		name, ok = opcode.MiscName(imm.SubOpcode)
+	case wasm.SIMDImm:
+		name, ok = opcode.SIMDName(imm.SubOpcode)
+	case wasm.AtomicImm:
+		name, ok = opcode.AtomicName(imm.SubOpcode)
+	case wasm.GCImm:
+		name, ok = opcode.GCName(imm.SubOpcode)
+	default:
+		name, ok = opcode.Name(in.Opcode)
+	}
+	if !ok {
+		if p.err == nil {
+			p.err = fmt.Errorf("no text form for opcode 0x%02x", in.Opcode)
+		}
+		return "unreachable"
+	}
+	return name
+}
+
+// instrText returns an instruction with its immediates but without any
+// folded operands.
+func (p *printer) instrText(in *wasm.Instruction) string {
+	var b strings.Builder
+	b.WriteString(p.mnemonic(in))
+	imm := func(s string) {
+		b.WriteByte(' ')
+		b.WriteString(s)
+	}
+
+	// Blocks are printed once entered, with their own label on top
+	blockID := func() {
+		if id := p.labels[len(p.labels)-1].id; id != "" {
+			imm(id)
+		}
+	}
+	switch v := in.Imm.(type) {
+	case wasm.BlockImm:
+		blockID()
+		b.WriteString(p.blockType(v.Type))
+	case wasm.TryTableImm:
+		blockID()
+		b.WriteString(p.blockType(v.BlockType))
+		for _, c := range v.Catches {
+			switch c.Kind {
+			case wasm.CatchKindCatch:
+				imm(fmt.Sprintf("(catch %d %d)", c.TagIdx, c.LabelIdx))
+			case wasm.CatchKindCatchRef:
+				imm(fmt.Sprintf("(catch_ref %d %d)", c.TagIdx, c.LabelIdx))
+			case wasm.CatchKindCatchAll:
+				imm(fmt.Sprintf("(catch_all %d)", c.LabelIdx))
+			case wasm.CatchKindCatchAllRef:
+				imm(fmt.Sprintf("(catch_all_ref %d)", c.LabelIdx))
+			}
+		}
+	case wasm.ThrowImm:
+		imm(u32(v.TagIdx))
+	case wasm.BranchImm:
+		if in.Opcode == wasm.OpDelegate {
+			// Relative to the block enclosing the try
+			imm(u32(v.LabelIdx))
+			break
+		}
+		imm(p.labelRef(v.LabelIdx))
+	case wasm.BrTableImm:
+		for _, l := range v.Labels {
+			imm(p.labelRef(l))
+		}
+		imm(p.labelRef(v.Default))
+	case wasm.CallImm:
+		imm(p.funcRef(v.FuncIdx))
+	case wasm.CallIndirectImm:
+		if v.TableIdx != 0 {
+			imm(u32(v.TableIdx))
+		}
+		imm("(type " + p.typeRef(v.TypeIdx) + ")")
+	case wasm.CallRefImm:
+		imm(p.typeRef(v.TypeIdx))
+	case wasm.LocalImm:
+		imm(p.localRef(v.LocalIdx))
+	case wasm.GlobalImm:
+		imm(idRef(p.names.globals, v.GlobalIdx))
+	case wasm.TableImm:
+		if v.TableIdx != 0 {
+			imm(u32(v.TableIdx))
+		}
+	case wasm.MemoryImm:
+		natural, _ := opcode.NaturalAlign(in.Opcode)
+		b.WriteString(memarg(v, natural))
+	case wasm.MemoryIdxImm:
+		if v.MemIdx != 0 {
+			imm(u32(v.MemIdx))
+		}
+	case wasm.I32Imm:
+		imm(strconv.FormatInt(int64(v.Value), 10))
+	case wasm.I64Imm:
+		imm(strconv.FormatInt(v.Value, 10))
+	case wasm.F32Imm:
+		imm(formatF32(v.Value))
+	case wasm.F64Imm:
+		imm(formatF64(v.Value))
+	case wasm.RefNullImm:
+		imm(heapType(v.HeapType))
+	case wasm.RefFuncImm:
+		imm(p.funcRef(v.FuncIdx))
+	case wasm.SelectTypeImm:
+		b.WriteString(" (result")
+		if len(v.ExtTypes) > 0 {
+			for _, t := range v.ExtTypes {
+				imm(p.extValType(t))
+			}
+		} else {
+			for _, t := range v.Types {
+				imm(valType(t))
+			}
+		}
+		b.WriteString(")")
+	case wasm.MiscImm:
+		b.WriteString(miscImmediates(v))
+	case wasm.SIMDImm:
+		b.WriteString(simdImmediates(v))
+	case wasm.AtomicImm:
+		if v.MemArg != nil {
+			b.WriteString(memarg(*v.MemArg, opcode.AtomicNaturalAlign(v.SubOpcode)))
+		}
+	case wasm.GCImm:
+		b.WriteString(p.gcImmediates(v))
+	}
+	return b.String()
+}
+
+// blockType prints a block type with a leading space, or nothing for the
+// empty block type.
+func (p *printer) blockType(bt int32) string {
+	switch {
+	case bt == wasm.BlockTypeVoid:
+		return ""
+	case bt >= 0:
+		return " (type " + p.typeRef(uint32(bt)) + ")"
+	}
+	// Value types are encoded as single negative SLEB128 bytes
+	return " (result " + valType(wasm.ValType(byte(bt)&0x7F)) + ")"
+}
+
+// memarg prints a memory index, offset and alignment, omitting defaults.
+func memarg(m wasm.MemoryImm, natural uint32) string {
+	var b strings.Builder
+	if m.MemIdx != 0 {
+		b.WriteString(" " + u32(m.MemIdx))
+	}
+	if m.Offset != 0 {
+		b.WriteString(" offset=" + strconv.FormatUint(m.Offset, 10))
+	}
+	if m.Align != natural {
+		b.WriteString(" align=" + strconv.FormatUint(1<<m.Align, 10))
+	}
+	return b.String()
+}
+
+func miscImmediates(v wasm.MiscImm) string {
+	ops := v.Operands
+	switch v.SubOpcode {
+	case wasm.MiscMemoryInit, wasm.MiscTableInit:
+		// Binary order is segment then memory or table; text order is the
+		// reverse, with the memory or table omitted when it is 0
+		if ops[1] != 0 {
+			return " " + u32(ops[1]) + " " + u32(ops[0])
+		}
+		return " " + u32(ops[0])
+	case wasm.MiscDataDrop, wasm.MiscElemDrop:
+		return " " + u32(ops[0])
+	case wasm.MiscMemoryCopy, wasm.MiscTableCopy:
+		if ops[0] != 0 || ops[1] != 0 {
+			return " " + u32(ops[0]) + " " + u32(ops[1])
+		}
+	case wasm.MiscMemoryFill, wasm.MiscMemoryDiscard,
+		wasm.MiscTableGrow, wasm.MiscTableSize, wasm.MiscTableFill:
+		if ops[0] != 0 {
+			return " " + u32(ops[0])
+		}
+	}
+	return ""
+}
+
+func simdImmediates(v wasm.SIMDImm) string {
+	var b strings.Builder
+	switch {
+	case v.MemArg != nil:
+		b.WriteString(memarg(*v.MemArg, opcode.SIMDNaturalAlign(v.SubOpcode)))
+	case v.SubOpcode == wasm.SimdV128Const:
+		b.WriteString(" i32x4")
+		for i := 0; i < 16; i += 4 {
+			fmt.Fprintf(&b, " 0x%08x", binary.LittleEndian.Uint32(v.V128Bytes[i:]))
+		}
+	case v.SubOpcode == wasm.SimdI8x16Shuffle:
+		for _, lane := range v.V128Bytes {
+			b.WriteString(" " + strconv.Itoa(int(lane)))
+		}
+	}
+	if v.LaneIdx != nil {
+		b.WriteString(" " + strconv.Itoa(int(*v.LaneIdx)))
+	}
+	return b.String()
+}
+
+func (p *printer) gcImmediates(v wasm.GCImm) string {
+	switch v.SubOpcode {
+	case wasm.GCStructNew, wasm.GCStructNewDefault,
+		wasm.GCArrayNew, wasm.GCArrayNewDefault, wasm.GCArrayGet, wasm.GCArrayGetS,
+		wasm.GCArrayGetU, wasm.GCArraySet, wasm.GCArrayFill:
+		return " " + u32(v.TypeIdx)
+	case wasm.GCStructGet, wasm.GCStructGetS, wasm.GCStructGetU, wasm.GCStructSet:
+		return " " + u32(v.TypeIdx) + " " + u32(v.FieldIdx)
+	case wasm.GCArrayNewFixed:
+		return " " + u32(v.TypeIdx) + " " + u32(v.Size)
+	case wasm.GCArrayNewData, wasm.GCArrayInitData:
+		return " " + u32(v.TypeIdx) + " " + u32(v.DataIdx)
+	case wasm.GCArrayNewElem, wasm.GCArrayInitElem:
+		return " " + u32(v.TypeIdx) + " " + u32(v.ElemIdx)
+	case wasm.GCArrayCopy:
+		return " " + u32(v.TypeIdx) + " " + u32(v.TypeIdx2)
+	case wasm.GCRefTest, wasm.GCRefCast:
+		return " " + p.refType(wasm.RefType{HeapType: v.HeapType})
+	case wasm.GCRefTestNull, wasm.GCRefCastNull:
+		return " " + p.refType(wasm.RefType{Nullable: true, HeapType: v.HeapType})
+	case wasm.GCBrOnCast, wasm.GCBrOnCastFail:
+		from := wasm.RefType{Nullable: v.CastFlags&1 != 0, HeapType: v.HeapType}
+		to := wasm.RefType{Nullable: v.CastFlags&2 != 0, HeapType: v.HeapType2}
+		return " " + u32(v.LabelIdx) + " " + p.refType(from) + " " + p.refType(to)
+	}
+	return ""
+}
+
+func u32(v uint32) string {
+	return strconv.FormatUint(uint64(v), 10)
+}
+
+// formatF32 prints v so that parsing it back yields the same bits.
+func formatF32(v float32) string {
+	bits := math.Float32bits(v)
+	sign := ""
+	if bits>>31 != 0 {
+		sign = "-"
+	}
+	switch {
+	case math.IsNaN(float64(v)):
+		if payload := bits & 0x7FFFFF; payload != 0x400000 {
+			return sign + "nan:0x" + strconv.FormatUint(uint64(payload), 16)
+		}
+		return sign + "nan"
+	case math.IsInf(float64(v), 0):
+		return sign + "inf"
+	}
+	return strconv.FormatFloat(float64(v), 'g', -1, 32)
+}
+
+// formatF64 prints v so that parsing it back yields the same bits.
+func formatF64(v float64) string {
+	bits := math.Float64bits(v)
+	sign := ""
+	if bits>>63 != 0 {
+		sign = "-"
+	}
+	switch {
+	case math.IsNaN(v):
+		if payload := bits & 0xFFFFFFFFFFFFF; payload != 0x8000000000000 {
+			return sign + "nan:0x" + strconv.FormatUint(payload, 16)
+		}
+		return sign + "nan"
+	case math.IsInf(v, 0):
+		return sign + "inf"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
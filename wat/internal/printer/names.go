@@ -0,0 +1,104 @@
+package printer
+
+import (
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/wippyai/wasm-runtime/wasm"
+)
+
+// names holds the identifiers printed for each index space, already
+// sanitized and unique within their space.
+type names struct {
+	funcs    map[uint32]string
+	locals   map[uint32]map[uint32]string
+	labels   map[uint32]map[uint32]string
+	types    map[uint32]string
+	tables   map[uint32]string
+	memories map[uint32]string
+	globals  map[uint32]string
+	elems    map[uint32]string
+	data     map[uint32]string
+	fields   map[uint32]map[uint32]string
+	tags     map[uint32]string
+	module   string
+}
+
+// readNames decodes the "name" custom section. A malformed section is
+// ignored: names only decorate the output.
+func readNames(m *wasm.Module) names {
+	n, err := m.Names()
+	if err != nil || n == nil {
+		n = &wasm.Names{}
+	}
+	return names{
+		module:   identifier(n.Module),
+		funcs:    uniqueIDs(n.Funcs),
+		locals:   indirectIDs(n.Locals),
+		labels:   indirectIDs(n.Labels),
+		types:    uniqueIDs(n.Types),
+		tables:   uniqueIDs(n.Tables),
+		memories: uniqueIDs(n.Memories),
+		globals:  uniqueIDs(n.Globals),
+		elems:    uniqueIDs(n.Elems),
+		data:     uniqueIDs(n.Data),
+		fields:   indirectIDs(n.Fields),
+		tags:     uniqueIDs(n.Tags),
+	}
+}
+
+func indirectIDs(raw wasm.IndirectNameMap) map[uint32]map[uint32]string {
+	ids := make(map[uint32]map[uint32]string, len(raw))
+	for idx, names := range raw {
+		ids[idx] = uniqueIDs(names)
+	}
+	return ids
+}
+
+// uniqueIDs turns raw names into identifiers, suffixing repeats so that
+// every index keeps a distinct identifier.
+func uniqueIDs(raw map[uint32]string) map[uint32]string {
+	idxs := make([]uint32, 0, len(raw))
+	for idx := range raw {
+		idxs = append(idxs, idx)
+	}
+	slices.Sort(idxs)
+
+	seen := make(map[string]bool, len(raw))
+	ids := make(map[uint32]string, len(raw))
+	for _, idx := range idxs {
+		id := identifier(raw[idx])
+		if id == "" {
+			continue
+		}
+		base := id
+		for n := 1; seen[id]; n++ {
+			id = base + "." + strconv.Itoa(n)
+		}
+		seen[id] = true
+		ids[idx] = id
+	}
+	return ids
+}
+
+// identifier returns name as a WAT identifier, replacing characters that
+// cannot appear in one.
+func identifier(name string) string {
+	if name == "" {
+		return ""
+	}
+	var b strings.Builder
+	b.WriteByte('$')
+	for i := 0; i < len(name); i++ {
+		c := name[i]
+		switch {
+		case c >= 'a' && c <= 'z', c >= 'A' && c <= 'Z', c >= '0' && c <= '9',
+			c == '_', c == '.', c == '-', c == ':':
+			b.WriteByte(c)
+		default:
+			b.WriteByte('_')
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,679 @@
+// Package printer renders a decoded wasm.Module as WebAssembly text.
+package printer
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/wippyai/wasm-runtime/wasm"
+)
+
+// Options controls the printed form.
+type Options struct {
+	// Indent is the string used for one level of nesting. Defaults to two
+	// spaces.
+	Indent string
+
+	// Folded prints instructions as nested S-expressions instead of a flat
+	// sequence.
+	Folded bool
+}
+
+type printer struct {
+	m     *wasm.Module
+	b     strings.Builder
+	names names
+	opts  Options
+
+	// types holds the flat type index space, with rec groups expanded
+	types []wasm.SubType
+
+	// Per-function state while printing a body
+	fn     uint32
+	labels []label
+	blocks uint32 // blocks entered so far, numbering their labels
+
+	// err records the first instruction with no text form
+	err error
+}
+
+// label is an enclosing block as seen by branch instructions.
+type label struct {
+	id    string // from the name section, if named
+	arity int    // values carried by a branch to it
+}
+
+// Print renders m as a (module ...) form.
+func Print(m *wasm.Module, opts Options) (string, error) {
+	if opts.Indent == "" {
+		opts.Indent = "  "
+	}
+	p := &printer{m: m, opts: opts, names: readNames(m)}
+	p.types = flatTypes(m)
+	if err := p.module(); err != nil {
+		return "", err
+	}
+	if p.err != nil {
+		return "", p.err
+	}
+	return p.b.String(), nil
+}
+
+func flatTypes(m *wasm.Module) []wasm.SubType {
+	if len(m.TypeDefs) == 0 {
+		types := make([]wasm.SubType, len(m.Types))
+		for i := range m.Types {
+			types[i] = wasm.SubType{Final: true, CompType: wasm.CompType{Kind: wasm.CompKindFunc, Func: &m.Types[i]}}
+		}
+		return types
+	}
+	var types []wasm.SubType
+	for _, td := range m.TypeDefs {
+		switch td.Kind {
+		case wasm.TypeDefKindFunc:
+			types = append(types, wasm.SubType{Final: true, CompType: wasm.CompType{Kind: wasm.CompKindFunc, Func: td.Func}})
+		case wasm.TypeDefKindSub:
+			types = append(types, *td.Sub)
+		case wasm.TypeDefKindRec:
+			types = append(types, td.Rec.Types...)
+		}
+	}
+	return types
+}
+
+func (p *printer) module() error {
+	p.b.WriteString("(module")
+	if p.names.module != "" {
+		p.b.WriteString(" " + p.names.module)
+	}
+
+	p.typeSection()
+	p.importSection()
+
+	numFuncImports := uint32(p.m.NumImportedFuncs())
+	for i, typeIdx := range p.m.Funcs {
+		if i >= len(p.m.Code) {
+			return fmt.Errorf("function %d has no body", numFuncImports+uint32(i))
+		}
+		if err := p.function(numFuncImports+uint32(i), typeIdx, &p.m.Code[i]); err != nil {
+			return err
+		}
+	}
+
+	tableBase := p.m.NumImportedTables()
+	for i := range p.m.Tables {
+		p.line(1, "(table "+p.defID(p.names.tables, uint32(tableBase+i))+p.tableType(&p.m.Tables[i]))
+		if init := p.m.Tables[i].Init; len(init) > 0 {
+			if err := p.constExpr(init); err != nil {
+				return err
+			}
+		}
+		p.b.WriteString(")")
+	}
+
+	memBase := p.m.NumImportedMemories()
+	for i := range p.m.Memories {
+		p.line(1, "(memory "+p.defID(p.names.memories, uint32(memBase+i))+limits(p.m.Memories[i].Limits)+")")
+	}
+
+	tagBase := p.m.NumImportedTags()
+	for i := range p.m.Tags {
+		p.line(1, fmt.Sprintf("(tag %s(type %s))", p.defID(p.names.tags, uint32(tagBase+i)), p.typeRef(p.m.Tags[i].TypeIdx)))
+	}
+
+	globalBase := p.m.NumImportedGlobals()
+	for i := range p.m.Globals {
+		g := &p.m.Globals[i]
+		p.line(1, "(global "+p.defID(p.names.globals, uint32(globalBase+i))+p.globalType(&g.Type))
+		if err := p.constExpr(g.Init); err != nil {
+			return err
+		}
+		p.b.WriteString(")")
+	}
+
+	for _, e := range p.m.Exports {
+		p.line(1, fmt.Sprintf("(export %s (%s %s))", quote([]byte(e.Name)), kindName(e.Kind), p.ref(e.Kind, e.Idx)))
+	}
+
+	if p.m.Start != nil {
+		p.line(1, "(start "+p.funcRef(*p.m.Start)+")")
+	}
+
+	for i := range p.m.Elements {
+		if err := p.element(i, &p.m.Elements[i]); err != nil {
+			return err
+		}
+	}
+
+	for i := range p.m.Data {
+		if err := p.data(i, &p.m.Data[i]); err != nil {
+			return err
+		}
+	}
+
+	p.b.WriteString(")\n")
+	return nil
+}
+
+func (p *printer) typeSection() {
+	if len(p.m.TypeDefs) == 0 {
+		for i := range p.m.Types {
+			p.line(1, fmt.Sprintf("(type %s(func%s))", p.defID(p.names.types, uint32(i)), p.funcSig(&p.m.Types[i], 0, false)))
+		}
+		return
+	}
+	var idx uint32
+	for _, td := range p.m.TypeDefs {
+		switch td.Kind {
+		case wasm.TypeDefKindFunc:
+			p.line(1, fmt.Sprintf("(type %s(func%s))", p.defID(p.names.types, idx), p.funcSig(td.Func, 0, false)))
+			idx++
+		case wasm.TypeDefKindSub:
+			p.line(1, fmt.Sprintf("(type %s%s)", p.defID(p.names.types, idx), p.subType(td.Sub, idx)))
+			idx++
+		case wasm.TypeDefKindRec:
+			p.line(1, "(rec")
+			for j := range td.Rec.Types {
+				p.line(2, fmt.Sprintf("(type %s%s)", p.defID(p.names.types, idx), p.subType(&td.Rec.Types[j], idx)))
+				idx++
+			}
+			p.b.WriteString(")")
+		}
+	}
+}
+
+// subType prints the type at index idx.
+func (p *printer) subType(st *wasm.SubType, idx uint32) string {
+	comp := p.compType(&st.CompType, idx)
+	if st.Final && len(st.Parents) == 0 {
+		return comp
+	}
+	var b strings.Builder
+	b.WriteString("(sub")
+	if st.Final {
+		b.WriteString(" final")
+	}
+	for _, parent := range st.Parents {
+		b.WriteString(" " + p.typeRef(parent))
+	}
+	b.WriteString(" " + comp + ")")
+	return b.String()
+}
+
+func (p *printer) compType(ct *wasm.CompType, idx uint32) string {
+	switch ct.Kind {
+	case wasm.CompKindStruct:
+		var b strings.Builder
+		b.WriteString("(struct")
+		for i, f := range ct.Struct.Fields {
+			b.WriteString(" (field ")
+			if id, ok := p.names.fields[idx][uint32(i)]; ok {
+				b.WriteString(id + " ")
+			}
+			b.WriteString(p.fieldType(f) + ")")
+		}
+		b.WriteString(")")
+		return b.String()
+	case wasm.CompKindArray:
+		return "(array " + p.fieldType(ct.Array.Element) + ")"
+	default:
+		return "(func" + p.funcSig(ct.Func, 0, false) + ")"
+	}
+}
+
+func (p *printer) fieldType(f wasm.FieldType) string {
+	var t string
+	switch f.Type.Kind {
+	case wasm.StorageKindPacked:
+		t = "i8"
+		if f.Type.Packed == wasm.PackedI16 {
+			t = "i16"
+		}
+	case wasm.StorageKindRef:
+		t = p.refType(f.Type.RefType)
+	default:
+		t = valType(f.Type.ValType)
+	}
+	if f.Mutable {
+		return "(mut " + t + ")"
+	}
+	return t
+}
+
+func (p *printer) importSection() {
+	var funcIdx, tableIdx, memIdx, globalIdx, tagIdx uint32
+	for _, imp := range p.m.Imports {
+		var desc string
+		switch imp.Desc.Kind {
+		case wasm.KindFunc:
+			desc = fmt.Sprintf("(func %s(type %s))", p.defID(p.names.funcs, funcIdx), p.typeRef(imp.Desc.TypeIdx))
+			funcIdx++
+		case wasm.KindTable:
+			desc = "(table " + p.defID(p.names.tables, tableIdx) + p.tableType(imp.Desc.Table) + ")"
+			tableIdx++
+		case wasm.KindMemory:
+			desc = "(memory " + p.defID(p.names.memories, memIdx) + limits(imp.Desc.Memory.Limits) + ")"
+			memIdx++
+		case wasm.KindGlobal:
+			desc = "(global " + p.defID(p.names.globals, globalIdx) + p.globalType(imp.Desc.Global) + ")"
+			globalIdx++
+		case wasm.KindTag:
+			desc = fmt.Sprintf("(tag %s(type %s))", p.defID(p.names.tags, tagIdx), p.typeRef(imp.Desc.Tag.TypeIdx))
+			tagIdx++
+		}
+		p.line(1, fmt.Sprintf("(import %s %s %s)", quote([]byte(imp.Module)), quote([]byte(imp.Name)), desc))
+	}
+}
+
+// defID returns the identifier or index comment that opens the definition
+// of idx, followed by a space.
+func (p *printer) defID(ids map[uint32]string, idx uint32) string {
+	if id, ok := ids[idx]; ok {
+		return id + " "
+	}
+	return fmt.Sprintf("(;%d;) ", idx)
+}
+
+func (p *printer) function(idx, typeIdx uint32, body *wasm.FuncBody) error {
+	ft := p.funcType(typeIdx)
+	if ft == nil {
+		return fmt.Errorf("function %d: type %d is not a function type", idx, typeIdx)
+	}
+	p.fn = idx
+	p.blocks = 0
+	p.line(1, fmt.Sprintf("(func %s(type %s)%s", p.defID(p.names.funcs, idx), p.typeRef(typeIdx), p.funcSig(ft, idx, true)))
+
+	// Locals are numbered after the parameters
+	localIdx := uint32(len(params(ft)))
+	localNames := p.names.locals[idx]
+	var unnamed []string
+	flush := func() {
+		if len(unnamed) > 0 {
+			p.line(2, "(local "+strings.Join(unnamed, " ")+")")
+			unnamed = nil
+		}
+	}
+	for _, le := range body.Locals {
+		t := p.localType(le)
+		for i := uint32(0); i < le.Count; i++ {
+			if id, ok := localNames[localIdx]; ok {
+				flush()
+				p.line(2, "(local "+id+" "+t+")")
+			} else {
+				unnamed = append(unnamed, t)
+			}
+			localIdx++
+		}
+	}
+	flush()
+
+	instrs, err := wasm.DecodeInstructions(body.Code)
+	if err != nil {
+		return fmt.Errorf("function %d: %w", idx, err)
+	}
+	// Drop the end that closes the body
+	if n := len(instrs); n > 0 && instrs[n-1].Opcode == wasm.OpEnd {
+		instrs = instrs[:n-1]
+	}
+	p.labels = append(p.labels[:0], label{arity: len(results(ft))})
+	if err := p.body(instrs, 2); err != nil {
+		return fmt.Errorf("function %d: %w", idx, err)
+	}
+	p.b.WriteString(")")
+	return nil
+}
+
+// funcSig prints the params and results of ft with a leading space. Named
+// params are printed one per clause when names is set.
+func (p *printer) funcSig(ft *wasm.FuncType, fn uint32, names bool) string {
+	var b strings.Builder
+	var localNames map[uint32]string
+	if names {
+		localNames = p.names.locals[fn]
+	}
+	ps := params(ft)
+	var unnamed []string
+	flush := func() {
+		if len(unnamed) > 0 {
+			b.WriteString(" (param " + strings.Join(unnamed, " ") + ")")
+			unnamed = nil
+		}
+	}
+	for i, t := range ps {
+		if id, ok := localNames[uint32(i)]; ok {
+			flush()
+			b.WriteString(" (param " + id + " " + p.extValType(t) + ")")
+			continue
+		}
+		unnamed = append(unnamed, p.extValType(t))
+	}
+	flush()
+	if rs := results(ft); len(rs) > 0 {
+		b.WriteString(" (result")
+		for _, t := range rs {
+			b.WriteString(" " + p.extValType(t))
+		}
+		b.WriteString(")")
+	}
+	return b.String()
+}
+
+func params(ft *wasm.FuncType) []wasm.ExtValType {
+	if len(ft.ExtParams) > 0 {
+		return ft.ExtParams
+	}
+	return simpleTypes(ft.Params)
+}
+
+func results(ft *wasm.FuncType) []wasm.ExtValType {
+	if len(ft.ExtResults) > 0 {
+		return ft.ExtResults
+	}
+	return simpleTypes(ft.Results)
+}
+
+func simpleTypes(vts []wasm.ValType) []wasm.ExtValType {
+	ext := make([]wasm.ExtValType, len(vts))
+	for i, vt := range vts {
+		ext[i] = wasm.ExtValType{Kind: wasm.ExtValKindSimple, ValType: vt}
+	}
+	return ext
+}
+
+// funcType returns the function type at typeIdx, or nil when the index is
+// out of range or names a struct or array type.
+func (p *printer) funcType(typeIdx uint32) *wasm.FuncType {
+	if int(typeIdx) >= len(p.types) {
+		return nil
+	}
+	return p.types[typeIdx].CompType.Func
+}
+
+func (p *printer) localType(le wasm.LocalEntry) string {
+	if le.ExtType != nil {
+		return p.extValType(*le.ExtType)
+	}
+	return valType(le.ValType)
+}
+
+func (p *printer) globalType(gt *wasm.GlobalType) string {
+	t := valType(gt.ValType)
+	if gt.ExtType != nil {
+		t = p.extValType(*gt.ExtType)
+	}
+	if gt.Mutable {
+		return "(mut " + t + ")"
+	}
+	return t
+}
+
+func (p *printer) tableType(tt *wasm.TableType) string {
+	elem := valType(wasm.ValType(tt.ElemType))
+	if tt.RefElemType != nil {
+		elem = p.refType(*tt.RefElemType)
+	}
+	return limits(tt.Limits) + " " + elem
+}
+
+func limits(l wasm.Limits) string {
+	var b strings.Builder
+	if l.Memory64 {
+		b.WriteString("i64 ")
+	}
+	b.WriteString(strconv.FormatUint(l.Min, 10))
+	if l.Max != nil {
+		b.WriteString(" " + strconv.FormatUint(*l.Max, 10))
+	}
+	if l.Shared {
+		b.WriteString(" shared")
+	}
+	return b.String()
+}
+
+func (p *printer) extValType(t wasm.ExtValType) string {
+	if t.Kind == wasm.ExtValKindRef {
+		return p.refType(t.RefType)
+	}
+	return valType(t.ValType)
+}
+
+// refType prints rt, using the shorthand form for nullable abstract types.
+func (p *printer) refType(rt wasm.RefType) string {
+	heap := heapType(rt.HeapType)
+	if rt.HeapType < 0 && rt.Nullable {
+		if short, ok := nullableShorthand[rt.HeapType]; ok {
+			return short
+		}
+	}
+	if rt.Nullable {
+		return "(ref null " + heap + ")"
+	}
+	return "(ref " + heap + ")"
+}
+
+var nullableShorthand = map[int64]string{
+	wasm.HeapTypeFunc:     "funcref",
+	wasm.HeapTypeExtern:   "externref",
+	wasm.HeapTypeAny:      "anyref",
+	wasm.HeapTypeEq:       "eqref",
+	wasm.HeapTypeI31:      "i31ref",
+	wasm.HeapTypeStruct:   "structref",
+	wasm.HeapTypeArray:    "arrayref",
+	wasm.HeapTypeExn:      "exnref",
+	wasm.HeapTypeNone:     "nullref",
+	wasm.HeapTypeNoExtern: "nullexternref",
+	wasm.HeapTypeNoFunc:   "nullfuncref",
+	wasm.HeapTypeNoExn:    "nullexnref",
+}
+
+var abstractHeapTypes = map[int64]string{
+	wasm.HeapTypeFunc:     "func",
+	wasm.HeapTypeExtern:   "extern",
+	wasm.HeapTypeAny:      "any",
+	wasm.HeapTypeEq:       "eq",
+	wasm.HeapTypeI31:      "i31",
+	wasm.HeapTypeStruct:   "struct",
+	wasm.HeapTypeArray:    "array",
+	wasm.HeapTypeExn:      "exn",
+	wasm.HeapTypeNone:     "none",
+	wasm.HeapTypeNoExtern: "noextern",
+	wasm.HeapTypeNoFunc:   "nofunc",
+	wasm.HeapTypeNoExn:    "noexn",
+}
+
+func heapType(ht int64) string {
+	if ht >= 0 {
+		return strconv.FormatInt(ht, 10)
+	}
+	if name, ok := abstractHeapTypes[ht]; ok {
+		return name
+	}
+	return fmt.Sprintf("(;heap type %d;) any", ht)
+}
+
+func valType(vt wasm.ValType) string {
+	switch vt {
+	case 0x69:
+		return "exnref"
+	case 0x74:
+		return "nullexnref"
+	}
+	return vt.String()
+}
+
+func kindName(kind byte) string {
+	switch kind {
+	case wasm.KindTable:
+		return "table"
+	case wasm.KindMemory:
+		return "memory"
+	case wasm.KindGlobal:
+		return "global"
+	case wasm.KindTag:
+		return "tag"
+	default:
+		return "func"
+	}
+}
+
+// ref prints a reference to idx in the index space of kind.
+func (p *printer) ref(kind byte, idx uint32) string {
+	switch kind {
+	case wasm.KindTable:
+		return idRef(p.names.tables, idx)
+	case wasm.KindMemory:
+		return idRef(p.names.memories, idx)
+	case wasm.KindGlobal:
+		return idRef(p.names.globals, idx)
+	case wasm.KindTag:
+		return idRef(p.names.tags, idx)
+	default:
+		return p.funcRef(idx)
+	}
+}
+
+func (p *printer) funcRef(idx uint32) string {
+	return idRef(p.names.funcs, idx)
+}
+
+func (p *printer) localRef(idx uint32) string {
+	return idRef(p.names.locals[p.fn], idx)
+}
+
+func (p *printer) typeRef(idx uint32) string {
+	return idRef(p.names.types, idx)
+}
+
+// idRef prints the identifier of idx, or the index itself if it has none.
+func idRef(ids map[uint32]string, idx uint32) string {
+	if id, ok := ids[idx]; ok {
+		return id
+	}
+	return strconv.FormatUint(uint64(idx), 10)
+}
+
+func (p *printer) element(i int, e *wasm.Element) error {
+	p.line(1, "(elem "+p.defID(p.names.elems, uint32(i)))
+	switch e.Flags {
+	case 1, 5:
+		// Passive
+	case 3, 7:
+		p.b.WriteString("declare ")
+	case 2, 6:
+		p.b.WriteString("(table " + idRef(p.names.tables, e.TableIdx) + ") ")
+		fallthrough
+	default:
+		p.b.WriteString("(offset")
+		if err := p.constExpr(e.Offset); err != nil {
+			return err
+		}
+		p.b.WriteString(") ")
+	}
+
+	if e.Flags < 4 {
+		p.b.WriteString("func")
+		for _, idx := range e.FuncIdxs {
+			p.b.WriteString(" " + p.funcRef(idx))
+		}
+		p.b.WriteString(")")
+		return nil
+	}
+
+	elemType := valType(e.Type)
+	if e.RefType != nil {
+		elemType = p.refType(*e.RefType)
+	} else if e.Type == 0 {
+		elemType = "funcref"
+	}
+	p.b.WriteString(elemType)
+	for _, expr := range e.Exprs {
+		instrs, err := constInstrs(expr)
+		if err != nil {
+			return err
+		}
+		if len(instrs) == 1 {
+			p.b.WriteString(" (" + p.instrText(&instrs[0]) + ")")
+			continue
+		}
+		p.b.WriteString(" (item")
+		for j := range instrs {
+			p.b.WriteString(" " + p.instrText(&instrs[j]))
+		}
+		p.b.WriteString(")")
+	}
+	p.b.WriteString(")")
+	return nil
+}
+
+func (p *printer) data(i int, d *wasm.DataSegment) error {
+	p.line(1, "(data "+p.defID(p.names.data, uint32(i)))
+	if d.Flags != 1 {
+		if d.Flags == 2 {
+			p.b.WriteString("(memory " + idRef(p.names.memories, d.MemIdx) + ") ")
+		}
+		p.b.WriteString("(offset")
+		if err := p.constExpr(d.Offset); err != nil {
+			return err
+		}
+		p.b.WriteString(") ")
+	}
+	p.b.WriteString(quote(d.Init) + ")")
+	return nil
+}
+
+// constExpr prints a constant expression, such as a global initializer or
+// segment offset, as folded instructions with a leading space.
+func (p *printer) constExpr(code []byte) error {
+	instrs, err := constInstrs(code)
+	if err != nil {
+		return err
+	}
+	for i := range instrs {
+		p.b.WriteString(" (" + p.instrText(&instrs[i]) + ")")
+	}
+	return nil
+}
+
+// constInstrs decodes a constant expression without its closing end.
+func constInstrs(code []byte) ([]wasm.Instruction, error) {
+	instrs, err := wasm.DecodeInstructions(code)
+	if err != nil {
+		return nil, fmt.Errorf("constant expression: %w", err)
+	}
+	if n := len(instrs); n > 0 && instrs[n-1].Opcode == wasm.OpEnd {
+		instrs = instrs[:n-1]
+	}
+	return instrs, nil
+}
+
+// line starts a new line at the given nesting depth.
+func (p *printer) line(depth int, s string) {
+	p.b.WriteByte('\n')
+	for i := 0; i < depth; i++ {
+		p.b.WriteString(p.opts.Indent)
+	}
+	p.b.WriteString(s)
+}
+
+// quote prints data as a WAT string literal.
+func quote(data []byte) string {
+	const hex = "0123456789abcdef"
+	var b strings.Builder
+	b.WriteByte('"')
+	for _, c := range data {
+		switch {
+		case c == '"' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c >= 0x20 && c < 0x7F:
+			b.WriteByte(c)
+		default:
+			b.WriteByte('\\')
+			b.WriteByte(hex[c>>4])
+			b.WriteByte(hex[c&0xF])
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
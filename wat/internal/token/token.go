@@ -15,6 +15,10 @@ const (
 	Number
 )
 
+// idChars are the non-alphanumeric characters allowed after the first
+// character of an identifier or keyword.
+const idChars = "!#$%&'*+-./:<=>?@\\^_`|~"
+
 func (t Type) String() string {
 	switch t {
 	case LParen:
@@ -55,10 +59,12 @@ func Tokenize(input string) []Token {
 
 		// Line comment
 		if r == ';' && i+1 < len(runes) && runes[i+1] == ';' {
-			for i < len(runes) && runes[i] != '\n' {
+			// Ends at a line feed or carriage return, which is then
+			// skipped as whitespace
+			for i < len(runes) && runes[i] != '\n' && runes[i] != '\r' {
 				i++
 			}
-			line++
+			i--
 			continue
 		}
 
@@ -146,7 +152,7 @@ func Tokenize(input string) []Token {
 			start := i
 			for i < len(runes) {
 				c := runes[i]
-				if unicode.IsLetter(c) || unicode.IsDigit(c) || c == '_' || c == '.' || c == '$' || c == '-' || c == ':' || c == '=' {
+				if unicode.IsLetter(c) || unicode.IsDigit(c) || strings.ContainsRune(idChars, c) {
 					i++
 				} else {
 					break
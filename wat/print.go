@@ -0,0 +1,33 @@
+package wat
+
+import (
+	"github.com/wippyai/wasm-runtime/wasm"
+	"github.com/wippyai/wasm-runtime/wat/internal/printer"
+)
+
+// PrintOptions controls how modules are rendered as text.
+type PrintOptions struct {
+	// Indent is the string used for one level of nesting. Defaults to two
+	// spaces.
+	Indent string
+
+	// Folded nests operands inside the instructions that consume them, as
+	// in (i32.add (local.get 0) (local.get 1)). The default prints one
+	// instruction per line.
+	Folded bool
+}
+
+// Print renders a decoded module as WebAssembly text that Compile accepts.
+// Identifiers are taken from the module's name section when present.
+func Print(m *wasm.Module, opts PrintOptions) (string, error) {
+	return printer.Print(m, printer.Options{Indent: opts.Indent, Folded: opts.Folded})
+}
+
+// Disassemble decodes a binary module and renders it as WebAssembly text.
+func Disassemble(data []byte, opts PrintOptions) (string, error) {
+	m, err := wasm.ParseModule(data)
+	if err != nil {
+		return "", err
+	}
+	return Print(m, opts)
+}
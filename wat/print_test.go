@@ -0,0 +1,128 @@
+package wat
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+)
+
+var roundTripModules = map[string]string{
+	"arith": `(module
+		(func $add (export "add") (param $a i32) (param $b i32) (result i32)
+			(i32.add (local.get $a) (local.get $b)))
+		(func (param f32 f64) (result f64)
+			(f64.add (f64.promote_f32 (local.get 0)) (f64.const -1.5)))
+		(func (result f32) (f32.const nan:0x200000))
+		(func (result f64) (f64.const -inf))
+		(func (result i64) (i64.const -9223372036854775808)))`,
+	"control": `(module
+		(type $t (func (param i32) (result i32)))
+		(table 2 funcref)
+		(func $f (type $t) (param $x i32) (result i32) (local $y i32) (local i64)
+			(block $out (result i32)
+				(loop $top
+					(br_if $out (i32.eqz (local.get $x)) (i32.const 7))
+					(local.set $x (i32.sub (local.get $x) (i32.const 1)))
+					(br $top))
+				(i32.const 0))
+			(if (result i32) (local.get $x)
+				(then (i32.const 1))
+				(else (call_indirect (type $t) (i32.const 2) (i32.const 0))))
+			(drop)
+			(block (block (br_table 0 1 (local.get $x))))
+			(select (local.get $x) (i32.const 3) (local.get $y)))
+		(elem (i32.const 0) $f $f))`,
+	"memory": `(module
+		(import "env" "log" (func $log (param i32)))
+		(import "env" "g" (global $g (mut i32)))
+		(memory 1 2)
+		(global $h i64 (i64.const 42))
+		(func (export "run")
+			(i32.store offset=8 align=2 (i32.const 0) (i32.load8_u offset=3 (i32.const 1)))
+			(memory.fill (i32.const 0) (i32.const 0) (memory.size))
+			(global.set $g (i32.const 1))
+			(call $log (global.get $g))
+			(data.drop 1))
+		(data (i32.const 16) "hi\00\ff\"")
+		(data "passive"))`,
+}
+
+func TestPrintRoundTrip(t *testing.T) {
+	for name, src := range roundTripModules {
+		for _, folded := range []bool{false, true} {
+			t.Run(name, func(t *testing.T) {
+				bin, err := Compile(src)
+				if err != nil {
+					t.Fatalf("Compile failed: %v", err)
+				}
+				text, err := Disassemble(bin, PrintOptions{Folded: folded})
+				if err != nil {
+					t.Fatalf("Disassemble failed: %v", err)
+				}
+				again, err := Compile(text)
+				if err != nil {
+					t.Fatalf("Compile of printed text failed: %v\n%s", err, text)
+				}
+				if !bytes.Equal(bin, again) {
+					t.Errorf("round trip changed the binary\n%s", text)
+				}
+			})
+		}
+	}
+}
+
+func TestPrintFolded(t *testing.T) {
+	bin, err := Compile(`(module
+		(func (param i32 i32) (result i32)
+			local.get 0
+			local.get 1
+			i32.add))`)
+	if err != nil {
+		t.Fatalf("Compile failed: %v", err)
+	}
+
+	flat, err := Disassemble(bin, PrintOptions{})
+	if err != nil {
+		t.Fatalf("Disassemble failed: %v", err)
+	}
+	if !strings.Contains(flat, "\n    local.get 1\n    i32.add") {
+		t.Errorf("flat output not one instruction per line:\n%s", flat)
+	}
+
+	folded, err := Disassemble(bin, PrintOptions{Folded: true})
+	if err != nil {
+		t.Fatalf("Disassemble failed: %v", err)
+	}
+	if !strings.Contains(folded, "(i32.add (local.get 0) (local.get 1))") {
+		t.Errorf("operands not folded:\n%s", folded)
+	}
+}
+
+func TestPrintNames(t *testing.T) {
+	bin, err := Compile(`(module (func (export "f") (param i32)))`)
+	if err != nil {
+		t.Fatalf("Compile failed: %v", err)
+	}
+	// Append a name section naming the module "m", function 0 "do it" and
+	// its first local "x"
+	names := []byte{
+		0, 2, 1, 'm',
+		1, 8, 1, 0, 5, 'd', 'o', ' ', 'i', 't',
+		2, 6, 1, 0, 1, 0, 1, 'x',
+	}
+	bin = append(bin, 0, byte(len(names)+5), 4, 'n', 'a', 'm', 'e')
+	bin = append(bin, names...)
+
+	text, err := Disassemble(bin, PrintOptions{})
+	if err != nil {
+		t.Fatalf("Disassemble failed: %v", err)
+	}
+	for _, want := range []string{"(module $m", "(func $do_it (type 0) (param $x i32)", `(export "f" (func $do_it))`} {
+		if !strings.Contains(text, want) {
+			t.Errorf("output missing %q:\n%s", want, text)
+		}
+	}
+	if _, err := Compile(text); err != nil {
+		t.Errorf("Compile of printed text failed: %v\n%s", err, text)
+	}
+}
@@ -0,0 +1,97 @@
+package wat
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/wippyai/wasm-runtime/wasm"
+	"github.com/wippyai/wasm-runtime/wat/internal/opcode"
+)
+
+func TestCompileSIMD(t *testing.T) {
+	bin, err := Compile(`(module
+		(memory 1)
+		(global $g v128 (v128.const f32x4 1.5 -0 inf nan))
+		(func (export "sum") (param $a v128) (param $b v128) (result i32)
+			(local $t v128)
+			(local.set $t (i32x4.add (local.get $a) (local.get $b)))
+			(i32x4.extract_lane 3 (local.get $t)))
+		(func (param i32) (result v128)
+			(v128.store offset=16 align=8 (local.get 0) (v128.load (local.get 0)))
+			(drop (v128.load8_lane offset=1 15 (local.get 0) (i8x16.splat (i32.const 7))))
+			(i8x16.shuffle 0 1 2 3 4 5 6 7 16 17 18 19 20 21 22 23
+				(v128.const i8x16 0 1 2 3 4 5 6 7 8 9 10 11 12 13 14 -1)
+				(v128.const i16x8 0 65535 -32768 3 4 5 6 7))
+			(v128.bitselect (v128.const i64x2 -1 0x7fffffffffffffff) (global.get $g))
+			(f64x2.relaxed_madd (v128.const f64x2 1 2) (v128.const i32x4 1 2 3 4))))`)
+	if err != nil {
+		t.Fatalf("Compile failed: %v", err)
+	}
+	if _, err := wasm.ParseModuleValidate(bin); err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+}
+
+func TestCompileV128ConstShapes(t *testing.T) {
+	want := []byte{0x01, 0x00, 0x00, 0x00, 0xFF, 0xFF, 0xFF, 0xFF, 0x00, 0x00, 0x80, 0x3F, 0x00, 0x00, 0x00, 0x00}
+	shapes := []string{
+		"i8x16 1 0 0 0 -1 255 0xff 0xFF 0 0 0x80 0x3f 0 0 0 0",
+		"i16x8 1 0 -1 0xffff 0 0x3f80 0 0",
+		"i32x4 1 -1 1065353216 0",
+		"i64x2 0xffffffff00000001 0x3f800000",
+	}
+	for _, shape := range shapes {
+		bin, err := Compile(`(module (func (result v128) (v128.const ` + shape + `)))`)
+		if err != nil {
+			t.Fatalf("%s: Compile failed: %v", shape, err)
+		}
+		if !bytes.Contains(bin, append([]byte{0xFD, 0x0C}, want...)) {
+			t.Errorf("%s: constant bytes not found in % x", shape, bin)
+		}
+	}
+}
+
+// TestSIMDRoundTrip prints every SIMD instruction and compiles it back.
+func TestSIMDRoundTrip(t *testing.T) {
+	var body strings.Builder
+	for subop := uint32(0); subop <= 0x113; subop++ {
+		name, ok := opcode.SIMDName(subop)
+		if !ok {
+			continue
+		}
+		op, _ := opcode.LookupSIMD(name)
+		body.WriteString("\n" + name)
+		switch op.Imm {
+		case opcode.SIMDImmMemarg:
+			body.WriteString(" offset=4")
+		case opcode.SIMDImmMemargLane:
+			body.WriteString(" offset=4 1")
+		case opcode.SIMDImmLane:
+			body.WriteString(" 1")
+		case opcode.SIMDImmConst:
+			body.WriteString(" i32x4 1 2 3 4")
+		case opcode.SIMDImmShuffle:
+			body.WriteString(strings.Repeat(" 31", 16))
+		}
+	}
+	src := fmt.Sprintf("(module (memory 1) (func %s))", body.String())
+	bin, err := Compile(src)
+	if err != nil {
+		t.Fatalf("Compile failed: %v", err)
+	}
+	for _, folded := range []bool{false, true} {
+		text, err := Disassemble(bin, PrintOptions{Folded: folded})
+		if err != nil {
+			t.Fatalf("Disassemble failed: %v", err)
+		}
+		again, err := Compile(text)
+		if err != nil {
+			t.Fatalf("Compile of printed text failed: %v", err)
+		}
+		if !bytes.Equal(bin, again) {
+			t.Errorf("round trip changed the binary (folded=%v)", folded)
+		}
+	}
+}
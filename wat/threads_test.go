@@ -0,0 +1,72 @@
+package wat
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/wippyai/wasm-runtime/wasm"
+	"github.com/wippyai/wasm-runtime/wat/internal/opcode"
+)
+
+func TestCompileThreads(t *testing.T) {
+	bin, err := Compile(`(module
+		(import "env" "mem" (memory $shared 1 4 shared))
+		(memory $own 1 1 shared)
+		(func (export "lock") (param $addr i32) (result i32)
+			(loop $spin
+				(br_if $spin (i32.atomic.rmw.cmpxchg (local.get $addr) (i32.const 0) (i32.const 1))))
+			(atomic.fence)
+			(drop (memory.atomic.wait32 offset=8 (local.get $addr) (i32.const 1) (i64.const -1)))
+			(drop (i64.atomic.rmw16.xor_u $own (local.get $addr) (i64.const 3)))
+			(i32.atomic.store8 (local.get $addr) (i32.atomic.load16_u align=2 (local.get $addr)))
+			(memory.atomic.notify (local.get $addr) (i32.const 1))))`)
+	if err != nil {
+		t.Fatalf("Compile failed: %v", err)
+	}
+	m, err := wasm.ParseModule(bin)
+	if err != nil {
+		t.Fatalf("ParseModule: %v", err)
+	}
+	// Two memories do not validate as a module, but the bodies type-check
+	if err := m.ValidateCode(); err != nil {
+		t.Fatalf("validate: %v", err)
+	}
+	if !m.Imports[0].Desc.Memory.Limits.Shared || !m.Memories[0].Limits.Shared {
+		t.Error("memories not marked shared")
+	}
+}
+
+// TestAtomicRoundTrip prints every atomic instruction and compiles it back.
+func TestAtomicRoundTrip(t *testing.T) {
+	var body strings.Builder
+	for subop := uint32(0); subop <= 0x4E; subop++ {
+		name, ok := opcode.AtomicName(subop)
+		if !ok {
+			continue
+		}
+		body.WriteString("\n" + name)
+		if name != "atomic.fence" {
+			body.WriteString(" offset=4")
+		}
+	}
+	src := fmt.Sprintf("(module (memory 1 1 shared) (func %s))", body.String())
+	bin, err := Compile(src)
+	if err != nil {
+		t.Fatalf("Compile failed: %v", err)
+	}
+	for _, folded := range []bool{false, true} {
+		text, err := Disassemble(bin, PrintOptions{Folded: folded})
+		if err != nil {
+			t.Fatalf("Disassemble failed: %v", err)
+		}
+		again, err := Compile(text)
+		if err != nil {
+			t.Fatalf("Compile of printed text failed: %v", err)
+		}
+		if !bytes.Equal(bin, again) {
+			t.Errorf("round trip changed the binary (folded=%v)", folded)
+		}
+	}
+}
@@ -0,0 +1,39 @@
+// Package wast parses and runs WebAssembly script (.wast) files, the
+// format of the core and component model spec test suites.
+//
+// A script is a sequence of commands. Modules and components in text form
+// are compiled with the wat compiler; (module binary ...) and
+// (module quote ...) forms are decoded and compiled as written. Commands
+// run in order against a Runner, which loads and instantiates modules
+// through engine.WazeroEngine:
+//
+//	script, err := wast.Parse(source)
+//	r, err := wast.NewRunner(ctx)
+//	defer r.Close(ctx)
+//	err = r.Run(ctx, script) // every failed command, joined
+//
+// Supported commands:
+//   - (module ...), (component ...), including the binary, quote,
+//     definition and instance forms
+//   - (register "name" $id?), which makes a module importable by name
+//   - (invoke $id? "name" arg*) and (get $id? "name")
+//   - assert_return, assert_trap, assert_exhaustion
+//   - assert_invalid, assert_malformed, assert_unlinkable and
+//     assert_uninstantiable, for modules and components alike
+//
+// Values are written as constants: i32.const through v128.const,
+// ref.null, ref.extern and ref.func for core functions, and bool.const,
+// s8.const through u64.const, f32.const, f64.const, char.const and
+// str.const for component functions. Expected results may also be
+// nan:canonical or nan:arithmetic, per lane of a v128, or (either ...).
+//
+// Modules import the usual "spectest" module: print functions, the
+// global_i32, global_i64, global_f32 and global_f64 globals, a table and
+// a memory.
+//
+// Assertions that a module fails only check that it does; the failure
+// message is not compared. A malformed module may fail to compile or to
+// load; an invalid one must compile and then fail to load. Trap messages are compared by their
+// errors.TrapCode, so "undefined element" and "uninitialized element"
+// both match a table access trap.
+package wast
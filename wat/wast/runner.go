@@ -0,0 +1,483 @@
+package wast
+
+import (
+	"context"
+	stderrors "errors"
+	"fmt"
+	"strings"
+
+	"github.com/tetratelabs/wazero/api"
+
+	"github.com/wippyai/wasm-runtime/component"
+	"github.com/wippyai/wasm-runtime/engine"
+	"github.com/wippyai/wasm-runtime/errors"
+	"github.com/wippyai/wasm-runtime/wasm"
+	"github.com/wippyai/wasm-runtime/wat"
+)
+
+// spectest is the module the spec tests import host items from. Its
+// print functions do nothing.
+const spectest = `(module
+	(global (export "global_i32") i32 (i32.const 666))
+	(global (export "global_i64") i64 (i64.const 666))
+	(global (export "global_f32") f32 (f32.const 666.6))
+	(global (export "global_f64") f64 (f64.const 666.6))
+	(table (export "table") 10 20 funcref)
+	(memory (export "memory") 1 2)
+	(func (export "print"))
+	(func (export "print_i32") (param i32))
+	(func (export "print_i64") (param i64))
+	(func (export "print_f32") (param f32))
+	(func (export "print_f64") (param f64))
+	(func (export "print_i32_f32") (param i32 f32))
+	(func (export "print_f64_f64") (param f64 f64)))`
+
+// trapCodes maps the trap messages of spec tests to the code of the trap
+// they expect. Messages not listed match any trap.
+var trapCodes = []struct {
+	message string
+	code    errors.TrapCode
+}{
+	{"unreachable", errors.TrapUnreachable},
+	{"integer divide by zero", errors.TrapIntegerDivideByZero},
+	{"integer overflow", errors.TrapIntegerOverflow},
+	{"invalid conversion to integer", errors.TrapInvalidConversion},
+	{"out of bounds memory access", errors.TrapOutOfBounds},
+	{"out of bounds table access", errors.TrapTableOutOfBounds},
+	{"undefined element", errors.TrapTableOutOfBounds},
+	{"uninitialized element", errors.TrapTableOutOfBounds},
+	{"indirect call type mismatch", errors.TrapIndirectCallType},
+	{"call stack exhausted", errors.TrapStackOverflow},
+	{"unaligned atomic", errors.TrapUnalignedAtomic},
+}
+
+// Runner runs the commands of scripts in order. Modules defined by one
+// command stay available to the next, including across scripts.
+type Runner struct {
+	engine      *engine.WazeroEngine
+	instances   map[string]*instance
+	definitions map[string]*Module
+	registered  map[string]*engine.WazeroInstance
+	last        *instance
+	count       int
+}
+
+// instance is a module or component instantiated by a script.
+type instance struct {
+	*engine.WazeroInstance
+	name      string // module name it is instantiated under
+	binary    []byte
+	component bool
+}
+
+// NewRunner creates a runner with its own engine, with the spectest
+// module instantiated.
+func NewRunner(ctx context.Context) (*Runner, error) {
+	eng, err := engine.NewWazeroEngine(ctx)
+	if err != nil {
+		return nil, err
+	}
+	r := &Runner{
+		engine:      eng,
+		instances:   make(map[string]*instance),
+		definitions: make(map[string]*Module),
+		registered:  make(map[string]*engine.WazeroInstance),
+	}
+	bin, err := wat.Compile(spectest)
+	if err != nil {
+		_ = eng.Close(ctx)
+		return nil, fmt.Errorf("compile spectest: %w", err)
+	}
+	if _, err := r.load(ctx, bin, "spectest"); err != nil {
+		_ = eng.Close(ctx)
+		return nil, fmt.Errorf("instantiate spectest: %w", err)
+	}
+	return r, nil
+}
+
+// Close releases the engine and every instance.
+func (r *Runner) Close(ctx context.Context) error {
+	return r.engine.Close(ctx)
+}
+
+// Run executes the commands of s in order and returns the failures of
+// all of them, joined.
+func (r *Runner) Run(ctx context.Context, s *Script) error {
+	var errs []error
+	for _, cmd := range s.Commands {
+		if err := r.Exec(ctx, cmd); err != nil {
+			errs = append(errs, fmt.Errorf("line %d: %s: %w", cmd.Line, cmd.Kind, err))
+		}
+	}
+	return stderrors.Join(errs...)
+}
+
+// Exec executes one command. It returns an error if the command fails,
+// or if an assertion does not hold.
+func (r *Runner) Exec(ctx context.Context, cmd *Command) error {
+	switch cmd.Kind {
+	case "module":
+		return r.define(ctx, cmd.Module)
+
+	case "register":
+		return r.register(ctx, cmd.Name, cmd.Ref)
+
+	case "invoke", "get":
+		_, err := r.act(ctx, cmd.Action)
+		return err
+
+	case "assert_return":
+		got, err := r.act(ctx, cmd.Action)
+		if err != nil {
+			return err
+		}
+		return checkResults(cmd.Expected, got)
+
+	case "assert_trap", "assert_exhaustion":
+		if cmd.Module != nil {
+			return r.expectInstantiateError(ctx, cmd.Module, cmd.Message)
+		}
+		_, err := r.act(ctx, cmd.Action)
+		return checkTrap(err, cmd.Message)
+
+	case "assert_malformed":
+		if cmd.Module.Err != nil {
+			return nil
+		}
+		return r.expectInvalid(ctx, cmd.Module, cmd.Message)
+
+	case "assert_invalid":
+		// Invalid modules are well formed: they must compile, and fail
+		// validation when loaded
+		if cmd.Module.Err != nil {
+			return fmt.Errorf("compile: %w", cmd.Module.Err)
+		}
+		return r.expectInvalid(ctx, cmd.Module, cmd.Message)
+
+	case "assert_unlinkable", "assert_uninstantiable":
+		return r.expectInstantiateError(ctx, cmd.Module, cmd.Message)
+	}
+	return fmt.Errorf("unsupported command %q", cmd.Kind)
+}
+
+// define runs a module command.
+func (r *Runner) define(ctx context.Context, m *Module) error {
+	if m.Definition {
+		if m.Err != nil {
+			return fmt.Errorf("compile: %w", m.Err)
+		}
+		if m.ID != "" {
+			r.definitions[m.ID] = m
+		}
+		return nil
+	}
+
+	src := m
+	if m.Instance {
+		if src = r.definitions[m.Of]; src == nil {
+			return fmt.Errorf("unknown module definition %s", m.Of)
+		}
+	}
+	inst, err := r.instantiate(ctx, src)
+	if err != nil {
+		// Later commands must not run against the module before it
+		r.last = nil
+		return err
+	}
+	if m.ID != "" {
+		r.instances[m.ID] = inst
+	}
+	r.last = inst
+	return nil
+}
+
+// expectInvalid checks that validating or loading m fails.
+func (r *Runner) expectInvalid(ctx context.Context, m *Module, message string) error {
+	if _, err := r.compile(ctx, m.Binary); err == nil {
+		return fmt.Errorf("module loaded, expected %q", message)
+	}
+	return nil
+}
+
+// expectInstantiateError checks that m compiles but fails to link or
+// instantiate.
+func (r *Runner) expectInstantiateError(ctx context.Context, m *Module, message string) error {
+	if m.Err != nil {
+		return fmt.Errorf("compile: %w", m.Err)
+	}
+	inst, err := r.instantiate(ctx, m)
+	if err != nil {
+		return nil
+	}
+	_ = inst.Close(ctx)
+	return fmt.Errorf("module instantiated, expected %q", message)
+}
+
+func (r *Runner) instantiate(ctx context.Context, m *Module) (*instance, error) {
+	if m.Err != nil {
+		return nil, fmt.Errorf("compile: %w", m.Err)
+	}
+	r.count++
+	name := fmt.Sprintf("wast:%d", r.count)
+	inst, err := r.load(ctx, m.Binary, name)
+	if err != nil {
+		return nil, err
+	}
+	return &instance{
+		WazeroInstance: inst,
+		name:           name,
+		binary:         m.Binary,
+		component:      component.IsComponent(m.Binary),
+	}, nil
+}
+
+// compile validates bin, when it is a core module, and loads it.
+func (r *Runner) compile(ctx context.Context, bin []byte) (*engine.WazeroModule, error) {
+	if !component.IsComponent(bin) {
+		if _, err := wasm.ParseModuleValidate(bin); err != nil {
+			return nil, fmt.Errorf("validate: %w", err)
+		}
+	}
+	return r.engine.LoadModule(ctx, bin)
+}
+
+// load loads bin and instantiates it under name.
+func (r *Runner) load(ctx context.Context, bin []byte, name string) (*engine.WazeroInstance, error) {
+	mod, err := r.compile(ctx, bin)
+	if err != nil {
+		return nil, err
+	}
+	return mod.InstantiateWithConfig(ctx, &engine.InstanceConfig{Name: name})
+}
+
+// lookup returns the instance with the given id, or the most recent one.
+func (r *Runner) lookup(id string) (*instance, error) {
+	if id == "" {
+		if r.last == nil {
+			return nil, fmt.Errorf("no module instantiated")
+		}
+		return r.last, nil
+	}
+	inst, ok := r.instances[id]
+	if !ok {
+		return nil, fmt.Errorf("unknown module %s", id)
+	}
+	return inst, nil
+}
+
+// register makes the instance with the given id importable under name,
+// by instantiating a module under that name that re-exports its exports.
+func (r *Runner) register(ctx context.Context, name, id string) error {
+	src, err := r.lookup(id)
+	if err != nil {
+		return err
+	}
+	if src.component {
+		return fmt.Errorf("cannot register a component")
+	}
+	fwd, err := forwarder(src.binary, src.name)
+	if err != nil {
+		return err
+	}
+	if old := r.registered[name]; old != nil {
+		_ = old.Close(ctx)
+		delete(r.registered, name)
+	}
+	inst, err := r.load(ctx, fwd, name)
+	if err != nil {
+		return err
+	}
+	r.registered[name] = inst
+	return nil
+}
+
+// forwarder builds a module that imports every export of bin, as
+// instantiated under name, and exports it again.
+func forwarder(bin []byte, name string) ([]byte, error) {
+	src, err := wasm.ParseModule(bin)
+	if err != nil {
+		return nil, err
+	}
+	var fwd wasm.Module
+	counts := make(map[byte]uint32)
+	for _, exp := range src.Exports {
+		desc := wasm.ImportDesc{Kind: exp.Kind}
+		switch exp.Kind {
+		case wasm.KindFunc:
+			ft := src.GetFuncType(exp.Idx)
+			if ft == nil {
+				return nil, fmt.Errorf("export %q: unknown function %d", exp.Name, exp.Idx)
+			}
+			desc.TypeIdx = fwd.AddType(*ft)
+		case wasm.KindTable:
+			if imp := importOf(src, wasm.KindTable, exp.Idx); imp != nil {
+				desc.Table = imp.Table
+			} else {
+				t := src.Tables[exp.Idx-uint32(src.NumImportedTables())]
+				t.Init = nil
+				desc.Table = &t
+			}
+		case wasm.KindMemory:
+			if imp := importOf(src, wasm.KindMemory, exp.Idx); imp != nil {
+				desc.Memory = imp.Memory
+			} else {
+				desc.Memory = &src.Memories[exp.Idx-uint32(src.NumImportedMemories())]
+			}
+		case wasm.KindGlobal:
+			if imp := importOf(src, wasm.KindGlobal, exp.Idx); imp != nil {
+				desc.Global = imp.Global
+			} else {
+				desc.Global = &src.Globals[exp.Idx-uint32(src.NumImportedGlobals())].Type
+			}
+		default:
+			return nil, fmt.Errorf("export %q: cannot register exports of kind %d", exp.Name, exp.Kind)
+		}
+		fwd.Imports = append(fwd.Imports, wasm.Import{Module: name, Name: exp.Name, Desc: desc})
+		fwd.Exports = append(fwd.Exports, wasm.Export{Name: exp.Name, Kind: exp.Kind, Idx: counts[exp.Kind]})
+		counts[exp.Kind]++
+	}
+	return fwd.Encode(), nil
+}
+
+// importOf returns the descriptor of the idx-th import of kind, or nil if
+// idx is past the imports of kind.
+func importOf(m *wasm.Module, kind byte, idx uint32) *wasm.ImportDesc {
+	for i := range m.Imports {
+		if m.Imports[i].Desc.Kind != kind {
+			continue
+		}
+		if idx == 0 {
+			return &m.Imports[i].Desc
+		}
+		idx--
+	}
+	return nil
+}
+
+// act runs an action. Core results are returned as Values, component
+// results as the Go values they lift to.
+func (r *Runner) act(ctx context.Context, a *Action) ([]any, error) {
+	inst, err := r.lookup(a.Module)
+	if err != nil {
+		return nil, err
+	}
+	if a.Kind == "get" {
+		g := inst.ExportedGlobal(a.Name)
+		if g == nil {
+			return nil, fmt.Errorf("unknown global %q", a.Name)
+		}
+		return []any{coreValue(g.Type(), g.Get(), 0)}, nil
+	}
+	if inst.component {
+		return invokeComponent(ctx, inst, a)
+	}
+
+	fn := inst.GetExportedFunction(a.Name)
+	if fn == nil {
+		return nil, fmt.Errorf("unknown function %q", a.Name)
+	}
+	def := fn.Definition()
+	params := def.ParamTypes()
+	if len(params) != len(a.Args) {
+		return nil, fmt.Errorf("%s takes %d arguments, got %d", a.Name, len(params), len(a.Args))
+	}
+	var stack []uint64
+	for i, arg := range a.Args {
+		if want := typeName(params[i]); arg.Type != want {
+			return nil, fmt.Errorf("argument %d is %s, want %s", i, arg.Type, want)
+		}
+		stack = append(stack, arg.Bits)
+		if arg.Type == "v128" {
+			stack = append(stack, arg.High)
+		}
+	}
+
+	out, err := fn.Call(ctx, stack...)
+	if err != nil {
+		return nil, err
+	}
+	var results []any
+	for _, t := range def.ResultTypes() {
+		low, high := out[0], uint64(0)
+		if wasm.ValType(t) == wasm.ValV128 {
+			high, out = out[1], out[1:]
+		}
+		results = append(results, coreValue(t, low, high))
+		out = out[1:]
+	}
+	return results, nil
+}
+
+// coreValue converts a core value as wazero passes it to a Value.
+func coreValue(t api.ValueType, b, high uint64) Value {
+	if t == api.ValueTypeI32 || t == api.ValueTypeF32 {
+		b = uint64(uint32(b))
+	}
+	return Value{Type: typeName(t), Bits: b, High: high}
+}
+
+// typeName names the value types wazero has no name for.
+func typeName(t api.ValueType) string {
+	switch wasm.ValType(t) {
+	case wasm.ValV128:
+		return "v128"
+	case wasm.ValFuncRef:
+		return "funcref"
+	}
+	return api.ValueTypeName(t)
+}
+
+func invokeComponent(ctx context.Context, inst *instance, a *Action) ([]any, error) {
+	args := make([]any, len(a.Args))
+	for i, arg := range a.Args {
+		v, err := arg.goValue()
+		if err != nil {
+			return nil, fmt.Errorf("argument %d: %w", i, err)
+		}
+		args[i] = v
+	}
+	res, err := inst.CallWithLift(ctx, a.Name, args...)
+	if err != nil || res == nil {
+		return nil, err
+	}
+	return []any{res}, nil
+}
+
+func checkResults(want []Value, got []any) error {
+	if len(want) != len(got) {
+		return fmt.Errorf("got %d results %v, want %d %v", len(got), got, len(want), want)
+	}
+	for i, w := range want {
+		var ok bool
+		if v, core := got[i].(Value); core {
+			ok = w.match(v)
+		} else {
+			ok = w.matchGo(got[i])
+		}
+		if !ok {
+			return fmt.Errorf("result %d: got %v, want %v", i, got[i], w)
+		}
+	}
+	return nil
+}
+
+// checkTrap checks that err is a trap matching message.
+func checkTrap(err error, message string) error {
+	if err == nil {
+		return fmt.Errorf("returned, expected trap %q", message)
+	}
+	trap, ok := errors.ParseTrap(err)
+	if !ok && err.Error() == "stack overflow" {
+		// wazero reports exhausting the stack without a backtrace
+		trap, ok = &errors.Trap{Code: errors.TrapStackOverflow, Message: err.Error()}, true
+	}
+	if !ok {
+		return fmt.Errorf("failed without trapping, expected %q: %w", message, err)
+	}
+	for _, t := range trapCodes {
+		if strings.HasPrefix(message, t.message) && trap.Code != t.code {
+			return fmt.Errorf("trapped with %s (%s), expected %q", trap.Code, trap.Message, message)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,357 @@
+package wast
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/wippyai/wasm-runtime/wat/internal/component"
+	"github.com/wippyai/wasm-runtime/wat/internal/encoder"
+	"github.com/wippyai/wasm-runtime/wat/internal/parser"
+	"github.com/wippyai/wasm-runtime/wat/internal/token"
+)
+
+// Script is a parsed .wast file.
+type Script struct {
+	Commands []*Command
+}
+
+// Command is one top-level form of a script.
+type Command struct {
+	Module *Module // module commands and module assertions
+	Action *Action // actions and action assertions
+
+	// Kind is the keyword of the form: "module" (for components too),
+	// "register", "invoke", "get", or one of the assert_* forms.
+	Kind string
+
+	// Message is the failure an assertion other than assert_return expects
+	Message string
+
+	// Name is the name register makes a module importable under, and Ref
+	// the id of the module it registers, empty for the most recent one
+	Name string
+	Ref  string
+
+	// Expected holds the results of assert_return
+	Expected []Value
+
+	Line int
+}
+
+// Module is a module or component defined by a script. Text modules are
+// compiled when the script is parsed; Err holds why one failed to, for
+// assert_malformed and assert_invalid to check.
+type Module struct {
+	Err error
+
+	// ID is the $id of the module, or of the instance (module instance ...)
+	// defines; Of names the definition it instantiates
+	ID string
+	Of string
+
+	Binary []byte
+	Line   int
+
+	Component bool
+
+	// Definition marks a (module definition ...), which is only
+	// instantiated by a later (module instance ...)
+	Definition bool
+
+	// Instance marks a (module instance ...)
+	Instance bool
+}
+
+// Action is an invoke or get, run on its own or by an assertion.
+type Action struct {
+	Kind   string // "invoke" or "get"
+	Module string // module id, empty for the most recent module
+	Name   string // export name
+	Args   []Value
+	Line   int
+}
+
+// Parse parses a .wast script. Modules that fail to compile do not fail
+// Parse; running their command reports the error instead.
+func Parse(source string) (*Script, error) {
+	p := &scriptParser{tokens: token.Tokenize(source)}
+	if len(p.tokens) > 1 && moduleFields[p.tokens[1].Value] {
+		// A script of module fields alone is one inline module
+		p.tokens = token.Tokenize("(module " + source + "\n)")
+	}
+	var s Script
+	for p.peek() != nil {
+		cmd, err := p.parseCommand()
+		if err != nil {
+			return nil, err
+		}
+		s.Commands = append(s.Commands, cmd)
+	}
+	return &s, nil
+}
+
+// moduleFields are the fields a script may consist of as an inline module.
+var moduleFields = map[string]bool{
+	"type": true, "rec": true, "import": true, "func": true, "table": true, "memory": true,
+	"global": true, "tag": true, "export": true, "start": true, "elem": true, "data": true,
+}
+
+type scriptParser struct {
+	tokens []token.Token
+	pos    int
+}
+
+func (p *scriptParser) parseCommand() (*Command, error) {
+	if p.isGroup("module") || p.isGroup("component") {
+		m, err := p.parseModule()
+		if err != nil {
+			return nil, err
+		}
+		return &Command{Kind: "module", Module: m, Line: m.Line}, nil
+	}
+	if p.isGroup("invoke") || p.isGroup("get") {
+		a, err := p.parseAction()
+		if err != nil {
+			return nil, err
+		}
+		return &Command{Kind: a.Kind, Action: a, Line: a.Line}, nil
+	}
+
+	if _, err := p.expect(token.LParen); err != nil {
+		return nil, err
+	}
+	kw, err := p.expect(token.Ident)
+	if err != nil {
+		return nil, err
+	}
+	cmd := &Command{Kind: kw.Value, Line: kw.Line}
+
+	switch kw.Value {
+	case "register":
+		if cmd.Name, err = p.parseString(); err != nil {
+			return nil, err
+		}
+		cmd.Ref = p.optionalID()
+
+	case "assert_return":
+		if cmd.Action, err = p.parseAction(); err != nil {
+			return nil, err
+		}
+		for p.isGroup("") {
+			v, err := p.parseValue(true)
+			if err != nil {
+				return nil, err
+			}
+			cmd.Expected = append(cmd.Expected, v)
+		}
+
+	case "assert_trap", "assert_exhaustion":
+		if kw.Value == "assert_trap" && (p.isGroup("module") || p.isGroup("component")) {
+			cmd.Module, err = p.parseModule()
+		} else {
+			cmd.Action, err = p.parseAction()
+		}
+		if err != nil {
+			return nil, err
+		}
+		if cmd.Message, err = p.parseString(); err != nil {
+			return nil, err
+		}
+
+	case "assert_invalid", "assert_malformed", "assert_unlinkable", "assert_uninstantiable":
+		if cmd.Module, err = p.parseModule(); err != nil {
+			return nil, err
+		}
+		if cmd.Message, err = p.parseString(); err != nil {
+			return nil, err
+		}
+
+	default:
+		return nil, fmt.Errorf("line %d: unsupported command %q", kw.Line, kw.Value)
+	}
+
+	if _, err := p.expect(token.RParen); err != nil {
+		return nil, err
+	}
+	return cmd, nil
+}
+
+// parseModule parses a module or component form, compiling text and quote
+// modules.
+func (p *scriptParser) parseModule() (*Module, error) {
+	start := p.pos
+	open := p.next()
+	kw := p.next()
+	m := &Module{Line: open.Line, Component: kw.Value == "component"}
+
+	switch {
+	case p.isKeyword("definition"):
+		p.pos++
+		m.Definition = true
+	case p.isKeyword("instance"):
+		p.pos++
+		m.Instance = true
+		m.ID, m.Of = p.optionalID(), p.optionalID()
+		if m.Of == "" {
+			m.ID, m.Of = "", m.ID
+		}
+		_, err := p.expect(token.RParen)
+		return m, err
+	}
+	body := p.pos
+	m.ID = p.optionalID()
+
+	switch {
+	case p.isKeyword("binary"):
+		p.pos++
+		for p.isString() {
+			m.Binary = append(m.Binary, parser.DecodeStringLiteral(p.next().Value)...)
+		}
+		_, err := p.expect(token.RParen)
+		return m, err
+
+	case p.isKeyword("quote"):
+		p.pos++
+		var text strings.Builder
+		text.WriteString("(" + kw.Value)
+		for p.isString() {
+			text.WriteByte(' ')
+			text.Write(parser.DecodeStringLiteral(p.next().Value))
+		}
+		text.WriteByte(')')
+		if _, err := p.expect(token.RParen); err != nil {
+			return nil, err
+		}
+		m.Binary, m.Err = compile(token.Tokenize(text.String()))
+		return m, nil
+	}
+
+	end := p.groupEnd(start)
+	if end < 0 {
+		return nil, fmt.Errorf("line %d: unclosed %s", open.Line, kw.Value)
+	}
+	tokens := append([]token.Token{*open, *kw}, p.tokens[body:end+1]...)
+	p.pos = end + 1
+	m.Binary, m.Err = compile(tokens)
+	return m, nil
+}
+
+// compile compiles the tokens of a module or component, as wat.Compile
+// does their text.
+func compile(tokens []token.Token) ([]byte, error) {
+	if component.IsComponent(tokens) {
+		return component.New(tokens).Parse()
+	}
+	mod, err := parser.New(tokens).Parse()
+	if err != nil {
+		return nil, err
+	}
+	return encoder.Encode(mod), nil
+}
+
+func (p *scriptParser) parseAction() (*Action, error) {
+	if _, err := p.expect(token.LParen); err != nil {
+		return nil, err
+	}
+	kw, err := p.expect(token.Ident)
+	if err != nil {
+		return nil, err
+	}
+	if kw.Value != "invoke" && kw.Value != "get" {
+		return nil, fmt.Errorf("line %d: expected invoke or get, got %q", kw.Line, kw.Value)
+	}
+	a := &Action{Kind: kw.Value, Module: p.optionalID(), Line: kw.Line}
+	if a.Name, err = p.parseString(); err != nil {
+		return nil, err
+	}
+	for a.Kind == "invoke" && p.isGroup("") {
+		v, err := p.parseValue(false)
+		if err != nil {
+			return nil, err
+		}
+		a.Args = append(a.Args, v)
+	}
+	if _, err := p.expect(token.RParen); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+func (p *scriptParser) peek() *token.Token {
+	if p.pos >= len(p.tokens) {
+		return nil
+	}
+	return &p.tokens[p.pos]
+}
+
+func (p *scriptParser) next() *token.Token {
+	t := p.peek()
+	if t != nil {
+		p.pos++
+	}
+	return t
+}
+
+func (p *scriptParser) expect(typ token.Type) (*token.Token, error) {
+	t := p.next()
+	if t == nil {
+		return nil, fmt.Errorf("unexpected end of script, expected %s", typ)
+	}
+	if t.Type != typ {
+		return nil, fmt.Errorf("line %d: expected %s, got %q", t.Line, typ, t.Value)
+	}
+	return t, nil
+}
+
+// isGroup reports whether the next tokens open a group with keyword kw,
+// or any group if kw is empty.
+func (p *scriptParser) isGroup(kw string) bool {
+	if p.pos+1 >= len(p.tokens) || p.tokens[p.pos].Type != token.LParen {
+		return false
+	}
+	return kw == "" || p.tokens[p.pos+1].Type == token.Ident && p.tokens[p.pos+1].Value == kw
+}
+
+func (p *scriptParser) isKeyword(kw string) bool {
+	t := p.peek()
+	return t != nil && t.Type == token.Ident && t.Value == kw
+}
+
+func (p *scriptParser) isString() bool {
+	t := p.peek()
+	return t != nil && t.Type == token.String
+}
+
+func (p *scriptParser) optionalID() string {
+	if t := p.peek(); t != nil && t.Type == token.Ident && strings.HasPrefix(t.Value, "$") {
+		p.pos++
+		return t.Value
+	}
+	return ""
+}
+
+func (p *scriptParser) parseString() (string, error) {
+	t, err := p.expect(token.String)
+	if err != nil {
+		return "", err
+	}
+	return string(parser.DecodeStringLiteral(t.Value)), nil
+}
+
+// groupEnd returns the index of the paren closing the group opened at
+// start, or -1 if it is not closed.
+func (p *scriptParser) groupEnd(start int) int {
+	depth := 0
+	for i := start; i < len(p.tokens); i++ {
+		switch p.tokens[i].Type {
+		case token.LParen:
+			depth++
+		case token.RParen:
+			depth--
+			if depth == 0 {
+				return i
+			}
+		}
+	}
+	return -1
+}
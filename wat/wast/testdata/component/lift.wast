@@ -0,0 +1,80 @@
+;; Calls into components through lifted functions
+
+(component $calc
+  (core module $m
+    (memory (export "memory") 1)
+    (global $top (mut i32) (i32.const 1024))
+    (func (export "cabi_realloc") (param i32 i32 i32 i32) (result i32)
+      (global.get $top)
+      (global.set $top (i32.add (global.get $top) (local.get 3))))
+    (func (export "add") (param i32 i32) (result i32)
+      (i32.add (local.get 0) (local.get 1)))
+    (func (export "neg") (param i64) (result i64)
+      (i64.sub (i64.const 0) (local.get 0)))
+    (func (export "not") (param i32) (result i32)
+      (i32.eqz (local.get 0)))
+    (func (export "half") (param f64) (result f64)
+      (f64.mul (local.get 0) (f64.const 0.5)))
+    (func (export "len") (param i32 i32) (result i32)
+      (local.get 1))
+    (func (export "fail") (param i32) (result i32)
+      (unreachable)))
+  (core instance $i (instantiate $m))
+  (alias core export $i "memory" (core memory $mem))
+  (core func $realloc (alias core export $i "cabi_realloc"))
+  (func (export "add") (param "a" u32) (param "b" u32) (result u32)
+    (canon lift (core func $i "add")))
+  (func (export "neg") (param "x" s64) (result s64)
+    (canon lift (core func $i "neg")))
+  (func (export "not") (param "b" bool) (result bool)
+    (canon lift (core func $i "not")))
+  (func (export "half") (param "x" f64) (result f64)
+    (canon lift (core func $i "half")))
+  (func (export "len") (param "s" string) (result u32)
+    (canon lift (core func $i "len") (memory $mem) (realloc $realloc)))
+  (func (export "fail") (param "x" u32) (result u32)
+    (canon lift (core func $i "fail"))))
+
+(assert_return (invoke "add" (u32.const 2) (u32.const 40)) (u32.const 42))
+(assert_return (invoke "add" (u32.const 0xffffffff) (u32.const 1)) (u32.const 0))
+(assert_return (invoke $calc "neg" (s64.const 7)) (s64.const -7))
+(assert_return (invoke "not" (bool.const false)) (bool.const true))
+(assert_return (invoke "not" (bool.const true)) (bool.const false))
+(assert_return (invoke "half" (f64.const 3)) (f64.const 1.5))
+(assert_return (invoke "len" (str.const "hello")) (u32.const 5))
+(assert_return (invoke "len" (str.const "")) (u32.const 0))
+(assert_trap (invoke "fail" (u32.const 1)) "unreachable")
+
+;; Components are checked like modules
+
+(assert_malformed
+  (component binary "\00asm" "\0d\00\01\00" "\ff\00")
+  "malformed section id")
+
+(assert_invalid
+  (component
+    (type $t (func))
+    (export "t" (type $t))
+    (export "t" (type $t)))
+  "duplicate export name")
+
+(assert_invalid
+  (component
+    (core module $m (func (export "f")))
+    (core instance $i (instantiate $m))
+    (type $r (record (field "a" u32)))
+    (func (type $r) (canon lift (core func $i "f"))))
+  "not a function type")
+
+;; Imports no host provides trap when called
+
+(component
+  (import "host" (func $f))
+  (core func $g (canon lower (func $f)))
+  (core module $m
+    (import "" "f" (func $f))
+    (func (export "run") (call $f)))
+  (core instance $i (instantiate $m (with "" (instance (export "f" (func $g))))))
+  (func (export "run") (canon lift (core func $i "run"))))
+
+(assert_trap (invoke "run") "unresolved host import")
@@ -0,0 +1,461 @@
+package wast
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/wippyai/wasm-runtime/wat/internal/token"
+)
+
+// Value is an argument or expected result of an action.
+type Value struct {
+	// Type is a core value type: i32, i64, f32, f64, v128, funcref or
+	// externref; a component value type: bool, s8 through u64, char or
+	// string; or a lane type, i8 or i16 included, for v128 lanes
+	Type string
+
+	// NaN is "canonical" or "arithmetic" for an expected NaN of any payload
+	NaN string
+
+	// Shape is the lane shape of a v128, such as i32x4
+	Shape string
+
+	// Str holds char and string values
+	Str string
+
+	// Lanes holds the lanes of a v128, and Either the alternatives of an
+	// (either ...) result
+	Lanes  []Value
+	Either []Value
+
+	// Bits holds scalars, and with High the low and high halves of a v128.
+	// References hold their host value, 0 for null.
+	Bits uint64
+	High uint64
+
+	// Any marks an expected non-null reference of any value
+	Any bool
+}
+
+// laneShapes maps v128 shapes to their lane type and count.
+var laneShapes = map[string]struct {
+	typ   string
+	count int
+}{
+	"i8x16": {"i8", 16},
+	"i16x8": {"i16", 8},
+	"i32x4": {"i32", 4},
+	"i64x2": {"i64", 2},
+	"f32x4": {"f32", 4},
+	"f64x2": {"f64", 2},
+}
+
+// typeBits is the width of integer and float types.
+var typeBits = map[string]int{
+	"i8": 8, "i16": 16, "i32": 32, "i64": 64, "f32": 32, "f64": 64,
+	"s8": 8, "u8": 8, "s16": 16, "u16": 16, "s32": 32, "u32": 32, "s64": 64, "u64": 64,
+}
+
+// parseValue parses a constant. Results may also be NaN patterns, any
+// reference, and (either ...).
+func (p *scriptParser) parseValue(result bool) (Value, error) {
+	if _, err := p.expect(token.LParen); err != nil {
+		return Value{}, err
+	}
+	kw, err := p.expect(token.Ident)
+	if err != nil {
+		return Value{}, err
+	}
+
+	var v Value
+	typ, _, _ := strings.Cut(kw.Value, ".")
+	switch kw.Value {
+	case "i32.const", "i64.const", "s8.const", "u8.const", "s16.const", "u16.const",
+		"s32.const", "u32.const", "s64.const", "u64.const":
+		v.Type = typ
+		t, err := p.expect(token.Number)
+		if err != nil {
+			return Value{}, err
+		}
+		if v.Bits, err = parseInt(t.Value, typeBits[typ]); err != nil {
+			return Value{}, fmt.Errorf("line %d: %w", t.Line, err)
+		}
+
+	case "f32.const", "f64.const":
+		if v, err = p.parseFloat(typ, result); err != nil {
+			return Value{}, err
+		}
+
+	case "v128.const":
+		if v, err = p.parseV128(result); err != nil {
+			return Value{}, err
+		}
+
+	case "bool.const":
+		v.Type = "bool"
+		switch {
+		case p.isKeyword("true"):
+			v.Bits = 1
+		case !p.isKeyword("false"):
+			return Value{}, fmt.Errorf("line %d: expected true or false", kw.Line)
+		}
+		p.pos++
+
+	case "char.const", "str.const":
+		v.Type = map[string]string{"char": "char", "str": "string"}[typ]
+		if v.Str, err = p.parseString(); err != nil {
+			return Value{}, err
+		}
+		if v.Type == "char" && utf8.RuneCountInString(v.Str) != 1 {
+			return Value{}, fmt.Errorf("line %d: char.const %q is not one character", kw.Line, v.Str)
+		}
+
+	case "ref.null":
+		heap, err := p.expect(token.Ident)
+		if err != nil {
+			return Value{}, err
+		}
+		v.Type = refType(heap.Value)
+
+	case "ref.extern", "ref.host", "ref.func":
+		v.Type = refType(strings.TrimPrefix(kw.Value, "ref."))
+		t := p.peek()
+		if t == nil || t.Type != token.Number {
+			if !result {
+				return Value{}, fmt.Errorf("line %d: %s needs a value", kw.Line, kw.Value)
+			}
+			v.Any = true
+			break
+		}
+		p.pos++
+		n, err := strconv.ParseUint(t.Value, 0, 32)
+		if err != nil {
+			return Value{}, fmt.Errorf("line %d: invalid reference %s", t.Line, t.Value)
+		}
+		// Host references are offset so that 0 remains null
+		v.Bits = n + 1
+		if v.Type == "funcref" {
+			v.Any, v.Bits = true, 0
+		}
+
+	case "either":
+		if !result {
+			return Value{}, fmt.Errorf("line %d: either is only allowed in results", kw.Line)
+		}
+		for p.isGroup("") {
+			alt, err := p.parseValue(true)
+			if err != nil {
+				return Value{}, err
+			}
+			v.Either = append(v.Either, alt)
+		}
+
+	default:
+		return Value{}, fmt.Errorf("line %d: unsupported value %q", kw.Line, kw.Value)
+	}
+
+	if _, err := p.expect(token.RParen); err != nil {
+		return Value{}, err
+	}
+	return v, nil
+}
+
+// refType maps a heap type to the reference type of its values.
+func refType(heap string) string {
+	switch heap {
+	case "func", "funcref":
+		return "funcref"
+	case "extern", "externref", "host":
+		return "externref"
+	}
+	return heap + "ref"
+}
+
+// parseFloat parses an f32 or f64 literal, or a NaN pattern in results.
+func (p *scriptParser) parseFloat(typ string, result bool) (Value, error) {
+	t := p.next()
+	if t == nil {
+		return Value{}, fmt.Errorf("unexpected end of script, expected %s literal", typ)
+	}
+	v := Value{Type: typ}
+	if pattern, ok := strings.CutPrefix(t.Value, "nan:"); ok && (pattern == "canonical" || pattern == "arithmetic") {
+		if !result {
+			return Value{}, fmt.Errorf("line %d: nan:%s is only allowed in results", t.Line, pattern)
+		}
+		v.NaN = pattern
+		return v, nil
+	}
+	var err error
+	if v.Bits, err = parseFloatBits(t.Value, typeBits[typ]); err != nil {
+		return Value{}, fmt.Errorf("line %d: %w", t.Line, err)
+	}
+	return v, nil
+}
+
+// parseV128 parses the shape and lanes of a v128.const.
+func (p *scriptParser) parseV128(result bool) (Value, error) {
+	t, err := p.expect(token.Ident)
+	if err != nil {
+		return Value{}, err
+	}
+	shape, ok := laneShapes[t.Value]
+	if !ok {
+		return Value{}, fmt.Errorf("line %d: unknown v128 shape %q", t.Line, t.Value)
+	}
+	v := Value{Type: "v128", Shape: t.Value}
+	width := 128 / shape.count
+	for i := range shape.count {
+		lane := Value{Type: shape.typ}
+		if shape.typ[0] == 'f' {
+			if lane, err = p.parseFloat(shape.typ, result); err != nil {
+				return Value{}, err
+			}
+		} else {
+			n, err := p.expect(token.Number)
+			if err != nil {
+				return Value{}, err
+			}
+			if lane.Bits, err = parseInt(n.Value, width); err != nil {
+				return Value{}, fmt.Errorf("line %d: %w", n.Line, err)
+			}
+		}
+		v.Lanes = append(v.Lanes, lane)
+
+		off := i * width
+		if off < 64 {
+			v.Bits |= lane.Bits << off
+		} else {
+			v.High |= lane.Bits << (off - 64)
+		}
+	}
+	return v, nil
+}
+
+// parseInt parses an integer literal of the given width into its two's
+// complement bits. Both signed and unsigned ranges are accepted, and
+// leading zeros do not make a literal octal.
+func parseInt(s string, width int) (uint64, error) {
+	clean := strings.ReplaceAll(s, "_", "")
+	neg := strings.HasPrefix(clean, "-")
+	digits := strings.TrimLeft(clean, "+-")
+	base := 10
+	if hex, ok := strings.CutPrefix(digits, "0x"); ok {
+		base, digits = 16, hex
+	}
+	n, err := strconv.ParseUint(digits, base, width)
+	if err != nil || neg && n > 1<<(width-1) {
+		return 0, fmt.Errorf("invalid i%d: %s", width, s)
+	}
+	if neg {
+		n = -n & (math.MaxUint64 >> (64 - width))
+	}
+	return n, nil
+}
+
+// parseFloatBits parses a float literal of the given width into its bits,
+// keeping NaN payloads and the sign of zero.
+func parseFloatBits(s string, width int) (uint64, error) {
+	var sign uint64
+	body := s
+	switch {
+	case strings.HasPrefix(s, "-"):
+		sign, body = 1, s[1:]
+	case strings.HasPrefix(s, "+"):
+		body = s[1:]
+	}
+	exp, mant := 11, 52
+	if width == 32 {
+		exp, mant = 8, 23
+	}
+	sign <<= width - 1
+	inf := (uint64(1)<<exp - 1) << mant
+
+	switch {
+	case body == "inf":
+		return sign | inf, nil
+	case body == "nan":
+		return sign | inf | 1<<(mant-1), nil
+	case strings.HasPrefix(body, "nan:0x"):
+		payload, err := strconv.ParseUint(strings.ReplaceAll(body[6:], "_", ""), 16, 64)
+		if err != nil || payload == 0 || payload >= 1<<mant {
+			return 0, fmt.Errorf("invalid NaN payload: %s", s)
+		}
+		return sign | inf | payload, nil
+	}
+
+	clean := strings.ReplaceAll(s, "_", "")
+	if strings.Contains(clean, "0x") && !strings.ContainsAny(clean, "pP") {
+		clean += "p0"
+	}
+	f, err := strconv.ParseFloat(clean, width)
+	if err != nil {
+		return 0, fmt.Errorf("invalid f%d: %s", width, s)
+	}
+	if width == 32 {
+		return uint64(math.Float32bits(float32(f))), nil
+	}
+	return math.Float64bits(f), nil
+}
+
+// match reports whether got, as returned by a call, matches v.
+func (v Value) match(got Value) bool {
+	if len(v.Either) > 0 {
+		for _, alt := range v.Either {
+			if alt.match(got) {
+				return true
+			}
+		}
+		return false
+	}
+	if v.Type != got.Type {
+		return false
+	}
+	switch {
+	case v.NaN != "":
+		return isNaN(got.Bits, typeBits[v.Type], v.NaN)
+	case v.Type == "v128" && len(v.Lanes) > 0:
+		width := 128 / len(v.Lanes)
+		for i, lane := range v.Lanes {
+			if !lane.match(Value{Type: lane.Type, Bits: laneBits(got, i, width)}) {
+				return false
+			}
+		}
+		return true
+	case v.Any:
+		return got.Bits != 0
+	}
+	return v.Bits == got.Bits && v.High == got.High
+}
+
+// isNaN reports whether bits is a NaN of the given kind.
+func isNaN(b uint64, width int, kind string) bool {
+	exp, mant := 11, 52
+	if width == 32 {
+		exp, mant = 8, 23
+	}
+	inf := (uint64(1)<<exp - 1) << mant
+	quiet := uint64(1) << (mant - 1)
+	b &^= 1 << (width - 1)
+	if kind == "canonical" {
+		return b == inf|quiet
+	}
+	return b&(inf|quiet) == inf|quiet
+}
+
+// laneBits returns lane i of the given width of a v128.
+func laneBits(v Value, i, width int) uint64 {
+	off := i * width
+	b := v.Bits >> off
+	if off >= 64 {
+		b = v.High >> (off - 64)
+	}
+	if width == 64 {
+		return b
+	}
+	return b & (1<<width - 1)
+}
+
+// goValue converts a component value to the Go value its type lifts to.
+func (v Value) goValue() (any, error) {
+	switch v.Type {
+	case "bool":
+		return v.Bits != 0, nil
+	case "s8":
+		return int8(v.Bits), nil
+	case "u8":
+		return uint8(v.Bits), nil
+	case "s16":
+		return int16(v.Bits), nil
+	case "u16":
+		return uint16(v.Bits), nil
+	case "s32":
+		return int32(v.Bits), nil
+	case "u32":
+		return uint32(v.Bits), nil
+	case "s64":
+		return int64(v.Bits), nil
+	case "u64":
+		return v.Bits, nil
+	case "f32":
+		return math.Float32frombits(uint32(v.Bits)), nil
+	case "f64":
+		return math.Float64frombits(v.Bits), nil
+	case "char":
+		r, _ := utf8.DecodeRuneInString(v.Str)
+		return r, nil
+	case "string":
+		return v.Str, nil
+	}
+	return nil, fmt.Errorf("%s is not a component value", v)
+}
+
+// matchGo reports whether got, as lifted from a component call, matches v.
+func (v Value) matchGo(got any) bool {
+	if len(v.Either) > 0 {
+		for _, alt := range v.Either {
+			if alt.matchGo(got) {
+				return true
+			}
+		}
+		return false
+	}
+	if v.NaN != "" {
+		switch f := got.(type) {
+		case float32:
+			return isNaN(uint64(math.Float32bits(f)), 32, v.NaN)
+		case float64:
+			return isNaN(math.Float64bits(f), 64, v.NaN)
+		}
+		return false
+	}
+	want, err := v.goValue()
+	return err == nil && reflect.DeepEqual(want, got)
+}
+
+// String formats v as the constant it was written as.
+func (v Value) String() string {
+	if len(v.Either) > 0 {
+		alts := make([]string, len(v.Either))
+		for i, alt := range v.Either {
+			alts[i] = alt.String()
+		}
+		return "(either " + strings.Join(alts, " ") + ")"
+	}
+	switch v.Type {
+	case "i32", "i64", "s8", "s16", "s32", "s64":
+		width := typeBits[v.Type]
+		n := int64(v.Bits<<(64-width)) >> (64 - width)
+		return fmt.Sprintf("(%s.const %d)", v.Type, n)
+	case "u8", "u16", "u32", "u64":
+		return fmt.Sprintf("(%s.const %d)", v.Type, v.Bits)
+	case "f32", "f64":
+		if v.NaN != "" {
+			return fmt.Sprintf("(%s.const nan:%s)", v.Type, v.NaN)
+		}
+		f := math.Float64frombits(v.Bits)
+		if v.Type == "f32" {
+			f = float64(math.Float32frombits(uint32(v.Bits)))
+		}
+		return fmt.Sprintf("(%s.const %v (0x%x))", v.Type, f, v.Bits)
+	case "v128":
+		return fmt.Sprintf("(v128.const i64x2 0x%016x 0x%016x)", v.Bits, v.High)
+	case "bool":
+		return fmt.Sprintf("(bool.const %t)", v.Bits != 0)
+	case "char":
+		return fmt.Sprintf("(char.const %q)", v.Str)
+	case "string":
+		return fmt.Sprintf("(str.const %q)", v.Str)
+	}
+	heap := strings.TrimSuffix(v.Type, "ref")
+	switch {
+	case v.Any:
+		return "(ref." + heap + ")"
+	case v.Bits == 0:
+		return "(ref.null " + heap + ")"
+	}
+	return fmt.Sprintf("(ref.%s %d)", heap, v.Bits-1)
+}
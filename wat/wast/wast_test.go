@@ -0,0 +1,250 @@
+package wast
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// skip lists the scripts TestSpec does not run, with the reason each one
+// fails. Drop an entry once the script passes.
+var skip = map[string]string{
+	// WAT compiler
+	"core/call_indirect": "type uses with (param) or (result) before (type), or (result) before (param), are accepted",
+	"core/func":          "(result) before (param), (local) after instructions and duplicate func and local names are accepted",
+	"core/type":          "(result) before (param) in a func type is accepted",
+	"core/global":        "duplicate global names are accepted",
+	"core/table":         "duplicate table names are accepted",
+	"core/token":         "tokens without separating whitespace, such as i32.const0, are accepted",
+	"core/tokens":        "tokens without separating whitespace, such as i32.const0, are accepted",
+	"core/elem":          "(item ...), bare expression items and expressions in a table's inline (elem ...) are not parsed; out of bounds active segments do not trap at instantiation",
+
+	// Engine
+	"core/linking": "out of bounds active element segments do not trap at instantiation",
+}
+
+// TestSpec runs the scripts under testdata: core/ holds the WebAssembly
+// spec test suite, component/ component model scripts.
+func TestSpec(t *testing.T) {
+	files, err := filepath.Glob("testdata/*/*.wast")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) == 0 {
+		t.Fatal("no scripts under testdata")
+	}
+	for _, file := range files {
+		name := strings.TrimSuffix(filepath.ToSlash(file), ".wast")
+		t.Run(strings.TrimPrefix(name, "testdata/"), func(t *testing.T) {
+			if reason, ok := skip[strings.TrimPrefix(name, "testdata/")]; ok {
+				t.Skip(reason)
+			}
+			t.Parallel()
+			src, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal(err)
+			}
+			script, err := Parse(string(src))
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			ctx := context.Background()
+			r, err := NewRunner(ctx)
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer r.Close(ctx)
+			for _, cmd := range script.Commands {
+				if err := r.Exec(ctx, cmd); err != nil {
+					t.Errorf("line %d: %s: %v", cmd.Line, cmd.Kind, err)
+				}
+			}
+		})
+	}
+}
+
+func TestParse(t *testing.T) {
+	script, err := Parse(`
+		(module $m binary "\00asm" "\01\00\00\00")
+		(module quote "(func (export \"f\"))")
+		(component definition $c)
+		(component instance $i $c)
+		(register "m" $m)
+		(assert_return (invoke $m "f" (i32.const -1) (i64.const 0x10) (f32.const -0x1p-1))
+			(f64.const nan:canonical)
+			(v128.const i16x8 0 1 2 3 4 5 6 -1)
+			(either (ref.null extern) (ref.extern 2)))
+		(assert_trap (invoke "f") "unreachable")
+		(assert_malformed (module quote "(func") "unexpected end")`)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+
+	kinds := []string{"module", "module", "module", "module", "register", "assert_return", "assert_trap", "assert_malformed"}
+	if len(script.Commands) != len(kinds) {
+		t.Fatalf("got %d commands, want %d", len(script.Commands), len(kinds))
+	}
+	for i, kind := range kinds {
+		if script.Commands[i].Kind != kind {
+			t.Errorf("command %d is %s, want %s", i, script.Commands[i].Kind, kind)
+		}
+	}
+
+	cmds := script.Commands
+	if m := cmds[0].Module; m.ID != "$m" || len(m.Binary) != 8 || m.Err != nil {
+		t.Errorf("binary module = %+v", m)
+	}
+	if m := cmds[1].Module; m.Err != nil || len(m.Binary) <= 8 {
+		t.Errorf("quote module did not compile: %v", m.Err)
+	}
+	if m := cmds[2].Module; !m.Definition || !m.Component || m.ID != "$c" {
+		t.Errorf("definition = %+v", m)
+	}
+	if m := cmds[3].Module; !m.Instance || m.ID != "$i" || m.Of != "$c" {
+		t.Errorf("instance = %+v", m)
+	}
+	if cmds[4].Name != "m" || cmds[4].Ref != "$m" {
+		t.Errorf("register = %q %q", cmds[4].Name, cmds[4].Ref)
+	}
+
+	a := cmds[5].Action
+	if a.Module != "$m" || a.Name != "f" || len(a.Args) != 3 {
+		t.Fatalf("action = %+v", a)
+	}
+	if a.Args[0].Bits != 0xFFFFFFFF || a.Args[1].Bits != 0x10 || a.Args[2].Bits != 0xBF000000 {
+		t.Errorf("args = %v", a.Args)
+	}
+	want := cmds[5].Expected
+	if len(want) != 3 || want[0].NaN != "canonical" || len(want[2].Either) != 2 {
+		t.Fatalf("expected = %v", want)
+	}
+	if want[1].Bits != 0x0003000200010000 || want[1].High != 0xFFFF000600050004 {
+		t.Errorf("v128 = %#x %#x", want[1].Bits, want[1].High)
+	}
+	if want[2].Either[1].Bits != 3 {
+		t.Errorf("ref.extern 2 = %d, want host value 3", want[2].Either[1].Bits)
+	}
+	if cmds[7].Module.Err == nil {
+		t.Error("malformed quote module compiled")
+	}
+}
+
+func TestParseInlineModule(t *testing.T) {
+	script, err := Parse(`(func (export "f") (result i32) (i32.const 1))`)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	if len(script.Commands) != 1 || script.Commands[0].Module == nil || script.Commands[0].Module.Err != nil {
+		t.Fatalf("commands = %+v", script.Commands)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := map[string]string{
+		"unknown command": `(assert_everything)`,
+		"unclosed module": `(module (func)`,
+		"pattern in args": `(invoke "f" (f32.const nan:canonical))`,
+		"bad literal":     `(invoke "f" (i32.const 0x1_0000_0000))`,
+		"bad shape":       `(invoke "f" (v128.const i128x1 0))`,
+		"missing message": `(assert_trap (invoke "f"))`,
+	}
+	for name, src := range tests {
+		t.Run(name, func(t *testing.T) {
+			if _, err := Parse(src); err == nil {
+				t.Error("expected an error")
+			}
+		})
+	}
+}
+
+func TestRunner(t *testing.T) {
+	script, err := Parse(`
+		(module $a
+			(global $g (export "g") (mut i32) (i32.const 7))
+			(memory (export "mem") 1)
+			(func (export "inc") (result i32)
+				(global.set $g (i32.add (global.get $g) (i32.const 1)))
+				(global.get $g))
+			(func (export "id") (param externref) (result externref) (local.get 0))
+			(func (export "nan") (result f32) (f32.const nan:0x600000)))
+		(register "a" $a)
+
+		(module $b
+			(import "a" "inc" (func $inc (result i32)))
+			(import "a" "g" (global $g (mut i32)))
+			(import "a" "mem" (memory 1))
+			(func (export "twice") (result i32) (drop (call $inc)) (call $inc))
+			(func (export "g") (result i32) (global.get $g)))
+		(assert_return (invoke "twice") (i32.const 9))
+		(assert_return (invoke $b "g") (i32.const 9))
+		(assert_return (get $a "g") (i32.const 9))
+		(assert_return (invoke $a "id" (ref.extern 1)) (ref.extern 1))
+		(assert_return (invoke $a "id" (ref.null extern)) (ref.null extern))
+		(assert_return (invoke $a "nan") (f32.const nan:arithmetic))
+		(assert_return (invoke $a "nan") (either (f32.const 0) (f32.const nan:0x600000)))
+
+		(module definition $def (func (export "one") (result i32) (i32.const 1)))
+		(module instance $one $def)
+		(assert_return (invoke $one "one") (i32.const 1))
+
+		(assert_unlinkable (module (import "a" "missing" (func))) "unknown import")
+		(assert_uninstantiable (module (func $f unreachable) (start $f)) "unreachable")
+		(assert_trap (module (func $f unreachable) (start $f)) "unreachable")
+		(assert_invalid (module (func (result i32))) "type mismatch")
+		(assert_malformed (module binary "\00asm" "\02\00\00\00") "unknown binary version")
+		(module $rec (func $f (export "f") (call $f)))
+		(assert_exhaustion (invoke $rec "f") "call stack exhausted")`)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	ctx := context.Background()
+	r, err := NewRunner(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close(ctx)
+	if err := r.Run(ctx, script); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestRunnerFailures(t *testing.T) {
+	script, err := Parse(`
+		(module
+			(func (export "one") (result i32) (i32.const 1))
+			(func (export "trap") (unreachable))
+			(func (export "div") (param i32) (result i32) (i32.div_u (i32.const 1) (local.get 0))))
+		(assert_return (invoke "one") (i32.const 2))
+		(assert_return (invoke "one"))
+		(assert_return (invoke "one" (i32.const 0)) (i32.const 1))
+		(assert_return (invoke "missing"))
+		(assert_trap (invoke "one") "unreachable")
+		(assert_trap (invoke "div" (i32.const 0)) "unreachable")
+		(assert_invalid (module (func)) "type mismatch")
+		(assert_invalid (module (func (bogus))) "type mismatch")
+		(assert_malformed (module (func)) "unexpected token")
+		(assert_unlinkable (module) "unknown import")
+		(module (func (bogus)))`)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	ctx := context.Background()
+	r, err := NewRunner(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer r.Close(ctx)
+
+	for _, cmd := range script.Commands[1:] {
+		t.Run(cmd.Kind, func(t *testing.T) {
+			if err := r.Exec(ctx, script.Commands[0]); err != nil {
+				t.Fatal(err)
+			}
+			if err := r.Exec(ctx, cmd); err == nil {
+				t.Errorf("line %d: expected the %s to fail", cmd.Line, cmd.Kind)
+			}
+		})
+	}
+}
@@ -1,13 +1,19 @@
 package wat
 
 import (
+	"github.com/wippyai/wasm-runtime/wat/internal/component"
 	"github.com/wippyai/wasm-runtime/wat/internal/encoder"
 	"github.com/wippyai/wasm-runtime/wat/internal/parser"
 	"github.com/wippyai/wasm-runtime/wat/internal/token"
 )
 
+// Compile compiles a (module ...) into a core module binary, or a
+// (component ...) into a component binary.
 func Compile(source string) ([]byte, error) {
 	tokens := token.Tokenize(source)
+	if component.IsComponent(tokens) {
+		return component.New(tokens).Parse()
+	}
 	p := parser.New(tokens)
 	mod, err := p.Parse()
 	if err != nil {
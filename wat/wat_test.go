@@ -1,6 +1,7 @@
 package wat
 
 import (
+	"bytes"
 	"strings"
 	"testing"
 
@@ -177,3 +178,115 @@ func TestWasmValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestCompileNames(t *testing.T) {
+	bin, err := Compile(`(module $demo
+		(type $pair (struct (field $x i32) (field $y i32)))
+		(type $sig (func (param i32)))
+		(import "env" "log" (func $log (type $sig)))
+		(import "env" "sp" (global $sp (mut i32)))
+		(tag $oops)
+		(table $fns 1 funcref)
+		(memory $heap 1)
+		(global $count (mut i32) (i32.const 0))
+		(func $run (param $n i32) (local $i i32) (local i64) (local $acc i32)
+			(block $done
+				(loop
+					(br_if $done (i32.eqz (local.get $n)))
+					(if $odd (i32.and (local.get $n) (i32.const 1))
+						(then (call $log (local.get $n)))))))
+		(elem $init (i32.const 0) $run)
+		(data $greeting (i32.const 0) "hi"))`)
+	if err != nil {
+		t.Fatalf("Compile failed: %v", err)
+	}
+	m, err := wasm.ParseModule(bin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	names, err := m.Names()
+	if err != nil || names == nil {
+		t.Fatalf("Names() = %v, %v", names, err)
+	}
+
+	tests := []struct {
+		space string
+		got   wasm.NameMap
+		want  wasm.NameMap
+	}{
+		{"funcs", names.Funcs, wasm.NameMap{0: "log", 1: "run"}},
+		{"locals", names.Locals[1], wasm.NameMap{0: "n", 1: "i", 3: "acc"}},
+		{"labels", names.Labels[1], wasm.NameMap{0: "done", 2: "odd"}},
+		{"types", names.Types, wasm.NameMap{0: "pair", 1: "sig"}},
+		{"fields", names.Fields[0], wasm.NameMap{0: "x", 1: "y"}},
+		{"tables", names.Tables, wasm.NameMap{0: "fns"}},
+		{"memories", names.Memories, wasm.NameMap{0: "heap"}},
+		{"globals", names.Globals, wasm.NameMap{0: "sp", 1: "count"}},
+		{"elems", names.Elems, wasm.NameMap{0: "init"}},
+		{"data", names.Data, wasm.NameMap{0: "greeting"}},
+		{"tags", names.Tags, wasm.NameMap{0: "oops"}},
+	}
+	if names.Module != "demo" {
+		t.Errorf("module name = %q, want demo", names.Module)
+	}
+	for _, tt := range tests {
+		if len(tt.got) != len(tt.want) {
+			t.Errorf("%s = %v, want %v", tt.space, tt.got, tt.want)
+			continue
+		}
+		for idx, name := range tt.want {
+			if tt.got[idx] != name {
+				t.Errorf("%s = %v, want %v", tt.space, tt.got, tt.want)
+				break
+			}
+		}
+	}
+
+	// Printing keeps every name, so the text compiles back to the same binary
+	text, err := Disassemble(bin, PrintOptions{})
+	if err != nil {
+		t.Fatalf("Disassemble failed: %v", err)
+	}
+	again, err := Compile(text)
+	if err != nil {
+		t.Fatalf("Compile of printed text failed: %v\n%s", err, text)
+	}
+	if !bytes.Equal(bin, again) {
+		t.Errorf("round trip changed the binary\n%s", text)
+	}
+}
+
+func TestCompileElemRefType(t *testing.T) {
+	bin, err := Compile(`(module
+		(table $t 1 externref)
+		(elem $passive externref)
+		(elem $active (table $t) (i32.const 0) externref)
+		(elem $declared declare externref))`)
+	if err != nil {
+		t.Fatalf("Compile failed: %v", err)
+	}
+	m, err := wasm.ParseModule(bin)
+	if err != nil {
+		t.Fatalf("ParseModule: %v", err)
+	}
+	if len(m.Elements) != 3 {
+		t.Fatalf("got %d element segments, want 3", len(m.Elements))
+	}
+	for i, elem := range m.Elements {
+		if elem.Type != wasm.ValExtern {
+			t.Errorf("segment %d: type = %v, want externref", i, elem.Type)
+		}
+	}
+
+	text, err := Disassemble(bin, PrintOptions{})
+	if err != nil {
+		t.Fatalf("Disassemble failed: %v", err)
+	}
+	again, err := Compile(text)
+	if err != nil {
+		t.Fatalf("Compile of printed text failed: %v\n%s", err, text)
+	}
+	if !bytes.Equal(bin, again) {
+		t.Errorf("round trip changed the binary\n%s", text)
+	}
+}
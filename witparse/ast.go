@@ -0,0 +1,138 @@
+package witparse
+
+import (
+	"go.bytecodealliance.org/wit"
+)
+
+// The parser produces this syntax tree; resolve turns it into wit types
+// once every name in the source is known.
+
+type pos struct {
+	line int
+	col  int
+}
+
+func (p pos) errorf(format string, args ...any) *PosError {
+	return errorf(p.line, p.col, format, args...)
+}
+
+type packageDecl struct {
+	name       string // ns:pkg@version; empty for the implicit package
+	docs       string
+	interfaces []*interfaceDecl
+	worlds     []*worldDecl
+	uses       []*useDecl // top-level `use path as name;`
+	pos
+}
+
+type interfaceDecl struct {
+	stability wit.Stability
+	name      string // empty for inline interfaces in worlds
+	docs      string
+	items     []any // *typeDecl, *funcDecl, *useDecl
+	pos
+}
+
+type worldDecl struct {
+	stability wit.Stability
+	name      string
+	docs      string
+	items     []any // *externDecl, *includeDecl, *useDecl, *typeDecl
+	pos
+}
+
+// externDecl is a world import or export.
+type externDecl struct {
+	stability wit.Stability
+	fn        *funcDecl      // name: func(...)
+	iface     *interfaceDecl // name: interface { ... }
+	path      *usePath       // bare interface reference
+	name      string
+	docs      string
+	export    bool
+	pos
+}
+
+type includeDecl struct {
+	stability wit.Stability
+	path      usePath
+	with      []useName
+	pos
+}
+
+type useDecl struct {
+	path  usePath
+	alias string    // top-level `use path as alias;`
+	names []useName // `use path.{a, b as c};`
+	pos
+}
+
+type useName struct {
+	name string
+	as   string
+	pos
+}
+
+// usePath references an interface or world: name, ns:pkg/name or
+// ns:pkg/name@version.
+type usePath struct {
+	pkg  string // ns:pkg, empty for local names
+	name string
+	ver  string
+	pos
+}
+
+func (p usePath) String() string {
+	s := p.name
+	if p.pkg != "" {
+		s = p.pkg + "/" + s
+	}
+	if p.ver != "" {
+		s += "@" + p.ver
+	}
+	return s
+}
+
+type typeDecl struct {
+	stability wit.Stability
+	alias     *typeExpr
+	kind      string // type, record, variant, enum, flags, resource
+	name      string
+	docs      string
+	fields    []fieldDecl // record fields, variant cases, enum cases, flags
+	funcs     []*funcDecl // resource constructor, methods and statics
+	pos
+}
+
+type fieldDecl struct {
+	typ  *typeExpr // nil for enum cases, flags and unit variant cases
+	name string
+	docs string
+	pos
+}
+
+type funcDecl struct {
+	stability wit.Stability
+	name      string
+	kind      string // func, constructor, method, static
+	docs      string
+	params    []paramDecl
+	results   []paramDecl
+	async     bool
+	pos
+}
+
+type paramDecl struct {
+	typ  *typeExpr
+	name string
+	pos
+}
+
+// typeExpr is a type reference. Generic types carry their arguments in
+// args; a nil argument stands for the `_` placeholder in result<_, E>.
+type typeExpr struct {
+	name string // primitive, named reference or generic constructor
+	args []*typeExpr
+	size string // fixed-size list length
+	pos
+}
@@ -0,0 +1,49 @@
+// Package witparse parses WIT (WebAssembly Interface Type) text into
+// go.bytecodealliance.org/wit types without external tools.
+//
+// The parser covers packages (including nested package blocks),
+// interfaces, worlds, use statements, type aliases, records, variants,
+// enums, flags and resources with constructors, methods and static
+// functions, along with the list, option, result, tuple, own, borrow,
+// future and stream type constructors and @since/@unstable/@deprecated
+// gates.
+//
+// # Usage
+//
+//	res, err := witparse.Parse(`
+//	    package example:calc;
+//
+//	    world calc {
+//	        record point { x: s32, y: s32 }
+//	        export distance: func(a: point, b: point) -> f64;
+//	    }
+//	`)
+//
+//	funcs := witparse.Functions(res)
+//	f := funcs["distance"]
+//
+// # Signature Lists
+//
+// For core modules loaded with runtime.LoadWASM and runtime.LoadWAT the
+// parser also accepts a bare list of signatures. Items outside any world
+// join an implicit "root" world in package root:component, and item
+// terminators may be omitted:
+//
+//	add: func(a: s32, b: s32) -> s32
+//	export log: func(msg: string);
+//	divmod: func(a: s32, b: s32) -> (s32, s32)
+//
+// For the same callers, functions inside an interface may keep the export
+// keyword that earlier versions of the runtime accepted there. It has no
+// effect, and WIT tooling rejects it, so new WIT should leave it out.
+//
+// # Errors
+//
+// Syntax and resolution errors are reported as errors.Error values in
+// PhaseParse wrapping a *PosError with the 1-based line and column:
+//
+//	var pe *witparse.PosError
+//	if errors.As(err, &pe) {
+//	    fmt.Println(pe.Line, pe.Column, pe.Msg)
+//	}
+package witparse
@@ -0,0 +1,22 @@
+package witparse
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// PosError is a WIT syntax or resolution error at a source position.
+// Parse wraps it in an errors.Error; use errors.As to recover the position.
+type PosError struct {
+	Msg    string
+	Line   int
+	Column int
+}
+
+func (e *PosError) Error() string {
+	return strconv.Itoa(e.Line) + ":" + strconv.Itoa(e.Column) + ": " + e.Msg
+}
+
+func errorf(line, col int, format string, args ...any) *PosError {
+	return &PosError{Line: line, Column: col, Msg: fmt.Sprintf(format, args...)}
+}
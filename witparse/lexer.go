@@ -0,0 +1,191 @@
+package witparse
+
+import (
+	"strings"
+	"unicode/utf8"
+)
+
+type tokenKind uint8
+
+const (
+	tokEOF tokenKind = iota
+	tokIdent
+	tokInt
+	tokPunct
+	tokArrow
+)
+
+// token is a lexical unit with its 1-based source position. Docs holds
+// the text of /// and /** */ comments immediately preceding the token.
+type token struct {
+	text string
+	docs string
+	off  int
+	end  int
+	line int
+	col  int
+	kind tokenKind
+}
+
+func (t token) String() string {
+	if t.kind == tokEOF {
+		return "end of input"
+	}
+	return "'" + t.text + "'"
+}
+
+type lexer struct {
+	src  string
+	off  int
+	line int
+	col  int
+}
+
+func newLexer(src string) *lexer {
+	return &lexer{src: src, line: 1, col: 1}
+}
+
+func (l *lexer) advance(n int) {
+	for i := 0; i < n && l.off < len(l.src); {
+		r, size := utf8.DecodeRuneInString(l.src[l.off:])
+		l.off += size
+		i += size
+		if r == '\n' {
+			l.line++
+			l.col = 1
+		} else {
+			l.col++
+		}
+	}
+}
+
+// tokenize splits src into tokens, ending with a tokEOF token.
+func tokenize(src string) ([]token, error) {
+	l := newLexer(src)
+	var toks []token
+	for {
+		docs, err := l.skipSpace()
+		if err != nil {
+			return nil, err
+		}
+		t := token{off: l.off, line: l.line, col: l.col, docs: docs}
+		if l.off >= len(l.src) {
+			t.kind = tokEOF
+			t.end = l.off
+			return append(toks, t), nil
+		}
+
+		c := l.src[l.off]
+		switch {
+		case isIdentStart(c):
+			n := 1
+			for l.off+n < len(l.src) && isIdentChar(l.src[l.off+n]) {
+				n++
+			}
+			t.kind = tokIdent
+			t.text = l.src[l.off : l.off+n]
+			l.advance(n)
+		case c >= '0' && c <= '9':
+			n := 1
+			for l.off+n < len(l.src) && l.src[l.off+n] >= '0' && l.src[l.off+n] <= '9' {
+				n++
+			}
+			t.kind = tokInt
+			t.text = l.src[l.off : l.off+n]
+			l.advance(n)
+		case c == '-' && l.off+1 < len(l.src) && l.src[l.off+1] == '>':
+			t.kind = tokArrow
+			t.text = "->"
+			l.advance(2)
+		case strings.IndexByte("{}()<>,;:.=*/@-+_", c) >= 0:
+			t.kind = tokPunct
+			t.text = l.src[l.off : l.off+1]
+			l.advance(1)
+		default:
+			r, _ := utf8.DecodeRuneInString(l.src[l.off:])
+			return nil, errorf(t.line, t.col, "unexpected character %q", r)
+		}
+		t.end = l.off
+		toks = append(toks, t)
+	}
+}
+
+// skipSpace skips whitespace and comments, returning the contents of any
+// doc comments seen.
+func (l *lexer) skipSpace() (string, error) {
+	var docs []string
+	for l.off < len(l.src) {
+		rest := l.src[l.off:]
+		switch {
+		case rest[0] == ' ' || rest[0] == '\t' || rest[0] == '\n' || rest[0] == '\r':
+			l.advance(1)
+		case strings.HasPrefix(rest, "//"):
+			end := strings.IndexByte(rest, '\n')
+			if end < 0 {
+				end = len(rest)
+			}
+			if strings.HasPrefix(rest, "///") && !strings.HasPrefix(rest, "////") {
+				line := strings.TrimSuffix(rest[3:end], "\r")
+				docs = append(docs, strings.TrimPrefix(line, " "))
+			}
+			l.advance(end)
+		case strings.HasPrefix(rest, "/*"):
+			line, col := l.line, l.col
+			end, ok := blockCommentEnd(rest)
+			if !ok {
+				return "", errorf(line, col, "unterminated block comment")
+			}
+			if strings.HasPrefix(rest, "/**") && end > 4 {
+				docs = append(docs, blockDocs(rest[3:end-2])...)
+			}
+			l.advance(end)
+		default:
+			return strings.Join(docs, "\n"), nil
+		}
+	}
+	return strings.Join(docs, "\n"), nil
+}
+
+// blockCommentEnd returns the length of the nested /* */ comment at the
+// start of s.
+func blockCommentEnd(s string) (int, bool) {
+	depth := 0
+	for i := 0; i+1 < len(s); i++ {
+		switch {
+		case s[i] == '/' && s[i+1] == '*':
+			depth++
+			i++
+		case s[i] == '*' && s[i+1] == '/':
+			depth--
+			i++
+			if depth == 0 {
+				return i + 1, true
+			}
+		}
+	}
+	return 0, false
+}
+
+func blockDocs(body string) []string {
+	var lines []string
+	for _, line := range strings.Split(body, "\n") {
+		line = strings.TrimSpace(line)
+		line = strings.TrimPrefix(strings.TrimPrefix(line, "*"), " ")
+		lines = append(lines, line)
+	}
+	for len(lines) > 0 && lines[0] == "" {
+		lines = lines[1:]
+	}
+	for len(lines) > 0 && lines[len(lines)-1] == "" {
+		lines = lines[:len(lines)-1]
+	}
+	return lines
+}
+
+func isIdentStart(c byte) bool {
+	return c == '%' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isIdentChar(c byte) bool {
+	return c == '-' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
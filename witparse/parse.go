@@ -0,0 +1,64 @@
+package witparse
+
+import (
+	"go.bytecodealliance.org/wit"
+
+	"github.com/wippyai/wasm-runtime/errors"
+)
+
+// Parse parses WIT source text and resolves it into packages, interfaces,
+// worlds and type definitions. Errors carry a *PosError with the line and
+// column of the offending token.
+func Parse(src string) (*wit.Resolve, error) {
+	f, err := parseFile(src)
+	if err != nil {
+		return nil, errors.ParseFailed("WIT", err)
+	}
+	res, err := resolveFile(f)
+	if err != nil {
+		return nil, errors.ParseFailed("WIT", err)
+	}
+	return res, nil
+}
+
+// Functions returns the functions of a parsed Resolve keyed by name:
+// bare names for world functions, and the interface-local name
+// (e.g. "get", "[method]counter.inc") for interface functions. When names
+// collide, world exports win over world imports, which win over functions
+// of standalone interfaces.
+func Functions(res *wit.Resolve) map[string]*wit.Function {
+	funcs := make(map[string]*wit.Function)
+	add := func(name string, f *wit.Function) {
+		if _, ok := funcs[name]; !ok {
+			funcs[name] = f
+		}
+	}
+	addInterface := func(iface *wit.Interface) {
+		iface.Functions.All()(func(name string, f *wit.Function) bool {
+			add(name, f)
+			return true
+		})
+	}
+	addItems := func(items *orderedItems) {
+		items.All()(func(key string, item wit.WorldItem) bool {
+			switch item := item.(type) {
+			case *wit.Function:
+				add(key, item)
+			case *wit.InterfaceRef:
+				addInterface(item.Interface)
+			}
+			return true
+		})
+	}
+
+	for _, w := range res.Worlds {
+		addItems(&w.Exports)
+	}
+	for _, w := range res.Worlds {
+		addItems(&w.Imports)
+	}
+	for _, iface := range res.Interfaces {
+		addInterface(iface)
+	}
+	return funcs
+}
@@ -0,0 +1,389 @@
+package witparse
+
+import (
+	stderrors "errors"
+	"os"
+	"sort"
+	"strings"
+	"testing"
+
+	"go.bytecodealliance.org/wit"
+
+	"github.com/wippyai/wasm-runtime/errors"
+)
+
+func mustParse(t *testing.T, src string) *wit.Resolve {
+	t.Helper()
+	res, err := Parse(src)
+	if err != nil {
+		t.Fatalf("Parse: %v", err)
+	}
+	return res
+}
+
+// typeString renders a type in WIT syntax, naming named types.
+func typeString(t wit.Type) string {
+	td, ok := t.(*wit.TypeDef)
+	if !ok {
+		if t == nil {
+			return "_"
+		}
+		return t.WIT(nil, "")
+	}
+	if td.Name != nil {
+		return *td.Name
+	}
+	switch k := td.Kind.(type) {
+	case *wit.List:
+		return "list<" + typeString(k.Type) + ">"
+	case *wit.Option:
+		return "option<" + typeString(k.Type) + ">"
+	case *wit.Result:
+		if k.OK == nil && k.Err == nil {
+			return "result"
+		}
+		return "result<" + typeString(k.OK) + ", " + typeString(k.Err) + ">"
+	case *wit.Tuple:
+		parts := make([]string, len(k.Types))
+		for i, e := range k.Types {
+			parts[i] = typeString(e)
+		}
+		return "tuple<" + strings.Join(parts, ", ") + ">"
+	case *wit.Own:
+		return "own<" + *k.Type.Name + ">"
+	case *wit.Borrow:
+		return "borrow<" + *k.Type.Name + ">"
+	}
+	return "?"
+}
+
+func funcString(f *wit.Function) string {
+	params := make([]string, len(f.Params))
+	for i, p := range f.Params {
+		params[i] = p.Name + ": " + typeString(p.Type)
+	}
+	s := f.Name + "(" + strings.Join(params, ", ") + ")"
+	if len(f.Results) > 0 {
+		results := make([]string, len(f.Results))
+		for i, r := range f.Results {
+			results[i] = typeString(r.Type)
+		}
+		s += " -> " + strings.Join(results, ", ")
+	}
+	return s
+}
+
+// summary lists every function and named type reachable from res in a
+// stable order, independent of how world items are keyed.
+func summary(res *wit.Resolve) []string {
+	var lines []string
+	ifaceName := func(i *wit.Interface) string {
+		if i.Name == nil {
+			return "(inline)"
+		}
+		return *i.Name
+	}
+	for _, iface := range res.Interfaces {
+		iface.Functions.All()(func(_ string, f *wit.Function) bool {
+			lines = append(lines, ifaceName(iface)+": "+funcString(f))
+			return true
+		})
+		iface.TypeDefs.All()(func(name string, td *wit.TypeDef) bool {
+			lines = append(lines, ifaceName(iface)+": type "+name)
+			return true
+		})
+	}
+	for _, w := range res.Worlds {
+		for dir, items := range map[string]*orderedItems{"import": &w.Imports, "export": &w.Exports} {
+			items.All()(func(key string, item wit.WorldItem) bool {
+				switch item := item.(type) {
+				case *wit.Function:
+					lines = append(lines, w.Name+" "+dir+": "+funcString(item))
+				case *wit.InterfaceRef:
+					lines = append(lines, w.Name+" "+dir+": interface "+ifaceName(item.Interface))
+				case *wit.TypeDef:
+					lines = append(lines, w.Name+" "+dir+": type "+key)
+				}
+				return true
+			})
+		}
+	}
+	sort.Strings(lines)
+	return lines
+}
+
+func TestParse_MatchesWasmTools(t *testing.T) {
+	src, err := os.ReadFile("testdata/full.wit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want, err := wit.LoadWIT("testdata/full.wit")
+	if err != nil {
+		t.Skipf("wasm-tools unavailable: %v", err)
+	}
+	got := mustParse(t, string(src))
+
+	g, w := summary(got), summary(want)
+	if strings.Join(g, "\n") != strings.Join(w, "\n") {
+		t.Errorf("summary mismatch\ngot:\n%s\n\nwant:\n%s", strings.Join(g, "\n"), strings.Join(w, "\n"))
+	}
+}
+
+func TestParse_Types(t *testing.T) {
+	src, err := os.ReadFile("testdata/full.wit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := mustParse(t, string(src))
+
+	if len(res.Packages) != 1 || res.Packages[0].Name.String() != "example:full@1.2.0" {
+		t.Fatalf("packages = %v", res.Packages)
+	}
+	types := res.Packages[0].Interfaces.Get("types")
+	if types == nil {
+		t.Fatal("interface types not found")
+	}
+	if got := types.Docs.Contents; got != "Shared geometry types." {
+		t.Errorf("interface docs = %q", got)
+	}
+
+	point := types.TypeDefs.Get("point")
+	rec, ok := point.Kind.(*wit.Record)
+	if !ok || len(rec.Fields) != 2 || rec.Fields[0].Type != (wit.S32{}) {
+		t.Errorf("point = %#v", point.Kind)
+	}
+	if point.Docs.Contents != "A point on the plane." || point.Owner != wit.TypeOwner(types) {
+		t.Errorf("point docs/owner = %q/%v", point.Docs.Contents, point.Owner)
+	}
+
+	shape := types.TypeDefs.Get("shape").Kind.(*wit.Variant)
+	if len(shape.Cases) != 3 || shape.Cases[2].Type != nil || typeString(shape.Cases[1].Type) != "list<point>" {
+		t.Errorf("shape = %#v", shape)
+	}
+	if n := len(types.TypeDefs.Get("perms").Kind.(*wit.Flags).Flags); n != 3 {
+		t.Errorf("perms has %d flags", n)
+	}
+	if got := typeString(types.TypeDefs.Get("points").Kind.(wit.Type)); got != "list<tuple<point, option<color>>>" {
+		t.Errorf("points = %s", got)
+	}
+
+	counter := types.TypeDefs.Get("counter")
+	ctor := types.Functions.Get("[constructor]counter")
+	if !ctor.IsConstructor() || typeString(ctor.Results[0].Type) != "own<counter>" {
+		t.Errorf("constructor = %s", funcString(ctor))
+	}
+	inc := types.Functions.Get("[method]counter.inc")
+	if !inc.IsMethod() || inc.Params[0].Name != "self" || inc.BaseName() != "inc" {
+		t.Errorf("method = %s", funcString(inc))
+	}
+	if mk := types.Functions.Get("[static]counter.make"); !mk.IsStatic() || mk.Type() != wit.Type(counter) {
+		t.Errorf("static = %s", funcString(mk))
+	}
+
+	store := res.Packages[0].Interfaces.Get("store")
+	loc := store.TypeDefs.Get("location")
+	if loc.Kind != wit.TypeDefKind(point) || loc.Root() != point {
+		t.Errorf("location does not alias point")
+	}
+	take := store.Functions.Get("take")
+	if got := funcString(take); got != "take(c: own<counter>) -> list<result<option<u8>, tuple<s64, f32>>>" {
+		t.Errorf("take = %s", got)
+	}
+}
+
+func TestParse_Worlds(t *testing.T) {
+	src, err := os.ReadFile("testdata/full.wit")
+	if err != nil {
+		t.Fatal(err)
+	}
+	res := mustParse(t, string(src))
+	app := res.Packages[0].Worlds.Get("app")
+
+	var imports, exports []string
+	app.Imports.All()(func(k string, _ wit.WorldItem) bool { imports = append(imports, k); return true })
+	app.Exports.All()(func(k string, _ wit.WorldItem) bool { exports = append(exports, k); return true })
+
+	// include base brings store (and types, which store uses) in first.
+	wantImports := []string{"example:full/types@1.2.0", "example:full/store@1.2.0", "color", "log"}
+	if strings.Join(imports, ",") != strings.Join(wantImports, ",") {
+		t.Errorf("imports = %v, want %v", imports, wantImports)
+	}
+	if strings.Join(exports, ",") != "run,api" {
+		t.Errorf("exports = %v", exports)
+	}
+
+	api := app.Exports.Get("api").(*wit.InterfaceRef).Interface
+	if api.Name != nil || api.Package != res.Packages[0] {
+		t.Errorf("inline interface name=%v package=%v", api.Name, api.Package)
+	}
+
+	funcs := Functions(res)
+	for _, name := range []string{"run", "log", "centroid", "get", "[method]counter.inc"} {
+		if funcs[name] == nil {
+			t.Errorf("Functions missing %q", name)
+		}
+	}
+}
+
+func TestParse_SignatureList(t *testing.T) {
+	res := mustParse(t, `
+		add: func(a: s32, b: s32) -> s32
+		export log: func(msg: string);
+		divmod: func(a: s32, b: s32) -> (s32, s32)
+		named: func() -> (q: u32, r: u32)
+		type: func(%type: u8)
+		record pair { a: u8, b: u8 }
+		swap: func(p: pair) -> pair
+	`)
+	if len(res.Worlds) != 1 || res.Worlds[0].Name != "root" {
+		t.Fatalf("worlds = %v", res.Worlds)
+	}
+	funcs := Functions(res)
+	tests := map[string]string{
+		"add":    "add(a: s32, b: s32) -> s32",
+		"log":    "log(msg: string)",
+		"divmod": "divmod(a: s32, b: s32) -> s32, s32",
+		"named":  "named() -> u32, u32",
+		"type":   "type(type: u8)",
+		"swap":   "swap(p: pair) -> pair",
+	}
+	for name, want := range tests {
+		f := funcs[name]
+		if f == nil {
+			t.Errorf("%s not found", name)
+			continue
+		}
+		if got := funcString(f); got != want {
+			t.Errorf("%s = %s, want %s", name, got, want)
+		}
+	}
+}
+
+func TestParse_ExportInInterface(t *testing.T) {
+	res := mustParse(t, `
+		package test:example@1.0.0;
+
+		interface calc {
+			export add: func(a: s32, b: s32) -> s32;
+			export: func() -> u64;
+		}
+	`)
+	funcs := Functions(res)
+	if f := funcs["add"]; f == nil || funcString(f) != "add(a: s32, b: s32) -> s32" {
+		t.Errorf("add = %v", f)
+	}
+	if funcs["export"] == nil {
+		t.Error("function named export not found")
+	}
+}
+
+func TestParse_Primitives(t *testing.T) {
+	prims := []string{"bool", "s8", "u8", "s16", "u16", "s32", "u32", "s64", "u64", "f32", "f64", "char", "string"}
+	var params []string
+	for _, p := range prims {
+		params = append(params, "p-"+p+": "+p)
+	}
+	res := mustParse(t, "f: func("+strings.Join(params, ", ")+")")
+	f := Functions(res)["f"]
+	for i, p := range f.Params {
+		want, _ := wit.ParseType(prims[i])
+		if p.Type != want {
+			t.Errorf("param %s = %T, want %T", p.Name, p.Type, want)
+		}
+	}
+}
+
+func TestParse_NestedPackages(t *testing.T) {
+	res := mustParse(t, `
+		package local:app;
+
+		world app {
+			import wasi:io/streams@0.2.0;
+			use wasi:io/streams@0.2.0.{input-stream};
+			export read: func(s: borrow<input-stream>) -> list<u8>;
+		}
+
+		package wasi:io@0.2.0 {
+			interface streams {
+				resource input-stream;
+			}
+		}
+	`)
+	if len(res.Packages) != 2 {
+		t.Fatalf("packages = %d, want 2", len(res.Packages))
+	}
+	read := Functions(res)["read"]
+	if got := funcString(read); got != "read(s: borrow<input-stream>) -> list<u8>" {
+		t.Errorf("read = %s", got)
+	}
+}
+
+func TestParse_Gates(t *testing.T) {
+	res := mustParse(t, `
+		package x:y@1.0.0;
+
+		@since(version = 1.0.0)
+		interface a {
+			@unstable(feature = fancy)
+			f: func();
+			@since(version = 0.9.0)
+			@deprecated(version = 1.0.0)
+			g: func();
+		}
+	`)
+	a := res.Packages[0].Interfaces.Get("a")
+	if s, ok := a.Stability.(*wit.Stable); !ok || s.Since.String() != "1.0.0" {
+		t.Errorf("interface stability = %#v", a.Stability)
+	}
+	if u, ok := a.Functions.Get("f").Stability.(*wit.Unstable); !ok || u.Feature != "fancy" {
+		t.Errorf("f stability = %#v", a.Functions.Get("f").Stability)
+	}
+	if s, ok := a.Functions.Get("g").Stability.(*wit.Stable); !ok || s.Deprecated == nil {
+		t.Errorf("g stability = %#v", a.Functions.Get("g").Stability)
+	}
+}
+
+func TestParse_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		msg  string
+		line int
+		col  int
+	}{
+		{"unclosed params", "f: func(a: u32", "expected ')'", 1, 15},
+		{"unknown type", "package a:b;\ninterface i {\n  f: func(x: widget);\n}", `type "widget" is not defined`, 3, 14},
+		{"bad char", "f: func() -> u32 $", "unexpected character", 1, 18},
+		{"unterminated comment", "/* open", "unterminated block comment", 1, 1},
+		{"self reference", "package a:b;\ninterface i {\n  record r { next: r }\n}", "refers to itself", 3, 3},
+		{"duplicate type", "package a:b;\ninterface i {\n  enum e { x }\n  enum e { y }\n}", "defined more than once", 4, 3},
+		{"borrow non-resource", "package a:b;\ninterface i {\n  record r { x: u8 }\n  f: func(x: borrow<r>);\n}", "not a resource", 4, 21},
+		{"missing use", "package a:b;\ninterface i {\n  use j.{t};\n}", `interface "j" is not defined`, 3, 7},
+		{"missing used type", "package a:b;\ninterface j {}\ninterface i {\n  use j.{t};\n}", `type "t" is not defined in interface j`, 4, 10},
+		{"use cycle", "package a:b;\ninterface i { use j.{t}; type u = u8; }\ninterface j { use i.{u}; type t = u8; }", "depends on itself", 2, 1},
+		{"bad version", "package a:b@1.x;", "invalid version", 1, 13},
+		{"async", "f: async func()", "async functions are not supported", 1, 1},
+		{"unknown gate", "package a:b;\n@wat(x = y)\ninterface i {}", "unknown annotation", 2, 2},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(tt.src)
+			if err == nil {
+				t.Fatal("expected error")
+			}
+			if !stderrors.Is(err, &errors.Error{Phase: errors.PhaseParse, Kind: errors.KindInvalidData}) {
+				t.Errorf("error %v is not a parse error", err)
+			}
+			var pe *PosError
+			if !stderrors.As(err, &pe) {
+				t.Fatalf("error %v has no position", err)
+			}
+			if !strings.Contains(pe.Msg, tt.msg) {
+				t.Errorf("message = %q, want it to contain %q", pe.Msg, tt.msg)
+			}
+			if pe.Line != tt.line || pe.Column != tt.col {
+				t.Errorf("position = %d:%d, want %d:%d (%s)", pe.Line, pe.Column, tt.line, tt.col, pe.Msg)
+			}
+		})
+	}
+}
@@ -0,0 +1,832 @@
+package witparse
+
+import (
+	"strings"
+
+	"github.com/coreos/go-semver/semver"
+	"go.bytecodealliance.org/wit"
+)
+
+// implicitWorld collects top-level imports, exports and bare function
+// signatures, so that signature lists like "add: func(a: s32) -> s32"
+// parse without a surrounding world.
+const implicitWorld = "root"
+
+type parser struct {
+	toks []token
+	i    int
+}
+
+type file struct {
+	packages []*packageDecl
+	loose    *worldDecl
+}
+
+func parseFile(src string) (*file, error) {
+	toks, err := tokenize(src)
+	if err != nil {
+		return nil, err
+	}
+	p := &parser{toks: toks}
+	return p.file()
+}
+
+func (p *parser) peek() token { return p.toks[p.i] }
+
+func (p *parser) peekAt(n int) token {
+	if p.i+n < len(p.toks) {
+		return p.toks[p.i+n]
+	}
+	return p.toks[len(p.toks)-1]
+}
+
+func (p *parser) next() token {
+	t := p.toks[p.i]
+	if t.kind != tokEOF {
+		p.i++
+	}
+	return t
+}
+
+func (p *parser) pos() pos {
+	t := p.peek()
+	return pos{line: t.line, col: t.col}
+}
+
+func (p *parser) is(text string) bool {
+	t := p.peek()
+	return t.kind != tokEOF && t.kind != tokInt && t.text == text
+}
+
+func (p *parser) accept(text string) bool {
+	if p.is(text) {
+		p.next()
+		return true
+	}
+	return false
+}
+
+func (p *parser) expect(text string) error {
+	if !p.accept(text) {
+		t := p.peek()
+		return errorf(t.line, t.col, "expected '%s', found %s", text, t)
+	}
+	return nil
+}
+
+// semi consumes an optional item terminator. Semicolons are optional so
+// that newline-separated signature lists remain valid input.
+func (p *parser) semi() {
+	p.accept(";")
+}
+
+func (p *parser) ident() (string, pos, error) {
+	t := p.peek()
+	if t.kind != tokIdent {
+		return "", pos{}, errorf(t.line, t.col, "expected identifier, found %s", t)
+	}
+	p.next()
+	return strings.TrimPrefix(t.text, "%"), pos{line: t.line, col: t.col}, nil
+}
+
+func (p *parser) file() (*file, error) {
+	f := &file{}
+	var cur *packageDecl
+	implicit := func() *packageDecl {
+		if cur == nil {
+			cur = &packageDecl{pos: p.pos()}
+			f.packages = append(f.packages, cur)
+		}
+		return cur
+	}
+
+	for p.peek().kind != tokEOF {
+		docs := p.peek().docs
+		if p.is("package") {
+			start := p.pos()
+			p.next()
+			name, err := p.packageName()
+			if err != nil {
+				return nil, err
+			}
+			pkg := &packageDecl{name: name, docs: docs, pos: start}
+			if p.accept("{") {
+				if err := p.packageBody(pkg, "}"); err != nil {
+					return nil, err
+				}
+				if err := p.expect("}"); err != nil {
+					return nil, err
+				}
+				f.packages = append(f.packages, pkg)
+				continue
+			}
+			p.semi()
+			if cur != nil && (cur.name != "" || len(cur.interfaces)+len(cur.worlds)+len(cur.uses) > 0) {
+				return nil, start.errorf("package declaration must come first")
+			}
+			if cur == nil {
+				f.packages = append(f.packages, pkg)
+			} else {
+				*cur = *pkg
+			}
+			cur = pkg
+			continue
+		}
+
+		if p.is("interface") || p.is("world") || (p.is("use") && !p.useHasNames()) || p.is("@") && p.gateFor("interface", "world") {
+			if err := p.packageItem(implicit()); err != nil {
+				return nil, err
+			}
+			continue
+		}
+
+		// Anything else is a loose world item.
+		if f.loose == nil {
+			f.loose = &worldDecl{name: implicitWorld, pos: p.pos()}
+		}
+		item, err := p.worldItem(true)
+		if err != nil {
+			return nil, err
+		}
+		f.loose.items = append(f.loose.items, item)
+	}
+
+	if f.loose != nil {
+		pkg := implicit()
+		pkg.worlds = append(pkg.worlds, f.loose)
+	}
+	return f, nil
+}
+
+// gateFor reports whether the feature gate starting at the current token
+// is followed by one of the given keywords.
+func (p *parser) gateFor(keywords ...string) bool {
+	depth := 0
+	for n := 0; ; n++ {
+		t := p.peekAt(n)
+		switch {
+		case t.kind == tokEOF:
+			return false
+		case t.text == "(":
+			depth++
+		case t.text == ")":
+			depth--
+		case depth == 0 && t.kind == tokIdent && t.text != "since" && t.text != "unstable" && t.text != "deprecated":
+			for _, kw := range keywords {
+				if t.text == kw {
+					return true
+				}
+			}
+			return false
+		}
+	}
+}
+
+// useHasNames reports whether the use statement at the current token
+// imports names (`use a.{b}`) rather than aliasing an interface.
+func (p *parser) useHasNames() bool {
+	for n := 1; ; n++ {
+		t := p.peekAt(n)
+		if t.kind == tokEOF || t.text == ";" || t.text == "as" && t.kind == tokIdent {
+			return false
+		}
+		if t.text == "{" {
+			return true
+		}
+	}
+}
+
+func (p *parser) packageBody(pkg *packageDecl, end string) error {
+	for !p.is(end) && p.peek().kind != tokEOF {
+		if err := p.packageItem(pkg); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *parser) packageItem(pkg *packageDecl) error {
+	docs := p.peek().docs
+	stability, err := p.gates()
+	if err != nil {
+		return err
+	}
+	start := p.pos()
+	switch {
+	case p.accept("interface"):
+		name, _, err := p.ident()
+		if err != nil {
+			return err
+		}
+		iface := &interfaceDecl{name: name, docs: docs, stability: stability, pos: start}
+		if err := p.interfaceBody(iface); err != nil {
+			return err
+		}
+		pkg.interfaces = append(pkg.interfaces, iface)
+	case p.accept("world"):
+		name, _, err := p.ident()
+		if err != nil {
+			return err
+		}
+		w := &worldDecl{name: name, docs: docs, stability: stability, pos: start}
+		if err := p.worldBody(w); err != nil {
+			return err
+		}
+		pkg.worlds = append(pkg.worlds, w)
+	case p.accept("use"):
+		path, err := p.usePath()
+		if err != nil {
+			return err
+		}
+		u := &useDecl{path: path, alias: path.name, pos: start}
+		if p.accept("as") {
+			if u.alias, _, err = p.ident(); err != nil {
+				return err
+			}
+		}
+		p.semi()
+		pkg.uses = append(pkg.uses, u)
+	default:
+		t := p.peek()
+		return errorf(t.line, t.col, "expected 'interface', 'world' or 'use', found %s", t)
+	}
+	return nil
+}
+
+// packageName parses ns:pkg@version.
+func (p *parser) packageName() (string, error) {
+	ns, _, err := p.ident()
+	if err != nil {
+		return "", err
+	}
+	if err := p.expect(":"); err != nil {
+		return "", err
+	}
+	name, _, err := p.ident()
+	if err != nil {
+		return "", err
+	}
+	id := ns + ":" + name
+	if p.accept("@") {
+		ver, err := p.version()
+		if err != nil {
+			return "", err
+		}
+		id += "@" + ver
+	}
+	return id, nil
+}
+
+// version parses a semantic version from adjacent tokens. A trailing dot
+// is left in place when it starts a `.{` name list.
+func (p *parser) version() (string, error) {
+	start := p.peek()
+	if start.kind != tokInt {
+		return "", errorf(start.line, start.col, "expected version, found %s", start)
+	}
+	var b strings.Builder
+	prevEnd := start.off
+	for {
+		t := p.peek()
+		if t.off != prevEnd || t.kind == tokEOF {
+			break
+		}
+		if t.kind == tokPunct && t.text != "." && t.text != "-" && t.text != "+" {
+			break
+		}
+		if t.text == "." && p.peekAt(1).text == "{" {
+			break
+		}
+		b.WriteString(t.text)
+		prevEnd = t.end
+		p.next()
+	}
+	v := b.String()
+	if _, err := semver.NewVersion(v); err != nil {
+		return "", errorf(start.line, start.col, "invalid version %q", v)
+	}
+	return v, nil
+}
+
+// usePath parses iface, ns:pkg/iface or ns:pkg/iface@version.
+func (p *parser) usePath() (usePath, error) {
+	start := p.pos()
+	first, _, err := p.ident()
+	if err != nil {
+		return usePath{}, err
+	}
+	if !p.accept(":") {
+		return usePath{name: first, pos: start}, nil
+	}
+	pkg, _, err := p.ident()
+	if err != nil {
+		return usePath{}, err
+	}
+	if err := p.expect("/"); err != nil {
+		return usePath{}, err
+	}
+	name, _, err := p.ident()
+	if err != nil {
+		return usePath{}, err
+	}
+	path := usePath{pkg: first + ":" + pkg, name: name, pos: start}
+	if p.accept("@") {
+		if path.ver, err = p.version(); err != nil {
+			return usePath{}, err
+		}
+	}
+	return path, nil
+}
+
+// gates parses @since, @unstable and @deprecated annotations.
+func (p *parser) gates() (wit.Stability, error) {
+	var stability wit.Stability
+	for p.is("@") {
+		p.next()
+		kind, kpos, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		if err := p.expect("("); err != nil {
+			return nil, err
+		}
+		args := make(map[string]string)
+		for !p.is(")") {
+			key, _, err := p.ident()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect("="); err != nil {
+				return nil, err
+			}
+			var val string
+			if key == "version" {
+				val, err = p.version()
+			} else {
+				val, _, err = p.ident()
+			}
+			if err != nil {
+				return nil, err
+			}
+			args[key] = val
+			if !p.accept(",") {
+				break
+			}
+		}
+		if err := p.expect(")"); err != nil {
+			return nil, err
+		}
+
+		switch kind {
+		case "since":
+			v, err := semver.NewVersion(args["version"])
+			if err != nil {
+				return nil, kpos.errorf("@since requires a version")
+			}
+			stability = &wit.Stable{Since: *v}
+		case "unstable":
+			if args["feature"] == "" {
+				return nil, kpos.errorf("@unstable requires a feature")
+			}
+			stability = &wit.Unstable{Feature: args["feature"]}
+		case "deprecated":
+			v, err := semver.NewVersion(args["version"])
+			if err != nil {
+				return nil, kpos.errorf("@deprecated requires a version")
+			}
+			switch s := stability.(type) {
+			case *wit.Stable:
+				s.Deprecated = v
+			case *wit.Unstable:
+				s.Deprecated = v
+			}
+		default:
+			return nil, kpos.errorf("unknown annotation @%s", kind)
+		}
+	}
+	return stability, nil
+}
+
+func (p *parser) interfaceBody(iface *interfaceDecl) error {
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+	for !p.accept("}") {
+		if p.peek().kind == tokEOF {
+			t := p.peek()
+			return errorf(t.line, t.col, "expected '}', found %s", t)
+		}
+		item, err := p.interfaceItem()
+		if err != nil {
+			return err
+		}
+		iface.items = append(iface.items, item)
+	}
+	return nil
+}
+
+func (p *parser) interfaceItem() (any, error) {
+	docs := p.peek().docs
+	stability, err := p.gates()
+	if err != nil {
+		return nil, err
+	}
+	if p.is("use") {
+		return p.useNames()
+	}
+	if td, ok, err := p.typeDecl(docs, stability); ok || err != nil {
+		return td, err
+	}
+	// Signature lists written for the old regex parser mark interface
+	// functions with export; the keyword changes nothing there
+	if p.is("export") && p.peekAt(1).text != ":" {
+		p.next()
+	}
+	return p.namedFunc(docs, stability)
+}
+
+func (p *parser) namedFunc(docs string, stability wit.Stability) (*funcDecl, error) {
+	name, start, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(":"); err != nil {
+		return nil, err
+	}
+	fn := &funcDecl{name: name, kind: "func", docs: docs, stability: stability, pos: start}
+	if err := p.funcType(fn); err != nil {
+		return nil, err
+	}
+	p.semi()
+	return fn, nil
+}
+
+// funcType parses [async] func(params) [-> results].
+func (p *parser) funcType(fn *funcDecl) error {
+	fn.async = p.accept("async")
+	if err := p.expect("func"); err != nil {
+		return err
+	}
+	params, err := p.paramList(true)
+	if err != nil {
+		return err
+	}
+	fn.params = params
+	if !p.accept("->") {
+		return nil
+	}
+	if !p.is("(") {
+		t, err := p.typeExpr()
+		if err != nil {
+			return err
+		}
+		fn.results = []paramDecl{{typ: t, pos: t.pos}}
+		return nil
+	}
+	// Named results, or an unnamed list kept for older signature text.
+	named := p.peekAt(1).kind == tokIdent && p.peekAt(2).text == ":"
+	fn.results, err = p.paramList(named)
+	return err
+}
+
+func (p *parser) paramList(named bool) ([]paramDecl, error) {
+	if err := p.expect("("); err != nil {
+		return nil, err
+	}
+	var params []paramDecl
+	for !p.is(")") {
+		var param paramDecl
+		param.pos = p.pos()
+		if named {
+			name, _, err := p.ident()
+			if err != nil {
+				return nil, err
+			}
+			if err := p.expect(":"); err != nil {
+				return nil, err
+			}
+			param.name = name
+		}
+		t, err := p.typeExpr()
+		if err != nil {
+			return nil, err
+		}
+		param.typ = t
+		params = append(params, param)
+		if !p.accept(",") {
+			break
+		}
+	}
+	return params, p.expect(")")
+}
+
+// typeDecl parses a type, record, variant, enum, flags or resource
+// declaration. ok is false when the current token starts none of them.
+func (p *parser) typeDecl(docs string, stability wit.Stability) (*typeDecl, bool, error) {
+	t := p.peek()
+	if t.kind != tokIdent {
+		return nil, false, nil
+	}
+	switch t.text {
+	case "type", "record", "variant", "enum", "flags", "resource":
+	default:
+		return nil, false, nil
+	}
+	// "type: func()" and friends are functions named after keywords.
+	if p.peekAt(1).text == ":" {
+		return nil, false, nil
+	}
+	p.next()
+	name, _, err := p.ident()
+	if err != nil {
+		return nil, true, err
+	}
+	td := &typeDecl{kind: t.text, name: name, docs: docs, stability: stability, pos: pos{line: t.line, col: t.col}}
+
+	switch td.kind {
+	case "type":
+		if err := p.expect("="); err != nil {
+			return nil, true, err
+		}
+		if td.alias, err = p.typeExpr(); err != nil {
+			return nil, true, err
+		}
+		p.semi()
+	case "resource":
+		if p.accept("{") {
+			for !p.accept("}") {
+				if p.peek().kind == tokEOF {
+					t := p.peek()
+					return nil, true, errorf(t.line, t.col, "expected '}', found %s", t)
+				}
+				fn, err := p.resourceFunc()
+				if err != nil {
+					return nil, true, err
+				}
+				td.funcs = append(td.funcs, fn)
+			}
+		}
+		p.semi()
+	default:
+		if err := p.expect("{"); err != nil {
+			return nil, true, err
+		}
+		for !p.is("}") {
+			f := fieldDecl{docs: p.peek().docs, pos: p.pos()}
+			if f.name, _, err = p.ident(); err != nil {
+				return nil, true, err
+			}
+			switch td.kind {
+			case "record":
+				if err := p.expect(":"); err != nil {
+					return nil, true, err
+				}
+				if f.typ, err = p.typeExpr(); err != nil {
+					return nil, true, err
+				}
+			case "variant":
+				if p.accept("(") {
+					if f.typ, err = p.typeExpr(); err != nil {
+						return nil, true, err
+					}
+					if err := p.expect(")"); err != nil {
+						return nil, true, err
+					}
+				}
+			}
+			td.fields = append(td.fields, f)
+			if !p.accept(",") {
+				break
+			}
+		}
+		if err := p.expect("}"); err != nil {
+			return nil, true, err
+		}
+		p.semi()
+	}
+	return td, true, nil
+}
+
+func (p *parser) resourceFunc() (*funcDecl, error) {
+	docs := p.peek().docs
+	stability, err := p.gates()
+	if err != nil {
+		return nil, err
+	}
+	start := p.pos()
+	if p.accept("constructor") {
+		fn := &funcDecl{name: "constructor", kind: "constructor", docs: docs, stability: stability, pos: start}
+		if fn.params, err = p.paramList(true); err != nil {
+			return nil, err
+		}
+		if p.accept("->") {
+			t, err := p.typeExpr()
+			if err != nil {
+				return nil, err
+			}
+			fn.results = []paramDecl{{typ: t, pos: t.pos}}
+		}
+		p.semi()
+		return fn, nil
+	}
+
+	name, _, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect(":"); err != nil {
+		return nil, err
+	}
+	fn := &funcDecl{name: name, kind: "method", docs: docs, stability: stability, pos: start}
+	if p.accept("static") {
+		fn.kind = "static"
+	}
+	if err := p.funcType(fn); err != nil {
+		return nil, err
+	}
+	p.semi()
+	return fn, nil
+}
+
+// useNames parses `use path.{a, b as c};`.
+func (p *parser) useNames() (*useDecl, error) {
+	start := p.pos()
+	p.next()
+	path, err := p.usePath()
+	if err != nil {
+		return nil, err
+	}
+	if err := p.expect("."); err != nil {
+		return nil, err
+	}
+	if err := p.expect("{"); err != nil {
+		return nil, err
+	}
+	u := &useDecl{path: path, pos: start}
+	for !p.is("}") {
+		name, npos, err := p.ident()
+		if err != nil {
+			return nil, err
+		}
+		n := useName{name: name, as: name, pos: npos}
+		if p.accept("as") {
+			if n.as, _, err = p.ident(); err != nil {
+				return nil, err
+			}
+		}
+		u.names = append(u.names, n)
+		if !p.accept(",") {
+			break
+		}
+	}
+	if err := p.expect("}"); err != nil {
+		return nil, err
+	}
+	p.semi()
+	return u, nil
+}
+
+func (p *parser) worldBody(w *worldDecl) error {
+	if err := p.expect("{"); err != nil {
+		return err
+	}
+	for !p.accept("}") {
+		if p.peek().kind == tokEOF {
+			t := p.peek()
+			return errorf(t.line, t.col, "expected '}', found %s", t)
+		}
+		item, err := p.worldItem(false)
+		if err != nil {
+			return err
+		}
+		w.items = append(w.items, item)
+	}
+	return nil
+}
+
+// worldItem parses an import, export, include, use or type declaration.
+// With loose set, a bare `name: func(...)` is accepted as an export.
+func (p *parser) worldItem(loose bool) (any, error) {
+	docs := p.peek().docs
+	stability, err := p.gates()
+	if err != nil {
+		return nil, err
+	}
+	start := p.pos()
+
+	switch {
+	case p.is("use"):
+		return p.useNames()
+	case p.is("include") && p.peekAt(1).text != ":":
+		p.next()
+		path, err := p.usePath()
+		if err != nil {
+			return nil, err
+		}
+		inc := &includeDecl{path: path, stability: stability, pos: start}
+		if p.accept("with") {
+			if err := p.expect("{"); err != nil {
+				return nil, err
+			}
+			for !p.is("}") {
+				name, npos, err := p.ident()
+				if err != nil {
+					return nil, err
+				}
+				if err := p.expect("as"); err != nil {
+					return nil, err
+				}
+				as, _, err := p.ident()
+				if err != nil {
+					return nil, err
+				}
+				inc.with = append(inc.with, useName{name: name, as: as, pos: npos})
+				if !p.accept(",") {
+					break
+				}
+			}
+			if err := p.expect("}"); err != nil {
+				return nil, err
+			}
+		}
+		p.semi()
+		return inc, nil
+	case (p.is("import") || p.is("export")) && p.peekAt(1).text != ":":
+		ext := &externDecl{export: p.next().text == "export", docs: docs, stability: stability, pos: start}
+		if p.peek().kind == tokIdent && p.peekAt(1).text == ":" && p.peekAt(2).kind == tokIdent &&
+			(p.peekAt(2).text == "func" || p.peekAt(2).text == "async" || p.peekAt(2).text == "interface") {
+			name, npos, _ := p.ident()
+			p.next()
+			ext.name = name
+			if p.accept("interface") {
+				ext.iface = &interfaceDecl{docs: docs, stability: stability, pos: npos}
+				if err := p.interfaceBody(ext.iface); err != nil {
+					return nil, err
+				}
+			} else {
+				ext.fn = &funcDecl{name: name, kind: "func", docs: docs, stability: stability, pos: npos}
+				if err := p.funcType(ext.fn); err != nil {
+					return nil, err
+				}
+			}
+			p.semi()
+			return ext, nil
+		}
+		path, err := p.usePath()
+		if err != nil {
+			return nil, err
+		}
+		ext.path = &path
+		p.semi()
+		return ext, nil
+	}
+
+	if td, ok, err := p.typeDecl(docs, stability); ok || err != nil {
+		return td, err
+	}
+	if loose {
+		fn, err := p.namedFunc(docs, stability)
+		if err != nil {
+			return nil, err
+		}
+		return &externDecl{export: true, name: fn.name, fn: fn, docs: docs, stability: stability, pos: fn.pos}, nil
+	}
+	t := p.peek()
+	return nil, errorf(t.line, t.col, "expected 'import', 'export', 'include', 'use' or type declaration, found %s", t)
+}
+
+var generics = map[string]bool{
+	"list": true, "option": true, "result": true, "tuple": true,
+	"own": true, "borrow": true, "future": true, "stream": true,
+}
+
+func (p *parser) typeExpr() (*typeExpr, error) {
+	name, start, err := p.ident()
+	if err != nil {
+		return nil, err
+	}
+	t := &typeExpr{name: name, pos: start}
+	if !generics[name] || !p.accept("<") {
+		return t, nil
+	}
+	for {
+		if name == "result" && p.accept("_") {
+			t.args = append(t.args, nil)
+		} else if name == "list" && len(t.args) == 1 && p.peek().kind == tokInt {
+			t.size = p.next().text
+		} else {
+			arg, err := p.typeExpr()
+			if err != nil {
+				return nil, err
+			}
+			t.args = append(t.args, arg)
+		}
+		if !p.accept(",") {
+			break
+		}
+	}
+	if err := p.expect(">"); err != nil {
+		return nil, err
+	}
+	return t, nil
+}
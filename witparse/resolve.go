@@ -0,0 +1,784 @@
+package witparse
+
+import (
+	"strings"
+
+	"go.bytecodealliance.org/wit"
+	"go.bytecodealliance.org/wit/ordered"
+)
+
+type orderedItems = ordered.Map[string, wit.WorldItem]
+
+const (
+	unresolved = iota
+	resolving
+	resolved
+)
+
+type resolver struct {
+	res      *wit.Resolve
+	packages map[string]*pkgState // by ns:pkg and ns:pkg@version
+}
+
+type pkgState struct {
+	decl       *packageDecl
+	pkg        *wit.Package
+	interfaces map[string]*ifaceState
+	worlds     map[string]*worldState
+	aliases    map[string]*ifaceState // top-level `use ... as name`
+}
+
+// scope holds the named types visible in an interface or world body.
+type scope struct {
+	owner wit.TypeOwner
+	pkg   *pkgState
+	types map[string]*typeState
+}
+
+type typeState struct {
+	decl  *typeDecl
+	use   *useName
+	from  *ifaceState // interface a used type comes from
+	def   *wit.TypeDef
+	scope *scope
+	state int
+}
+
+type ifaceState struct {
+	decl  *interfaceDecl
+	iface *wit.Interface
+	scope *scope
+	deps  []*ifaceState // interfaces referenced through `use`
+	state int
+}
+
+type worldState struct {
+	decl  *worldDecl
+	world *wit.World
+	scope *scope
+	state int
+}
+
+func resolveFile(f *file) (*wit.Resolve, error) {
+	r := &resolver{res: &wit.Resolve{}, packages: make(map[string]*pkgState)}
+
+	var pkgs []*pkgState
+	for _, decl := range f.packages {
+		ps, err := r.declarePackage(decl)
+		if err != nil {
+			return nil, err
+		}
+		pkgs = append(pkgs, ps)
+	}
+	for _, ps := range pkgs {
+		for _, u := range ps.decl.uses {
+			target, err := r.lookupInterface(ps, u.path)
+			if err != nil {
+				return nil, err
+			}
+			if _, dup := ps.aliases[u.alias]; dup || ps.interfaces[u.alias] != nil {
+				return nil, u.pos.errorf("name %q is already defined", u.alias)
+			}
+			ps.aliases[u.alias] = target
+		}
+	}
+	for _, ps := range pkgs {
+		for _, decl := range ps.decl.interfaces {
+			if err := r.resolveInterface(ps.interfaces[decl.name]); err != nil {
+				return nil, err
+			}
+		}
+		for _, decl := range ps.decl.worlds {
+			if err := r.resolveWorld(ps.worlds[decl.name]); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return r.res, nil
+}
+
+func (r *resolver) declarePackage(decl *packageDecl) (*pkgState, error) {
+	name := decl.name
+	if name == "" {
+		name = "root:component"
+	}
+	id, err := wit.ParseIdent(name)
+	if err != nil {
+		return nil, decl.pos.errorf("invalid package name %q: %v", name, err)
+	}
+	ps := &pkgState{
+		decl:       decl,
+		pkg:        &wit.Package{Name: id, Docs: wit.Docs{Contents: decl.docs}},
+		interfaces: make(map[string]*ifaceState),
+		worlds:     make(map[string]*worldState),
+		aliases:    make(map[string]*ifaceState),
+	}
+	key := id.Namespace + ":" + id.Package
+	if _, dup := r.packages[name]; dup {
+		return nil, decl.pos.errorf("package %s is defined more than once", name)
+	}
+	r.packages[name] = ps
+	if _, ok := r.packages[key]; !ok {
+		r.packages[key] = ps
+	}
+	r.res.Packages = append(r.res.Packages, ps.pkg)
+
+	for _, idecl := range decl.interfaces {
+		if ps.interfaces[idecl.name] != nil {
+			return nil, idecl.pos.errorf("interface %q is defined more than once", idecl.name)
+		}
+		is, err := r.declareInterface(ps, idecl)
+		if err != nil {
+			return nil, err
+		}
+		ps.interfaces[idecl.name] = is
+		ps.pkg.Interfaces.Set(idecl.name, is.iface)
+	}
+	for _, wdecl := range decl.worlds {
+		if ps.worlds[wdecl.name] != nil || ps.interfaces[wdecl.name] != nil {
+			return nil, wdecl.pos.errorf("name %q is already defined", wdecl.name)
+		}
+		w := &wit.World{
+			Name:      wdecl.name,
+			Package:   ps.pkg,
+			Stability: wdecl.stability,
+			Docs:      wit.Docs{Contents: wdecl.docs},
+		}
+		ws := &worldState{decl: wdecl, world: w, scope: newScope(w, ps)}
+		for _, item := range wdecl.items {
+			if td, ok := item.(*typeDecl); ok {
+				if err := ws.scope.declare(td.name, &typeState{decl: td}, td.pos); err != nil {
+					return nil, err
+				}
+			}
+		}
+		ps.worlds[wdecl.name] = ws
+		ps.pkg.Worlds.Set(wdecl.name, w)
+	}
+	return ps, nil
+}
+
+// declareInterface creates the interface and registers its local type
+// names; bodies are resolved later so declarations can appear in any order.
+func (r *resolver) declareInterface(ps *pkgState, decl *interfaceDecl) (*ifaceState, error) {
+	iface := &wit.Interface{
+		Package:   ps.pkg,
+		Stability: decl.stability,
+		Docs:      wit.Docs{Contents: decl.docs},
+	}
+	if decl.name != "" {
+		name := decl.name
+		iface.Name = &name
+	}
+	is := &ifaceState{decl: decl, iface: iface, scope: newScope(iface, ps)}
+	for _, item := range decl.items {
+		if td, ok := item.(*typeDecl); ok {
+			if err := is.scope.declare(td.name, &typeState{decl: td}, td.pos); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return is, nil
+}
+
+func newScope(owner wit.TypeOwner, ps *pkgState) *scope {
+	return &scope{owner: owner, pkg: ps, types: make(map[string]*typeState)}
+}
+
+func (s *scope) declare(name string, ts *typeState, at pos) error {
+	if _, dup := s.types[name]; dup {
+		return at.errorf("type %q is defined more than once", name)
+	}
+	ts.scope = s
+	s.types[name] = ts
+	return nil
+}
+
+// lookupInterface finds the interface a use path refers to, relative to ps.
+func (r *resolver) lookupInterface(ps *pkgState, path usePath) (*ifaceState, error) {
+	if path.pkg == "" {
+		if is := ps.interfaces[path.name]; is != nil {
+			return is, nil
+		}
+		if is := ps.aliases[path.name]; is != nil {
+			return is, nil
+		}
+		return nil, path.pos.errorf("interface %q is not defined", path.name)
+	}
+	key := path.pkg
+	if path.ver != "" {
+		key += "@" + path.ver
+	}
+	target := r.packages[key]
+	if target == nil {
+		return nil, path.pos.errorf("package %s is not defined", key)
+	}
+	if is := target.interfaces[path.name]; is != nil {
+		return is, nil
+	}
+	return nil, path.pos.errorf("interface %s is not defined", path)
+}
+
+func (r *resolver) lookupWorld(ps *pkgState, path usePath) (*worldState, error) {
+	target := ps
+	if path.pkg != "" {
+		key := path.pkg
+		if path.ver != "" {
+			key += "@" + path.ver
+		}
+		if target = r.packages[key]; target == nil {
+			return nil, path.pos.errorf("package %s is not defined", key)
+		}
+	}
+	if ws := target.worlds[path.name]; ws != nil {
+		return ws, nil
+	}
+	return nil, path.pos.errorf("world %s is not defined", path)
+}
+
+// resolveInterface resolves every type and function of an interface and
+// appends it to the Resolve after the interfaces it uses.
+func (r *resolver) resolveInterface(is *ifaceState) error {
+	switch is.state {
+	case resolved:
+		return nil
+	case resolving:
+		return is.decl.pos.errorf("interface %s depends on itself through use", interfaceLabel(is))
+	}
+	is.state = resolving
+
+	for _, item := range is.decl.items {
+		u, ok := item.(*useDecl)
+		if !ok {
+			continue
+		}
+		if err := r.resolveUse(is.scope, u, &is.deps); err != nil {
+			return err
+		}
+		for _, n := range u.names {
+			if _, err := r.resolveNamed(is.scope.types[n.as]); err != nil {
+				return err
+			}
+		}
+	}
+	for _, item := range is.decl.items {
+		switch item := item.(type) {
+		case *typeDecl:
+			def, err := r.resolveNamed(is.scope.types[item.name])
+			if err != nil {
+				return err
+			}
+			for _, fn := range item.funcs {
+				f, err := r.resourceFunc(is.scope, def, fn)
+				if err != nil {
+					return err
+				}
+				if is.iface.Functions.Set(f.Name, f) {
+					return fn.pos.errorf("function %q is defined more than once", f.Name)
+				}
+			}
+		case *funcDecl:
+			f, err := r.function(is.scope, item, item.name, &wit.Freestanding{})
+			if err != nil {
+				return err
+			}
+			if is.iface.Functions.Set(f.Name, f) {
+				return item.pos.errorf("function %q is defined more than once", f.Name)
+			}
+		}
+	}
+
+	is.state = resolved
+	r.res.Interfaces = append(r.res.Interfaces, is.iface)
+	return nil
+}
+
+// resolveUse resolves the interface named by u and declares its names in s.
+func (r *resolver) resolveUse(s *scope, u *useDecl, deps *[]*ifaceState) error {
+	from, err := r.lookupInterface(s.pkg, u.path)
+	if err != nil {
+		return err
+	}
+	if err := r.resolveInterface(from); err != nil {
+		return err
+	}
+	*deps = appendUnique(*deps, from)
+	for i := range u.names {
+		n := &u.names[i]
+		if _, ok := from.scope.types[n.name]; !ok {
+			return n.pos.errorf("type %q is not defined in interface %s", n.name, interfaceLabel(from))
+		}
+		if err := s.declare(n.as, &typeState{use: n, from: from}, n.pos); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func appendUnique(list []*ifaceState, is *ifaceState) []*ifaceState {
+	for _, x := range list {
+		if x == is {
+			return list
+		}
+	}
+	return append(list, is)
+}
+
+func interfaceLabel(is *ifaceState) string {
+	if is.iface.Name == nil {
+		return "(inline)"
+	}
+	return *is.iface.Name
+}
+
+// resolveNamed builds the TypeDef for a named type, resolving the types
+// it refers to first.
+func (r *resolver) resolveNamed(ts *typeState) (*wit.TypeDef, error) {
+	switch ts.state {
+	case resolved:
+		return ts.def, nil
+	case resolving:
+		return nil, ts.pos().errorf("type %q refers to itself", ts.name())
+	}
+	ts.state = resolving
+
+	name := ts.name()
+	def := &wit.TypeDef{Name: &name, Owner: ts.scope.owner}
+	if ts.use != nil {
+		target, err := r.resolveNamed(ts.from.scope.types[ts.use.name])
+		if err != nil {
+			return nil, err
+		}
+		def.Kind = target
+	} else {
+		def.Stability = ts.decl.stability
+		def.Docs = wit.Docs{Contents: ts.decl.docs}
+		kind, err := r.typeDefKind(ts.scope, ts.decl)
+		if err != nil {
+			return nil, err
+		}
+		def.Kind = kind
+	}
+
+	ts.def = def
+	ts.state = resolved
+	r.res.TypeDefs = append(r.res.TypeDefs, def)
+	if iface, ok := ts.scope.owner.(*wit.Interface); ok {
+		iface.TypeDefs.Set(name, def)
+	}
+	return def, nil
+}
+
+func (ts *typeState) name() string {
+	if ts.use != nil {
+		return ts.use.as
+	}
+	return ts.decl.name
+}
+
+func (ts *typeState) pos() pos {
+	if ts.use != nil {
+		return ts.use.pos
+	}
+	return ts.decl.pos
+}
+
+func (r *resolver) typeDefKind(s *scope, td *typeDecl) (wit.TypeDefKind, error) {
+	switch td.kind {
+	case "type":
+		return r.typeRef(s, td.alias, false)
+	case "resource":
+		return &wit.Resource{}, nil
+	case "record":
+		rec := &wit.Record{}
+		for _, f := range td.fields {
+			t, err := r.typeRef(s, f.typ, true)
+			if err != nil {
+				return nil, err
+			}
+			rec.Fields = append(rec.Fields, wit.Field{Name: f.name, Type: t, Docs: wit.Docs{Contents: f.docs}})
+		}
+		return rec, checkUnique(td)
+	case "variant":
+		v := &wit.Variant{}
+		for _, f := range td.fields {
+			c := wit.Case{Name: f.name, Docs: wit.Docs{Contents: f.docs}}
+			if f.typ != nil {
+				t, err := r.typeRef(s, f.typ, true)
+				if err != nil {
+					return nil, err
+				}
+				c.Type = t
+			}
+			v.Cases = append(v.Cases, c)
+		}
+		if len(v.Cases) == 0 {
+			return nil, td.pos.errorf("variant %q has no cases", td.name)
+		}
+		return v, checkUnique(td)
+	case "enum":
+		e := &wit.Enum{}
+		for _, f := range td.fields {
+			e.Cases = append(e.Cases, wit.EnumCase{Name: f.name, Docs: wit.Docs{Contents: f.docs}})
+		}
+		if len(e.Cases) == 0 {
+			return nil, td.pos.errorf("enum %q has no cases", td.name)
+		}
+		return e, checkUnique(td)
+	case "flags":
+		fl := &wit.Flags{}
+		for _, f := range td.fields {
+			fl.Flags = append(fl.Flags, wit.Flag{Name: f.name, Docs: wit.Docs{Contents: f.docs}})
+		}
+		if len(fl.Flags) > 64 {
+			return nil, td.pos.errorf("flags %q has more than 64 flags", td.name)
+		}
+		return fl, checkUnique(td)
+	}
+	return nil, td.pos.errorf("unknown type declaration %q", td.kind)
+}
+
+func checkUnique(td *typeDecl) error {
+	seen := make(map[string]bool, len(td.fields))
+	for _, f := range td.fields {
+		if seen[f.name] {
+			return f.pos.errorf("%q is defined more than once in %s %q", f.name, td.kind, td.name)
+		}
+		seen[f.name] = true
+	}
+	return nil
+}
+
+// typeRef resolves a type expression. In value positions a bare resource
+// name means own<resource>; type aliases refer to the resource itself.
+func (r *resolver) typeRef(s *scope, t *typeExpr, value bool) (wit.Type, error) {
+	if len(t.args) == 0 && !generics[t.name] || t.name == "result" && len(t.args) == 0 {
+		if t.name == "result" {
+			return r.anon(&wit.Result{}), nil
+		}
+		if prim, err := wit.ParseType(t.name); err == nil && !strings.HasPrefix(t.name, "float") {
+			return prim, nil
+		}
+		ts := s.types[t.name]
+		if ts == nil {
+			return nil, t.pos.errorf("type %q is not defined", t.name)
+		}
+		def, err := r.resolveNamed(ts)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := def.Root().Kind.(*wit.Resource); ok && value {
+			return r.anon(&wit.Own{Type: def}), nil
+		}
+		return def, nil
+	}
+
+	switch t.name {
+	case "future", "stream":
+		var elem wit.Type
+		if len(t.args) > 1 {
+			return nil, t.pos.errorf("%s takes at most one type argument", t.name)
+		}
+		if len(t.args) == 1 {
+			var err error
+			if elem, err = r.typeRef(s, t.args[0], true); err != nil {
+				return nil, err
+			}
+		}
+		if t.name == "future" {
+			return r.anon(&wit.Future{Type: elem}), nil
+		}
+		if elem == nil {
+			return nil, t.pos.errorf("stream requires a type argument")
+		}
+		return r.anon(&wit.Stream{Type: elem}), nil
+	case "own", "borrow":
+		if len(t.args) != 1 || t.args[0] == nil || len(t.args[0].args) > 0 {
+			return nil, t.pos.errorf("%s requires a resource type argument", t.name)
+		}
+		ts := s.types[t.args[0].name]
+		if ts == nil {
+			return nil, t.args[0].pos.errorf("type %q is not defined", t.args[0].name)
+		}
+		def, err := r.resolveNamed(ts)
+		if err != nil {
+			return nil, err
+		}
+		if _, ok := def.Root().Kind.(*wit.Resource); !ok {
+			return nil, t.args[0].pos.errorf("type %q is not a resource", t.args[0].name)
+		}
+		if t.name == "own" {
+			return r.anon(&wit.Own{Type: def}), nil
+		}
+		return r.anon(&wit.Borrow{Type: def}), nil
+	}
+
+	args := make([]wit.Type, len(t.args))
+	for i, a := range t.args {
+		if a == nil {
+			continue
+		}
+		at, err := r.typeRef(s, a, true)
+		if err != nil {
+			return nil, err
+		}
+		args[i] = at
+	}
+
+	switch t.name {
+	case "list":
+		if len(args) != 1 {
+			return nil, t.pos.errorf("list takes one type argument")
+		}
+		if t.size != "" {
+			return nil, t.pos.errorf("fixed-size lists are not supported")
+		}
+		return r.anon(&wit.List{Type: args[0]}), nil
+	case "option":
+		if len(args) != 1 {
+			return nil, t.pos.errorf("option takes one type argument")
+		}
+		return r.anon(&wit.Option{Type: args[0]}), nil
+	case "result":
+		if len(args) > 2 {
+			return nil, t.pos.errorf("result takes at most two type arguments")
+		}
+		res := &wit.Result{OK: args[0]}
+		if len(args) == 2 {
+			res.Err = args[1]
+		}
+		return r.anon(res), nil
+	case "tuple":
+		return r.anon(&wit.Tuple{Types: args}), nil
+	}
+	return nil, t.pos.errorf("type %q does not take type arguments", t.name)
+}
+
+// anon wraps an anonymous type such as list<u8> in an unnamed TypeDef.
+func (r *resolver) anon(kind wit.TypeDefKind) *wit.TypeDef {
+	def := &wit.TypeDef{Kind: kind}
+	r.res.TypeDefs = append(r.res.TypeDefs, def)
+	return def
+}
+
+func (r *resolver) function(s *scope, fd *funcDecl, name string, kind wit.FunctionKind) (*wit.Function, error) {
+	if fd.async {
+		return nil, fd.pos.errorf("async functions are not supported")
+	}
+	f := &wit.Function{
+		Name:      name,
+		Kind:      kind,
+		Stability: fd.stability,
+		Docs:      wit.Docs{Contents: fd.docs},
+	}
+	seen := make(map[string]bool)
+	for _, p := range fd.params {
+		if seen[p.name] {
+			return nil, p.pos.errorf("parameter %q is defined more than once", p.name)
+		}
+		seen[p.name] = true
+		t, err := r.typeRef(s, p.typ, true)
+		if err != nil {
+			return nil, err
+		}
+		f.Params = append(f.Params, wit.Param{Name: p.name, Type: t})
+	}
+	for _, p := range fd.results {
+		t, err := r.typeRef(s, p.typ, true)
+		if err != nil {
+			return nil, err
+		}
+		f.Results = append(f.Results, wit.Param{Name: p.name, Type: t})
+	}
+	return f, nil
+}
+
+// resourceFunc builds a resource constructor, method or static function
+// using the canonical [constructor]r, [method]r.name and [static]r.name
+// names. Methods take borrow<r> as their implicit self parameter.
+func (r *resolver) resourceFunc(s *scope, res *wit.TypeDef, fd *funcDecl) (*wit.Function, error) {
+	rname := *res.Name
+	switch fd.kind {
+	case "constructor":
+		f, err := r.function(s, fd, "[constructor]"+rname, &wit.Constructor{Type: res})
+		if err != nil {
+			return nil, err
+		}
+		if len(f.Results) == 0 {
+			f.Results = []wit.Param{{Type: r.anon(&wit.Own{Type: res})}}
+		}
+		return f, nil
+	case "static":
+		return r.function(s, fd, "[static]"+rname+"."+fd.name, &wit.Static{Type: res})
+	default:
+		f, err := r.function(s, fd, "[method]"+rname+"."+fd.name, &wit.Method{Type: res})
+		if err != nil {
+			return nil, err
+		}
+		self := wit.Param{Name: "self", Type: r.anon(&wit.Borrow{Type: res})}
+		f.Params = append([]wit.Param{self}, f.Params...)
+		return f, nil
+	}
+}
+
+// resolveWorld resolves a world's items. Interfaces used by imported or
+// exported interfaces are imported implicitly ahead of them, and included
+// worlds contribute their items in place.
+func (r *resolver) resolveWorld(ws *worldState) error {
+	switch ws.state {
+	case resolved:
+		return nil
+	case resolving:
+		return ws.decl.pos.errorf("world %q includes itself", ws.decl.name)
+	}
+	ws.state = resolving
+	w := ws.world
+
+	var deps []*ifaceState
+	for _, item := range ws.decl.items {
+		switch item := item.(type) {
+		case *useDecl:
+			before := len(deps)
+			if err := r.resolveUse(ws.scope, item, &deps); err != nil {
+				return err
+			}
+			for _, dep := range deps[before:] {
+				r.importDeps(w, dep)
+				r.importInterface(w, dep)
+			}
+			for _, n := range item.names {
+				def, err := r.resolveNamed(ws.scope.types[n.as])
+				if err != nil {
+					return err
+				}
+				w.Imports.Set(n.as, def)
+			}
+		case *typeDecl:
+			def, err := r.resolveNamed(ws.scope.types[item.name])
+			if err != nil {
+				return err
+			}
+			w.Imports.Set(item.name, def)
+		case *includeDecl:
+			if err := r.include(ws, item); err != nil {
+				return err
+			}
+		case *externDecl:
+			if err := r.extern(ws, item); err != nil {
+				return err
+			}
+		}
+	}
+	ws.state = resolved
+	r.res.Worlds = append(r.res.Worlds, w)
+	return nil
+}
+
+func (r *resolver) extern(ws *worldState, ext *externDecl) error {
+	w := ws.world
+	items := &w.Imports
+	if ext.export {
+		items = &w.Exports
+	}
+
+	var (
+		key  string
+		item wit.WorldItem
+	)
+	switch {
+	case ext.fn != nil:
+		f, err := r.function(ws.scope, ext.fn, ext.name, &wit.Freestanding{})
+		if err != nil {
+			return err
+		}
+		key, item = ext.name, f
+	case ext.iface != nil:
+		is, err := r.declareInterface(ws.scope.pkg, ext.iface)
+		if err != nil {
+			return err
+		}
+		if err := r.resolveInterface(is); err != nil {
+			return err
+		}
+		r.importDeps(w, is)
+		key, item = ext.name, &wit.InterfaceRef{Interface: is.iface, Stability: ext.stability}
+	default:
+		is, err := r.lookupInterface(ws.scope.pkg, *ext.path)
+		if err != nil {
+			return err
+		}
+		if err := r.resolveInterface(is); err != nil {
+			return err
+		}
+		r.importDeps(w, is)
+		key, item = interfaceID(is.iface), &wit.InterfaceRef{Interface: is.iface, Stability: ext.stability}
+	}
+
+	if _, dup := w.Imports.GetOK(key); dup {
+		if ext.export || ext.path == nil {
+			return ext.pos.errorf("%q is already imported", key)
+		}
+		return nil
+	}
+	if _, dup := w.Exports.GetOK(key); dup {
+		return ext.pos.errorf("%q is already exported", key)
+	}
+	items.Set(key, item)
+	return nil
+}
+
+// importDeps imports the interfaces is uses, transitively, unless the
+// world already imports or exports them.
+func (r *resolver) importDeps(w *wit.World, is *ifaceState) {
+	for _, dep := range is.deps {
+		r.importDeps(w, dep)
+		r.importInterface(w, dep)
+	}
+}
+
+func (r *resolver) importInterface(w *wit.World, is *ifaceState) {
+	key := interfaceID(is.iface)
+	if _, ok := w.Imports.GetOK(key); ok {
+		return
+	}
+	if _, ok := w.Exports.GetOK(key); ok {
+		return
+	}
+	w.Imports.Set(key, &wit.InterfaceRef{Interface: is.iface})
+}
+
+func (r *resolver) include(ws *worldState, inc *includeDecl) error {
+	from, err := r.lookupWorld(ws.scope.pkg, inc.path)
+	if err != nil {
+		return err
+	}
+	if err := r.resolveWorld(from); err != nil {
+		return err
+	}
+	rename := make(map[string]string, len(inc.with))
+	for _, n := range inc.with {
+		rename[n.name] = n.as
+	}
+	copyItems := func(dst, src *orderedItems) {
+		src.All()(func(name string, item wit.WorldItem) bool {
+			if as, ok := rename[name]; ok {
+				name = as
+			}
+			if _, ok := dst.GetOK(name); !ok {
+				dst.Set(name, item)
+			}
+			return true
+		})
+	}
+	copyItems(&ws.world.Imports, &from.world.Imports)
+	copyItems(&ws.world.Exports, &from.world.Exports)
+	return nil
+}
+
+// interfaceID returns ns:pkg/name@version, the key named interfaces are
+// imported and exported under.
+func interfaceID(iface *wit.Interface) string {
+	id := iface.Package.Name
+	id.Extension = *iface.Name
+	return id.String()
+}